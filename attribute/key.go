@@ -3,6 +3,8 @@
 
 package attribute // import "go.opentelemetry.io/otel/attribute"
 
+import "math/big"
+
 // Key represents the key part in key-value pairs. It's a string. The
 // allowed character set in the key depends on the use of the key.
 type Key string
@@ -117,6 +119,30 @@ func (k Key) StringSlice(v []string) KeyValue {
 	}
 }
 
+// BigInt creates a KeyValue instance with a STRING Value holding the base 10
+// representation of v. See [BigIntValue] for details.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- BigInt(name, value).
+func (k Key) BigInt(v *big.Int) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: BigIntValue(v),
+	}
+}
+
+// Decimal creates a KeyValue instance with a STRING Value holding the decimal
+// representation of v. See [DecimalValue] for details.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Decimal(name, value).
+func (k Key) Decimal(v *big.Float) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: DecimalValue(v),
+	}
+}
+
 // Defined returns true for non-empty keys.
 func (k Key) Defined() bool {
 	return len(k) != 0
@@ -5,6 +5,7 @@ package attribute // import "go.opentelemetry.io/otel/attribute"
 
 import (
 	"fmt"
+	"math/big"
 )
 
 // KeyValue holds a key and value pair.
@@ -68,6 +69,18 @@ func StringSlice(k string, v []string) KeyValue {
 	return Key(k).StringSlice(v)
 }
 
+// BigInt creates a KeyValue with a STRING Value type holding the base 10
+// representation of v. See [BigIntValue] for details.
+func BigInt(k string, v *big.Int) KeyValue {
+	return Key(k).BigInt(v)
+}
+
+// Decimal creates a KeyValue with a STRING Value type holding the decimal
+// representation of v. See [DecimalValue] for details.
+func Decimal(k string, v *big.Float) KeyValue {
+	return Key(k).Decimal(v)
+}
+
 // Stringer creates a new key-value pair with a passed name and a string
 // value generated by the passed Stringer interface.
 func Stringer(k string, v fmt.Stringer) KeyValue {
@@ -4,6 +4,7 @@
 package attribute_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -58,6 +59,22 @@ func TestKeyValueConstructors(t *testing.T) {
 				Value: attribute.IntValue(123),
 			},
 		},
+		{
+			name:   "BigInt",
+			actual: attribute.BigInt("k1", new(big.Int).Lsh(big.NewInt(1), 100)),
+			expected: attribute.KeyValue{
+				Key:   "k1",
+				Value: attribute.StringValue("1267650600228229401496703205376"),
+			},
+		},
+		{
+			name:   "Decimal",
+			actual: attribute.Decimal("k1", mustDecimal("12345678901234567890.0123456789")),
+			expected: attribute.KeyValue{
+				Key:   "k1",
+				Value: attribute.StringValue("12345678901234567890.0123456789"),
+			},
+		},
 	}
 
 	for _, test := range tt {
@@ -169,3 +186,36 @@ func TestIncorrectCast(t *testing.T) {
 		})
 	}
 }
+
+func mustDecimal(s string) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, 128, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func TestBigIntValueRoundTrip(t *testing.T) {
+	want, _ := new(big.Int).SetString("-170141183460469231731687303715884105728", 10)
+	v := attribute.BigIntValue(want)
+	assert.Equal(t, attribute.STRING, v.Type())
+
+	got, ok := new(big.Int).SetString(v.AsString(), 10)
+	assert.True(t, ok)
+	assert.Equal(t, 0, want.Cmp(got))
+}
+
+func TestDecimalValueRoundTrip(t *testing.T) {
+	want := mustDecimal("0.1")
+	v := attribute.DecimalValue(want)
+	assert.Equal(t, "0.1", v.AsString())
+
+	got, _, err := big.ParseFloat(v.AsString(), 10, want.Prec(), big.ToNearestEven)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, want.Cmp(got))
+}
+
+func TestBigNil(t *testing.T) {
+	assert.Equal(t, "<nil>", attribute.BigIntValue(nil).AsString())
+	assert.Equal(t, "<nil>", attribute.DecimalValue(nil).AsString())
+}
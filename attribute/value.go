@@ -6,6 +6,7 @@ package attribute // import "go.opentelemetry.io/otel/attribute"
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 
@@ -117,6 +118,36 @@ func StringSliceValue(v []string) Value {
 	return Value{vtype: STRINGSLICE, slice: attribute.StringSliceValue(v)}
 }
 
+// BigIntValue creates a STRING Value holding the base 10 representation of
+// v, e.g. "-170141183460469231731687303715884105728". Use it for integers
+// that may not fit in an int64, e.g. 128-bit identifiers or monetary amounts
+// in minor units. Converting them to an INT64 or FLOAT64 Value would silently
+// lose precision.
+//
+// There is no big integer attribute type in OpenTelemetry. The value is
+// exported as a string, e.g. as the string_value of an OTLP AnyValue. It can
+// be parsed back with [big.Int.SetString] using base 10. A nil v is
+// represented as "<nil>".
+func BigIntValue(v *big.Int) Value {
+	return StringValue(v.String())
+}
+
+// DecimalValue creates a STRING Value holding the decimal representation of
+// v without an exponent, e.g. "12345678901234567890.0123456789". The
+// smallest number of digits needed to represent v uniquely at its precision
+// is used. Use it for decimal numbers that cannot be represented by a
+// float64 without losing precision, e.g. monetary amounts.
+//
+// There is no decimal attribute type in OpenTelemetry. The value is exported
+// as a string, e.g. as the string_value of an OTLP AnyValue. It can be parsed
+// back with [big.Float.SetString]. A nil v is represented as "<nil>".
+func DecimalValue(v *big.Float) Value {
+	if v == nil {
+		return StringValue("<nil>")
+	}
+	return StringValue(v.Text('f', -1))
+}
+
 // Type returns a type of the Value.
 func (v Value) Type() Type {
 	return v.vtype
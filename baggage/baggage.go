@@ -423,6 +423,61 @@ func Parse(bStr string) (Baggage, error) {
 	return Baggage{b}, nil
 }
 
+// LookupMember decodes the list-member identified by key from the passed
+// baggage-string without decoding any of the other list-members. It returns
+// an error if the baggage-string exceeds the W3C Baggage specification limits
+// or if the matching list-member is invalid.
+//
+// If there is no list-member matching the passed key the returned Member will
+// be a zero-value Member. If there are duplicate list-members, the last one
+// defined (reading left-to-right) is returned, the same as [Parse].
+//
+// Use this instead of [Parse] when only a single list-member is needed.
+func LookupMember(bStr, key string) (Member, error) {
+	if n := len(bStr); n > maxBytesPerBaggageString {
+		return newInvalidMember(), fmt.Errorf("%w: %d", errBaggageBytes, n)
+	}
+
+	var (
+		match string
+		found bool
+		n     int
+	)
+	for rest := bStr; rest != ""; {
+		var memberStr string
+		memberStr, rest, _ = strings.Cut(rest, listDelimiter)
+		n++
+
+		// Only compare the key, the member is decoded once it is known to be
+		// the last match.
+		k, _, _ := strings.Cut(memberStr, keyValueDelimiter)
+		if strings.TrimSpace(k) == key {
+			match, found = memberStr, true
+		}
+	}
+	if n > maxMembers && countKeys(bStr) > maxMembers {
+		// The same limit as Parse, applied to the deduplicated list-members.
+		return newInvalidMember(), errMemberNumber
+	}
+	if !found {
+		return newInvalidMember(), nil
+	}
+	return parseMember(match)
+}
+
+// countKeys returns the number of distinct list-member keys in the passed
+// baggage-string.
+func countKeys(bStr string) int {
+	keys := make(map[string]struct{})
+	for rest := bStr; rest != ""; {
+		var memberStr string
+		memberStr, rest, _ = strings.Cut(rest, listDelimiter)
+		k, _, _ := strings.Cut(memberStr, keyValueDelimiter)
+		keys[strings.TrimSpace(k)] = struct{}{}
+	}
+	return len(keys)
+}
+
 // Member returns the baggage list-member identified by key.
 //
 // If there is no list-member matching the passed key the returned Member will
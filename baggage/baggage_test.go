@@ -822,6 +822,95 @@ func TestBaggageMember(t *testing.T) {
 	assert.Equal(t, Member{}, bag.Member("bar"))
 }
 
+func TestLookupMember(t *testing.T) {
+	const bStr = "foo=1, bar = 2;prop;propKey=propVal,baz=%20three,foo=4"
+
+	testcases := []struct {
+		name    string
+		key     string
+		want    Member
+		wantErr bool
+	}{
+		{
+			name: "missing",
+			key:  "missing",
+			want: Member{},
+		},
+		{
+			name: "trimmed with properties",
+			key:  "bar",
+			want: Member{
+				key:   "bar",
+				value: "2",
+				properties: properties{
+					{key: "prop"},
+					{key: "propKey", value: "propVal", hasValue: true},
+				},
+				hasData: true,
+			},
+		},
+		{
+			name: "percent-encoded value",
+			key:  "baz",
+			want: Member{key: "baz", value: " three", hasData: true},
+		},
+		{
+			name: "duplicate last-one-wins",
+			key:  "foo",
+			want: Member{key: "foo", value: "4", hasData: true},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := LookupMember(bStr, tc.key)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, m)
+
+			// Ensure the same result as the full parse.
+			b, err := Parse(bStr)
+			require.NoError(t, err)
+			assert.Equal(t, b.Member(tc.key), m)
+		})
+	}
+}
+
+func TestLookupMemberInvalid(t *testing.T) {
+	m, err := LookupMember("foo=1,bar=\\", "bar")
+	assert.ErrorIs(t, err, errInvalidValue)
+	assert.Equal(t, Member{}, m)
+
+	// Invalid list-members not matching the key are not decoded.
+	m, err = LookupMember("foo=1,bar=\\", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, Member{key: "foo", value: "1", hasData: true}, m)
+
+	_, err = LookupMember(strings.Repeat("a", maxBytesPerBaggageString+1), "a")
+	assert.ErrorIs(t, err, errBaggageBytes)
+}
+
+func TestLookupMemberMaxMembers(t *testing.T) {
+	members := make([]string, maxMembers+1)
+	for i := range members {
+		members[i] = fmt.Sprintf("k%d=v", i)
+	}
+	bStr := strings.Join(members, listDelimiter)
+
+	_, err := Parse(bStr)
+	require.ErrorIs(t, err, errMemberNumber, "Parse")
+	m, err := LookupMember(bStr, "k0")
+	assert.ErrorIs(t, err, errMemberNumber)
+	assert.Equal(t, Member{}, m)
+
+	// Duplicate list-members are counted once, as with Parse.
+	bStr = strings.Join(append(members[:maxMembers], "k0=v"), listDelimiter)
+	_, err = Parse(bStr)
+	require.NoError(t, err, "Parse")
+	m, err = LookupMember(bStr, "k0")
+	require.NoError(t, err)
+	assert.Equal(t, Member{key: "k0", value: "v", hasData: true}, m)
+}
+
 func TestMemberKey(t *testing.T) {
 	m := Member{}
 	assert.Equal(t, "", m.Key(), "even invalid values should be returned")
@@ -983,6 +1072,14 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkLookupMember(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		benchMember, _ = LookupMember(`userId=alice,serverNode = DF28 , isProduction = false,hasProp=stuff;propKey;propWValue=value`, "userId")
+	}
+}
+
 func BenchmarkString(b *testing.B) {
 	var members []Member
 	addMember := func(k, v string) {
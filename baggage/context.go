@@ -26,3 +26,12 @@ func FromContext(ctx context.Context) Baggage {
 	// Delegate so any hooks for the OpenTracing bridge are handled.
 	return Baggage{list: baggage.ListFromContext(ctx)}
 }
+
+// MemberFromContext returns the baggage list-member identified by key
+// contained in ctx.
+//
+// If there is no list-member matching the passed key the returned Member will
+// be a zero-value Member.
+func MemberFromContext(ctx context.Context, key string) Member {
+	return FromContext(ctx).Member(key)
+}
@@ -23,3 +23,13 @@ func TestContext(t *testing.T) {
 	ctx = ContextWithoutBaggage(ctx)
 	assert.Equal(t, Baggage{}, FromContext(ctx))
 }
+
+func TestMemberFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Member{}, MemberFromContext(ctx, "key"))
+
+	b := Baggage{list: baggage.List{"key": baggage.Item{Value: "val"}}}
+	ctx = ContextWithBaggage(ctx, b)
+	assert.Equal(t, Member{key: "key", value: "val", hasData: true}, MemberFromContext(ctx, "key"))
+	assert.Equal(t, Member{}, MemberFromContext(ctx, "missing"))
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelapex // import "go.opentelemetry.io/otel/bridge/otelapex"
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a Handler.
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+	// sampledOnly is true if the log records are only correlated with
+	// sampled spans.
+	sampledOnly bool
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	return c
+}
+
+// logger returns the log.Logger named name of the configured provider.
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// Option configures a [Handler].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Handler]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Handler]. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithSampledOnlyCorrelation returns an [Option] that configures a [Handler]
+// to only correlate the log records it emits with sampled spans. The entries
+// with a field holding the context of a span that is not sampled are emitted
+// with no trace context.
+//
+// By default, if this Option is not provided, the log records are correlated
+// with the span of the context field, sampled or not.
+func WithSampledOnlyCorrelation() Option {
+	return optFunc(func(c config) config {
+		c.sampledOnly = true
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Handler] to create its [log.Logger].
+//
+// By default, if this Option is not provided, the Handler will use the global
+// LoggerProvider.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelapex provides an [apex.Handler], a bridge from
+// [github.com/apex/log] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewHandler] to create a [Handler] emitting log records to a
+// [log.Logger] of the configured [log.LoggerProvider], and set it as the
+// Handler of an [apex.Logger] or with [apex.SetHandler]. The entries are
+// filtered by the level of the apex.Logger before they are handled.
+//
+// The log entries are converted as follows:
+//
+//   - The timestamp is the timestamp.
+//   - The message is the body as a string value.
+//   - The level is converted to the severity: DebugLevel, InfoLevel,
+//     WarnLevel, ErrorLevel, and FatalLevel to [log.SeverityDebug],
+//     [log.SeverityInfo], [log.SeverityWarn], [log.SeverityError], and
+//     [log.SeverityFatal]. The severity text is the name of the level.
+//   - The error field, added with [apex.Entry.WithError], is converted to
+//     the exception.message attribute defined by the semantic conventions.
+//   - The [context.Context] field values are not converted, see below.
+//   - The other fields, including the ones added by the error, are converted
+//     to attributes sorted by key.
+//
+// The field values are converted based on the Go value they hold. Booleans,
+// numbers, and strings, including the ones of named types, are converted to
+// the values of the matching kind. Times are converted to their Unix time in
+// nanoseconds, durations to nanoseconds. Byte slices and arrays are converted
+// to [log.KindBytes] values, other slices and arrays to [log.KindSlice]
+// values, and maps to [log.KindMap] values sorted by key. Pointers are
+// dereferenced. Errors and [fmt.Stringer] values are converted to the string
+// they return. Other values are converted to their string representation.
+//
+// The apex entries hold no dedicated context. The [context.Context] value of
+// the last field holding one in key order, e.g. added with
+// [apex.Entry.WithField], is passed to the [log.Logger] so that the log record
+// is correlated with the span it holds. Otherwise, [context.Background] is
+// passed.
+package otelapex // import "go.opentelemetry.io/otel/bridge/otelapex"
@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelapex_test
+
+import (
+	"errors"
+
+	apex "github.com/apex/log"
+
+	"go.opentelemetry.io/otel/bridge/otelapex"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create an apex logger handling the log entries of the warning level
+	// and above with the OpenTelemetry Logs Bridge API.
+	logger := &apex.Logger{
+		Handler: otelapex.NewHandler("my/pkg/name", otelapex.WithLoggerProvider(provider)),
+		Level:   apex.WarnLevel,
+	}
+
+	// The error is emitted as the exception.message attribute.
+	logger.WithField("user", "alice").WithError(errors.New("denied")).Warn("hello")
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelapex // import "go.opentelemetry.io/otel/bridge/otelapex"
+
+import (
+	"context"
+
+	apex "github.com/apex/log"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// errorField is the key of the field holding the message of the error added
+// with apex.Entry.WithError.
+const errorField = "error"
+
+// Compile-time check Handler implements apex.Handler.
+var _ apex.Handler = (*Handler)(nil)
+
+// Handler is an [apex.Handler] that emits the log entries it handles using a
+// [log.Logger].
+//
+// Use [NewHandler] to create a Handler and set it as the Handler of an
+// [apex.Logger], or with [apex.SetHandler].
+type Handler struct {
+	logger      log.Logger
+	sampledOnly bool
+}
+
+// NewHandler returns a new [Handler] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewHandler(name string, options ...Option) *Handler {
+	cfg := newConfig(options)
+	return &Handler{logger: cfg.logger(name), sampledOnly: cfg.sampledOnly}
+}
+
+// HandleLog emits the conversion of entry using the [log.Logger] of h. The
+// entry is not converted if the Logger is not enabled for its severity.
+//
+// The [context.Context] value of the last field holding one, in key order, or
+// [context.Background] if there is none, is passed to the Logger, see
+// [bridgeutil.TraceContext].
+//
+// The returned error is always nil.
+func (h *Handler) HandleLog(entry *apex.Entry) error {
+	var (
+		ctx    context.Context
+		ctxKey string
+	)
+	for k, v := range entry.Fields {
+		// The iteration order of a map is not deterministic, use the last
+		// key in order.
+		if c, ok := v.(context.Context); ok && (ctx == nil || k > ctxKey) {
+			ctx, ctxKey = c, k
+		}
+	}
+	ctx = bridgeutil.TraceContext(ctx, h.sampledOnly)
+
+	var record log.Record
+	record.SetSeverity(bridgeutil.ApexLevelSeverity(entry.Level))
+	if !h.logger.Enabled(ctx, record) {
+		return nil
+	}
+
+	if !entry.Timestamp.IsZero() {
+		record.SetTimestamp(entry.Timestamp)
+	}
+	record.SetBody(log.StringValue(entry.Message))
+	if entry.Level >= apex.DebugLevel && entry.Level <= apex.FatalLevel {
+		// The String method of apex.Level panics for the other levels.
+		record.SetSeverityText(entry.Level.String())
+	}
+
+	if len(entry.Fields) > 0 {
+		// Names returns the keys sorted, the iteration order of a map is not
+		// deterministic.
+		batch := bridgeutil.NewAttrBatch(&record)
+		for _, k := range entry.Fields.Names() {
+			if _, ok := entry.Fields[k].(context.Context); ok {
+				continue
+			}
+			key := k
+			if k == errorField {
+				key = string(semconv.ExceptionMessageKey)
+			}
+			batch.Add(log.KeyValue{Key: key, Value: bridgeutil.ConvertAny(entry.Fields[k])})
+		}
+		batch.Flush()
+	}
+
+	h.logger.Emit(ctx, record)
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelapex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apex "github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newLogger returns an apex.Logger of the debug level using h.
+func newLogger(h apex.Handler) *apex.Logger {
+	return &apex.Logger{Handler: h, Level: apex.DebugLevel}
+}
+
+func TestNewHandler(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	newLogger(h).Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewHandlerGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	newLogger(NewHandler("name")).Info("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestHandleLog(t *testing.T) {
+	orig := apex.Now
+	t.Cleanup(func() { apex.Now = orig })
+	now := time.Unix(0, 1000)
+	apex.Now = func() time.Time { return now }
+
+	rec := logtest.NewRecorder()
+	newLogger(NewHandler("name", WithLoggerProvider(rec))).
+		WithError(errors.New("err")).
+		WithFields(apex.Fields{"b": 1, "a": "v", "t": now}).
+		Warn("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, now, got[0].Timestamp())
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "warn", got[0].SeverityText())
+	assert.Equal(t, []log.KeyValue{
+		log.String("a", "v"),
+		log.Int64("b", 1),
+		log.String(string(semconv.ExceptionMessageKey), "err"),
+		log.Int64("t", 1000),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestHandleLogLevels(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler("name", WithLoggerProvider(rec))
+	levels := []apex.Level{
+		apex.DebugLevel,
+		apex.InfoLevel,
+		apex.WarnLevel,
+		apex.ErrorLevel,
+		apex.FatalLevel,
+	}
+	for _, level := range levels {
+		require.NoError(t, h.HandleLog(&apex.Entry{Level: level, Message: "msg"}))
+	}
+	require.NoError(t, h.HandleLog(&apex.Entry{Level: apex.InvalidLevel, Message: "invalid"}))
+
+	got := rec.Records()
+	require.Len(t, got, len(levels)+1)
+	for i, want := range []log.Severity{
+		log.SeverityDebug,
+		log.SeverityInfo,
+		log.SeverityWarn,
+		log.SeverityError,
+		log.SeverityFatal,
+	} {
+		assert.Equal(t, want, got[i].Severity(), levels[i].String())
+		assert.Equal(t, levels[i].String(), got[i].SeverityText())
+	}
+	assert.Equal(t, log.SeverityUndefined, got[len(levels)].Severity(), "invalid")
+	assert.Equal(t, "", got[len(levels)].SeverityText(), "invalid")
+	assert.True(t, got[len(levels)].Timestamp().IsZero(), "no timestamp")
+}
+
+func TestHandleLogEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	l := newLogger(NewHandler("name", WithLoggerProvider(rec)))
+	l.Debug("debug")
+	l.Info("info")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+}
+
+func TestHandleLogContext(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler("name")
+	h.logger = rec
+	newLogger(h).Info("msg")
+
+	require.Len(t, rec.Contexts(), 1)
+	assert.Equal(t, context.Background(), rec.Contexts()[0])
+}
+
+type ctxKey struct{}
+
+func TestHandleLogContextField(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+	other := context.WithValue(context.Background(), ctxKey{}, "other")
+	rec := logtest.NewRecorder()
+	h := NewHandler("name")
+	h.logger = rec
+	newLogger(h).WithFields(apex.Fields{"b": ctx, "a": other}).Info("msg")
+
+	require.Len(t, rec.Contexts(), 1)
+	assert.Equal(t, ctx, rec.Contexts()[0], "last context field in key order")
+}
+
+func TestHandleLogContextFieldNotConverted(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler("name", WithLoggerProvider(rec))
+	newLogger(h).WithFields(apex.Fields{"ctx": context.Background(), "a": 1}).Info("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1)}, logtest.Attributes(got[0]))
+}
+
+func TestHandleLogSampledOnlyCorrelation(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rec := logtest.NewRecorder()
+	h := NewHandler("name")
+	h.logger = rec
+	newLogger(h).WithField("ctx", ctx).Info("msg")
+
+	h = NewHandler("name", WithSampledOnlyCorrelation())
+	h.logger = rec
+	newLogger(h).WithField("ctx", ctx).Info("msg")
+
+	require.Len(t, rec.Contexts(), 2)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.Contexts()[0]), "default")
+	assert.False(t, trace.SpanContextFromContext(rec.Contexts()[1]).IsValid(), "sampled only")
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkHandler(b *testing.B) {
+	h := NewHandler("name")
+	h.logger = discardLogger{}
+	entry := &apex.Entry{
+		Fields:    apex.Fields{"a": "b", "c": 1, "d": true},
+		Level:     apex.InfoLevel,
+		Timestamp: time.Now(),
+		Message:   "msg",
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = h.HandleLog(entry)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelhclog // import "go.opentelemetry.io/otel/bridge/otelhclog"
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a Logger.
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	return c
+}
+
+// loggers returns the cache of the log.Logger of the configured provider.
+func (c config) loggers() *bridgeutil.LoggerCache {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return bridgeutil.NewLoggerCache(c.provider, opts...)
+}
+
+// Option configures a [Logger].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Logger]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Logger]. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Logger] to create its [log.Logger].
+//
+// By default, if this Option is not provided, the Logger will use the global
+// LoggerProvider.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
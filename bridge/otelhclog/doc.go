@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelhclog provides an [hclog.Logger], a bridge from
+// [github.com/hashicorp/go-hclog] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewLogger] to create a [Logger] emitting log records to a [log.Logger]
+// of the configured [log.LoggerProvider], and pass it to the libraries
+// accepting an hclog.Logger.
+//
+// The log records are converted as follows:
+//
+//   - The time the log record is logged is the timestamp.
+//   - The message is the body as a string value.
+//   - The level is converted to the severity: Trace, Debug, Info, Warn, and
+//     Error to [log.SeverityTrace], [log.SeverityDebug], [log.SeverityInfo],
+//     [log.SeverityWarn], and [log.SeverityError]. The log records of the
+//     NoLevel level have an undefined severity, the ones of the Off level are
+//     not emitted. The severity text is the name of the level.
+//   - The key-value pairs of the arguments, including the ones added with
+//     [Logger.With] returned by [Logger.ImpliedArgs], are converted to
+//     attributes, see [Logger.With].
+//
+// The name of a Logger, set with [Logger.Named] and [Logger.ResetNamed], is the
+// name of the [log.Logger] emitting its log records, i.e. of their
+// instrumentation scope.
+//
+// The log records are not correlated with a span, hclog has no context.
+// [context.Background] is passed to the [log.Logger].
+package otelhclog // import "go.opentelemetry.io/otel/bridge/otelhclog"
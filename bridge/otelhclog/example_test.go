@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelhclog_test
+
+import (
+	"github.com/hashicorp/go-hclog"
+
+	"go.opentelemetry.io/otel/bridge/otelhclog"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create an hclog.Logger emitting log records to the OpenTelemetry Logs
+	// Bridge API.
+	var logger hclog.Logger = otelhclog.NewLogger("my/pkg/name", otelhclog.WithLoggerProvider(provider))
+	logger.SetLevel(hclog.Info)
+
+	// The log records of the named logger are emitted with the
+	// my/pkg/name.db instrumentation scope.
+	logger.Named("db").With("user", "alice").Info("hello", "attempt", 1)
+}
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelhclog // import "go.opentelemetry.io/otel/bridge/otelhclog"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	stdlog "log"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+)
+
+// Compile-time check Logger implements hclog.Logger.
+var _ hclog.Logger = (*Logger)(nil)
+
+// Logger is an [hclog.Logger] that emits the log records it receives using a
+// [log.Logger].
+//
+// Use [NewLogger] to create a Logger.
+type Logger struct {
+	loggers *bridgeutil.LoggerCache
+	logger  log.Logger
+
+	// name is the name of logger.
+	name string
+	// level is the minimum level of the log records emitted, shared by the
+	// Loggers derived from the same NewLogger call.
+	level *atomic.Int32
+	// implied are the arguments added with With.
+	implied []interface{}
+	// attrs are the attributes converted from implied.
+	attrs []log.KeyValue
+}
+
+// NewLogger returns a new [Logger] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewLogger(name string, options ...Option) *Logger {
+	cfg := newConfig(options)
+	loggers := cfg.loggers()
+	return &Logger{
+		loggers: loggers,
+		logger:  loggers.Logger(name),
+		name:    name,
+		level:   new(atomic.Int32),
+	}
+}
+
+// Log emits a log record with the level, msg, and the key-value pairs of
+// args, see [Logger.With]. Nothing is emitted for the [hclog.Off] level, for
+// the levels below the one set with [Logger.SetLevel], or if the [log.Logger]
+// of l is not enabled for the severity of the level.
+func (l *Logger) Log(level hclog.Level, msg string, args ...interface{}) {
+	if level == hclog.Off || level < l.GetLevel() {
+		return
+	}
+
+	ctx := context.Background()
+	var record log.Record
+	record.SetSeverity(convertLevel(level))
+	if !l.logger.Enabled(ctx, record) {
+		return
+	}
+
+	record.SetTimestamp(time.Now())
+	record.SetBody(log.StringValue(msg))
+	if level != hclog.NoLevel {
+		record.SetSeverityText(level.String())
+	}
+	record.AddAttributes(l.attrs...)
+	batch := bridgeutil.NewAttrBatch(&record)
+	convertArgs(args, batch.Add)
+	batch.Flush()
+
+	l.logger.Emit(ctx, record)
+}
+
+// Trace emits a log record with the [hclog.Trace] level, see [Logger.Log].
+func (l *Logger) Trace(msg string, args ...interface{}) { l.Log(hclog.Trace, msg, args...) }
+
+// Debug emits a log record with the [hclog.Debug] level, see [Logger.Log].
+func (l *Logger) Debug(msg string, args ...interface{}) { l.Log(hclog.Debug, msg, args...) }
+
+// Info emits a log record with the [hclog.Info] level, see [Logger.Log].
+func (l *Logger) Info(msg string, args ...interface{}) { l.Log(hclog.Info, msg, args...) }
+
+// Warn emits a log record with the [hclog.Warn] level, see [Logger.Log].
+func (l *Logger) Warn(msg string, args ...interface{}) { l.Log(hclog.Warn, msg, args...) }
+
+// Error emits a log record with the [hclog.Error] level, see [Logger.Log].
+func (l *Logger) Error(msg string, args ...interface{}) { l.Log(hclog.Error, msg, args...) }
+
+// enabled returns if the log records of level are emitted.
+func (l *Logger) enabled(level hclog.Level) bool {
+	if level < l.GetLevel() {
+		return false
+	}
+	var record log.Record
+	record.SetSeverity(convertLevel(level))
+	return l.logger.Enabled(context.Background(), record)
+}
+
+// IsTrace returns if the log records of the [hclog.Trace] level are emitted.
+func (l *Logger) IsTrace() bool { return l.enabled(hclog.Trace) }
+
+// IsDebug returns if the log records of the [hclog.Debug] level are emitted.
+func (l *Logger) IsDebug() bool { return l.enabled(hclog.Debug) }
+
+// IsInfo returns if the log records of the [hclog.Info] level are emitted.
+func (l *Logger) IsInfo() bool { return l.enabled(hclog.Info) }
+
+// IsWarn returns if the log records of the [hclog.Warn] level are emitted.
+func (l *Logger) IsWarn() bool { return l.enabled(hclog.Warn) }
+
+// IsError returns if the log records of the [hclog.Error] level are emitted.
+func (l *Logger) IsError() bool { return l.enabled(hclog.Error) }
+
+// ImpliedArgs returns the arguments added with [Logger.With].
+func (l *Logger) ImpliedArgs() []interface{} {
+	return l.implied
+}
+
+// With returns a new [Logger] adding the key-value pairs of args as
+// attributes to all the log records it emits.
+//
+// The keys that are not strings are formatted with [fmt.Sprint]. A value
+// without a key is added with the [hclog.MissingKey] key. The [hclog.Format]
+// values are formatted with [fmt.Sprintf], the other values are converted with
+// [bridgeutil.ConvertAny].
+func (l *Logger) With(args ...interface{}) hclog.Logger {
+	if len(args) == 0 {
+		return l
+	}
+	l2 := *l
+	l2.implied = append(slices.Clip(l.implied), args...)
+	l2.attrs = slices.Clip(l.attrs)
+	convertArgs(args, func(kv log.KeyValue) { l2.attrs = append(l2.attrs, kv) })
+	return &l2
+}
+
+// Name returns the name of l, the name of the instrumentation scope of the
+// log records it emits.
+func (l *Logger) Name() string {
+	return l.name
+}
+
+// Named returns a new [Logger] emitting the log records using the
+// [log.Logger] named with the name of l and name, separated by a ".".
+func (l *Logger) Named(name string) hclog.Logger {
+	if l.name != "" {
+		name = l.name + "." + name
+	}
+	return l.ResetNamed(name)
+}
+
+// ResetNamed returns a new [Logger] emitting the log records using the
+// [log.Logger] named name.
+func (l *Logger) ResetNamed(name string) hclog.Logger {
+	l2 := *l
+	l2.name = name
+	l2.logger = l.loggers.Logger(name)
+	return &l2
+}
+
+// SetLevel sets the minimum level of the log records emitted by l and by the
+// Loggers derived from the same [NewLogger] call. The levels are still
+// filtered by the [log.Logger].
+//
+// The minimum level is [hclog.NoLevel] by default, all levels are emitted.
+func (l *Logger) SetLevel(level hclog.Level) {
+	l.level.Store(int32(level))
+}
+
+// GetLevel returns the level set with [Logger.SetLevel].
+func (l *Logger) GetLevel() hclog.Level {
+	return hclog.Level(l.level.Load())
+}
+
+// StandardLogger returns a standard library log.Logger writing to
+// [Logger.StandardWriter].
+func (l *Logger) StandardLogger(opts *hclog.StandardLoggerOptions) *stdlog.Logger {
+	return stdlog.New(l.StandardWriter(opts), "", 0)
+}
+
+// StandardWriter returns an [io.Writer] emitting each write as a log record
+// using l. The level of the log records is the one inferred or forced as
+// configured with opts, or [hclog.Info].
+func (l *Logger) StandardWriter(opts *hclog.StandardLoggerOptions) io.Writer {
+	if opts == nil {
+		opts = &hclog.StandardLoggerOptions{}
+	}
+	return &stdWriter{logger: l, opts: *opts}
+}
+
+// convertLevel returns the log.Severity of level.
+func convertLevel(level hclog.Level) log.Severity {
+	switch level {
+	case hclog.Trace:
+		return log.SeverityTrace
+	case hclog.Debug:
+		return log.SeverityDebug
+	case hclog.Info:
+		return log.SeverityInfo
+	case hclog.Warn:
+		return log.SeverityWarn
+	case hclog.Error:
+		return log.SeverityError
+	default:
+		return log.SeverityUndefined
+	}
+}
+
+// convertArgs calls f with the attributes of the key-value pairs of args.
+func convertArgs(args []interface{}, f func(log.KeyValue)) {
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			f(log.KeyValue{Key: hclog.MissingKey, Value: convertValue(args[i])})
+			return
+		}
+		key, ok := args[i].(string)
+		if !ok {
+			key = fmt.Sprint(args[i])
+		}
+		f(log.KeyValue{Key: key, Value: convertValue(args[i+1])})
+	}
+}
+
+// convertValue returns the log.Value of the argument v.
+func convertValue(v interface{}) log.Value {
+	if f, ok := v.(hclog.Format); ok && len(f) > 0 {
+		if format, ok := f[0].(string); ok {
+			return log.StringValue(fmt.Sprintf(format, f[1:]...))
+		}
+	}
+	return bridgeutil.ConvertAny(v)
+}
@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelhclog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+)
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	assert.Equal(t, "name", l.Name())
+	l.Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewLoggerGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	NewLogger("name").Info("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestLoggerLog(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l.Warn("msg",
+		"s", "v",
+		"i", 1,
+		"err", errors.New("err"),
+		"fmt", hclog.Fmt("%d beans", 3),
+		"hex", hclog.Hex(17),
+		"quote", hclog.Quote("a\nb"),
+		42, true,
+		"extra",
+	)
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.False(t, got[0].Timestamp().IsZero(), "timestamp")
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "warn", got[0].SeverityText())
+	assert.Equal(t, []log.KeyValue{
+		log.String("s", "v"),
+		log.Int64("i", 1),
+		log.String("err", "err"),
+		log.String("fmt", "3 beans"),
+		log.Int64("hex", 17),
+		log.String("quote", "a\nb"),
+		log.Bool("42", true),
+		log.String(hclog.MissingKey, "extra"),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestConvertLevel(t *testing.T) {
+	for level, want := range map[hclog.Level]log.Severity{
+		hclog.NoLevel: log.SeverityUndefined,
+		hclog.Trace:   log.SeverityTrace,
+		hclog.Debug:   log.SeverityDebug,
+		hclog.Info:    log.SeverityInfo,
+		hclog.Warn:    log.SeverityWarn,
+		hclog.Error:   log.SeverityError,
+	} {
+		assert.Equal(t, want, convertLevel(level), level.String())
+	}
+}
+
+func TestLoggerLevels(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l.Trace("trace")
+	l.Debug("debug")
+	l.Info("info")
+	l.Warn("warn")
+	l.Error("error")
+	l.Log(hclog.NoLevel, "none")
+	l.Log(hclog.Off, "off")
+
+	var got []string
+	for _, r := range rec.Records() {
+		got = append(got, r.SeverityText())
+	}
+	assert.Equal(t, []string{"trace", "debug", "info", "warn", "error", ""}, got)
+}
+
+func TestLoggerSetLevel(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	child := l.Named("child")
+	assert.Equal(t, hclog.NoLevel, l.GetLevel())
+	assert.True(t, l.IsTrace())
+
+	l.SetLevel(hclog.Warn)
+	assert.Equal(t, hclog.Warn, child.GetLevel(), "shared level")
+	assert.False(t, child.IsInfo())
+	assert.True(t, child.IsWarn())
+	child.Info("info")
+	child.Error("error")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("error"), got[0].Body())
+}
+
+func TestLoggerEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	l := NewLogger("name", WithLoggerProvider(rec))
+	assert.False(t, l.IsDebug())
+	assert.True(t, l.IsInfo())
+	assert.True(t, l.IsError())
+	l.Debug("debug")
+	l.Info("info")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+}
+
+func TestLoggerWith(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l1 := l.With("a", 1)
+	l2 := l1.With("b", 2)
+	l3 := l1.With("c", 3)
+	assert.Same(t, l, l.With())
+	assert.Equal(t, []interface{}{"a", 1, "b", 2}, l2.ImpliedArgs())
+	assert.Equal(t, []interface{}{"a", 1, "c", 3}, l3.ImpliedArgs())
+
+	l2.Info("msg", "d", 4)
+	l3.Info("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("b", 2), log.Int64("d", 4)}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("c", 3)}, logtest.Attributes(got[1]))
+}
+
+func TestLoggerNamed(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec)).With("a", 1)
+	named := l.Named("sub")
+	assert.Equal(t, "name.sub", named.Name())
+	assert.Equal(t, "name.sub.subsub", named.Named("subsub").Name())
+	reset := named.ResetNamed("other")
+	assert.Equal(t, "other", reset.Name())
+	assert.Equal(t, "sub", NewLogger("", WithLoggerProvider(rec)).Named("sub").Name())
+
+	named.Info("msg1")
+	l.Named("sub").Info("msg2")
+	reset.Info("msg3")
+
+	got := rec.Result()
+	scopes := map[string]int{}
+	for _, sr := range got {
+		scopes[sr.Name] += len(sr.Records)
+		for _, r := range sr.Records {
+			assert.Equal(t, []log.KeyValue{log.Int64("a", 1)}, logtest.Attributes(r), "With attributes kept")
+		}
+	}
+	assert.Equal(t, 2, scopes["name.sub"], "Logger reused for the same name")
+	assert.Equal(t, 1, scopes["other"])
+}
+
+func TestLoggerStandardWriter(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  *hclog.StandardLoggerOptions
+		line  string
+		level log.Severity
+		body  string
+	}{
+		{"Default", nil, "[ERROR] failed\n", log.SeverityInfo, "[ERROR] failed"},
+		{"InferLevels", &hclog.StandardLoggerOptions{InferLevels: true}, "[ERR] failed\n", log.SeverityError, "failed"},
+		{"InferLevelsWithoutPrefix", &hclog.StandardLoggerOptions{InferLevels: true}, "hello\n", log.SeverityInfo, "hello"},
+		{
+			"InferLevelsWithTimestamp",
+			&hclog.StandardLoggerOptions{InferLevels: true, InferLevelsWithTimestamp: true},
+			"2009/11/10 23:00:00 [DEBUG] details\n",
+			log.SeverityDebug,
+			"details",
+		},
+		{"ForceLevel", &hclog.StandardLoggerOptions{ForceLevel: hclog.Warn}, "[ERROR] failed\n", log.SeverityWarn, "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := logtest.NewRecorder()
+			l := NewLogger("name", WithLoggerProvider(rec))
+			n, err := l.StandardWriter(tt.opts).Write([]byte(tt.line))
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.line), n)
+
+			got := rec.Records()
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.level, got[0].Severity())
+			assert.Equal(t, log.StringValue(tt.body), got[0].Body())
+		})
+	}
+
+	rec := logtest.NewRecorder()
+	NewLogger("name", WithLoggerProvider(rec)).StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true}).Print("[WARN] slow")
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, log.StringValue("slow"), got[0].Body())
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkLogger(b *testing.B) {
+	l := NewLogger("name")
+	l.logger = discardLogger{}
+	with := l.With("a", "b")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		with.Info("msg", "c", 1, "d", true)
+	}
+}
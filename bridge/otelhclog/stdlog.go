@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelhclog // import "go.opentelemetry.io/otel/bridge/otelhclog"
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// timestampRegexp matches the characters commonly found in timestamp formats
+// at the beginning of a line, as hclog does.
+var timestampRegexp = regexp.MustCompile(`^[\d\s\:\/\.\+-TZ]*`)
+
+// levelPrefixes are the level prefixes inferred, as hclog does.
+var levelPrefixes = []struct {
+	prefix string
+	level  hclog.Level
+}{
+	{"[TRACE]", hclog.Trace},
+	{"[DEBUG]", hclog.Debug},
+	{"[INFO]", hclog.Info},
+	{"[WARN]", hclog.Warn},
+	{"[ERROR]", hclog.Error},
+	{"[ERR]", hclog.Error},
+}
+
+// stdWriter is the io.Writer returned by Logger.StandardWriter.
+type stdWriter struct {
+	logger *Logger
+	opts   hclog.StandardLoggerOptions
+}
+
+func (w *stdWriter) Write(p []byte) (int, error) {
+	str := string(bytes.TrimRight(p, " \t\n"))
+
+	switch {
+	case w.opts.ForceLevel != hclog.NoLevel:
+		// The level prefix is stripped, the level is forced.
+		_, str = inferLevel(str)
+		w.logger.Log(w.opts.ForceLevel, str)
+	case w.opts.InferLevels:
+		if w.opts.InferLevelsWithTimestamp {
+			str = str[timestampRegexp.FindStringIndex(str)[1]:]
+		}
+		level, str := inferLevel(str)
+		w.logger.Log(level, str)
+	default:
+		w.logger.Info(str)
+	}
+	return len(p), nil
+}
+
+// inferLevel returns the level of the level prefix of str, or hclog.Info if
+// it has none, and str without the prefix.
+func inferLevel(str string) (hclog.Level, string) {
+	for _, p := range levelPrefixes {
+		if rest, ok := strings.CutPrefix(str, p.prefix); ok {
+			return p.level, strings.TrimSpace(rest)
+		}
+	}
+	return hclog.Info, str
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelkitlog provides a [kitlog.Logger], a bridge from
+// [github.com/go-kit/log] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewLogger] to create a [Logger] emitting log records to a
+// [log.Logger] of the configured [log.LoggerProvider]. It can be wrapped like
+// any go-kit logger, e.g. with [kitlog.With] or [level.NewFilter].
+//
+// The key-value pairs are converted as follows:
+//
+//   - The value of the first "msg" key is the body.
+//   - The value of the level key, added by the loggers of the
+//     [github.com/go-kit/log/level] package, is converted to the severity:
+//     debug, info, warn, and error to [log.SeverityDebug],
+//     [log.SeverityInfo], [log.SeverityWarn], and [log.SeverityError]. The
+//     severity text is the name of the level. The pairs without a level are
+//     emitted with the severity configured with [WithSeverity].
+//   - The [context.Context] values are not converted, see below.
+//   - The other pairs are converted to attributes, in order. Keys that are
+//     not strings are converted to their string representation. A key
+//     without a value is added with the [kitlog.ErrMissingValue] value, as
+//     done by go-kit.
+//
+// The values are converted based on the Go value they hold. Booleans,
+// numbers, and strings, including the ones of named types, are converted to
+// the values of the matching kind. Times are converted to their Unix time in
+// nanoseconds, durations to nanoseconds. Byte slices and arrays are converted
+// to [log.KindBytes] values, other slices and arrays to [log.KindSlice]
+// values, and maps to [log.KindMap] values sorted by key. Pointers are
+// dereferenced. Errors and [fmt.Stringer] values are converted to the string
+// they return. Other values are converted to their string representation.
+//
+// The last [context.Context] value of the key-value pairs, e.g. added with
+// [kitlog.With], is passed to the [log.Logger] so that the log record is
+// correlated with the span it holds. Otherwise, [context.Background] is
+// passed.
+package otelkitlog // import "go.opentelemetry.io/otel/bridge/otelkitlog"
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelkitlog_test
+
+import (
+	"context"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"go.opentelemetry.io/otel/bridge/otelkitlog"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create a go-kit logger emitting the key-value pairs of the info level
+	// and above to the OpenTelemetry Logs Bridge API.
+	var logger kitlog.Logger = otelkitlog.NewLogger("my/pkg/name", otelkitlog.WithLoggerProvider(provider))
+	logger = level.NewFilter(logger, level.AllowInfo())
+
+	// The "msg" value is the body, the level is the severity.
+	level.Info(logger).Log("msg", "hello", "user", "alice")
+
+	// The context correlates the log record with the span it holds.
+	ctx := context.Background()
+	level.Warn(kitlog.With(logger, "ctx", ctx)).Log("msg", "slow request")
+}
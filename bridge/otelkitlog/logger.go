@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelkitlog // import "go.opentelemetry.io/otel/bridge/otelkitlog"
+
+import (
+	"context"
+	"fmt"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+)
+
+// messageKey is the key of the message of the key-value pairs, the key used
+// by convention with go-kit loggers.
+const messageKey = "msg"
+
+// levelSeverities are the severities of the go-kit levels.
+var levelSeverities = map[string]log.Severity{
+	"debug": log.SeverityDebug,
+	"info":  log.SeverityInfo,
+	"warn":  log.SeverityWarn,
+	"error": log.SeverityError,
+}
+
+// Compile-time check Logger implements kitlog.Logger.
+var _ kitlog.Logger = (*Logger)(nil)
+
+// Logger is a [kitlog.Logger] that emits the key-value pairs it logs as log
+// records using a [log.Logger].
+//
+// Use [NewLogger] to create a Logger.
+type Logger struct {
+	logger   log.Logger
+	severity log.Severity
+	// sampledOnly is true if the log records are only correlated with
+	// sampled spans.
+	sampledOnly bool
+}
+
+// NewLogger returns a new [Logger] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewLogger(name string, options ...Option) *Logger {
+	cfg := newConfig(options)
+	return &Logger{
+		logger:      cfg.logger(name),
+		severity:    cfg.severity,
+		sampledOnly: cfg.sampledOnly,
+	}
+}
+
+// Log emits the conversion of keyvals, alternating keys and values, using the
+// [log.Logger] of l. The keyvals are not converted if the Logger is not
+// enabled for their severity.
+//
+// The last [context.Context] value of keyvals, or [context.Background] if
+// there is none, is passed to the Logger, see [bridgeutil.TraceContext].
+//
+// The returned error is always nil.
+func (l *Logger) Log(keyvals ...any) error {
+	var (
+		ctx    context.Context
+		record log.Record
+		// msg and lvl are the indexes of the message and level keys, or -1.
+		msg, lvl = -1, -1
+	)
+	record.SetSeverity(l.severity)
+	// Find the message, level, and context first, the other pairs are only
+	// converted if the Logger is enabled.
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if c, ok := keyvals[i+1].(context.Context); ok {
+			ctx = c
+			continue
+		}
+		switch key := keyvals[i]; {
+		case msg < 0 && key == messageKey:
+			msg = i
+		case lvl < 0 && key == level.Key():
+			if s, text, ok := levelSeverity(keyvals[i+1]); ok {
+				record.SetSeverity(s)
+				record.SetSeverityText(text)
+				lvl = i
+			}
+		}
+	}
+	ctx = bridgeutil.TraceContext(ctx, l.sampledOnly)
+	if !l.logger.Enabled(ctx, record) {
+		return nil
+	}
+
+	if msg >= 0 {
+		record.SetBody(bridgeutil.ConvertAny(keyvals[msg+1]))
+	}
+
+	batch := bridgeutil.NewAttrBatch(&record)
+	for i := 0; i < len(keyvals); i += 2 {
+		if i == msg || i == lvl {
+			continue
+		}
+		var value any = kitlog.ErrMissingValue
+		if i+1 < len(keyvals) {
+			value = keyvals[i+1]
+			if _, ok := value.(context.Context); ok {
+				continue
+			}
+		}
+		batch.Add(log.KeyValue{Key: keyString(keyvals[i]), Value: bridgeutil.ConvertAny(value)})
+	}
+	batch.Flush()
+
+	l.logger.Emit(ctx, record)
+	return nil
+}
+
+// levelSeverity returns the severity and the severity text of the value v of
+// the level key. False is returned if v is not a go-kit level, i.e. a
+// [level.Value] or the string of one.
+func levelSeverity(v any) (log.Severity, string, bool) {
+	var text string
+	switch v := v.(type) {
+	case level.Value:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return log.SeverityUndefined, "", false
+	}
+	s, ok := levelSeverities[text]
+	return s, text, ok
+}
+
+// keyString returns the string of the key k. The keys that are not strings
+// are converted to their string representation.
+func keyString(k any) string {
+	if s, ok := k.(string); ok {
+		return s
+	}
+	return fmt.Sprint(k)
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelkitlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	require.NoError(t, l.Log("msg", "hello"))
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewLoggerGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	require.NoError(t, NewLogger("name").Log("msg", "hello"))
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestLoggerLog(t *testing.T) {
+	rec := logtest.NewRecorder()
+	logger := kitlog.With(NewLogger("name", WithLoggerProvider(rec)), "a", "b")
+	level.Warn(logger).Log(
+		"msg", "hello",
+		"err", errors.New("failed"),
+		"d", time.Second,
+		42, true,
+		"msg", "again",
+		"extra",
+	)
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("hello"), got[0].Body())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "warn", got[0].SeverityText())
+	assert.Equal(t, []log.KeyValue{
+		log.String("a", "b"),
+		log.String("err", "failed"),
+		log.Int64("d", int64(time.Second)),
+		log.Bool("42", true),
+		log.String("msg", "again"),
+		log.String("extra", kitlog.ErrMissingValue.Error()),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestLoggerLevels(t *testing.T) {
+	rec := logtest.NewRecorder()
+	logger := NewLogger("name", WithLoggerProvider(rec))
+	level.Debug(logger).Log()
+	level.Info(logger).Log()
+	level.Warn(logger).Log()
+	level.Error(logger).Log()
+	_ = logger.Log("level", "error")
+	_ = logger.Log()
+	_ = logger.Log("level", "unknown")
+
+	got := rec.Records()
+	require.Len(t, got, 7)
+	for i, want := range []struct {
+		severity log.Severity
+		text     string
+	}{
+		{log.SeverityDebug, "debug"},
+		{log.SeverityInfo, "info"},
+		{log.SeverityWarn, "warn"},
+		{log.SeverityError, "error"},
+		{log.SeverityError, "error"},
+		{log.SeverityInfo, ""},
+		{log.SeverityInfo, ""},
+	} {
+		assert.Equal(t, want.severity, got[i].Severity(), "record %d", i)
+		assert.Equal(t, want.text, got[i].SeverityText(), "record %d", i)
+	}
+	assert.Equal(t, []log.KeyValue{log.String("level", "unknown")}, logtest.Attributes(got[6]), "unknown level")
+}
+
+func TestWithSeverity(t *testing.T) {
+	rec := logtest.NewRecorder()
+	logger := NewLogger("name", WithLoggerProvider(rec), WithSeverity(log.SeverityDebug))
+	_ = logger.Log("msg", "hello")
+	level.Error(logger).Log("msg", "hello")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, log.SeverityDebug, got[0].Severity())
+	assert.Equal(t, log.SeverityError, got[1].Severity())
+}
+
+func TestLoggerEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	logger := NewLogger("name", WithLoggerProvider(rec))
+	level.Debug(logger).Log("msg", "debug")
+	level.Info(logger).Log("msg", "info")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+}
+
+type ctxKey struct{}
+
+func TestLoggerContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+	rec := logtest.NewRecorder()
+	l := NewLogger("name")
+	l.logger = rec
+	_ = kitlog.With(l, "ctx", ctx).Log("msg", "hello")
+	_ = l.Log("msg", "hello")
+
+	require.Len(t, rec.Contexts(), 2)
+	assert.Equal(t, ctx, rec.Contexts()[0])
+	assert.Equal(t, context.Background(), rec.Contexts()[1])
+}
+
+func TestLoggerContextNotConverted(t *testing.T) {
+	rec := logtest.NewRecorder()
+	_ = NewLogger("name", WithLoggerProvider(rec)).Log("ctx", context.Background(), "a", 1)
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1)}, logtest.Attributes(got[0]))
+}
+
+func TestLoggerSampledOnlyCorrelation(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rec := logtest.NewRecorder()
+	l := NewLogger("name")
+	l.logger = rec
+	_ = l.Log("ctx", ctx)
+
+	l = NewLogger("name", WithSampledOnlyCorrelation())
+	l.logger = rec
+	_ = l.Log("ctx", ctx)
+
+	require.Len(t, rec.Contexts(), 2)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.Contexts()[0]), "default")
+	assert.False(t, trace.SpanContextFromContext(rec.Contexts()[1]).IsValid(), "sampled only")
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkLogger(b *testing.B) {
+	l := NewLogger("name")
+	l.logger = discardLogger{}
+	logger := level.Info(l)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = logger.Log("msg", "hello", "a", "b", "c", 1, "d", true)
+	}
+}
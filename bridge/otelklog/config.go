@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelklog // import "go.opentelemetry.io/otel/bridge/otelklog"
+
+import (
+	"go.opentelemetry.io/otel/bridge/otellogr"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a Writer and of the logr.Logger
+// returned by NewLogger.
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+	levelFunc func(int) log.Severity
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	return c
+}
+
+// logger returns the log.Logger named name of the configured provider.
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// logrOptions returns the otellogr options of the configuration.
+func (c config) logrOptions() []otellogr.Option {
+	opts := []otellogr.Option{otellogr.WithLoggerProvider(c.provider)}
+	if c.version != "" {
+		opts = append(opts, otellogr.WithVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, otellogr.WithSchemaURL(c.schemaURL))
+	}
+	if c.levelFunc != nil {
+		opts = append(opts, otellogr.WithLevelSeverity(c.levelFunc))
+	}
+	return opts
+}
+
+// Option configures a [Writer] or the logr.Logger returned by [NewLogger].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by the bridge. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by the bridge. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by the bridge to create its [log.Logger].
+//
+// By default, if this Option is not provided, the global LoggerProvider is
+// used.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithLevelSeverity returns an [Option] that configures the function used to
+// convert the klog verbosity level of the structured log records, as set
+// with klog.V, to a [log.Severity]. The function is not called for the log
+// records emitted with klog.ErrorS, their severity is [log.SeverityError].
+// It is not used by a [Writer] either, the klog text output does not hold
+// the verbosity level.
+//
+// By default, if this Option is not provided, level 0 is converted to
+// [log.SeverityInfo], and each greater level to the severity below it, down
+// to [log.SeverityTrace1]: level 1 is [log.SeverityDebug4], level 4 is
+// [log.SeverityDebug1], and level 8 and greater levels are
+// [log.SeverityTrace1].
+func WithLevelSeverity(f func(level int) log.Severity) Option {
+	return optFunc(func(c config) config {
+		c.levelFunc = f
+		return c
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelklog provides a bridge from [klog], the logging library of the
+// Kubernetes components, client-go, and controller-runtime, to the
+// OpenTelemetry Logs Bridge API.
+//
+// Use [SetLogger] to redirect all the klog output to a [log.Logger] of the
+// configured [log.LoggerProvider]:
+//
+//   - The structured log records, e.g. of klog.InfoS and klog.ErrorS, are
+//     passed to a logr.Logger created with [NewLogger]. Their key/value pairs
+//     are attributes and their severity is derived from their klog verbosity
+//     level, see [WithLevelSeverity].
+//   - The formatted log records, e.g. of klog.Infof and klog.Warningf, are
+//     written with their klog header to a [Writer] created with [NewWriter].
+//     Their severity is the klog severity of the header.
+//
+// A [Writer] can also be set as the output of klog with klog.SetOutput, or
+// get the output of glog, which uses the same text format.
+//
+// [context.Background] is passed to the [log.Logger], the log records are not
+// correlated with a span.
+//
+// [klog]: https://pkg.go.dev/k8s.io/klog/v2
+package otelklog // import "go.opentelemetry.io/otel/bridge/otelklog"
@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelklog_test
+
+import (
+	"k8s.io/klog/v2"
+
+	"go.opentelemetry.io/otel/bridge/otelklog"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Redirect the klog output, e.g. of client-go, to the OpenTelemetry
+	// Logs Bridge API.
+	otelklog.SetLogger("my/pkg/name", otelklog.WithLoggerProvider(provider))
+	defer klog.ClearLogger()
+
+	klog.InfoS("pod updated", "pod", klog.KRef("default", "nginx"))
+	klog.Warningf("retrying in %s", "1s")
+}
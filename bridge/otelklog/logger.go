@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelklog // import "go.opentelemetry.io/otel/bridge/otelklog"
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+
+	"go.opentelemetry.io/otel/bridge/otellogr"
+)
+
+// NewLogger returns a new [logr.Logger] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+//
+// The returned Logger is meant to be set as the backing implementation of
+// klog with klog.SetLogger. The structured log records, e.g. of klog.InfoS,
+// klog.ErrorS, and klog.V(2).InfoS, are emitted with their key/value pairs as
+// attributes and the severity of their verbosity level, see
+// [WithLevelSeverity]. However, klog also passes the formatted log records,
+// e.g. of klog.Warningf, to the Logger, losing their warning severity. Use
+// [SetLogger] to keep it.
+//
+// [log.Logger]: https://pkg.go.dev/go.opentelemetry.io/otel/log#Logger
+// [log.LoggerProvider]: https://pkg.go.dev/go.opentelemetry.io/otel/log#LoggerProvider
+func NewLogger(name string, options ...Option) logr.Logger {
+	return otellogr.NewLogger(name, newConfig(options).logrOptions()...)
+}
+
+// SetLogger sets the backing implementation of klog to a [logr.Logger]
+// created with [NewLogger] and redirects the formatted log records, e.g. of
+// klog.Infof and klog.Warningf, to a [Writer] created with [NewWriter]. The
+// Writer gets the header written by klog and emits the log records with
+// their klog severity.
+//
+// As klog.SetLogger, SetLogger is not safe for concurrent use with the klog
+// logging functions and should be called during the program initialization.
+// Use klog.ClearLogger to restore the default klog output.
+func SetLogger(name string, options ...Option) {
+	w := NewWriter(name, options...)
+	klog.SetLoggerWithOptions(
+		NewLogger(name, options...),
+		klog.WriteKlogBuffer(func(data []byte) { _, _ = w.Write(data) }),
+	)
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelklog
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/klog/v2"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// setVerbosity sets the klog verbosity to v for the duration of the test.
+func setVerbosity(t *testing.T, v string) {
+	fs := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(fs)
+	orig := fs.Lookup("v").Value.String()
+	require.NoError(t, fs.Set("v", v))
+	t.Cleanup(func() { _ = fs.Set("v", orig) })
+}
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+		WithLevelSeverity(func(int) log.Severity { return log.SeverityDebug }),
+	)
+	l.V(1).Info("msg", "k", "v")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+	assert.Equal(t, log.SeverityDebug, got[1].Records[0].Severity())
+	assert.Equal(t, []log.KeyValue{log.String("k", "v")}, logtest.Attributes(got[1].Records[0]))
+}
+
+func TestSetLogger(t *testing.T) {
+	setVerbosity(t, "2")
+	rec := logtest.NewRecorder()
+	SetLogger("name", WithLoggerProvider(rec))
+	t.Cleanup(klog.ClearLogger)
+
+	klog.InfoS("structured", "pod", "nginx", "restarts", 2)
+	klog.V(2).InfoS("verbose")
+	klog.V(3).InfoS("disabled")
+	klog.ErrorS(errors.New("boom"), "failed")
+	klog.Warningf("retrying in %s", "1s")
+	klog.Errorf("failed: %d", 1)
+
+	// The structured and the formatted log records are emitted by different
+	// loggers, find them by body.
+	got := make(map[string]log.Record)
+	for _, r := range rec.Records() {
+		got[r.Body().AsString()] = r
+	}
+	require.Len(t, got, 5)
+
+	r := got["structured"]
+	assert.Equal(t, log.SeverityInfo, r.Severity())
+	assert.Equal(t, []log.KeyValue{
+		log.String("pod", "nginx"),
+		log.Int64("restarts", 2),
+	}, logtest.Attributes(r))
+
+	r = got["verbose"]
+	assert.Equal(t, log.SeverityDebug3, r.Severity())
+
+	r = got["failed"]
+	assert.Equal(t, log.SeverityError, r.Severity())
+	assert.Contains(t, logtest.Attributes(r), log.String(string(semconv.ExceptionMessageKey), "boom"))
+
+	r = got["retrying in 1s"]
+	assert.Equal(t, log.SeverityWarn, r.Severity())
+	assert.Equal(t, "WARNING", r.SeverityText())
+	require.Len(t, logtest.Attributes(r), 2)
+	assert.Equal(t, log.String(string(semconv.CodeFilepathKey), "logger_test.go"), logtest.Attributes(r)[0])
+
+	r = got["failed: 1"]
+	assert.Equal(t, log.SeverityError, r.Severity())
+}
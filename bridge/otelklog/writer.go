@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelklog // import "go.opentelemetry.io/otel/bridge/otelklog"
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Compile-time check Writer implements io.Writer.
+var _ io.Writer = (*Writer)(nil)
+
+// Writer is an [io.Writer] that emits the log records written to it in the
+// klog text format, also used by glog, using a [log.Logger].
+//
+// Use [NewWriter] to create a Writer, and set it as the klog output with
+// klog.SetOutput, or use [SetLogger].
+//
+// A Writer is safe for concurrent use.
+type Writer struct {
+	logger log.Logger
+}
+
+// NewWriter returns a new [Writer] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewWriter(name string, options ...Option) *Writer {
+	return &Writer{logger: newConfig(options).logger(name)}
+}
+
+// Write emits the log records of p. Each line starting with a klog header,
+//
+//	Lmmdd hh:mm:ss.uuuuuu threadid file:line] msg
+//
+// starts a new log record, and the following lines without a header, e.g. of
+// a stack trace, are appended to its body. The log record is converted as
+// follows:
+//
+//   - The header time is the timestamp. The header has no year, the year is
+//     the one of the time of the call.
+//   - The header severity character, "I", "W", "E", or "F", is converted to
+//     [log.SeverityInfo], [log.SeverityWarn], [log.SeverityError], or
+//     [log.SeverityFatal]. The severity text is the klog severity name, e.g.
+//     "WARNING".
+//   - The header file and line are the code.filepath and code.lineno
+//     attributes.
+//   - The message is the body as a string value, without its trailing
+//     newline.
+//
+// The lines written before the first header, e.g. if klog is configured to
+// skip the headers, are emitted as a log record with the [log.SeverityInfo]
+// severity and the time of the call as timestamp. [context.Background] is
+// passed to the [log.Logger].
+//
+// The returned error is always nil and the returned length is always len(p).
+func (w *Writer) Write(p []byte) (int, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	var (
+		cur  entry
+		body strings.Builder
+	)
+	flush := func() {
+		if msg := strings.TrimRight(body.String(), "\r\n"); msg != "" || cur.header {
+			w.emit(ctx, cur, msg)
+		}
+		body.Reset()
+	}
+
+	s := string(p)
+	for s != "" {
+		var line string
+		line, s, _ = strings.Cut(s, "\n")
+		if e, msg, ok := parseHeader(now, line); ok {
+			flush()
+			cur = e
+			line = msg
+		} else if !cur.header && body.Len() == 0 {
+			cur = entry{time: now, severity: log.SeverityInfo, text: "INFO"}
+		}
+		if body.Len() > 0 {
+			body.WriteByte('\n')
+		}
+		body.WriteString(line)
+	}
+	flush()
+	return len(p), nil
+}
+
+// emit emits the log record of e with the body msg using the log.Logger of
+// w.
+func (w *Writer) emit(ctx context.Context, e entry, msg string) {
+	var record log.Record
+	record.SetSeverity(e.severity)
+	if !w.logger.Enabled(ctx, record) {
+		return
+	}
+	record.SetTimestamp(e.time)
+	record.SetBody(log.StringValue(msg))
+	record.SetSeverityText(e.text)
+	if e.file != "" {
+		record.AddAttributes(
+			log.String(string(semconv.CodeFilepathKey), e.file),
+			log.Int(string(semconv.CodeLineNumberKey), e.line),
+		)
+	}
+	w.logger.Emit(ctx, record)
+}
+
+// entry is a log record parsed from a klog header.
+type entry struct {
+	header   bool
+	time     time.Time
+	severity log.Severity
+	text     string
+	file     string
+	line     int
+}
+
+// severities are the log.Severity and the severity names of the klog
+// severity characters.
+var severities = map[byte]struct {
+	severity log.Severity
+	text     string
+}{
+	'I': {log.SeverityInfo, "INFO"},
+	'W': {log.SeverityWarn, "WARNING"},
+	'E': {log.SeverityError, "ERROR"},
+	'F': {log.SeverityFatal, "FATAL"},
+}
+
+// parseHeader returns the entry of the klog header of line and the message
+// following it. If line does not start with a klog header, false is returned.
+// The year of the entry time is the one of now, unless that puts it more
+// than a day after now, the header was then written the previous year.
+func parseHeader(now time.Time, line string) (entry, string, bool) {
+	const layout = "Lmmdd hh:mm:ss.uuuuuu "
+	if len(line) < len(layout) || line[len(layout)-1] != ' ' {
+		return entry{}, "", false
+	}
+	sev, ok := severities[line[0]]
+	if !ok || !matchDigits(line[1:len(layout)-1], "dddd dd:dd:dd.dddddd") {
+		return entry{}, "", false
+	}
+	num := func(i, n int) int {
+		v, _ := strconv.Atoi(line[i : i+n])
+		return v
+	}
+	t := time.Date(now.Year(), time.Month(num(1, 2)), num(3, 2),
+		num(6, 2), num(9, 2), num(12, 2), num(15, 6)*1000, now.Location())
+	if t.After(now.AddDate(0, 0, 1)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+
+	// The thread ID, padded with spaces, and the source code location.
+	rest := strings.TrimLeft(line[len(layout):], " ")
+	_, rest, ok = strings.Cut(rest, " ")
+	if !ok {
+		return entry{}, "", false
+	}
+	loc, msg, ok := strings.Cut(rest, "]")
+	if !ok {
+		return entry{}, "", false
+	}
+	i := strings.LastIndexByte(loc, ':')
+	if i < 0 {
+		return entry{}, "", false
+	}
+	lineno, err := strconv.Atoi(loc[i+1:])
+	if err != nil {
+		return entry{}, "", false
+	}
+
+	return entry{
+		header:   true,
+		time:     t,
+		severity: sev.severity,
+		text:     sev.text,
+		file:     loc[:i],
+		line:     lineno,
+	}, strings.TrimPrefix(msg, " "), true
+}
+
+// matchDigits returns if s matches pattern, where each 'd' of the pattern
+// matches a digit and the other characters match themselves.
+func matchDigits(s, pattern string) bool {
+	if len(s) != len(pattern) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if pattern[i] == 'd' {
+			if s[i] < '0' || s[i] > '9' {
+				return false
+			}
+		} else if s[i] != pattern[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelklog
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// codeAttrs returns the source code location attributes of file and line.
+func codeAttrs(file string, line int) []log.KeyValue {
+	return []log.KeyValue{
+		log.String(string(semconv.CodeFilepathKey), file),
+		log.Int(string(semconv.CodeLineNumberKey), line),
+	}
+}
+
+func TestNewWriter(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	_, err := io.WriteString(w, "I0102 15:04:05.000000   12345 main.go:42] msg\n")
+	require.NoError(t, err)
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewWriterGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	_, _ = io.WriteString(NewWriter("name"), "msg\n")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestWriterWrite(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter("name", WithLoggerProvider(rec))
+
+	p := "W0102 15:04:05.123456    7 reflector.go:539] watch failed\n" +
+		"E0102 15:04:06.000001   12 main.go:9] panic\n" +
+		"goroutine 1 [running]:\n" +
+		"main.main()\n"
+	n, err := io.WriteString(w, p)
+	require.NoError(t, err)
+	assert.Equal(t, len(p), n)
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+
+	year := time.Now().Year()
+	assert.True(t, time.Date(year, 1, 2, 15, 4, 5, 123456000, time.Local).Equal(got[0].Timestamp()), got[0].Timestamp())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "WARNING", got[0].SeverityText())
+	assert.Equal(t, log.StringValue("watch failed"), got[0].Body())
+	assert.Equal(t, codeAttrs("reflector.go", 539), logtest.Attributes(got[0]))
+
+	assert.True(t, time.Date(year, 1, 2, 15, 4, 6, 1000, time.Local).Equal(got[1].Timestamp()), got[1].Timestamp())
+	assert.Equal(t, log.SeverityError, got[1].Severity())
+	assert.Equal(t, "ERROR", got[1].SeverityText())
+	assert.Equal(t, log.StringValue("panic\ngoroutine 1 [running]:\nmain.main()"), got[1].Body())
+	assert.Equal(t, codeAttrs("main.go", 9), logtest.Attributes(got[1]))
+}
+
+func TestWriterNoHeader(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter("name", WithLoggerProvider(rec))
+
+	before := time.Now()
+	_, _ = io.WriteString(w, "msg\nsecond line\n\n")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.False(t, got[0].Timestamp().Before(before))
+	assert.Equal(t, log.SeverityInfo, got[0].Severity())
+	assert.Equal(t, "INFO", got[0].SeverityText())
+	assert.Equal(t, log.StringValue("msg\nsecond line"), got[0].Body())
+	assert.Empty(t, logtest.Attributes(got[0]))
+
+	_, _ = io.WriteString(w, "\n")
+	assert.Len(t, rec.Records(), 1, "empty write")
+}
+
+func TestParseHeader(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	e, msg, ok := parseHeader(now, "F1231 23:59:59.999999 1 a/b.go:1] ")
+	require.True(t, ok)
+	assert.Equal(t, "", msg)
+	assert.Equal(t, time.Date(2023, 12, 31, 23, 59, 59, 999999000, time.UTC), e.time, "previous year")
+	assert.Equal(t, log.SeverityFatal, e.severity)
+	assert.Equal(t, "FATAL", e.text)
+	assert.Equal(t, "a/b.go", e.file)
+	assert.Equal(t, 1, e.line)
+
+	for _, line := range []string{
+		"",
+		"msg",
+		"X0102 15:04:05.000000 1 main.go:1] msg",
+		"I0102 15:04:05 1 main.go:1] msg",
+		"I0102 15:04:05.000000 1 main.go:1 msg",
+		"I0102 15:04:05.000000 1 main.go:x] msg",
+		"I0102 15:04:05.000000 main.go] msg",
+	} {
+		_, _, ok := parseHeader(now, line)
+		assert.False(t, ok, line)
+	}
+}
+
+func TestWriterEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityWarn
+	}))
+	w := NewWriter("name", WithLoggerProvider(rec))
+	_, _ = io.WriteString(w, "I0102 15:04:05.000000 1 main.go:1] info\n")
+	_, _ = io.WriteString(w, "W0102 15:04:05.000000 1 main.go:2] warn\n")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("warn"), got[0].Body())
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkWriter(b *testing.B) {
+	w := &Writer{logger: discardLogger{}}
+	p := []byte("I0102 15:04:05.000000   12345 main.go:42] msg\n")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = w.Write(p)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogr // import "go.opentelemetry.io/otel/bridge/otellogr"
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a LogSink.
+type config struct {
+	provider    log.LoggerProvider
+	version     string
+	schemaURL   string
+	levelFunc   func(int) log.Severity
+	nameMapping NameMapping
+	// sampledOnly is true if the log records are only correlated with
+	// sampled spans.
+	sampledOnly bool
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	if c.levelFunc == nil {
+		c.levelFunc = bridgeutil.VerbositySeverity
+	}
+	return c
+}
+
+// loggers returns the cache of the log.Logger of the configured provider.
+func (c config) loggers() *bridgeutil.LoggerCache {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return bridgeutil.NewLoggerCache(c.provider, opts...)
+}
+
+// Option configures a [LogSink].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [LogSink]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [LogSink]. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [LogSink] to create its [log.Logger].
+//
+// By default, if this Option is not provided, the LogSink will use the global
+// LoggerProvider.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithLevelSeverity returns an [Option] that configures the function used by
+// a [LogSink] to convert the verbosity level of the log records it emits, as
+// set with [logr.Logger.V], to a [log.Severity]. The function is not called
+// for the log records emitted with [logr.Logger.Error], their severity is
+// [log.SeverityError].
+//
+// By default, if this Option is not provided, level 0 is converted to
+// [log.SeverityInfo], and each greater level to the severity below it, down
+// to [log.SeverityTrace1]: level 1 is [log.SeverityDebug4], level 4 is
+// [log.SeverityDebug1], and level 8 and greater levels are
+// [log.SeverityTrace1].
+func WithLevelSeverity(f func(level int) log.Severity) Option {
+	return optFunc(func(c config) config {
+		c.levelFunc = f
+		return c
+	})
+}
+
+// WithSampledOnlyCorrelation returns an [Option] that configures a [LogSink]
+// to only correlate the log records it emits with sampled spans. The log
+// records emitted with a context value holding a span that is not sampled
+// are emitted with no trace context.
+//
+// By default, if this Option is not provided, the log records are correlated
+// with the span of the context value, sampled or not.
+func WithSampledOnlyCorrelation() Option {
+	return optFunc(func(c config) config {
+		c.sampledOnly = true
+		return c
+	})
+}
+
+// NameMapping defines how the names added with [logr.Logger.WithName] are
+// mapped to the log records emitted by a [LogSink].
+type NameMapping int
+
+const (
+	// NameToScope maps the names to the name of the [log.Logger] emitting the
+	// log records, the instrumentation scope name. The names are appended to
+	// the name the LogSink was created with, separated by a "/".
+	NameToScope NameMapping = iota
+	// NameToAttribute maps the names to the [NameKey] attribute of the log
+	// records. The names are joined with a "/". The name the LogSink was
+	// created with is kept as the instrumentation scope name.
+	NameToAttribute
+)
+
+// NameKey is the attribute key of the names added with [logr.Logger.WithName]
+// when the [NameToAttribute] mapping is used.
+const NameKey = "logger.name"
+
+// WithNameMapping returns an [Option] that configures how a [LogSink] maps
+// the names added with [logr.Logger.WithName].
+//
+// By default, if this Option is not provided, [NameToScope] is used.
+func WithNameMapping(m NameMapping) Option {
+	return optFunc(func(c config) config {
+		c.nameMapping = m
+		return c
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otellogr provides a [logr.LogSink], a bridge from
+// [github.com/go-logr/logr] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewLogger] to create a [logr.Logger] emitting log records to a
+// [log.Logger] of the configured [log.LoggerProvider], or [NewLogSink] to
+// create the [LogSink] itself.
+//
+// The log records are converted as follows:
+//
+//   - The message is the body as a string value.
+//   - The verbosity level is converted to the severity, see
+//     [WithLevelSeverity]. The severity of the log records emitted with
+//     [logr.Logger.Error] is [log.SeverityError].
+//   - The error passed to [logr.Logger.Error], if not nil, is added as the
+//     exception.type, exception.message, and, if the error provides its stack
+//     trace, exception.stacktrace attributes defined by the semantic
+//     conventions. An error provides its stack trace if it, or an error it
+//     wraps, has a StackTrace method with no parameters and one result, e.g.
+//     the errors of github.com/pkg/errors.
+//   - The names added with [logr.Logger.WithName] are mapped to the
+//     instrumentation scope name or to an attribute, see [WithNameMapping].
+//   - The key-value pairs, including the ones added with
+//     [logr.Logger.WithValues], are converted to attributes. Keys that are not
+//     strings are converted to their string representation.
+//
+// The attribute values are converted based on the Go value they hold.
+// [logr.Marshaler] values are converted to the value they marshal to.
+// Booleans, numbers, and strings, including the ones of named types, are
+// converted to the values of the matching kind. Byte slices and arrays are
+// converted to [log.KindBytes] values, other slices and arrays to
+// [log.KindSlice] values, and maps to [log.KindMap] values sorted by key.
+// Pointers are dereferenced. Errors and [fmt.Stringer] values are converted
+// to the string they return. Other values are converted to their string
+// representation.
+//
+// A [logr.Logger] has no context, the log records are emitted with
+// [context.Background]. Use [logr.Logger.WithValues] to correlate them.
+package otellogr // import "go.opentelemetry.io/otel/bridge/otellogr"
@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogr_test
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/bridge/otellogr"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create a logr.Logger emitting log records to the OpenTelemetry Logs
+	// Bridge API.
+	logger := otellogr.NewLogger("my/pkg/name", otellogr.WithLoggerProvider(provider))
+
+	logger.Info("hello", "user", "alice")
+
+	// The verbosity level is converted to a lower severity.
+	logger.V(1).Info("details", "count", 3)
+
+	// The error message is added as an attribute.
+	logger.WithName("db").Error(errors.New("timeout"), "query failed", "table", "users")
+}
@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogr // import "go.opentelemetry.io/otel/bridge/otellogr"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/go-logr/logr"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Compile-time check LogSink implements logr.LogSink.
+var _ logr.LogSink = (*LogSink)(nil)
+
+// LogSink is a [logr.LogSink] that emits the log records it receives using a
+// [log.Logger].
+//
+// Use [NewLogSink] to create a LogSink.
+type LogSink struct {
+	cfg    config
+	logger log.Logger
+	// loggers caches the loggers of the names added with WithName, they are
+	// shared by all the LogSinks derived from the same NewLogSink call.
+	loggers *bridgeutil.LoggerCache
+
+	// name is the name of logger.
+	name string
+	// names are the names added with WithName, joined with a "/", if the
+	// NameToAttribute mapping is used.
+	names string
+	// values are the attributes added with WithValues.
+	values []log.KeyValue
+	// ctx is the last context added with WithValues. It is nil if none was
+	// added.
+	ctx context.Context
+}
+
+// NewLogger returns a new [logr.Logger] backed by a [LogSink] created with
+// [NewLogSink].
+func NewLogger(name string, options ...Option) logr.Logger {
+	return logr.New(NewLogSink(name, options...))
+}
+
+// NewLogSink returns a new [LogSink] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewLogSink(name string, options ...Option) *LogSink {
+	cfg := newConfig(options)
+	loggers := cfg.loggers()
+	return &LogSink{cfg: cfg, logger: loggers.Logger(name), loggers: loggers, name: name}
+}
+
+// Init does nothing. The call depth is not needed, the source code location
+// of the log records is not emitted.
+func (l *LogSink) Init(logr.RuntimeInfo) {}
+
+// Enabled returns true if the [log.Logger] of l is enabled for the severity
+// of the verbosity level.
+func (l *LogSink) Enabled(level int) bool {
+	var record log.Record
+	record.SetSeverity(l.cfg.levelFunc(level))
+	return l.logger.Enabled(l.traceContext(nil), record)
+}
+
+// Info emits a log record with the severity of the verbosity level, the body
+// msg, and the attributes of keysAndValues, using the [log.Logger] of l.
+//
+// If a value of keysAndValues, or of the key-value pairs added with
+// WithValues, is a [context.Context], it is passed to the Logger instead of
+// being converted to an attribute, see [bridgeutil.TraceContext]. Otherwise,
+// [context.Background] is passed.
+func (l *LogSink) Info(level int, msg string, keysAndValues ...any) {
+	record := l.newRecord(msg, l.cfg.levelFunc(level))
+	ctx := convertKVs(keysAndValues, func(kv log.KeyValue) { record.AddAttributes(kv) })
+	l.logger.Emit(l.traceContext(ctx), record)
+}
+
+// Error emits a log record with the [log.SeverityError] severity, the body
+// msg, and the attributes of keysAndValues, using the [log.Logger] of l. If
+// err is not nil, it is added as the exception attributes defined by the
+// semantic conventions, see [convertError]. The contexts are handled as
+// described in [LogSink.Info].
+func (l *LogSink) Error(err error, msg string, keysAndValues ...any) {
+	record := l.newRecord(msg, log.SeverityError)
+	if err != nil {
+		convertError(err, func(kv log.KeyValue) { record.AddAttributes(kv) })
+	}
+	ctx := convertKVs(keysAndValues, func(kv log.KeyValue) { record.AddAttributes(kv) })
+	l.logger.Emit(l.traceContext(ctx), record)
+}
+
+// traceContext returns the context to emit log records with: ctx if it is not
+// nil, the context added with WithValues otherwise.
+func (l *LogSink) traceContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = l.ctx
+	}
+	return bridgeutil.TraceContext(ctx, l.cfg.sampledOnly)
+}
+
+// newRecord returns a new log.Record with the body msg, the severity sev,
+// and the attributes of l.
+func (l *LogSink) newRecord(msg string, sev log.Severity) log.Record {
+	var record log.Record
+	record.SetBody(log.StringValue(msg))
+	record.SetSeverity(sev)
+	if l.names != "" {
+		record.AddAttributes(log.String(NameKey, l.names))
+	}
+	record.AddAttributes(l.values...)
+	return record
+}
+
+// WithValues returns a new [LogSink] holding the attributes of l and the
+// attributes of keysAndValues.
+func (l *LogSink) WithValues(keysAndValues ...any) logr.LogSink {
+	if len(keysAndValues) == 0 {
+		return l
+	}
+	l2 := *l
+	l2.values = slices.Clip(l.values)
+	if ctx := convertKVs(keysAndValues, func(kv log.KeyValue) { l2.values = append(l2.values, kv) }); ctx != nil {
+		l2.ctx = ctx
+	}
+	return &l2
+}
+
+// WithName returns a new [LogSink] with name appended to the names of l. The
+// names are mapped to the log records as configured with [WithNameMapping].
+func (l *LogSink) WithName(name string) logr.LogSink {
+	l2 := *l
+	switch l.cfg.nameMapping {
+	case NameToAttribute:
+		l2.names = joinName(l.names, name)
+	default:
+		l2.name = joinName(l.name, name)
+		l2.logger = l.loggers.Logger(l2.name)
+	}
+	return &l2
+}
+
+// joinName returns name appended to the names separated by a "/".
+func joinName(names, name string) string {
+	if names == "" {
+		return name
+	}
+	return names + "/" + name
+}
+
+// convertError calls f with the exception attributes of err: the
+// exception.type attribute holding the type of err, the exception.message
+// attribute holding its error message, and, if err or an error it wraps
+// provides its stack trace, the exception.stacktrace attribute holding it.
+//
+// An error provides its stack trace if it has a StackTrace method with no
+// parameters and one result, e.g. the errors of github.com/pkg/errors. The
+// stack trace is the result formatted with the %+v verb. If several errors of
+// the chain of err provide one, the stack trace of the innermost error, the
+// closest to the origin of err, is used.
+func convertError(err error, f func(log.KeyValue)) {
+	f(log.String(string(semconv.ExceptionTypeKey), typeStr(err)))
+	f(log.String(string(semconv.ExceptionMessageKey), err.Error()))
+	if st, ok := stackTrace(err); ok {
+		f(log.String(string(semconv.ExceptionStacktraceKey), st))
+	}
+}
+
+// typeStr returns the name of the type of v, qualified by its package path.
+func typeStr(v any) string {
+	t := reflect.TypeOf(v)
+	if t.PkgPath() == "" && t.Name() == "" {
+		// Likely a builtin type, or a pointer.
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
+
+// stackTrace returns the stack trace of the innermost error of the chain of
+// err providing one. False is returned if no error provides one.
+func stackTrace(err error) (string, bool) {
+	var st reflect.Value
+	for ; err != nil; err = errors.Unwrap(err) {
+		m := reflect.ValueOf(err).MethodByName("StackTrace")
+		if m.IsValid() && m.Type().NumIn() == 0 && m.Type().NumOut() == 1 {
+			st = m
+		}
+	}
+	if !st.IsValid() {
+		return "", false
+	}
+	return fmt.Sprintf("%+v", st.Call(nil)[0].Interface()), true
+}
+
+// convertKVs calls f with the attributes of the key-value pairs of kvs. Keys
+// that are not strings are converted to their string representation. The
+// value of a key missing its value is empty.
+//
+// The pairs holding a [context.Context] value are not converted, the last
+// context found is returned instead. Nil is returned if kvs holds none.
+func convertKVs(kvs []any, f func(log.KeyValue)) (ctx context.Context) {
+	for i := 0; i < len(kvs); i += 2 {
+		key, ok := kvs[i].(string)
+		if !ok {
+			key = fmt.Sprint(kvs[i])
+		}
+		var value log.Value
+		if i+1 < len(kvs) {
+			if c, ok := kvs[i+1].(context.Context); ok {
+				ctx = c
+				continue
+			}
+			value = convertValue(kvs[i+1])
+		}
+		f(log.KeyValue{Key: key, Value: value})
+	}
+	return ctx
+}
+
+// convertValue returns the log.Value of the Go value v. The [logr.Marshaler]
+// values are converted to the value they marshal to, other values with
+// [bridgeutil.ConvertAny].
+func convertValue(v any) log.Value {
+	if m, ok := v.(logr.Marshaler); ok {
+		return convertValue(m.MarshalLog())
+	}
+	return bridgeutil.ConvertAny(v)
+}
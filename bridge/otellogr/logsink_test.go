@@ -0,0 +1,335 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	l.Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewLogSinkGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	NewLogger("name").Info("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestLogSinkInfo(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l.WithValues("a", 1).V(1).Info("msg", "b", "c", 2, true, "odd")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, log.SeverityDebug4, got[0].Severity())
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("a", 1),
+		log.String("b", "c"),
+		log.Bool("2", true),
+		{Key: "odd"},
+	}, logtest.Attributes(got[0]))
+}
+
+func TestLogSinkError(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l.V(2).Error(errors.New("boom"), "msg", "k", "v")
+	l.Error(nil, "msg")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, log.SeverityError, got[0].Severity())
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, []log.KeyValue{
+		log.String("exception.type", "*errors.errorString"),
+		log.String("exception.message", "boom"),
+		log.String("k", "v"),
+	}, logtest.Attributes(got[0]))
+	assert.Empty(t, logtest.Attributes(got[1]))
+}
+
+type namedError struct{}
+
+func (namedError) Error() string { return "named" }
+
+// stackError is an error providing its stack trace.
+type stackError struct {
+	err   error
+	stack []string
+}
+
+func (e stackError) Error() string { return e.err.Error() }
+
+func (e stackError) Unwrap() error { return e.err }
+
+func (e stackError) StackTrace() []string { return e.stack }
+
+func TestConvertError(t *testing.T) {
+	convert := func(err error) []log.KeyValue {
+		var out []log.KeyValue
+		convertError(err, func(kv log.KeyValue) { out = append(out, kv) })
+		return out
+	}
+
+	assert.Equal(t, []log.KeyValue{
+		log.String("exception.type", "go.opentelemetry.io/otel/bridge/otellogr.namedError"),
+		log.String("exception.message", "named"),
+	}, convert(namedError{}), "named type")
+
+	inner := stackError{err: namedError{}, stack: []string{"inner"}}
+	outer := stackError{err: fmt.Errorf("wrap: %w", inner), stack: []string{"outer"}}
+	assert.Equal(t, []log.KeyValue{
+		log.String("exception.type", "go.opentelemetry.io/otel/bridge/otellogr.stackError"),
+		log.String("exception.message", "wrap: named"),
+		log.String("exception.stacktrace", "[inner]"),
+	}, convert(outer), "stack trace")
+}
+
+func TestLogSinkWithValues(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec)).WithValues("a", 1)
+	l1 := l.WithValues("b", 2)
+	l2 := l.WithValues("c", 3)
+	l1.Info("msg")
+	l2.Info("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("b", 2)}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("c", 3)}, logtest.Attributes(got[1]))
+}
+
+func TestLogSinkWithNameScope(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec), WithVersion("v1"))
+	l.WithName("a").WithName("b").Info("msg")
+
+	var found bool
+	for _, sr := range rec.Result() {
+		if sr.Name == "name/a/b" {
+			found = true
+			assert.Equal(t, "v1", sr.Version)
+			require.Len(t, sr.Records, 1)
+			assert.Empty(t, logtest.Attributes(sr.Records[0]))
+		}
+	}
+	assert.True(t, found, "logger name/a/b not used")
+}
+
+func TestLogSinkWithNameAttribute(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec), WithNameMapping(NameToAttribute))
+	l.WithName("a").WithValues("k", "v").WithName("b").Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	require.Len(t, got[1].Records, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.String(NameKey, "a/b"),
+		log.String("k", "v"),
+	}, logtest.Attributes(got[1].Records[0]))
+}
+
+func TestLogSinkEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityDebug1
+	}))
+	l := NewLogger("name", WithLoggerProvider(rec))
+	assert.True(t, l.Enabled())
+	assert.True(t, l.V(4).Enabled())
+	assert.False(t, l.V(5).Enabled())
+
+	l.V(5).Info("msg")
+	assert.Empty(t, rec.Records())
+}
+
+func TestDefaultLevelSeverity(t *testing.T) {
+	for level, want := range map[int]log.Severity{
+		0:   log.SeverityInfo,
+		1:   log.SeverityDebug4,
+		4:   log.SeverityDebug1,
+		5:   log.SeverityTrace4,
+		8:   log.SeverityTrace1,
+		100: log.SeverityTrace1,
+	} {
+		assert.Equal(t, want, bridgeutil.VerbositySeverity(level), "level %d", level)
+	}
+}
+
+func TestWithLevelSeverity(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec), WithLevelSeverity(func(level int) log.Severity {
+		if level > 0 {
+			return log.SeverityDebug
+		}
+		return log.SeverityInfo
+	}))
+	l.V(3).Info("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.SeverityDebug, got[0].Severity())
+}
+
+type marshaler struct{}
+
+func (marshaler) MarshalLog() any { return map[string]int{"b": 2, "a": 1} }
+
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+func TestConvertValue(t *testing.T) {
+	i := 1
+	var nilPtr *int
+	tests := []struct {
+		name string
+		v    any
+		want log.Value
+	}{
+		{"nil", nil, log.Value{}},
+		{"bool", true, log.BoolValue(true)},
+		{"int", 1, log.Int64Value(1)},
+		{"uint", uint8(1), log.Int64Value(1)},
+		{"uint overflow", uint64(math.MaxUint64), log.StringValue("18446744073709551615")},
+		{"float", float32(1.5), log.Float64Value(1.5)},
+		{"string", "s", log.StringValue("s")},
+		{"bytes", []byte{1}, log.BytesValue([]byte{1})},
+		{"byte array", [1]byte{1}, log.BytesValue([]byte{1})},
+		{"slice", []any{1, "a"}, log.SliceValue(log.Int64Value(1), log.StringValue("a"))},
+		{"map", map[int]bool{2: false, 1: true}, log.MapValue(log.Bool("1", true), log.Bool("2", false))},
+		{"pointer", &i, log.Int64Value(1)},
+		{"nil pointer", nilPtr, log.Value{}},
+		{"error", errors.New("err"), log.StringValue("err")},
+		{"stringer", stringer{}, log.StringValue("stringer")},
+		{"marshaler", marshaler{}, log.MapValue(log.Int64("a", 1), log.Int64("b", 2))},
+		{"struct", struct{ A int }{1}, log.StringValue("{A:1}")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, convertValue(tt.v))
+		})
+	}
+}
+
+func TestLogSinkImplementsLogr(t *testing.T) {
+	var sink logr.LogSink = NewLogSink("name", WithLoggerProvider(logtest.NewRecorder()))
+	sink.Init(logr.RuntimeInfo{CallDepth: 1})
+	assert.Same(t, sink, sink.WithValues())
+}
+
+type ctxKey struct{}
+
+func TestLogSinkContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+	ctx2 := context.WithValue(context.Background(), ctxKey{}, "v2")
+
+	rec := logtest.NewRecorder()
+	sink := NewLogSink("name", WithLoggerProvider(rec))
+	l := logr.New(sink)
+	l.Info("msg1", "ctx", ctx, "k", "v")
+	l.Error(nil, "msg2", "ctx", ctx)
+	l.Info("msg3")
+	l.WithValues("ctx", ctx).Info("msg4")
+	l.WithValues("ctx", ctx).Info("msg5", "ctx", ctx2)
+
+	require.Len(t, rec.Contexts(), 5)
+	assert.Equal(t, ctx, rec.Contexts()[0])
+	assert.Equal(t, ctx, rec.Contexts()[1])
+	assert.Equal(t, context.Background(), rec.Contexts()[2])
+	assert.Equal(t, ctx, rec.Contexts()[3])
+	assert.Equal(t, ctx2, rec.Contexts()[4])
+
+	rec.Reset()
+	l = NewLogger("name", WithLoggerProvider(rec))
+	l.Info("msg", "ctx", ctx, "k", "v")
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{log.String("k", "v")}, logtest.Attributes(got[0]), "context converted to an attribute")
+}
+
+func TestLogSinkSampledOnlyCorrelation(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rec := logtest.NewRecorder()
+	sink := NewLogSink("name")
+	sink.logger = rec
+	logr.New(sink).Info("msg", "ctx", ctx)
+
+	sink = NewLogSink("name", WithSampledOnlyCorrelation())
+	sink.logger = rec
+	logr.New(sink).Info("msg", "ctx", ctx)
+
+	require.Len(t, rec.Contexts(), 2)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.Contexts()[0]), "default")
+	assert.False(t, trace.SpanContextFromContext(rec.Contexts()[1]).IsValid(), "sampled only")
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkLogSink(b *testing.B) {
+	err := errors.New("err")
+	sink := NewLogSink("name")
+	sink.logger = discardLogger{}
+	l := logr.New(sink).WithValues("k", "v")
+
+	b.Run("Info", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l.Info("msg", "a", "b", "c", 1, "d", true)
+		}
+	})
+
+	b.Run("Error", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l.Error(err, "msg", "a", "b", "c", 1)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogrus // import "go.opentelemetry.io/otel/bridge/otellogrus"
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a Hook.
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+	levels    []logrus.Level
+	// sampledOnly is true if the log records are only correlated with
+	// sampled spans.
+	sampledOnly bool
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	if c.levels == nil {
+		c.levels = logrus.AllLevels
+	}
+	return c
+}
+
+// logger returns the log.Logger named name of the configured provider.
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// Option configures a [Hook].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Hook]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Hook]. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Hook] to create its [log.Logger].
+//
+// By default, if this Option is not provided, the Hook will use the global
+// LoggerProvider.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithLevels returns an [Option] that configures the logrus levels of the
+// entries a [Hook] is fired for, and thus emits. The entries of other levels
+// are not emitted.
+//
+// By default, if this Option is not provided, the Hook is fired for all the
+// levels, i.e. [logrus.AllLevels]. The entries are still filtered by the
+// level of the [logrus.Logger] and by the [log.Logger].
+func WithLevels(levels ...logrus.Level) Option {
+	return optFunc(func(c config) config {
+		c.levels = append([]logrus.Level{}, levels...)
+		return c
+	})
+}
+
+// WithSampledOnlyCorrelation returns an [Option] that configures a [Hook] to
+// only correlate the entries it fires for with sampled spans. The entries
+// holding the context of a span that is not sampled, e.g. added with
+// [logrus.WithContext], are emitted with no trace context.
+//
+// By default, if this Option is not provided, the entries are correlated
+// with the span of their context, sampled or not.
+func WithSampledOnlyCorrelation() Option {
+	return optFunc(func(c config) config {
+		c.sampledOnly = true
+		return c
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otellogrus provides a [logrus.Hook], a bridge from
+// [github.com/sirupsen/logrus] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewHook] to create a [Hook] emitting log records to a [log.Logger] of
+// the configured [log.LoggerProvider], and add it to a [logrus.Logger] with
+// [logrus.Logger.AddHook]. The Hook is fired for all the levels, use
+// [WithLevels] to select them.
+//
+// The log entries are converted as follows:
+//
+//   - The time is the timestamp.
+//   - The message is the body as a string value.
+//   - The level is converted to the severity: TraceLevel, DebugLevel,
+//     InfoLevel, WarnLevel, ErrorLevel, and FatalLevel to
+//     [log.SeverityTrace], [log.SeverityDebug], [log.SeverityInfo],
+//     [log.SeverityWarn], [log.SeverityError], and [log.SeverityFatal], and
+//     PanicLevel to [log.SeverityFatal2]. The severity text is the name of
+//     the level.
+//   - The caller, if reported, is added as the code.filepath, code.lineno,
+//     and code.function attributes defined by the semantic conventions.
+//   - The fields, including the error added with [logrus.Entry.WithError],
+//     are converted to attributes sorted by key.
+//
+// The field values are converted based on the Go value they hold. Booleans,
+// numbers, and strings, including the ones of named types, are converted to
+// the values of the matching kind. Times are converted to their Unix time in
+// nanoseconds, durations to nanoseconds. Byte slices and arrays are converted
+// to [log.KindBytes] values, other slices and arrays to [log.KindSlice]
+// values, and maps to [log.KindMap] values sorted by key. Pointers are
+// dereferenced. Errors and [fmt.Stringer] values are converted to the string
+// they return. Other values are converted to their string representation.
+//
+// The context of the entry, set with [logrus.Entry.WithContext], is passed to
+// the [log.Logger] so that the log record is correlated with the span it
+// holds. Otherwise, [context.Background] is passed.
+package otellogrus // import "go.opentelemetry.io/otel/bridge/otellogrus"
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogrus_test
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"go.opentelemetry.io/otel/bridge/otellogrus"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Add a hook emitting the log entries of the warning level and above to
+	// the OpenTelemetry Logs Bridge API.
+	logger := logrus.New()
+	logger.AddHook(otellogrus.NewHook(
+		"my/pkg/name",
+		otellogrus.WithLoggerProvider(provider),
+		otellogrus.WithLevels(logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel, logrus.WarnLevel),
+	))
+
+	// The context correlates the log record with the span it holds.
+	ctx := context.Background()
+	logger.WithContext(ctx).WithField("user", "alice").Warn("hello")
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogrus // import "go.opentelemetry.io/otel/bridge/otellogrus"
+
+import (
+	"slices"
+
+	"github.com/sirupsen/logrus"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Compile-time check Hook implements logrus.Hook.
+var _ logrus.Hook = (*Hook)(nil)
+
+// Hook is a [logrus.Hook] that emits the log entries it is fired for using a
+// [log.Logger].
+//
+// Use [NewHook] to create a Hook and add it to a [logrus.Logger] with
+// [logrus.Logger.AddHook].
+type Hook struct {
+	logger log.Logger
+	levels []logrus.Level
+	// sampledOnly is true if the entries are only correlated with sampled
+	// spans.
+	sampledOnly bool
+}
+
+// NewHook returns a new [Hook] emitting log records using the [log.Logger]
+// named name of the configured [log.LoggerProvider]. The name should be the
+// package import path that is being logged.
+func NewHook(name string, options ...Option) *Hook {
+	cfg := newConfig(options)
+	return &Hook{logger: cfg.logger(name), levels: cfg.levels, sampledOnly: cfg.sampledOnly}
+}
+
+// Levels returns the levels h is fired for, see [WithLevels].
+func (h *Hook) Levels() []logrus.Level {
+	return h.levels
+}
+
+// Fire emits the conversion of entry using the [log.Logger] of h. The context
+// of entry, or [context.Background] if it has none, is passed to the Logger,
+// see [bridgeutil.TraceContext].
+// The entry is not converted if the Logger is not enabled for its severity.
+//
+// The returned error is always nil.
+func (h *Hook) Fire(entry *logrus.Entry) error {
+	ctx := bridgeutil.TraceContext(entry.Context, h.sampledOnly)
+
+	var record log.Record
+	record.SetSeverity(bridgeutil.LogrusLevelSeverity(entry.Level))
+	if !h.logger.Enabled(ctx, record) {
+		return nil
+	}
+
+	if !entry.Time.IsZero() {
+		record.SetTimestamp(entry.Time)
+	}
+	record.SetBody(log.StringValue(entry.Message))
+	record.SetSeverityText(entry.Level.String())
+
+	if entry.HasCaller() {
+		record.AddAttributes(
+			log.String(string(semconv.CodeFilepathKey), entry.Caller.File),
+			log.Int(string(semconv.CodeLineNumberKey), entry.Caller.Line),
+			log.String(string(semconv.CodeFunctionKey), entry.Caller.Function),
+		)
+	}
+
+	if len(entry.Data) > 0 {
+		// The fields are added sorted by key, the iteration order of a map is
+		// not deterministic.
+		keys := make([]string, 0, len(entry.Data))
+		for k := range entry.Data {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		batch := bridgeutil.NewAttrBatch(&record)
+		for _, k := range keys {
+			batch.Add(log.KeyValue{Key: k, Value: bridgeutil.ConvertAny(entry.Data[k])})
+		}
+		batch.Flush()
+	}
+
+	h.logger.Emit(ctx, record)
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otellogrus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newLogger returns a logrus.Logger discarding its output and firing h.
+func newLogger(h logrus.Hook) *logrus.Logger {
+	l := logrus.New()
+	l.SetOutput(io.Discard)
+	l.SetLevel(logrus.TraceLevel)
+	l.AddHook(h)
+	return l
+}
+
+func TestNewHook(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHook(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	assert.Equal(t, logrus.AllLevels, h.Levels())
+	newLogger(h).Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewHookGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	newLogger(NewHook("name")).Info("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestHookFire(t *testing.T) {
+	rec := logtest.NewRecorder()
+	now := time.Unix(0, 1000)
+	err := errors.New("err")
+	newLogger(NewHook("name", WithLoggerProvider(rec))).
+		WithTime(now).
+		WithError(err).
+		WithFields(logrus.Fields{"b": 1, "a": "v", "t": now}).
+		Warn("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, now, got[0].Timestamp())
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "warning", got[0].SeverityText())
+	assert.Equal(t, []log.KeyValue{
+		log.String("a", "v"),
+		log.Int64("b", 1),
+		log.String(logrus.ErrorKey, "err"),
+		log.Int64("t", 1000),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestHookCaller(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := newLogger(NewHook("name", WithLoggerProvider(rec)))
+	l.SetReportCaller(true)
+	pc, file, line, _ := runtime.Caller(0)
+	l.Info("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.String(string(semconv.CodeFilepathKey), file),
+		log.Int(string(semconv.CodeLineNumberKey), line+1),
+		log.String(string(semconv.CodeFunctionKey), runtime.FuncForPC(pc).Name()),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestConvertLevel(t *testing.T) {
+	for level, want := range map[logrus.Level]log.Severity{
+		logrus.PanicLevel: log.SeverityFatal2,
+		logrus.FatalLevel: log.SeverityFatal,
+		logrus.ErrorLevel: log.SeverityError,
+		logrus.WarnLevel:  log.SeverityWarn,
+		logrus.InfoLevel:  log.SeverityInfo,
+		logrus.DebugLevel: log.SeverityDebug,
+		logrus.TraceLevel: log.SeverityTrace,
+	} {
+		assert.Equal(t, want, bridgeutil.LogrusLevelSeverity(level), "level %s", level)
+	}
+}
+
+func TestWithLevels(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHook("name", WithLoggerProvider(rec), WithLevels(logrus.ErrorLevel, logrus.WarnLevel))
+	assert.Equal(t, []logrus.Level{logrus.ErrorLevel, logrus.WarnLevel}, h.Levels())
+
+	l := newLogger(h)
+	l.Info("info")
+	l.Warn("warn")
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("warn"), got[0].Body())
+}
+
+func TestHookEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	l := newLogger(NewHook("name", WithLoggerProvider(rec)))
+	l.Debug("debug")
+	l.Info("info")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+}
+
+type ctxKey struct{}
+
+func TestHookContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+	rec := logtest.NewRecorder()
+	h := NewHook("name")
+	h.logger = rec
+	l := newLogger(h)
+	l.WithContext(ctx).Info("msg1")
+	l.Info("msg2")
+
+	require.Len(t, rec.Contexts(), 2)
+	assert.Equal(t, ctx, rec.Contexts()[0])
+	assert.Equal(t, context.Background(), rec.Contexts()[1])
+}
+
+func TestHookSampledOnlyCorrelation(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rec := logtest.NewRecorder()
+	h := NewHook("name")
+	h.logger = rec
+	newLogger(h).WithContext(ctx).Info("msg")
+
+	h = NewHook("name", WithSampledOnlyCorrelation())
+	h.logger = rec
+	newLogger(h).WithContext(ctx).Info("msg")
+
+	require.Len(t, rec.Contexts(), 2)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.Contexts()[0]), "default")
+	assert.False(t, trace.SpanContextFromContext(rec.Contexts()[1]).IsValid(), "sampled only")
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkHook(b *testing.B) {
+	h := NewHook("name")
+	h.logger = discardLogger{}
+	entry := logrus.NewEntry(logrus.New()).WithFields(logrus.Fields{"a": "b", "c": 1, "d": true})
+	entry.Message = "msg"
+	entry.Level = logrus.InfoLevel
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = h.Fire(entry)
+	}
+}
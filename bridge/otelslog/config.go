@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"log/slog"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a Handler.
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+	source    bool
+	// sampledOnly is true if the log records are only correlated with
+	// sampled spans.
+	sampledOnly bool
+	fields      bridgeutil.FieldMapping
+	tee         slog.Handler
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	return c
+}
+
+// logger returns the log.Logger named name of the configured provider.
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// Option configures a [Handler].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Handler]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Handler]. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Handler] to create its [log.Logger].
+//
+// By default, if this Option is not provided, the Handler will use the global
+// LoggerProvider.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithSource returns an [Option] that configures a [Handler] to add the
+// source code location of the log records it handles as the code.filepath,
+// code.lineno, and code.function attributes defined by the semantic
+// conventions.
+//
+// Resolving the location of a log record has a cost, it is only done if
+// source is true. By default, if this Option is not provided, the source code
+// location is not added.
+func WithSource(source bool) Option {
+	return optFunc(func(c config) config {
+		c.source = source
+		return c
+	})
+}
+
+// WithFieldMapping returns an [Option] that configures a [Handler] to rename
+// the keys of the attributes it handles as defined by fields, e.g. the
+// mapping returned by [bridgeutil.SemconvFieldMapping] or
+// [bridgeutil.ECSFieldMapping].
+//
+// The mapping is applied to the attributes not nested in a group, the ones
+// added with [Handler.WithAttrs] included, when a record is handled. An
+// attribute mapped to the body replaces the message of a record only if it is
+// empty.
+//
+// By default, if this Option is not provided, the keys are not renamed.
+func WithFieldMapping(fields bridgeutil.FieldMapping) Option {
+	return optFunc(func(c config) config {
+		c.fields = fields
+		return c
+	})
+}
+
+// WithTee returns an [Option] that configures a [Handler] to also pass the
+// records it handles to handler, e.g. a [slog.JSONHandler] writing to the
+// console or to a local file. It eases the adoption of the bridge, the
+// existing output is kept while migrating to the OpenTelemetry Logs Bridge
+// API.
+//
+// A record is emitted if the [log.Logger] is enabled for its level, and
+// passed to handler if handler is enabled for it. The attributes and groups
+// added with [Handler.WithAttrs] and [Handler.WithGroup] are added to handler
+// as well. The error returned by handler is returned by [Handler.Handle].
+//
+// By default, if this Option is not provided or handler is nil, the records
+// are only emitted.
+func WithTee(handler slog.Handler) Option {
+	return optFunc(func(c config) config {
+		c.tee = handler
+		return c
+	})
+}
+
+// WithSampledOnlyCorrelation returns an [Option] that configures a [Handler]
+// to only correlate the log records it handles with sampled spans. The log
+// records handled with the context of a span that is not sampled are emitted
+// with no trace context.
+//
+// By default, if this Option is not provided, the log records are correlated
+// with the span of their context, sampled or not.
+func WithSampledOnlyCorrelation() Option {
+	return optFunc(func(c config) config {
+		c.sampledOnly = true
+		return c
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelslog provides a [slog.Handler], a bridge from [log/slog] to the
+// OpenTelemetry Logs Bridge API.
+//
+// Use [NewLogger] to create a [slog.Logger] emitting log records to a
+// [log.Logger] of the configured [log.LoggerProvider], or [NewHandler] to
+// create the [Handler] itself.
+//
+// The log records are converted as follows:
+//
+//   - The time is the timestamp. It is not set if the time is zero.
+//   - The message is the body as a string value.
+//   - The level is the severity, offset so that [slog.LevelDebug],
+//     [slog.LevelInfo], [slog.LevelWarn], and [slog.LevelError] are
+//     [log.SeverityDebug], [log.SeverityInfo], [log.SeverityWarn], and
+//     [log.SeverityError]. The string representation of the level is the
+//     severity text.
+//   - The attributes are converted to attributes of the same key. Groups,
+//     including the ones opened with [slog.Logger.WithGroup], are converted to
+//     [log.KindMap] values. Empty attributes and empty groups are dropped, and
+//     the attributes of groups with an empty key are inlined.
+//   - If the [WithSource] option is used, the source code location is added as
+//     the code.filepath, code.lineno, and code.function attributes.
+//
+// The attribute values are converted as follows:
+//
+//   - [slog.KindBool], [slog.KindFloat64], [slog.KindInt64], and
+//     [slog.KindString] values are converted to the values of the same kind.
+//   - [slog.KindUint64] values are converted to [log.KindInt64] values, or to
+//     their string representation if they overflow an int64.
+//   - [slog.KindDuration] values are converted to their count of nanoseconds
+//     and [slog.KindTime] values to their Unix time in nanoseconds.
+//   - [slog.KindLogValuer] values are resolved before being converted. The
+//     values of the attributes added with [slog.Logger.With] are resolved each
+//     time a log record is emitted, so they are not resolved if the
+//     [log.Logger] is not enabled.
+//   - [slog.KindAny] values are converted based on the Go value they hold.
+//     Booleans, numbers, and strings, including the ones of named types, are
+//     converted to the values of the matching kind. Byte slices and arrays are
+//     converted to [log.KindBytes] values, other slices and arrays to
+//     [log.KindSlice] values, and maps to [log.KindMap] values sorted by key.
+//     Pointers are dereferenced. Errors and [fmt.Stringer] values are
+//     converted to the string they return. Other values are converted to
+//     their string representation.
+//
+// Use the [WithTee] option to also pass the log records to another
+// [slog.Handler], e.g. to keep writing them to the console while migrating to
+// the OpenTelemetry Logs Bridge API.
+//
+// The context passed to the [slog.Logger] methods, e.g.
+// [slog.Logger.InfoContext], is passed to the [log.Logger], so it can
+// correlate the log records with the active span.
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/otel/bridge/otelslog"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create a *slog.Logger emitting log records to the OpenTelemetry Logs
+	// Bridge API.
+	logger := otelslog.NewLogger("my/pkg/name", otelslog.WithLoggerProvider(provider))
+
+	// The context is passed to the OpenTelemetry Logger. It is used to
+	// correlate the log record with the active span.
+	logger.InfoContext(context.Background(), "hello", "user", "alice")
+
+	// Attributes and groups are nested as map values.
+	logger.WithGroup("request").With("method", "GET").Info("served", "status", 200)
+}
+
+func ExampleWithTee() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Keep writing the log records to the standard error while emitting them
+	// to the OpenTelemetry Logs Bridge API.
+	console := slog.NewJSONHandler(os.Stderr, nil)
+	logger := otelslog.NewLogger(
+		"my/pkg/name",
+		otelslog.WithLoggerProvider(provider),
+		otelslog.WithTee(console),
+	)
+	logger.Info("hello", "user", "alice")
+}
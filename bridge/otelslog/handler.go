@@ -0,0 +1,362 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"slices"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Compile-time check Handler implements slog.Handler.
+var _ slog.Handler = (*Handler)(nil)
+
+// Handler is a [slog.Handler] that emits the log records it handles using a
+// [log.Logger].
+//
+// Use [NewHandler] to create a Handler.
+type Handler struct {
+	logger log.Logger
+	// source is true if the source code location of records is added.
+	source bool
+	// sampledOnly is true if the records are only correlated with sampled
+	// spans.
+	sampledOnly bool
+	// fields maps the keys of the attributes not nested in a group.
+	fields bridgeutil.FieldMapping
+	// tee is the handler the records are also passed to. It is nil if the
+	// records are only emitted.
+	tee slog.Handler
+
+	// attrs are the attributes added with WithAttrs while no group is open.
+	attrs []attr
+	// group is the innermost group opened with WithGroup. It is nil if no
+	// group is open.
+	group *group
+}
+
+// group is a group of attributes opened with WithGroup.
+type group struct {
+	name string
+	// attrs are the attributes added with WithAttrs while the group is the
+	// innermost group.
+	attrs []attr
+	// parent is the group the group is nested in. It is nil for the
+	// outermost group.
+	parent *group
+}
+
+// attr is an attribute added with WithAttrs.
+//
+// The attributes holding a slog.LogValuer are kept as is, and resolved each
+// time a record is handled, so that they are only resolved if the log.Logger
+// is enabled. The other attributes are converted once.
+type attr struct {
+	kv log.KeyValue
+
+	// valuer is the attribute holding a slog.LogValuer. It is only used if
+	// lazy is true.
+	valuer slog.Attr
+	lazy   bool
+}
+
+// walk calls f with the log attributes a is converted to.
+func (a attr) walk(f func(log.KeyValue)) {
+	if a.lazy {
+		walkAttr(a.valuer, f)
+		return
+	}
+	f(a.kv)
+}
+
+// newAttrs returns attrs converted to attributes added with WithAttrs.
+func newAttrs(attrs []slog.Attr) []attr {
+	var out []attr
+	for _, a := range attrs {
+		if hasLogValuer(a.Value) {
+			out = append(out, attr{valuer: a, lazy: true})
+			continue
+		}
+		walkAttr(a, func(kv log.KeyValue) {
+			out = append(out, attr{kv: kv})
+		})
+	}
+	return out
+}
+
+// hasLogValuer returns if v, or any value of the group it holds, is a
+// slog.LogValuer.
+func hasLogValuer(v slog.Value) bool {
+	switch v.Kind() {
+	case slog.KindLogValuer:
+		return true
+	case slog.KindGroup:
+		for _, a := range v.Group() {
+			if hasLogValuer(a.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewLogger returns a new [slog.Logger] backed by a [Handler] created with
+// [NewHandler].
+func NewLogger(name string, options ...Option) *slog.Logger {
+	return slog.New(NewHandler(name, options...))
+}
+
+// NewHandler returns a new [Handler] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewHandler(name string, options ...Option) *Handler {
+	cfg := newConfig(options)
+	return &Handler{
+		logger:      cfg.logger(name),
+		source:      cfg.source,
+		sampledOnly: cfg.sampledOnly,
+		fields:      cfg.fields,
+		tee:         cfg.tee,
+	}
+}
+
+// Enabled returns true if the [log.Logger] of h is enabled for the severity
+// of level, or if the handler configured with [WithTee] is enabled for level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.enabled(ctx, level) || (h.tee != nil && h.tee.Enabled(ctx, level))
+}
+
+// enabled returns true if the log.Logger of h is enabled for the severity of
+// level.
+func (h *Handler) enabled(ctx context.Context, level slog.Level) bool {
+	var record log.Record
+	record.SetSeverity(bridgeutil.SlogLevelSeverity(level))
+	return h.logger.Enabled(bridgeutil.TraceContext(ctx, h.sampledOnly), record)
+}
+
+// Handle emits the conversion of record using the [log.Logger] of h. The ctx
+// is passed to the Logger, see [bridgeutil.TraceContext].
+//
+// If a handler is configured with [WithTee], the record is only emitted if
+// the Logger is enabled for its level, and it is passed to the handler if the
+// handler is enabled for its level. The error returned by the handler is
+// returned. Otherwise, the returned error is always nil.
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	if h.tee == nil {
+		h.logger.Emit(bridgeutil.TraceContext(ctx, h.sampledOnly), h.convertRecord(record))
+		return nil
+	}
+
+	// Enabled only tells that one of the handlers is enabled.
+	if h.enabled(ctx, record.Level) {
+		h.logger.Emit(bridgeutil.TraceContext(ctx, h.sampledOnly), h.convertRecord(record))
+	}
+	if h.tee.Enabled(ctx, record.Level) {
+		return h.tee.Handle(ctx, record)
+	}
+	return nil
+}
+
+// convertRecord returns r converted to a log.Record holding the attributes
+// of h.
+func (h *Handler) convertRecord(r slog.Record) log.Record {
+	var record log.Record
+	if !r.Time.IsZero() {
+		record.SetTimestamp(r.Time)
+	}
+	record.SetBody(log.StringValue(r.Message))
+	record.SetSeverity(bridgeutil.SlogLevelSeverity(r.Level))
+	record.SetSeverityText(r.Level.String())
+
+	// Add the attributes one at a time so no slice holding them all is
+	// allocated.
+	addRecord := func(kv log.KeyValue) { record.AddAttributes(kv) }
+	if h.source && r.PC != 0 {
+		addSource(r.PC, addRecord)
+	}
+	addRecord = h.fields.Adder(&record, addRecord)
+	for _, a := range h.attrs {
+		a.walk(addRecord)
+	}
+
+	if h.group == nil {
+		r.Attrs(func(a slog.Attr) bool {
+			walkAttr(a, addRecord)
+			return true
+		})
+		return record
+	}
+
+	kvs := make([]log.KeyValue, 0, len(h.group.attrs)+r.NumAttrs())
+	add := func(kv log.KeyValue) { kvs = append(kvs, kv) }
+	for _, a := range h.group.attrs {
+		a.walk(add)
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		walkAttr(a, add)
+		return true
+	})
+	if kv, ok := h.group.keyValue(kvs); ok {
+		addRecord(kv)
+	}
+	return record
+}
+
+// keyValue returns the attribute of the outermost group of g, where kvs are
+// the attributes of the innermost group g. False is returned if all the
+// groups are empty.
+func (g *group) keyValue(kvs []log.KeyValue) (log.KeyValue, bool) {
+	for ; g.parent != nil; g = g.parent {
+		var parent []log.KeyValue
+		add := func(kv log.KeyValue) { parent = append(parent, kv) }
+		for _, a := range g.parent.attrs {
+			a.walk(add)
+		}
+		// Empty groups are dropped.
+		if len(kvs) > 0 {
+			parent = append(parent, log.Map(g.name, kvs...))
+		}
+		kvs = parent
+	}
+	if len(kvs) == 0 {
+		return log.KeyValue{}, false
+	}
+	return log.Map(g.name, kvs...), true
+}
+
+// WithAttrs returns a new [Handler] holding the attributes of h and attrs.
+// The attributes are added to the innermost group opened with WithGroup, if
+// any.
+//
+// The attributes holding a [slog.LogValuer] are resolved each time a record is
+// handled, not when WithAttrs is called.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	added := newAttrs(attrs)
+	if len(added) == 0 && h.tee == nil {
+		return h
+	}
+
+	h2 := *h
+	if h.tee != nil {
+		h2.tee = h.tee.WithAttrs(attrs)
+	}
+	if h.group == nil {
+		h2.attrs = append(slices.Clip(h.attrs), added...)
+	} else {
+		g := *h.group
+		g.attrs = append(slices.Clip(g.attrs), added...)
+		h2.group = &g
+	}
+	return &h2
+}
+
+// WithGroup returns a new [Handler] holding the attributes of h and nesting
+// the attributes added after it in the group name. If name is empty, h is
+// returned.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.group = &group{name: name, parent: h.group}
+	if h.tee != nil {
+		h2.tee = h.tee.WithGroup(name)
+	}
+	return &h2
+}
+
+// addSource calls f with the source code location attributes of the program
+// counter pc. The attributes of the unknown parts of the location are not
+// added.
+func addSource(pc uintptr, f func(log.KeyValue)) {
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	if frame.File != "" {
+		f(log.String(string(semconv.CodeFilepathKey), frame.File))
+	}
+	if frame.Line != 0 {
+		f(log.Int(string(semconv.CodeLineNumberKey), frame.Line))
+	}
+	if frame.Function != "" {
+		f(log.String(string(semconv.CodeFunctionKey), frame.Function))
+	}
+}
+
+// walkAttr calls f with the log attributes a is converted to: none if a, or
+// the group it holds, is empty, the attributes of the group a holds if its key
+// is empty, or the conversion of a otherwise.
+func walkAttr(a slog.Attr, f func(log.KeyValue)) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		attrs := a.Value.Group()
+		if len(attrs) == 0 {
+			return
+		}
+		if a.Key == "" {
+			for _, ga := range attrs {
+				walkAttr(ga, f)
+			}
+			return
+		}
+		kvs := make([]log.KeyValue, 0, len(attrs))
+		add := func(kv log.KeyValue) { kvs = append(kvs, kv) }
+		for _, ga := range attrs {
+			walkAttr(ga, add)
+		}
+		if len(kvs) > 0 {
+			f(log.Map(a.Key, kvs...))
+		}
+		return
+	}
+	f(log.KeyValue{Key: a.Key, Value: convertValue(a.Value)})
+}
+
+// convertValue returns the log.Value of the resolved, non-group, v.
+func convertValue(v slog.Value) log.Value {
+	switch v.Kind() {
+	case slog.KindBool:
+		return log.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return log.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindFloat64:
+		return log.Float64Value(v.Float64())
+	case slog.KindInt64:
+		return log.Int64Value(v.Int64())
+	case slog.KindString:
+		return log.StringValue(v.String())
+	case slog.KindTime:
+		return bridgeutil.TimeValue(v.Time())
+	case slog.KindUint64:
+		return bridgeutil.Uint64Value(v.Uint64())
+	}
+	return convertAny(v.Any())
+}
+
+// convertAny returns the log.Value of the Go value v, e.g. the value a
+// slog.LogValuer resolved to. A slog.Value is converted as an attribute value
+// is, other values with [bridgeutil.ConvertAny].
+func convertAny(v any) log.Value {
+	if val, ok := v.(slog.Value); ok {
+		val = val.Resolve()
+		if val.Kind() == slog.KindGroup {
+			var kvs []log.KeyValue
+			for _, a := range val.Group() {
+				walkAttr(a, func(kv log.KeyValue) { kvs = append(kvs, kv) })
+			}
+			return log.MapValue(kvs...)
+		}
+		return convertValue(val)
+	}
+	return bridgeutil.ConvertAny(v)
+}
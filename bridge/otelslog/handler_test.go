@@ -0,0 +1,517 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"runtime"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/embedded"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// toMap returns the record converted to the map expected by slogtest.
+func toMap(r log.Record) map[string]any {
+	m := map[string]any{
+		slog.LevelKey:   r.Severity(),
+		slog.MessageKey: r.Body().AsString(),
+	}
+	if t := r.Timestamp(); !t.IsZero() {
+		m[slog.TimeKey] = t
+	}
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		m[kv.Key] = toAny(kv.Value)
+		return true
+	})
+	return m
+}
+
+func toAny(v log.Value) any {
+	if v.Kind() != log.KindMap {
+		return v.String()
+	}
+	m := map[string]any{}
+	for _, kv := range v.AsMap() {
+		m[kv.Key] = toAny(kv.Value)
+	}
+	return m
+}
+
+func TestSlogtest(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler("test", WithLoggerProvider(rec))
+
+	results := func() []map[string]any {
+		var out []map[string]any
+		for _, r := range rec.Records() {
+			out = append(out, toMap(r))
+		}
+		return out
+	}
+	require.NoError(t, slogtest.TestHandler(h, results))
+}
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	l.Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewHandlerGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	NewLogger("name").Info("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestHandlerFieldMapping(t *testing.T) {
+	rec := logtest.NewRecorder()
+	fields := bridgeutil.SemconvFieldMapping()
+	fields["uid"] = "enduser.id"
+	l := NewLogger("name", WithLoggerProvider(rec), WithFieldMapping(fields))
+
+	l.With("uid", 1).Info("", "msg", "body", "err", "boom", slog.Group("g", "err", "nested"))
+	l.WithGroup("g").Info("msg", "err", "nested")
+	l.With("err", "boom").WithGroup("g").Info("msg", "msg", "nested")
+
+	got := rec.Records()
+	require.Len(t, got, 3)
+	assert.Equal(t, log.StringValue("body"), got[0].Body())
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("enduser.id", 1),
+		log.String("exception.message", "boom"),
+		log.Map("g", log.String("err", "nested")),
+	}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{log.Map("g", log.String("err", "nested"))}, logtest.Attributes(got[1]))
+	assert.Equal(t, log.StringValue("msg"), got[2].Body())
+	assert.Equal(t, []log.KeyValue{
+		log.String("exception.message", "boom"),
+		log.Map("g", log.String("msg", "nested")),
+	}, logtest.Attributes(got[2]))
+}
+
+func TestHandlerConvertRecord(t *testing.T) {
+	now := time.Now()
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+
+	var r slog.Record
+	r.Time = now
+	r.Level = slog.LevelWarn
+	r.Message = "msg"
+	r.AddAttrs(
+		slog.Bool("bool", true),
+		slog.Duration("duration", time.Second),
+		slog.Float64("float64", 1.5),
+		slog.Int64("int64", -1),
+		slog.String("string", "str"),
+		slog.Time("time", now),
+		slog.Uint64("uint64", 1),
+		slog.Uint64("uint64max", math.MaxUint64),
+		slog.Any("bytes", []byte{1}),
+		slog.Any("any", struct{ A int }{1}),
+		slog.Any("valuer", valuer{}),
+	)
+	require.NoError(t, l.Handler().Handle(context.Background(), r))
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, now, got[0].Timestamp())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "WARN", got[0].SeverityText())
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, []log.KeyValue{
+		log.Bool("bool", true),
+		log.Int64("duration", time.Second.Nanoseconds()),
+		log.Float64("float64", 1.5),
+		log.Int64("int64", -1),
+		log.String("string", "str"),
+		log.Int64("time", now.UnixNano()),
+		log.Int64("uint64", 1),
+		log.String("uint64max", "18446744073709551615"),
+		log.Bytes("bytes", []byte{1}),
+		log.String("any", "{A:1}"),
+		log.String("valuer", "resolved"),
+	}, logtest.Attributes(got[0]))
+}
+
+type valuer struct{}
+
+func (valuer) LogValue() slog.Value { return slog.StringValue("resolved") }
+
+func TestHandlerGroups(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec)).With("a", 1)
+
+	g1 := l.WithGroup("g1").With("b", 2)
+	g2 := g1.WithGroup("g2")
+	g2.Info("msg", "c", 3)
+	// The attributes added to g1 after g2 was created are not part of g2.
+	_ = g1.With("d", 4)
+	g2.Info("msg")
+	g1.WithGroup("empty").Info("msg", slog.Group("inline", slog.Group("")))
+	l.WithGroup("empty").Info("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 4)
+	assert.Equal(t, []log.KeyValue{
+		log.Int("a", 1),
+		log.Map("g1", log.Int("b", 2), log.Map("g2", log.Int("c", 3))),
+	}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{
+		log.Int("a", 1),
+		log.Map("g1", log.Int("b", 2)),
+	}, logtest.Attributes(got[1]))
+	assert.Equal(t, []log.KeyValue{
+		log.Int("a", 1),
+		log.Map("g1", log.Int("b", 2)),
+	}, logtest.Attributes(got[2]))
+	assert.Equal(t, []log.KeyValue{log.Int("a", 1)}, logtest.Attributes(got[3]))
+}
+
+func TestHandlerLevels(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  log.Severity
+	}{
+		{slog.LevelDebug, log.SeverityDebug},
+		{slog.LevelDebug + 1, log.SeverityDebug2},
+		{slog.LevelInfo, log.SeverityInfo},
+		{slog.LevelWarn, log.SeverityWarn},
+		{slog.LevelError, log.SeverityError},
+		{slog.LevelError + 4, log.SeverityFatal},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, bridgeutil.SlogLevelSeverity(tt.level), tt.level.String())
+	}
+}
+
+type ctxKey struct{}
+
+type ctxLogger struct {
+	embedded.Logger
+
+	min     log.Severity
+	enabled context.Context
+	emitted context.Context
+}
+
+func (l *ctxLogger) Emit(ctx context.Context, _ log.Record) { l.emitted = ctx }
+
+func (l *ctxLogger) Enabled(ctx context.Context, r log.Record) bool {
+	l.enabled = ctx
+	return r.Severity() >= l.min
+}
+
+func TestHandlerContext(t *testing.T) {
+	l := &ctxLogger{min: log.SeverityWarn}
+	h := &Handler{logger: l}
+	ctx := context.WithValue(context.Background(), ctxKey{}, true)
+
+	assert.False(t, h.Enabled(ctx, slog.LevelInfo), "info enabled")
+	assert.True(t, h.Enabled(ctx, slog.LevelWarn), "warn disabled")
+	assert.Equal(t, ctx, l.enabled, "Enabled context")
+
+	slog.New(h).WarnContext(ctx, "msg")
+	assert.Equal(t, ctx, l.emitted, "Emit context")
+}
+
+func TestHandlerSampledOnlyCorrelation(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	l := &ctxLogger{}
+	h := NewHandler("name")
+	h.logger = l
+	slog.New(h).InfoContext(ctx, "msg")
+	assert.Equal(t, sc, trace.SpanContextFromContext(l.emitted), "default")
+
+	h = NewHandler("name", WithSampledOnlyCorrelation())
+	h.logger = l
+	assert.True(t, h.Enabled(ctx, slog.LevelInfo))
+	assert.False(t, trace.SpanContextFromContext(l.enabled).IsValid(), "Enabled context")
+	slog.New(h).InfoContext(ctx, "msg")
+	assert.False(t, trace.SpanContextFromContext(l.emitted).IsValid(), "Emit context")
+}
+
+func TestHandlerTee(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	var buf bytes.Buffer
+	tee := slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	h := NewHandler("name", WithLoggerProvider(rec), WithTee(tee))
+
+	l := slog.New(h).With("a", 1).WithGroup("g").With("b", 2)
+	assert.True(t, l.Enabled(context.Background(), slog.LevelDebug), "tee enabled")
+	l.Debug("debug")
+	l.Info("info", "c", 3)
+
+	got := rec.Records()
+	require.Len(t, got, 1, "debug emitted")
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("a", 1),
+		log.Map("g", log.Int64("b", 2), log.Int64("c", 3)),
+	}, logtest.Attributes(got[0]))
+
+	assert.Equal(t, `{"level":"DEBUG","msg":"debug","a":1,"g":{"b":2}}
+{"level":"INFO","msg":"info","a":1,"g":{"b":2,"c":3}}
+`, buf.String())
+}
+
+func TestHandlerTeeLevel(t *testing.T) {
+	rec := logtest.NewRecorder()
+	var buf bytes.Buffer
+	tee := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn})
+	l := slog.New(NewHandler("name", WithLoggerProvider(rec), WithTee(tee)))
+	l.Info("info")
+
+	assert.Len(t, rec.Records(), 1)
+	assert.Empty(t, buf.String(), "tee disabled")
+}
+
+// errHandler is a slog.Handler failing to handle the records.
+type errHandler struct{ slog.Handler }
+
+var errTee = errors.New("tee")
+
+func (errHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (errHandler) Handle(context.Context, slog.Record) error { return errTee }
+
+func TestHandlerTeeError(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler("name", WithLoggerProvider(rec), WithTee(errHandler{}))
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "msg", 0)
+	assert.ErrorIs(t, h.Handle(context.Background(), r), errTee)
+	assert.Len(t, rec.Records(), 1, "emitted")
+}
+
+type discardLogger struct{ embedded.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func TestHandlerAllocs(t *testing.T) {
+	h := &Handler{logger: discardLogger{}}
+	ctx := context.Background()
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "msg", 0)
+	r.AddAttrs(
+		slog.String("a", "b"),
+		slog.Int("c", 1),
+		slog.Bool("d", true),
+		slog.Float64("e", 1),
+		slog.Duration("f", time.Second),
+	)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = h.Handle(ctx, r)
+	})
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkHandler(b *testing.B) {
+	ctx := context.Background()
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "msg", 0)
+	r.AddAttrs(
+		slog.String("a", "b"),
+		slog.Int("c", 1),
+		slog.Bool("d", true),
+		slog.Float64("e", 1),
+		slog.Duration("f", time.Second),
+	)
+
+	b.Run("NoGroup", func(b *testing.B) {
+		h := &Handler{logger: discardLogger{}}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = h.Handle(ctx, r)
+		}
+	})
+
+	b.Run("Group", func(b *testing.B) {
+		var h slog.Handler = &Handler{logger: discardLogger{}}
+		h = h.WithAttrs([]slog.Attr{slog.String("k", "v")}).WithGroup("g")
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = h.Handle(ctx, r)
+		}
+	})
+}
+
+// countingValuer counts the calls of its LogValue method.
+type countingValuer struct {
+	n   *int
+	val slog.Value
+}
+
+func (v countingValuer) LogValue() slog.Value {
+	*v.n++
+	return v.val
+}
+
+func TestHandlerLogValuerLazy(t *testing.T) {
+	var n int
+	v := countingValuer{n: &n, val: slog.IntValue(1)}
+
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	l := NewLogger("name", WithLoggerProvider(rec)).With("v", v)
+	g := l.WithGroup("g").With(slog.Group("inner", "v", v))
+	assert.Equal(t, 0, n, "resolved by With")
+
+	l.Debug("msg")
+	g.Debug("msg")
+	assert.Equal(t, 0, n, "resolved while disabled")
+
+	l.Info("msg")
+	assert.Equal(t, 1, n, "not resolved while enabled")
+	g.Info("msg")
+	assert.Equal(t, 3, n, "not resolved for each record")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, []log.KeyValue{log.Int("v", 1)}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{
+		log.Int("v", 1),
+		log.Map("g", log.Map("inner", log.Int("v", 1))),
+	}, logtest.Attributes(got[1]))
+}
+
+type named int
+
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+func TestConvertAny(t *testing.T) {
+	var nilPtr *int
+	i := 1
+	tests := []struct {
+		name string
+		in   any
+		want log.Value
+	}{
+		{"nil", nil, log.Value{}},
+		{"NilPointer", nilPtr, log.Value{}},
+		{"Pointer", &i, log.Int64Value(1)},
+		{"Named", named(2), log.Int64Value(2)},
+		{"Int8", int8(-3), log.Int64Value(-3)},
+		{"Uint32", uint32(4), log.Int64Value(4)},
+		{"UintOverflow", uint(math.MaxUint64), log.StringValue("18446744073709551615")},
+		{"Float32", float32(0.5), log.Float64Value(0.5)},
+		{"Bool", true, log.BoolValue(true)},
+		{"String", "s", log.StringValue("s")},
+		{"Bytes", []byte{1}, log.BytesValue([]byte{1})},
+		{"ByteArray", [2]byte{1, 2}, log.BytesValue([]byte{1, 2})},
+		{"Slice", []int{1, 2}, log.SliceValue(log.Int64Value(1), log.Int64Value(2))},
+		{"Array", [1]string{"a"}, log.SliceValue(log.StringValue("a"))},
+		{"Map", map[string]any{"b": 2, "a": "x"}, log.MapValue(log.String("a", "x"), log.Int64("b", 2))},
+		{"IntKeyMap", map[int]bool{1: true}, log.MapValue(log.Bool("1", true))},
+		{"Error", errors.New("err"), log.StringValue("err")},
+		{"Stringer", stringer{}, log.StringValue("stringer")},
+		{"SlogValue", slog.IntValue(5), log.Int64Value(5)},
+		{"SlogGroupValue", slog.GroupValue(slog.Int("a", 1)), log.MapValue(log.Int("a", 1))},
+		{"Struct", struct{ A int }{1}, log.StringValue("{A:1}")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, convertAny(tt.in))
+		})
+	}
+}
+
+func TestHandlerLogValuerKinds(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l.Info("msg", "v", countingValuer{n: new(int), val: slog.AnyValue([]string{"a", "b"})})
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.Slice("v", log.StringValue("a"), log.StringValue("b")),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestHandlerSource(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(rec), WithSource(true))
+	l.Info("msg", "k", "v")
+	pc, file, line, _ := runtime.Caller(0)
+	line-- // The line of the l.Info call.
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.String("code.filepath", file),
+		log.Int("code.lineno", line),
+		log.String("code.function", runtime.FuncForPC(pc).Name()),
+		log.String("k", "v"),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestHandlerSourceDisabled(t *testing.T) {
+	rec := logtest.NewRecorder()
+	NewLogger("name", WithLoggerProvider(rec)).Info("msg")
+	NewLogger("name", WithLoggerProvider(rec), WithSource(false)).Info("msg")
+
+	for _, r := range rec.Records() {
+		assert.Empty(t, logtest.Attributes(r))
+	}
+}
+
+func TestHandlerSourceNoPC(t *testing.T) {
+	rec := logtest.NewRecorder()
+	h := NewHandler("name", WithLoggerProvider(rec), WithSource(true))
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "msg", 0)
+	require.NoError(t, h.Handle(context.Background(), r))
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Empty(t, logtest.Attributes(got[0]))
+}
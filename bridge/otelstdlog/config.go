@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog // import "go.opentelemetry.io/otel/bridge/otelstdlog"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// config contains the configuration of a Writer.
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+	severity  log.Severity
+	prefixes  map[string]log.Severity
+}
+
+// newConfig returns the config configured with options.
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	if c.severity == log.SeverityUndefined {
+		c.severity = log.SeverityInfo
+	}
+	if c.prefixes == nil {
+		c.prefixes = defaultSeverityPrefixes
+	}
+	return c
+}
+
+// logger returns the log.Logger named name of the configured provider.
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// Option configures a [Writer].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Writer]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Writer]. The schemaURL should be
+// the schema URL for the semantic conventions used in log records.
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Writer] to create its [log.Logger].
+//
+// By default, if this Option is not provided, the Writer will use the global
+// LoggerProvider.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithSeverity returns an [Option] that configures the severity of the log
+// records emitted by a [Writer] for the lines without a severity prefix, see
+// [WithSeverityPrefixes].
+//
+// By default, if this Option is not provided, the severity is
+// [log.SeverityInfo].
+func WithSeverity(severity log.Severity) Option {
+	return optFunc(func(c config) config {
+		c.severity = severity
+		return c
+	})
+}
+
+// defaultSeverityPrefixes are the severity prefixes used by default.
+var defaultSeverityPrefixes = map[string]log.Severity{
+	"TRACE":    log.SeverityTrace,
+	"DEBUG":    log.SeverityDebug,
+	"INFO":     log.SeverityInfo,
+	"NOTICE":   log.SeverityInfo2,
+	"WARN":     log.SeverityWarn,
+	"WARNING":  log.SeverityWarn,
+	"ERROR":    log.SeverityError,
+	"ERR":      log.SeverityError,
+	"CRITICAL": log.SeverityFatal,
+	"FATAL":    log.SeverityFatal,
+	"PANIC":    log.SeverityFatal2,
+}
+
+// WithSeverityPrefixes returns an [Option] that configures the severity
+// prefixes sniffed by a [Writer] to determine the severity of the lines.
+//
+// The first word of a line, after the date and time written by a standard
+// library log.Logger configured with the log.Ldate and log.Ltime flags, is a
+// severity prefix if, with the brackets enclosing it and the colon
+// following it removed, it is a key of prefixes. The keys are matched
+// case-insensitively. The line is emitted with the severity of the key and
+// the prefix as severity text. For example, the lines "ERROR: failed",
+// "[error] failed", and "2009/11/10 23:00:00 Error failed" are emitted with
+// the severity of the ERROR key.
+//
+// Passing an empty prefixes disables the sniffing, all the lines are emitted
+// with the severity configured with [WithSeverity].
+//
+// By default, if this Option is not provided, the prefixes are TRACE, DEBUG,
+// INFO, NOTICE, WARN, WARNING, ERROR, ERR, CRITICAL, FATAL, and PANIC,
+// mapped to [log.SeverityTrace], [log.SeverityDebug], [log.SeverityInfo],
+// [log.SeverityInfo2], [log.SeverityWarn], [log.SeverityWarn],
+// [log.SeverityError], [log.SeverityError], [log.SeverityFatal],
+// [log.SeverityFatal], and [log.SeverityFatal2].
+func WithSeverityPrefixes(prefixes map[string]log.Severity) Option {
+	// Copy and normalize the prefixes, the sniffing matches the upper case
+	// prefixes.
+	p := make(map[string]log.Severity, len(prefixes))
+	for k, v := range prefixes {
+		p[strings.ToUpper(k)] = v
+	}
+	return optFunc(func(c config) config {
+		c.prefixes = p
+		return c
+	})
+}
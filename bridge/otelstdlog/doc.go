@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelstdlog provides an [io.Writer], a bridge from the standard
+// library log package to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewWriter] to create a [Writer] emitting log records to a [log.Logger]
+// of the configured [log.LoggerProvider], and set it as the output of a
+// standard library log.Logger, e.g. of the standard logger with log.SetOutput
+// to capture the lines of legacy code using log.Printf. Use [NewLogger] to
+// create a standard library log.Logger writing to a Writer.
+//
+// Each line written is converted as follows:
+//
+//   - The time the line is written is the timestamp.
+//   - The line is the body as a string value.
+//   - The severity is sniffed from the severity prefix of the line, e.g.
+//     "ERROR:" or "[warn]", see [WithSeverityPrefixes]. The lines without a
+//     severity prefix have the severity configured with [WithSeverity],
+//     [log.SeverityInfo] by default. The severity text is the severity prefix.
+//
+// [context.Background] is passed to the [log.Logger], the log records are not
+// correlated with a span.
+package otelstdlog // import "go.opentelemetry.io/otel/bridge/otelstdlog"
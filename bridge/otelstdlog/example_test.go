@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog_test
+
+import (
+	stdlog "log"
+
+	"go.opentelemetry.io/otel/bridge/otelstdlog"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create a standard library logger emitting its lines to the
+	// OpenTelemetry Logs Bridge API.
+	logger := otelstdlog.NewLogger("my/pkg/name", otelstdlog.WithLoggerProvider(provider))
+	logger.Printf("ERROR: user %s not found", "alice")
+}
+
+func ExampleNewWriter() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Capture the lines logged by legacy code using the standard logger. The
+	// lines without a severity prefix are emitted as warnings.
+	stdlog.SetOutput(otelstdlog.NewWriter(
+		"my/pkg/name",
+		otelstdlog.WithLoggerProvider(provider),
+		otelstdlog.WithSeverity(log.SeverityWarn),
+	))
+	stdlog.Print("disk almost full")
+}
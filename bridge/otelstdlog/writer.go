@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog // import "go.opentelemetry.io/otel/bridge/otelstdlog"
+
+import (
+	"context"
+	"io"
+	stdlog "log"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check Writer implements io.Writer.
+var _ io.Writer = (*Writer)(nil)
+
+// Writer is an [io.Writer] that emits each line written to it as a log record
+// using a [log.Logger].
+//
+// Use [NewWriter] to create a Writer, and set it as the output of a standard
+// library log.Logger, or use [NewLogger].
+//
+// A Writer is safe for concurrent use.
+type Writer struct {
+	logger   log.Logger
+	severity log.Severity
+	prefixes map[string]log.Severity
+}
+
+// NewWriter returns a new [Writer] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewWriter(name string, options ...Option) *Writer {
+	cfg := newConfig(options)
+	return &Writer{
+		logger:   cfg.logger(name),
+		severity: cfg.severity,
+		prefixes: cfg.prefixes,
+	}
+}
+
+// NewLogger returns a new standard library log.Logger writing to a [Writer]
+// created with [NewWriter]. The Logger has no prefix and no flags, the
+// timestamp of the log records is the time the lines are written.
+func NewLogger(name string, options ...Option) *stdlog.Logger {
+	return stdlog.New(NewWriter(name, options...), "", 0)
+}
+
+// Write emits each non-empty line of p as a log record. The lines are
+// separated by "\n", optionally preceded by "\r". The timestamp of the log
+// records is the time of the call and [context.Background] is passed to the
+// [log.Logger].
+//
+// The severity of a line is sniffed from its severity prefix, see
+// [WithSeverityPrefixes], or is the one configured with [WithSeverity]. A
+// line is not emitted if the Logger is not enabled for its severity.
+//
+// The returned error is always nil and the returned length is always len(p).
+func (w *Writer) Write(p []byte) (int, error) {
+	ctx := context.Background()
+	now := time.Now()
+	s := string(p)
+	for s != "" {
+		var line string
+		line, s, _ = strings.Cut(s, "\n")
+		line = strings.TrimSuffix(line, "\r")
+		if line == "" {
+			continue
+		}
+		w.emit(ctx, now, line)
+	}
+	return len(p), nil
+}
+
+// emit emits line using the log.Logger of w.
+func (w *Writer) emit(ctx context.Context, now time.Time, line string) {
+	severity, text := w.sniff(line)
+
+	var record log.Record
+	record.SetSeverity(severity)
+	if !w.logger.Enabled(ctx, record) {
+		return
+	}
+	record.SetTimestamp(now)
+	record.SetBody(log.StringValue(line))
+	record.SetSeverityText(text)
+	w.logger.Emit(ctx, record)
+}
+
+// sniff returns the severity and severity text of line, based on its severity
+// prefix.
+func (w *Writer) sniff(line string) (log.Severity, string) {
+	if len(w.prefixes) == 0 {
+		return w.severity, ""
+	}
+	prefix := strings.TrimSuffix(firstWord(line), ":")
+	if strings.HasPrefix(prefix, "[") && strings.HasSuffix(prefix, "]") {
+		prefix = prefix[1 : len(prefix)-1]
+	}
+	if s, ok := w.prefixes[strings.ToUpper(prefix)]; ok {
+		return s, prefix
+	}
+	return w.severity, ""
+}
+
+// firstWord returns the first space separated word of line, skipping the date
+// and time written by a standard library log.Logger.
+func firstWord(line string) string {
+	for {
+		var word string
+		word, line, _ = strings.Cut(strings.TrimLeft(line, " \t"), " ")
+		if word == "" || (!isDate(word) && !isTime(word)) {
+			return word
+		}
+	}
+}
+
+// isDate returns if s is a date written with the log.Ldate flag, e.g.
+// "2009/11/10".
+func isDate(s string) bool {
+	return len(s) == len("2009/11/10") && matchDigits(s, "dddd/dd/dd")
+}
+
+// isTime returns if s is a time written with the log.Ltime flag, e.g.
+// "23:00:00", optionally followed by the microseconds written with the
+// log.Lmicroseconds flag, e.g. "23:00:00.123123".
+func isTime(s string) bool {
+	const hms = "dd:dd:dd"
+	if len(s) < len(hms) || !matchDigits(s[:len(hms)], hms) {
+		return false
+	}
+	frac := s[len(hms):]
+	if frac == "" {
+		return true
+	}
+	return len(frac) > 1 && frac[0] == '.' && matchDigits(frac[1:], strings.Repeat("d", len(frac)-1))
+}
+
+// matchDigits returns if s matches pattern, where each 'd' of the pattern
+// matches a digit and the other characters match themselves.
+func matchDigits(s, pattern string) bool {
+	if len(s) != len(pattern) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if pattern[i] == 'd' {
+			if s[i] < '0' || s[i] > '9' {
+				return false
+			}
+		} else if s[i] != pattern[i] {
+			return false
+		}
+	}
+	return true
+}
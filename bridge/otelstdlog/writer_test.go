@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelstdlog
+
+import (
+	"context"
+	stdlog "log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+)
+
+func TestNewWriter(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	_, err := w.Write([]byte("msg\n"))
+	require.NoError(t, err)
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewWriterGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	NewLogger("name").Print("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestNewLogger(t *testing.T) {
+	rec := logtest.NewRecorder()
+	before := time.Now()
+	NewLogger("name", WithLoggerProvider(rec)).Printf("hello %s", "world")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("hello world"), got[0].Body())
+	assert.Equal(t, log.SeverityInfo, got[0].Severity())
+	assert.Equal(t, "", got[0].SeverityText())
+	assert.False(t, got[0].Timestamp().Before(before), "timestamp")
+}
+
+func TestWriterLines(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter("name", WithLoggerProvider(rec))
+	p := []byte("first\r\n\nsecond\nthird")
+	n, err := w.Write(p)
+	require.NoError(t, err)
+	assert.Equal(t, len(p), n)
+
+	var bodies []string
+	for _, r := range rec.Records() {
+		bodies = append(bodies, r.Body().AsString())
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, bodies)
+}
+
+func TestWriterSeverity(t *testing.T) {
+	tests := []struct {
+		line     string
+		severity log.Severity
+		text     string
+	}{
+		{"no prefix", log.SeverityWarn, ""},
+		{"ERROR: failed", log.SeverityError, "ERROR"},
+		{"[error] failed", log.SeverityError, "error"},
+		{"[Debug]: details", log.SeverityDebug, "Debug"},
+		{"2009/11/10 23:00:00 WARN slow", log.SeverityWarn, "WARN"},
+		{"2009/11/10 23:00:00.123456 panic: boom", log.SeverityFatal2, "panic"},
+		{"2009/11/10 hello", log.SeverityWarn, ""},
+		{"errors happen", log.SeverityWarn, ""},
+	}
+	rec := logtest.NewRecorder()
+	w := NewWriter("name", WithLoggerProvider(rec), WithSeverity(log.SeverityWarn))
+	for _, tt := range tests {
+		_, err := w.Write([]byte(tt.line + "\n"))
+		require.NoError(t, err)
+	}
+
+	got := rec.Records()
+	require.Len(t, got, len(tests))
+	for i, tt := range tests {
+		assert.Equal(t, tt.severity, got[i].Severity(), tt.line)
+		assert.Equal(t, tt.text, got[i].SeverityText(), tt.line)
+		assert.Equal(t, log.StringValue(tt.line), got[i].Body(), tt.line)
+	}
+}
+
+func TestWithSeverityPrefixes(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := stdlog.New(NewWriter(
+		"name",
+		WithLoggerProvider(rec),
+		WithSeverityPrefixes(map[string]log.Severity{"oops": log.SeverityError3}),
+	), "", stdlog.LstdFlags)
+	l.Print("OOPS something")
+	l.Print("ERROR not a prefix")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, log.SeverityError3, got[0].Severity())
+	assert.Equal(t, "OOPS", got[0].SeverityText())
+	assert.Equal(t, log.SeverityInfo, got[1].Severity())
+
+	rec = logtest.NewRecorder()
+	NewLogger("name", WithLoggerProvider(rec), WithSeverityPrefixes(nil)).Print("ERROR: failed")
+	got = rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.SeverityInfo, got[0].Severity(), "sniffing disabled")
+}
+
+func TestWriterEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	l := NewLogger("name", WithLoggerProvider(rec))
+	l.Print("DEBUG details")
+	l.Print("INFO info")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("INFO info"), got[0].Body())
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkWriter(b *testing.B) {
+	w := NewWriter("name")
+	w.logger = discardLogger{}
+	p := []byte("2009/11/10 23:00:00 ERROR: something failed\n")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = w.Write(p)
+	}
+}
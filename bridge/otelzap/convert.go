@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzap // import "go.opentelemetry.io/otel/bridge/otelzap"
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+)
+
+// walkField calls fn with the log.KeyValue f is converted to. It is called
+// once for each field held by an inline marshaler field, and not at all for a
+// skip or namespace field.
+//
+// The objects and arrays of marshaler fields are converted to [log.KindMap]
+// and [log.KindSlice] values. If marshaling fails, the value marshaled so far
+// is kept and the error message is added as the <key>Error attribute, as zap
+// encoders do.
+func walkField(f zapcore.Field, fn func(log.KeyValue)) {
+	switch f.Type {
+	case zapcore.SkipType, zapcore.NamespaceType:
+		return
+	case zapcore.ObjectMarshalerType, zapcore.ArrayMarshalerType, zapcore.InlineMarshalerType:
+		enc := newObjectEncoder()
+		f.AddTo(enc)
+		for _, kv := range enc.keyValues() {
+			fn(kv)
+		}
+		return
+	}
+	fn(convertField(f))
+}
+
+// convertField returns the log.KeyValue of f. It must not be called with a
+// skip, namespace, or marshaler field.
+func convertField(f zapcore.Field) log.KeyValue {
+	switch f.Type {
+	case zapcore.BoolType:
+		return log.Bool(f.Key, f.Integer == 1)
+	case zapcore.Int64Type, zapcore.Int32Type, zapcore.Int16Type, zapcore.Int8Type:
+		return log.Int64(f.Key, f.Integer)
+	case zapcore.Uint64Type, zapcore.Uint32Type, zapcore.Uint16Type, zapcore.Uint8Type, zapcore.UintptrType:
+		return log.KeyValue{Key: f.Key, Value: bridgeutil.Uint64Value(uint64(f.Integer))}
+	case zapcore.Float64Type:
+		return log.Float64(f.Key, math.Float64frombits(uint64(f.Integer)))
+	case zapcore.Float32Type:
+		return log.Float64(f.Key, float64(math.Float32frombits(uint32(f.Integer))))
+	case zapcore.Complex128Type, zapcore.Complex64Type:
+		return log.String(f.Key, fmt.Sprint(f.Interface))
+	case zapcore.StringType:
+		return log.String(f.Key, f.String)
+	case zapcore.BinaryType:
+		b, _ := f.Interface.([]byte)
+		return log.Bytes(f.Key, b)
+	case zapcore.ByteStringType:
+		b, _ := f.Interface.([]byte)
+		return log.String(f.Key, string(b))
+	case zapcore.DurationType:
+		return log.Int64(f.Key, f.Integer)
+	case zapcore.TimeType:
+		// f.Integer holds the Unix time in nanoseconds, f.Interface the
+		// location, which is irrelevant to it.
+		return log.Int64(f.Key, f.Integer)
+	case zapcore.TimeFullType:
+		t, _ := f.Interface.(time.Time)
+		return log.KeyValue{Key: f.Key, Value: bridgeutil.TimeValue(t)}
+	case zapcore.StringerType:
+		return log.String(f.Key, bridgeutil.StringerString(f.Interface.(fmt.Stringer)))
+	case zapcore.ErrorType:
+		return log.String(f.Key, bridgeutil.ErrorString(f.Interface.(error)))
+	}
+	return log.KeyValue{Key: f.Key, Value: bridgeutil.ConvertAny(f.Interface)}
+}
@@ -0,0 +1,243 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzap // import "go.opentelemetry.io/otel/bridge/otelzap"
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Compile-time check Core implements zapcore.Core.
+var _ zapcore.Core = (*Core)(nil)
+
+// Core is a [zapcore.Core] that emits the log entries it writes using a
+// [log.Logger].
+//
+// Use [NewCore] to create a Core.
+type Core struct {
+	provider log.LoggerProvider
+	logger   log.Logger
+	// sampledOnly is true if the records are only correlated with sampled
+	// spans.
+	sampledOnly bool
+	// fields maps the keys of the fields not nested in a namespace.
+	fields bridgeutil.FieldMapping
+
+	// attrs are the attributes added with With while no namespace is open.
+	attrs []log.KeyValue
+	// ns is the innermost namespace opened with a zap.Namespace field passed
+	// to With. It is nil if no namespace is open.
+	ns *namespace
+}
+
+// namespace is a namespace opened with a zap.Namespace field.
+type namespace struct {
+	name string
+	// attrs are the attributes added with With while the namespace is the
+	// innermost namespace.
+	attrs []log.KeyValue
+	// parent is the namespace the namespace is nested in. It is nil for the
+	// outermost namespace.
+	parent *namespace
+}
+
+// NewCore returns a new [Core] emitting log records using the [log.Logger]
+// named name of the configured [log.LoggerProvider]. The name should be the
+// package import path that is being logged.
+func NewCore(name string, options ...Option) *Core {
+	cfg := newConfig(options)
+	return &Core{
+		provider:    cfg.provider,
+		logger:      cfg.logger(name),
+		sampledOnly: cfg.sampledOnly,
+		fields:      cfg.fields,
+	}
+}
+
+// Enabled returns true if the [log.Logger] of c is enabled for the severity
+// of level.
+func (c *Core) Enabled(level zapcore.Level) bool {
+	var record log.Record
+	record.SetSeverity(bridgeutil.ZapLevelSeverity(level))
+	return c.logger.Enabled(context.Background(), record)
+}
+
+// With returns a new [Core] holding the attributes of c and the conversion
+// of fields. The fields following a zap.Namespace field are nested in it.
+func (c *Core) With(fields []zapcore.Field) zapcore.Core {
+	if len(fields) == 0 {
+		return c
+	}
+
+	c2 := *c
+	if c.ns == nil {
+		c2.attrs = slices.Clip(c.attrs)
+	} else {
+		ns := *c.ns
+		ns.attrs = slices.Clip(ns.attrs)
+		c2.ns = &ns
+	}
+	for _, f := range fields {
+		if f.Type == zapcore.NamespaceType {
+			c2.ns = &namespace{name: f.Key, parent: c2.ns}
+			continue
+		}
+		walkField(f, func(kv log.KeyValue) {
+			if c2.ns == nil {
+				c2.attrs = append(c2.attrs, kv)
+			} else {
+				c2.ns.attrs = append(c2.ns.attrs, kv)
+			}
+		})
+	}
+	return &c2
+}
+
+// Check adds c to ce if c is enabled for the level of ent.
+func (c *Core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write emits the conversion of ent and fields using the [log.Logger] of c.
+//
+// If a field holds a [context.Context], e.g. added with zap.Any, it is passed
+// to the Logger instead of being converted to an attribute, see
+// [bridgeutil.TraceContext]. Otherwise, [context.Background] is passed.
+//
+// The returned error is always nil.
+func (c *Core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ctx := context.Background()
+	var record log.Record
+	if !ent.Time.IsZero() {
+		record.SetTimestamp(ent.Time)
+	}
+	record.SetBody(log.StringValue(ent.Message))
+	record.SetSeverity(bridgeutil.ZapLevelSeverity(ent.Level))
+	record.SetSeverityText(ent.Level.String())
+
+	if ent.Caller.Defined {
+		record.AddAttributes(
+			log.String(string(semconv.CodeFilepathKey), ent.Caller.File),
+			log.Int(string(semconv.CodeLineNumberKey), ent.Caller.Line),
+		)
+		if ent.Caller.Function != "" {
+			record.AddAttributes(log.String(string(semconv.CodeFunctionKey), ent.Caller.Function))
+		}
+	}
+	if ent.Stack != "" {
+		record.AddAttributes(log.String(string(semconv.CodeStacktraceKey), ent.Stack))
+	}
+	// Add the attributes one at a time so no slice holding them all is
+	// allocated.
+	add := c.fields.Adder(&record, func(kv log.KeyValue) { record.AddAttributes(kv) })
+	if len(c.fields) == 0 {
+		record.AddAttributes(c.attrs...)
+	} else {
+		for _, kv := range c.attrs {
+			add(kv)
+		}
+	}
+
+	if c.ns == nil && !hasNamespace(fields) {
+		for _, f := range fields {
+			if fctx, ok := fieldContext(f); ok {
+				ctx = fctx
+				continue
+			}
+			walkField(f, add)
+		}
+		c.logger.Emit(bridgeutil.TraceContext(ctx, c.sampledOnly), record)
+		return nil
+	}
+
+	// frames are the namespaces open, from the outermost to the innermost.
+	// The first frame holds the attributes not nested in a namespace.
+	frames := []frame{{}}
+	var chain []*namespace
+	for ns := c.ns; ns != nil; ns = ns.parent {
+		chain = append(chain, ns)
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		frames = append(frames, frame{name: chain[i].name, kvs: slices.Clip(chain[i].attrs)})
+	}
+	for _, f := range fields {
+		if fctx, ok := fieldContext(f); ok {
+			ctx = fctx
+			continue
+		}
+		if f.Type == zapcore.NamespaceType {
+			frames = append(frames, frame{name: f.Key})
+			continue
+		}
+		last := &frames[len(frames)-1]
+		walkField(f, func(kv log.KeyValue) { last.kvs = append(last.kvs, kv) })
+	}
+	for _, kv := range foldFrames(frames) {
+		add(kv)
+	}
+
+	c.logger.Emit(bridgeutil.TraceContext(ctx, c.sampledOnly), record)
+	return nil
+}
+
+// frame holds the attributes of a namespace being converted.
+type frame struct {
+	name string
+	kvs  []log.KeyValue
+}
+
+// foldFrames nests the attributes of each frame in the frame preceding it and
+// returns the attributes of the first frame. Empty namespaces are dropped.
+func foldFrames(frames []frame) []log.KeyValue {
+	for i := len(frames) - 1; i > 0; i-- {
+		if len(frames[i].kvs) > 0 {
+			frames[i-1].kvs = append(frames[i-1].kvs, log.Map(frames[i].name, frames[i].kvs...))
+		}
+	}
+	return frames[0].kvs
+}
+
+// hasNamespace returns if fields holds a zap.Namespace field.
+func hasNamespace(fields []zapcore.Field) bool {
+	for _, f := range fields {
+		if f.Type == zapcore.NamespaceType {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldContext returns the context.Context held by f and true. False is
+// returned if f holds none.
+func fieldContext(f zapcore.Field) (context.Context, bool) {
+	// zap.Any returns a zap.Stringer field for the contexts implementing
+	// fmt.Stringer, a zap.Reflect field for the others.
+	if f.Type != zapcore.ReflectType && f.Type != zapcore.StringerType {
+		return nil, false
+	}
+	ctx, ok := f.Interface.(context.Context)
+	return ctx, ok
+}
+
+// Sync flushes the log records emitted by c if its [log.LoggerProvider] has
+// a ForceFlush(context.Context) error method, e.g. the LoggerProvider of
+// go.opentelemetry.io/otel/sdk/log. Otherwise, it does nothing and returns
+// nil.
+func (c *Core) Sync() error {
+	if f, ok := c.provider.(interface {
+		ForceFlush(context.Context) error
+	}); ok {
+		return f.ForceFlush(context.Background())
+	}
+	return nil
+}
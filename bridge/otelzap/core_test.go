@@ -0,0 +1,324 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzap
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewCore(t *testing.T) {
+	rec := logtest.NewRecorder()
+	zap.New(NewCore(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)).Info("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewCoreGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	zap.New(NewCore("name")).Info("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestCoreFieldMapping(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := zap.New(NewCore("name", WithLoggerProvider(rec), WithFieldMapping(bridgeutil.ECSFieldMapping())))
+
+	l.With(zap.String("logger", "l")).Info("msg", zap.String("err", "boom"), zap.String("other", "v"))
+	l.Info("msg", zap.String("error", "boom"), zap.Namespace("ns"), zap.String("err", "nested"))
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, []log.KeyValue{
+		log.String("log.logger", "l"),
+		log.String("error.message", "boom"),
+		log.String("other", "v"),
+	}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{
+		log.String("error.message", "boom"),
+		log.Map("ns", log.String("err", "nested")),
+	}, logtest.Attributes(got[1]))
+}
+
+func TestCoreWrite(t *testing.T) {
+	rec := logtest.NewRecorder()
+	now := time.Now()
+	c := NewCore("name", WithLoggerProvider(rec))
+	err := c.Write(zapcore.Entry{
+		Level:   zapcore.WarnLevel,
+		Time:    now,
+		Message: "msg",
+		Caller:  zapcore.NewEntryCaller(0, "file.go", 42, true),
+		Stack:   "stack",
+	}, []zapcore.Field{zap.String("k", "v")})
+	require.NoError(t, err)
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, now, got[0].Timestamp())
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "warn", got[0].SeverityText())
+	assert.Equal(t, []log.KeyValue{
+		log.String(string(semconv.CodeFilepathKey), "file.go"),
+		log.Int(string(semconv.CodeLineNumberKey), 42),
+		log.String(string(semconv.CodeStacktraceKey), "stack"),
+		log.String("k", "v"),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestConvertLevel(t *testing.T) {
+	for level, want := range map[zapcore.Level]log.Severity{
+		zapcore.DebugLevel:  log.SeverityDebug,
+		zapcore.InfoLevel:   log.SeverityInfo,
+		zapcore.WarnLevel:   log.SeverityWarn,
+		zapcore.ErrorLevel:  log.SeverityError,
+		zapcore.DPanicLevel: log.SeverityFatal1,
+		zapcore.PanicLevel:  log.SeverityFatal2,
+		zapcore.FatalLevel:  log.SeverityFatal3,
+	} {
+		assert.Equal(t, want, bridgeutil.ZapLevelSeverity(level), "level %s", level)
+	}
+}
+
+func TestCoreEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	c := NewCore("name", WithLoggerProvider(rec))
+	assert.False(t, c.Enabled(zapcore.DebugLevel))
+	assert.True(t, c.Enabled(zapcore.InfoLevel))
+
+	l := zap.New(c)
+	l.Debug("debug")
+	l.Info("info")
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+}
+
+func TestCoreWith(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := zap.New(NewCore("name", WithLoggerProvider(rec)))
+	parent := l.With(zap.Int("a", 1))
+	parent.With(zap.Int("b", 2)).Info("msg1", zap.Int("c", 3))
+	parent.With(zap.Int("d", 4)).Info("msg2")
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("b", 2), log.Int64("c", 3)}, logtest.Attributes(got[0]))
+	assert.Equal(t, []log.KeyValue{log.Int64("a", 1), log.Int64("d", 4)}, logtest.Attributes(got[1]))
+}
+
+func TestCoreNamespace(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := zap.New(NewCore("name", WithLoggerProvider(rec)))
+	l = l.With(zap.Int("a", 1), zap.Namespace("ns1"), zap.Int("b", 2))
+	l.Info("msg1", zap.Int("c", 3), zap.Namespace("ns2"), zap.Int("d", 4))
+	l.Info("msg2", zap.Namespace("empty"))
+	l.With(zap.Namespace("empty")).Info("msg3")
+
+	got := rec.Records()
+	require.Len(t, got, 3)
+	assert.Equal(t, []log.KeyValue{
+		log.Int64("a", 1),
+		log.Map("ns1", log.Int64("b", 2), log.Int64("c", 3), log.Map("ns2", log.Int64("d", 4))),
+	}, logtest.Attributes(got[0]))
+	want := []log.KeyValue{log.Int64("a", 1), log.Map("ns1", log.Int64("b", 2))}
+	assert.Equal(t, want, logtest.Attributes(got[1]))
+	assert.Equal(t, want, logtest.Attributes(got[2]))
+}
+
+type ctxKey struct{}
+
+func TestCoreContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+	rec := logtest.NewRecorder()
+	c := NewCore("name")
+	c.logger = rec
+	l := zap.New(c)
+	l.Info("msg1", zap.Any("ctx", ctx))
+	l.Info("msg2", zap.Namespace("ns"), zap.Reflect("ctx", ctx))
+	l.Info("msg3")
+
+	require.Len(t, rec.Contexts(), 3)
+	assert.Equal(t, ctx, rec.Contexts()[0])
+	assert.Equal(t, ctx, rec.Contexts()[1])
+	assert.Equal(t, context.Background(), rec.Contexts()[2])
+}
+
+func TestCoreSampledOnlyCorrelation(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rec := logtest.NewRecorder()
+	c := NewCore("name")
+	c.logger = rec
+	zap.New(c).Info("msg", zap.Any("ctx", ctx))
+
+	c = NewCore("name", WithSampledOnlyCorrelation())
+	c.logger = rec
+	zap.New(c).Info("msg", zap.Any("ctx", ctx))
+	zap.New(c).Info("msg", zap.Namespace("ns"), zap.Any("ctx", ctx))
+
+	require.Len(t, rec.Contexts(), 3)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.Contexts()[0]), "default")
+	assert.False(t, trace.SpanContextFromContext(rec.Contexts()[1]).IsValid(), "sampled only")
+	assert.False(t, trace.SpanContextFromContext(rec.Contexts()[2]).IsValid(), "sampled only with namespace")
+}
+
+// flushProvider is a log.LoggerProvider with a ForceFlush method.
+type flushProvider struct {
+	*logtest.Recorder
+	flushed int
+	err     error
+}
+
+func (p *flushProvider) ForceFlush(context.Context) error {
+	p.flushed++
+	return p.err
+}
+
+func TestCoreSync(t *testing.T) {
+	p := &flushProvider{Recorder: logtest.NewRecorder(), err: errors.New("flush")}
+	c := NewCore("name", WithLoggerProvider(p))
+	assert.ErrorIs(t, c.Sync(), p.err)
+	assert.Equal(t, 1, p.flushed)
+
+	assert.NoError(t, NewCore("name", WithLoggerProvider(logtest.NewRecorder())).Sync())
+}
+
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+type obj struct{}
+
+func (obj) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("s", "v")
+	enc.AddInt("i", 1)
+	return nil
+}
+
+type arr struct{}
+
+func (arr) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	enc.AppendInt(1)
+	enc.AppendString("a")
+	return nil
+}
+
+func TestConvertField(t *testing.T) {
+	now := time.Unix(0, 1000)
+	var nilStringer *stringerPtr
+	tests := []struct {
+		name  string
+		field zapcore.Field
+		want  log.KeyValue
+	}{
+		{"bool", zap.Bool("k", true), log.Bool("k", true)},
+		{"int", zap.Int8("k", -1), log.Int64("k", -1)},
+		{"uint", zap.Uint32("k", 1), log.Int64("k", 1)},
+		{"uint overflow", zap.Uint64("k", math.MaxUint64), log.String("k", "18446744073709551615")},
+		{"uintptr", zap.Uintptr("k", 1), log.Int64("k", 1)},
+		{"float64", zap.Float64("k", 1.5), log.Float64("k", 1.5)},
+		{"float32", zap.Float32("k", 1.5), log.Float64("k", 1.5)},
+		{"complex", zap.Complex128("k", 1+2i), log.String("k", "(1+2i)")},
+		{"string", zap.String("k", "v"), log.String("k", "v")},
+		{"binary", zap.Binary("k", []byte{1}), log.Bytes("k", []byte{1})},
+		{"byte string", zap.ByteString("k", []byte("v")), log.String("k", "v")},
+		{"duration", zap.Duration("k", time.Second), log.Int64("k", int64(time.Second))},
+		{"time", zap.Time("k", now), log.Int64("k", 1000)},
+		{"time full", zap.Time("k", time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)), log.String("k", "3000-01-01T00:00:00Z")},
+		{"stringer", zap.Stringer("k", stringer{}), log.String("k", "stringer")},
+		{"nil stringer", zap.Stringer("k", nilStringer), log.String("k", "<nil>")},
+		{"error", zap.NamedError("k", errors.New("err")), log.String("k", "err")},
+		{"object", zap.Object("k", obj{}), log.Map("k", log.String("s", "v"), log.Int64("i", 1))},
+		{"array", zap.Array("k", arr{}), log.Slice("k", log.Int64Value(1), log.StringValue("a"))},
+		{"reflect", zap.Reflect("k", map[string]int{"b": 2, "a": 1}), log.Map("k", log.Int64("a", 1), log.Int64("b", 2))},
+		{"any slice", zap.Any("k", []any{1, "a"}), log.Slice("k", log.Int64Value(1), log.StringValue("a"))},
+		{"nil", zap.Reflect("k", nil), log.Empty("k")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []log.KeyValue
+			walkField(tt.field, func(kv log.KeyValue) { got = append(got, kv) })
+			assert.Equal(t, []log.KeyValue{tt.want}, got)
+		})
+	}
+}
+
+type stringerPtr struct{ s string }
+
+func (s *stringerPtr) String() string { return s.s }
+
+func TestWalkFieldInline(t *testing.T) {
+	var got []log.KeyValue
+	walkField(zap.Inline(obj{}), func(kv log.KeyValue) { got = append(got, kv) })
+	assert.Equal(t, []log.KeyValue{log.String("s", "v"), log.Int64("i", 1)}, got)
+
+	got = nil
+	walkField(zap.Skip(), func(kv log.KeyValue) { got = append(got, kv) })
+	assert.Empty(t, got)
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkCore(b *testing.B) {
+	c := NewCore("name")
+	c.logger = discardLogger{}
+	l := zap.New(c).With(zap.String("k", "v"))
+	nested := l.With(zap.Namespace("ns"), zap.String("k", "v"))
+
+	b.Run("Info", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l.Info("msg", zap.String("a", "b"), zap.Int("c", 1), zap.Bool("d", true))
+		}
+	})
+
+	b.Run("Namespace", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			nested.Info("msg", zap.String("a", "b"), zap.Int("c", 1), zap.Bool("d", true))
+		}
+	})
+}
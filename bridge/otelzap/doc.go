@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelzap provides a [zapcore.Core], a bridge from
+// [go.uber.org/zap] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewCore] to create a [Core] emitting log records to a [log.Logger] of
+// the configured [log.LoggerProvider], and pass it to zap.New to create a
+// zap.Logger. A Core can be combined with other cores using
+// [zapcore.NewTee].
+//
+// The log entries are converted as follows:
+//
+//   - The time is the timestamp.
+//   - The message is the body as a string value.
+//   - The level is converted to the severity: DebugLevel, InfoLevel,
+//     WarnLevel, and ErrorLevel to [log.SeverityDebug], [log.SeverityInfo],
+//     [log.SeverityWarn], and [log.SeverityError], and DPanicLevel,
+//     PanicLevel, and FatalLevel to [log.SeverityFatal1],
+//     [log.SeverityFatal2], and [log.SeverityFatal3]. The severity text is
+//     the name of the level.
+//   - The caller, if recorded, is added as the code.filepath, code.lineno,
+//     and code.function attributes, and the stack trace as the
+//     code.stacktrace attribute, as defined by the semantic conventions.
+//   - The fields, including the ones added with zap.Logger.With, are
+//     converted to attributes. The fields following a zap.Namespace field are
+//     nested in a [log.KindMap] attribute named after it. Empty namespaces
+//     are dropped.
+//
+// The field values are converted based on their type. Booleans, numbers,
+// strings, and byte slices are converted to the values of the matching kind.
+// Unsigned integers that overflow an int64 and complex numbers are converted
+// to their string representation. Durations are converted to nanoseconds,
+// times to Unix nanoseconds, or to their RFC 3339 representation if out of
+// range. Errors and [fmt.Stringer] values are converted to the string they
+// return. The objects of zap.Object fields are converted to [log.KindMap]
+// values, and the arrays of zap.Array fields to [log.KindSlice] values,
+// recursively, preserving their structure. The fields of a zap.Inline field
+// are added to the attributes. Other values, e.g. of zap.Reflect fields, are
+// converted based on the Go value they hold: slices and arrays to
+// [log.KindSlice] values, maps to [log.KindMap] values sorted by key, and
+// other values to their string representation.
+//
+// A field holding a [context.Context], e.g. added with zap.Any, is not
+// converted. The context is passed to the [log.Logger] instead, so that the
+// log record is correlated with the span it holds. The log records are
+// otherwise emitted with [context.Background].
+//
+// [Core.Enabled] reports whether the [log.Logger] is enabled for the severity
+// of a level. [Core.Sync] flushes the [log.LoggerProvider] if it has a
+// ForceFlush method, e.g. the one of go.opentelemetry.io/otel/sdk/log.
+package otelzap // import "go.opentelemetry.io/otel/bridge/otelzap"
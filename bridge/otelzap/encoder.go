@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzap // import "go.opentelemetry.io/otel/bridge/otelzap"
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+)
+
+var (
+	_ zapcore.ObjectEncoder = (*objectEncoder)(nil)
+	_ zapcore.ArrayEncoder  = (*arrayEncoder)(nil)
+)
+
+// objectEncoder is a zapcore.ObjectEncoder converting the fields added to it
+// to log.KeyValue.
+type objectEncoder struct {
+	// frames are the namespaces open, from the outermost to the innermost.
+	// The first frame holds the fields not nested in a namespace.
+	frames []frame
+}
+
+func newObjectEncoder() *objectEncoder {
+	return &objectEncoder{frames: make([]frame, 1)}
+}
+
+// keyValues returns the fields added to enc, the ones added after a namespace
+// was opened being nested in it.
+func (enc *objectEncoder) keyValues() []log.KeyValue {
+	return foldFrames(enc.frames)
+}
+
+func (enc *objectEncoder) add(kv log.KeyValue) {
+	last := &enc.frames[len(enc.frames)-1]
+	last.kvs = append(last.kvs, kv)
+}
+
+func (enc *objectEncoder) AddArray(key string, marshaler zapcore.ArrayMarshaler) error {
+	arr := &arrayEncoder{}
+	err := marshaler.MarshalLogArray(arr)
+	enc.add(log.Slice(key, arr.elems...))
+	return err
+}
+
+func (enc *objectEncoder) AddObject(key string, marshaler zapcore.ObjectMarshaler) error {
+	obj := newObjectEncoder()
+	err := marshaler.MarshalLogObject(obj)
+	enc.add(log.Map(key, obj.keyValues()...))
+	return err
+}
+
+func (enc *objectEncoder) AddBinary(key string, value []byte) {
+	enc.add(log.Bytes(key, value))
+}
+
+func (enc *objectEncoder) AddByteString(key string, value []byte) {
+	enc.add(log.String(key, string(value)))
+}
+
+func (enc *objectEncoder) AddBool(key string, value bool) {
+	enc.add(log.Bool(key, value))
+}
+
+func (enc *objectEncoder) AddComplex128(key string, value complex128) {
+	enc.add(log.String(key, fmt.Sprint(value)))
+}
+
+func (enc *objectEncoder) AddComplex64(key string, value complex64) {
+	enc.add(log.String(key, fmt.Sprint(value)))
+}
+
+func (enc *objectEncoder) AddDuration(key string, value time.Duration) {
+	enc.add(log.Int64(key, value.Nanoseconds()))
+}
+
+func (enc *objectEncoder) AddFloat64(key string, value float64) {
+	enc.add(log.Float64(key, value))
+}
+
+func (enc *objectEncoder) AddFloat32(key string, value float32) {
+	enc.add(log.Float64(key, float64(value)))
+}
+
+func (enc *objectEncoder) AddInt(key string, value int) {
+	enc.add(log.Int(key, value))
+}
+
+func (enc *objectEncoder) AddInt64(key string, value int64) {
+	enc.add(log.Int64(key, value))
+}
+
+func (enc *objectEncoder) AddInt32(key string, value int32) {
+	enc.add(log.Int64(key, int64(value)))
+}
+
+func (enc *objectEncoder) AddInt16(key string, value int16) {
+	enc.add(log.Int64(key, int64(value)))
+}
+
+func (enc *objectEncoder) AddInt8(key string, value int8) {
+	enc.add(log.Int64(key, int64(value)))
+}
+
+func (enc *objectEncoder) AddString(key, value string) {
+	enc.add(log.String(key, value))
+}
+
+func (enc *objectEncoder) AddTime(key string, value time.Time) {
+	enc.add(log.KeyValue{Key: key, Value: bridgeutil.TimeValue(value)})
+}
+
+func (enc *objectEncoder) AddUint(key string, value uint) {
+	enc.add(log.KeyValue{Key: key, Value: bridgeutil.Uint64Value(uint64(value))})
+}
+
+func (enc *objectEncoder) AddUint64(key string, value uint64) {
+	enc.add(log.KeyValue{Key: key, Value: bridgeutil.Uint64Value(value)})
+}
+
+func (enc *objectEncoder) AddUint32(key string, value uint32) {
+	enc.add(log.Int64(key, int64(value)))
+}
+
+func (enc *objectEncoder) AddUint16(key string, value uint16) {
+	enc.add(log.Int64(key, int64(value)))
+}
+
+func (enc *objectEncoder) AddUint8(key string, value uint8) {
+	enc.add(log.Int64(key, int64(value)))
+}
+
+func (enc *objectEncoder) AddUintptr(key string, value uintptr) {
+	enc.add(log.KeyValue{Key: key, Value: bridgeutil.Uint64Value(uint64(value))})
+}
+
+func (enc *objectEncoder) AddReflected(key string, value interface{}) error {
+	enc.add(log.KeyValue{Key: key, Value: bridgeutil.ConvertAny(value)})
+	return nil
+}
+
+func (enc *objectEncoder) OpenNamespace(key string) {
+	enc.frames = append(enc.frames, frame{name: key})
+}
+
+// arrayEncoder is a zapcore.ArrayEncoder converting the elements appended to
+// it to log.Value.
+type arrayEncoder struct {
+	elems []log.Value
+}
+
+func (enc *arrayEncoder) AppendBool(value bool) {
+	enc.elems = append(enc.elems, log.BoolValue(value))
+}
+
+func (enc *arrayEncoder) AppendByteString(value []byte) {
+	enc.elems = append(enc.elems, log.StringValue(string(value)))
+}
+
+func (enc *arrayEncoder) AppendComplex128(value complex128) {
+	enc.elems = append(enc.elems, log.StringValue(fmt.Sprint(value)))
+}
+
+func (enc *arrayEncoder) AppendComplex64(value complex64) {
+	enc.elems = append(enc.elems, log.StringValue(fmt.Sprint(value)))
+}
+
+func (enc *arrayEncoder) AppendFloat64(value float64) {
+	enc.elems = append(enc.elems, log.Float64Value(value))
+}
+
+func (enc *arrayEncoder) AppendFloat32(value float32) {
+	enc.elems = append(enc.elems, log.Float64Value(float64(value)))
+}
+
+func (enc *arrayEncoder) AppendInt(value int) {
+	enc.elems = append(enc.elems, log.IntValue(value))
+}
+
+func (enc *arrayEncoder) AppendInt64(value int64) {
+	enc.elems = append(enc.elems, log.Int64Value(value))
+}
+
+func (enc *arrayEncoder) AppendInt32(value int32) {
+	enc.elems = append(enc.elems, log.Int64Value(int64(value)))
+}
+
+func (enc *arrayEncoder) AppendInt16(value int16) {
+	enc.elems = append(enc.elems, log.Int64Value(int64(value)))
+}
+
+func (enc *arrayEncoder) AppendInt8(value int8) {
+	enc.elems = append(enc.elems, log.Int64Value(int64(value)))
+}
+
+func (enc *arrayEncoder) AppendString(value string) {
+	enc.elems = append(enc.elems, log.StringValue(value))
+}
+
+func (enc *arrayEncoder) AppendUint(value uint) {
+	enc.elems = append(enc.elems, bridgeutil.Uint64Value(uint64(value)))
+}
+
+func (enc *arrayEncoder) AppendUint64(value uint64) {
+	enc.elems = append(enc.elems, bridgeutil.Uint64Value(value))
+}
+
+func (enc *arrayEncoder) AppendUint32(value uint32) {
+	enc.elems = append(enc.elems, log.Int64Value(int64(value)))
+}
+
+func (enc *arrayEncoder) AppendUint16(value uint16) {
+	enc.elems = append(enc.elems, log.Int64Value(int64(value)))
+}
+
+func (enc *arrayEncoder) AppendUint8(value uint8) {
+	enc.elems = append(enc.elems, log.Int64Value(int64(value)))
+}
+
+func (enc *arrayEncoder) AppendUintptr(value uintptr) {
+	enc.elems = append(enc.elems, bridgeutil.Uint64Value(uint64(value)))
+}
+
+func (enc *arrayEncoder) AppendDuration(value time.Duration) {
+	enc.elems = append(enc.elems, log.Int64Value(value.Nanoseconds()))
+}
+
+func (enc *arrayEncoder) AppendTime(value time.Time) {
+	enc.elems = append(enc.elems, bridgeutil.TimeValue(value))
+}
+
+func (enc *arrayEncoder) AppendArray(marshaler zapcore.ArrayMarshaler) error {
+	arr := &arrayEncoder{}
+	err := marshaler.MarshalLogArray(arr)
+	enc.elems = append(enc.elems, log.SliceValue(arr.elems...))
+	return err
+}
+
+func (enc *arrayEncoder) AppendObject(marshaler zapcore.ObjectMarshaler) error {
+	obj := newObjectEncoder()
+	err := marshaler.MarshalLogObject(obj)
+	enc.elems = append(enc.elems, log.MapValue(obj.keyValues()...))
+	return err
+}
+
+func (enc *arrayEncoder) AppendReflected(value interface{}) error {
+	enc.elems = append(enc.elems, bridgeutil.ConvertAny(value))
+	return nil
+}
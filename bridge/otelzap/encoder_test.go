@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzap
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+)
+
+// objectFunc is a zapcore.ObjectMarshaler calling itself.
+type objectFunc func(zapcore.ObjectEncoder) error
+
+func (f objectFunc) MarshalLogObject(enc zapcore.ObjectEncoder) error { return f(enc) }
+
+// arrayFunc is a zapcore.ArrayMarshaler calling itself.
+type arrayFunc func(zapcore.ArrayEncoder) error
+
+func (f arrayFunc) MarshalLogArray(enc zapcore.ArrayEncoder) error { return f(enc) }
+
+func TestObjectEncoder(t *testing.T) {
+	now := time.Unix(0, 1000)
+	far := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	enc := newObjectEncoder()
+	enc.AddBinary("binary", []byte{1})
+	enc.AddByteString("byte string", []byte("v"))
+	enc.AddBool("bool", true)
+	enc.AddComplex128("complex128", 1+2i)
+	enc.AddComplex64("complex64", 1+2i)
+	enc.AddDuration("duration", time.Second)
+	enc.AddFloat64("float64", 1.5)
+	enc.AddFloat32("float32", 1.5)
+	enc.AddInt("int", 1)
+	enc.AddInt64("int64", 1)
+	enc.AddInt32("int32", 1)
+	enc.AddInt16("int16", 1)
+	enc.AddInt8("int8", 1)
+	enc.AddString("string", "v")
+	enc.AddTime("time", now)
+	enc.AddTime("far time", far)
+	enc.AddUint("uint", 1)
+	enc.AddUint64("uint64", math.MaxUint64)
+	enc.AddUint32("uint32", 1)
+	enc.AddUint16("uint16", 1)
+	enc.AddUint8("uint8", 1)
+	enc.AddUintptr("uintptr", 1)
+	assert.NoError(t, enc.AddReflected("reflected", []int{1}))
+
+	assert.Equal(t, []log.KeyValue{
+		log.Bytes("binary", []byte{1}),
+		log.String("byte string", "v"),
+		log.Bool("bool", true),
+		log.String("complex128", "(1+2i)"),
+		log.String("complex64", "(1+2i)"),
+		log.Int64("duration", int64(time.Second)),
+		log.Float64("float64", 1.5),
+		log.Float64("float32", 1.5),
+		log.Int("int", 1),
+		log.Int64("int64", 1),
+		log.Int64("int32", 1),
+		log.Int64("int16", 1),
+		log.Int64("int8", 1),
+		log.String("string", "v"),
+		log.Int64("time", 1000),
+		log.String("far time", "3000-01-01T00:00:00Z"),
+		log.Int64("uint", 1),
+		log.String("uint64", "18446744073709551615"),
+		log.Int64("uint32", 1),
+		log.Int64("uint16", 1),
+		log.Int64("uint8", 1),
+		log.Int64("uintptr", 1),
+		log.Slice("reflected", log.Int64Value(1)),
+	}, enc.keyValues())
+}
+
+func TestArrayEncoder(t *testing.T) {
+	now := time.Unix(0, 1000)
+	enc := &arrayEncoder{}
+	enc.AppendBool(true)
+	enc.AppendByteString([]byte("v"))
+	enc.AppendComplex128(1 + 2i)
+	enc.AppendComplex64(1 + 2i)
+	enc.AppendFloat64(1.5)
+	enc.AppendFloat32(1.5)
+	enc.AppendInt(1)
+	enc.AppendInt64(1)
+	enc.AppendInt32(1)
+	enc.AppendInt16(1)
+	enc.AppendInt8(1)
+	enc.AppendString("v")
+	enc.AppendUint(1)
+	enc.AppendUint64(math.MaxUint64)
+	enc.AppendUint32(1)
+	enc.AppendUint16(1)
+	enc.AppendUint8(1)
+	enc.AppendUintptr(1)
+	enc.AppendDuration(time.Second)
+	enc.AppendTime(now)
+	assert.NoError(t, enc.AppendReflected(map[string]int{"a": 1}))
+
+	assert.Equal(t, []log.Value{
+		log.BoolValue(true),
+		log.StringValue("v"),
+		log.StringValue("(1+2i)"),
+		log.StringValue("(1+2i)"),
+		log.Float64Value(1.5),
+		log.Float64Value(1.5),
+		log.IntValue(1),
+		log.Int64Value(1),
+		log.Int64Value(1),
+		log.Int64Value(1),
+		log.Int64Value(1),
+		log.StringValue("v"),
+		log.Int64Value(1),
+		log.StringValue("18446744073709551615"),
+		log.Int64Value(1),
+		log.Int64Value(1),
+		log.Int64Value(1),
+		log.Int64Value(1),
+		log.Int64Value(int64(time.Second)),
+		log.Int64Value(1000),
+		log.MapValue(log.Int64("a", 1)),
+	}, enc.elems)
+}
+
+func TestEncoderNested(t *testing.T) {
+	field := zap.Object("obj", objectFunc(func(enc zapcore.ObjectEncoder) error {
+		enc.AddString("a", "b")
+		if err := enc.AddArray("arr", arrayFunc(func(enc zapcore.ArrayEncoder) error {
+			enc.AppendInt(1)
+			if err := enc.AppendArray(arrayFunc(func(enc zapcore.ArrayEncoder) error {
+				enc.AppendString("x")
+				return nil
+			})); err != nil {
+				return err
+			}
+			return enc.AppendObject(objectFunc(func(enc zapcore.ObjectEncoder) error {
+				enc.AddBool("c", true)
+				return nil
+			}))
+		})); err != nil {
+			return err
+		}
+		return enc.AddObject("child", objectFunc(func(enc zapcore.ObjectEncoder) error {
+			enc.AddInt("d", 1)
+			enc.OpenNamespace("ns")
+			enc.AddInt("e", 2)
+			enc.OpenNamespace("empty")
+			return nil
+		}))
+	}))
+
+	var got []log.KeyValue
+	walkField(field, func(kv log.KeyValue) { got = append(got, kv) })
+	assert.Equal(t, []log.KeyValue{
+		log.Map("obj",
+			log.String("a", "b"),
+			log.Slice("arr",
+				log.IntValue(1),
+				log.SliceValue(log.StringValue("x")),
+				log.MapValue(log.Bool("c", true)),
+			),
+			log.Map("child", log.Int("d", 1), log.Map("ns", log.Int("e", 2))),
+		),
+	}, got)
+}
+
+func TestEncoderError(t *testing.T) {
+	err := errors.New("marshal")
+	field := zap.Object("obj", objectFunc(func(enc zapcore.ObjectEncoder) error {
+		enc.AddString("a", "b")
+		return err
+	}))
+
+	var got []log.KeyValue
+	walkField(field, func(kv log.KeyValue) { got = append(got, kv) })
+	assert.Equal(t, []log.KeyValue{
+		log.Map("obj", log.String("a", "b")),
+		log.String("objError", "marshal"),
+	}, got)
+}
+
+func TestCoreObject(t *testing.T) {
+	rec := logtest.NewRecorder()
+	zap.New(NewCore("name", WithLoggerProvider(rec))).Info("msg", zap.Object("k", obj{}), zap.Array("l", arr{}))
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.Map("k", log.String("s", "v"), log.Int("i", 1)),
+		log.Slice("l", log.IntValue(1), log.StringValue("a")),
+	}, logtest.Attributes(got[0]))
+}
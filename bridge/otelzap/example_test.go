@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzap_test
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/otel/bridge/otelzap"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Create a zap.Logger emitting log records to the OpenTelemetry Logs
+	// Bridge API.
+	logger := zap.New(otelzap.NewCore("my/pkg/name", otelzap.WithLoggerProvider(provider)))
+	defer func() { _ = logger.Sync() }()
+
+	logger.Info("hello", zap.String("user", "alice"))
+
+	// The fields following a namespace are nested in a map attribute.
+	logger.With(zap.Namespace("http")).Info("request", zap.String("method", "GET"))
+
+	// The context passed with zap.Any correlates the log record with the
+	// span it holds.
+	ctx := context.Background()
+	logger.Info("with context", zap.Any("ctx", ctx))
+}
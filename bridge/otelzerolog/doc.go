@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otelzerolog provides a [zerolog.LevelWriter], a bridge from
+// [github.com/rs/zerolog] to the OpenTelemetry Logs Bridge API.
+//
+// Use [NewWriter] to create a [Writer] emitting log records to a [log.Logger]
+// of the configured [log.LoggerProvider], and write the events of a
+// [zerolog.Logger] to it, along with other writers using
+// [zerolog.MultiLevelWriter] to dual-write. A zerolog.Hook has no access to
+// the fields of the events, the Writer parses the JSON encoded events
+// instead. The events are not parsed if zerolog is built with the binary_log
+// build tag, the CBOR encoded events are emitted as the body of the log
+// records.
+//
+// The events are converted as follows:
+//
+//   - The [zerolog.TimestampFieldName] field, encoded using
+//     [zerolog.TimeFieldFormat], is the timestamp.
+//   - The [zerolog.MessageFieldName] field is the body.
+//   - The level is converted to the severity: TraceLevel, DebugLevel,
+//     InfoLevel, WarnLevel, ErrorLevel, and FatalLevel to
+//     [log.SeverityTrace], [log.SeverityDebug], [log.SeverityInfo],
+//     [log.SeverityWarn], [log.SeverityError], and [log.SeverityFatal], and
+//     PanicLevel to [log.SeverityFatal2]. The events without a level have an
+//     undefined severity. The severity text is the [zerolog.LevelFieldName]
+//     field.
+//   - The [zerolog.CallerFieldName] field is converted to the code.filepath
+//     and code.lineno attributes, and the [zerolog.ErrorFieldName] field to
+//     the exception.message attribute, defined by the semantic conventions.
+//   - The other fields are converted to attributes, in the order of the
+//     event. The JSON objects are converted to [log.KindMap] values, the
+//     arrays to [log.KindSlice] values, and the integer numbers to
+//     [log.KindInt64] values.
+//
+// The context of the events is not passed to writers. Add [TraceHook] to the
+// [zerolog.Logger] to have the trace context of the span in the context of
+// the events, set with [zerolog.Event.Ctx], added to them. The Writer passes
+// a context holding this trace context to the [log.Logger] so that the log
+// records are correlated with the span. Otherwise, [context.Background] is
+// passed.
+package otelzerolog // import "go.opentelemetry.io/otel/bridge/otelzerolog"
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzerolog_test
+
+import (
+	"context"
+	"os"
+
+	"github.com/rs/zerolog"
+
+	"go.opentelemetry.io/otel/bridge/otelzerolog"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func Example() {
+	// Use a working LoggerProvider implementation instead e.g. using go.opentelemetry.io/otel/sdk/log.
+	provider := noop.NewLoggerProvider()
+
+	// Write the events both to stderr and to the OpenTelemetry Logs Bridge
+	// API, with the trace context of the span held by their context.
+	w := otelzerolog.NewWriter("my/pkg/name", otelzerolog.WithLoggerProvider(provider))
+	logger := zerolog.New(zerolog.MultiLevelWriter(os.Stderr, w)).
+		Hook(otelzerolog.TraceHook{}).
+		With().Timestamp().Logger()
+
+	// The context correlates the log record with the span it holds.
+	ctx := context.Background()
+	logger.Info().Ctx(ctx).Str("user", "alice").Msg("hello")
+}
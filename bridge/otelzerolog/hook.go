@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzerolog // import "go.opentelemetry.io/otel/bridge/otelzerolog"
+
+import (
+	"github.com/rs/zerolog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The names of the fields TraceHook adds to the events.
+const (
+	TraceIDFieldName    = "trace_id"
+	SpanIDFieldName     = "span_id"
+	TraceFlagsFieldName = "trace_flags"
+)
+
+// Compile-time check TraceHook implements zerolog.Hook.
+var _ zerolog.Hook = TraceHook{}
+
+// TraceHook is a [zerolog.Hook] adding the trace context of the span held by
+// the context of the events, set with [zerolog.Event.Ctx] or
+// [zerolog.Context.Ctx], to the events as the [TraceIDFieldName],
+// [SpanIDFieldName], and [TraceFlagsFieldName] fields.
+//
+// A [Writer] emits the events with the context holding this trace context so
+// that the log records are correlated with the span, and does not add the
+// fields as attributes. The events of the contexts without a valid span
+// context are left untouched.
+type TraceHook struct{}
+
+// Run adds the trace context fields to e.
+func (TraceHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	sc := trace.SpanContextFromContext(e.GetCtx())
+	if !sc.IsValid() {
+		return
+	}
+	e.Str(TraceIDFieldName, sc.TraceID().String()).
+		Str(SpanIDFieldName, sc.SpanID().String()).
+		Str(TraceFlagsFieldName, sc.TraceFlags().String())
+}
@@ -0,0 +1,398 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzerolog // import "go.opentelemetry.io/otel/bridge/otelzerolog"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/bridgeutil"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Compile-time check Writer implements zerolog.LevelWriter.
+var _ zerolog.LevelWriter = (*Writer)(nil)
+
+// Writer is a [zerolog.LevelWriter] that emits the JSON encoded events it is
+// written using a [log.Logger].
+//
+// Use [NewWriter] to create a Writer, and [zerolog.New] or
+// [zerolog.MultiLevelWriter] to write the events of a [zerolog.Logger] to it.
+type Writer struct {
+	logger log.Logger
+}
+
+// NewWriter returns a new [Writer] emitting log records using the
+// [log.Logger] named name of the configured [log.LoggerProvider]. The name
+// should be the package import path that is being logged.
+func NewWriter(name string, options ...Option) *Writer {
+	cfg := newConfig(options)
+	return &Writer{logger: cfg.logger(name)}
+}
+
+// Write emits the event p. The level of the event is parsed from its
+// [zerolog.LevelFieldName] field.
+//
+// The returned error is always nil and the returned length is always len(p).
+func (w *Writer) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel emits the event p of the level. If level is [zerolog.NoLevel],
+// the level is parsed from the [zerolog.LevelFieldName] field of the event.
+//
+// The event is emitted with the context holding the span context added with
+// [TraceHook], or [context.Background] if it has none. The event is not
+// emitted if the [log.Logger] of w is not enabled for its severity.
+//
+// If p is not a JSON object, e.g. if zerolog is built with the binary_log
+// build tag, p is emitted as the body of the log record.
+//
+// The returned error is always nil and the returned length is always len(p).
+func (w *Writer) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	e, err := parseEvent(p)
+	if err != nil {
+		var record log.Record
+		record.SetSeverity(convertLevel(level))
+		record.SetSeverityText(levelText(level))
+		record.SetBody(log.StringValue(string(bytes.TrimSpace(p))))
+		if w.logger.Enabled(context.Background(), record) {
+			w.logger.Emit(context.Background(), record)
+		}
+		return len(p), nil
+	}
+	if level == zerolog.NoLevel && e.level != "" {
+		if l, err := zerolog.ParseLevel(e.level); err == nil {
+			level = l
+		}
+	}
+
+	ctx := context.Background()
+	if e.spanContext.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, e.spanContext)
+	}
+
+	var record log.Record
+	record.SetSeverity(convertLevel(level))
+	if !w.logger.Enabled(ctx, record) {
+		return len(p), nil
+	}
+
+	record.SetTimestamp(e.time)
+	record.SetBody(e.message)
+	if e.level != "" {
+		record.SetSeverityText(e.level)
+	} else {
+		record.SetSeverityText(levelText(level))
+	}
+
+	batch := bridgeutil.NewAttrBatch(&record)
+	for _, kv := range e.attrs {
+		batch.Add(kv)
+	}
+	batch.Flush()
+
+	w.logger.Emit(ctx, record)
+	return len(p), nil
+}
+
+// convertLevel returns the log.Severity of level.
+func convertLevel(level zerolog.Level) log.Severity {
+	switch level {
+	case zerolog.TraceLevel:
+		return log.SeverityTrace
+	case zerolog.DebugLevel:
+		return log.SeverityDebug
+	case zerolog.InfoLevel:
+		return log.SeverityInfo
+	case zerolog.WarnLevel:
+		return log.SeverityWarn
+	case zerolog.ErrorLevel:
+		return log.SeverityError
+	case zerolog.FatalLevel:
+		return log.SeverityFatal
+	case zerolog.PanicLevel:
+		return log.SeverityFatal2
+	default:
+		return log.SeverityUndefined
+	}
+}
+
+// levelText returns the severity text of level, or an empty string for
+// zerolog.NoLevel and zerolog.Disabled.
+func levelText(level zerolog.Level) string {
+	switch level {
+	case zerolog.NoLevel, zerolog.Disabled:
+		return ""
+	}
+	return level.String()
+}
+
+// event is a parsed zerolog event.
+type event struct {
+	time        time.Time
+	level       string
+	message     log.Value
+	spanContext trace.SpanContext
+	attrs       []log.KeyValue
+}
+
+var errNotObject = errors.New("not a JSON object")
+
+// parseEvent parses the JSON encoded event p.
+func parseEvent(p []byte) (event, error) {
+	dec := json.NewDecoder(bytes.NewReader(p))
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil {
+		return event{}, err
+	} else if tok != json.Delim('{') {
+		return event{}, errNotObject
+	}
+	fields, err := parseObject(dec)
+	if err != nil {
+		return event{}, err
+	}
+
+	var (
+		e                       event
+		traceID, spanID, flags  string
+		traceIdx, spanIdx, fIdx = -1, -1, -1
+	)
+	for _, kv := range fields {
+		switch kv.Key {
+		case zerolog.TimestampFieldName:
+			if t, ok := parseTime(kv.Value); ok {
+				e.time = t
+				continue
+			}
+		case zerolog.LevelFieldName:
+			if kv.Value.Kind() == log.KindString {
+				e.level = kv.Value.AsString()
+				continue
+			}
+		case zerolog.MessageFieldName:
+			e.message = kv.Value
+			continue
+		case zerolog.CallerFieldName:
+			if attrs, ok := parseCaller(kv.Value); ok {
+				e.attrs = append(e.attrs, attrs...)
+				continue
+			}
+		case zerolog.ErrorFieldName:
+			if kv.Value.Kind() == log.KindString {
+				e.attrs = append(e.attrs, log.KeyValue{Key: string(semconv.ExceptionMessageKey), Value: kv.Value})
+				continue
+			}
+		case TraceIDFieldName:
+			traceID, traceIdx = stringValue(kv.Value), len(e.attrs)
+		case SpanIDFieldName:
+			spanID, spanIdx = stringValue(kv.Value), len(e.attrs)
+		case TraceFlagsFieldName:
+			flags, fIdx = stringValue(kv.Value), len(e.attrs)
+		}
+		e.attrs = append(e.attrs, kv)
+	}
+
+	if sc, ok := parseSpanContext(traceID, spanID, flags); ok {
+		e.spanContext = sc
+		// The trace context fields are not attributes of the log record.
+		e.attrs = deleteIndexes(e.attrs, traceIdx, spanIdx, fIdx)
+	}
+	return e, nil
+}
+
+// parseObject parses the fields of the JSON object read by dec. The opening
+// delimiter of the object needs to be read already.
+func parseObject(dec *json.Decoder) ([]log.KeyValue, error) {
+	var kvs []log.KeyValue
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if tok == json.Delim('}') {
+			return kvs, nil
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, errNotObject
+		}
+		v, err := parseValue(dec)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, log.KeyValue{Key: key, Value: v})
+	}
+}
+
+// parseValue parses the next JSON value read by dec.
+func parseValue(dec *json.Decoder) (log.Value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return log.Value{}, unexpectedEOF(err)
+	}
+	switch t := tok.(type) {
+	case nil:
+		return log.Value{}, nil
+	case bool:
+		return log.BoolValue(t), nil
+	case string:
+		return log.StringValue(t), nil
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return log.Int64Value(i), nil
+		}
+		f, err := t.Float64()
+		if err != nil {
+			// Out of the range of float64, keep the number as it is.
+			return log.StringValue(t.String()), nil
+		}
+		return log.Float64Value(f), nil
+	case json.Delim:
+		switch t {
+		case '{':
+			kvs, err := parseObject(dec)
+			if err != nil {
+				return log.Value{}, err
+			}
+			return log.MapValue(kvs...), nil
+		case '[':
+			var vals []log.Value
+			for dec.More() {
+				v, err := parseValue(dec)
+				if err != nil {
+					return log.Value{}, err
+				}
+				vals = append(vals, v)
+			}
+			if _, err := dec.Token(); err != nil { // Closing ']'.
+				return log.Value{}, unexpectedEOF(err)
+			}
+			return log.SliceValue(vals...), nil
+		}
+	}
+	return log.Value{}, errNotObject
+}
+
+// stringValue returns the string held by v, or an empty string if v is not a
+// string value.
+func stringValue(v log.Value) string {
+	if v.Kind() != log.KindString {
+		return ""
+	}
+	return v.AsString()
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// parseTime returns the time of the timestamp field value v, encoded using
+// zerolog.TimeFieldFormat.
+func parseTime(v log.Value) (time.Time, bool) {
+	switch zerolog.TimeFieldFormat {
+	case zerolog.TimeFormatUnix:
+		return unixTime(v, time.Second)
+	case zerolog.TimeFormatUnixMs:
+		return unixTime(v, time.Millisecond)
+	case zerolog.TimeFormatUnixMicro:
+		return unixTime(v, time.Microsecond)
+	case zerolog.TimeFormatUnixNano:
+		return unixTime(v, time.Nanosecond)
+	}
+	if v.Kind() != log.KindString {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(zerolog.TimeFieldFormat, v.AsString())
+	return t, err == nil
+}
+
+func unixTime(v log.Value, unit time.Duration) (time.Time, bool) {
+	switch v.Kind() {
+	case log.KindInt64:
+		return time.Unix(0, v.AsInt64()*int64(unit)), true
+	case log.KindFloat64:
+		return time.Unix(0, int64(v.AsFloat64()*float64(unit))), true
+	}
+	return time.Time{}, false
+}
+
+// parseCaller returns the code.filepath and code.lineno attributes of the
+// caller field value v, formatted as file:line.
+func parseCaller(v log.Value) ([]log.KeyValue, bool) {
+	if v.Kind() != log.KindString {
+		return nil, false
+	}
+	s := v.AsString()
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return nil, false
+	}
+	line, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return nil, false
+	}
+	return []log.KeyValue{
+		log.String(string(semconv.CodeFilepathKey), s[:i]),
+		log.Int(string(semconv.CodeLineNumberKey), line),
+	}, true
+}
+
+// parseSpanContext returns the span context of the trace context fields added
+// by TraceHook.
+func parseSpanContext(traceID, spanID, flags string) (trace.SpanContext, bool) {
+	tid, err := trace.TraceIDFromHex(traceID)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	sid, err := trace.SpanIDFromHex(spanID)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	var tf trace.TraceFlags
+	if flags != "" {
+		f, err := strconv.ParseUint(flags, 16, 8)
+		if err != nil {
+			return trace.SpanContext{}, false
+		}
+		tf = trace.TraceFlags(f)
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: tf,
+	}), true
+}
+
+// deleteIndexes returns kvs without the elements at the indexes. Negative
+// indexes are ignored.
+func deleteIndexes(kvs []log.KeyValue, indexes ...int) []log.KeyValue {
+	out := kvs[:0]
+	for i, kv := range kvs {
+		del := false
+		for _, idx := range indexes {
+			if i == idx {
+				del = true
+				break
+			}
+		}
+		if !del {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelzerolog
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/logtest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewWriter(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter(
+		"name",
+		WithLoggerProvider(rec),
+		WithVersion("v1.0.0"),
+		WithSchemaURL("https://example.com/schema"),
+	)
+	l := zerolog.New(w)
+	l.Info().Msg("msg")
+
+	got := rec.Result()
+	require.Len(t, got, 2)
+	assert.Equal(t, "name", got[1].Name)
+	assert.Equal(t, "v1.0.0", got[1].Version)
+	assert.Equal(t, "https://example.com/schema", got[1].SchemaURL)
+	require.Len(t, got[1].Records, 1)
+}
+
+func TestNewWriterGlobalProvider(t *testing.T) {
+	orig := global.GetLoggerProvider()
+	t.Cleanup(func() { global.SetLoggerProvider(orig) })
+
+	rec := logtest.NewRecorder()
+	global.SetLoggerProvider(rec)
+
+	l := zerolog.New(NewWriter("name"))
+	l.Info().Msg("msg")
+	assert.Len(t, rec.Records(), 1)
+}
+
+func TestWriterEvent(t *testing.T) {
+	rec := logtest.NewRecorder()
+	now := time.Unix(1700000000, 0)
+	l := zerolog.New(NewWriter("name", WithLoggerProvider(rec)))
+	l.Warn().
+		Time(zerolog.TimestampFieldName, now).
+		Err(errors.New("err")).
+		Str("s", "v").
+		Int("i", 1).
+		Float64("f", 1.5).
+		Bool("b", true).
+		Interface("n", nil).
+		Ints("is", []int{1, 2}).
+		Dict("d", zerolog.Dict().Str("k", "v")).
+		Msg("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.True(t, now.Equal(got[0].Timestamp()), got[0].Timestamp())
+	assert.Equal(t, log.StringValue("msg"), got[0].Body())
+	assert.Equal(t, log.SeverityWarn, got[0].Severity())
+	assert.Equal(t, "warn", got[0].SeverityText())
+	assert.Equal(t, []log.KeyValue{
+		log.String(string(semconv.ExceptionMessageKey), "err"),
+		log.String("s", "v"),
+		log.Int64("i", 1),
+		log.Float64("f", 1.5),
+		log.Bool("b", true),
+		{Key: "n"},
+		log.Slice("is", log.Int64Value(1), log.Int64Value(2)),
+		log.Map("d", log.String("k", "v")),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestWriterLevel(t *testing.T) {
+	tests := []struct {
+		level zerolog.Level
+		want  log.Severity
+	}{
+		{zerolog.TraceLevel, log.SeverityTrace},
+		{zerolog.DebugLevel, log.SeverityDebug},
+		{zerolog.InfoLevel, log.SeverityInfo},
+		{zerolog.WarnLevel, log.SeverityWarn},
+		{zerolog.ErrorLevel, log.SeverityError},
+		{zerolog.FatalLevel, log.SeverityFatal},
+		{zerolog.PanicLevel, log.SeverityFatal2},
+		{zerolog.NoLevel, log.SeverityUndefined},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, convertLevel(tt.level), tt.level.String())
+	}
+
+	rec := logtest.NewRecorder()
+	w := NewWriter("name", WithLoggerProvider(rec))
+	_, err := w.Write([]byte(`{"level":"error","message":"parsed"}`))
+	require.NoError(t, err)
+	_, err = w.WriteLevel(zerolog.DebugLevel, []byte(`{"message":"param"}`))
+	require.NoError(t, err)
+
+	got := rec.Records()
+	require.Len(t, got, 2)
+	assert.Equal(t, log.SeverityError, got[0].Severity())
+	assert.Equal(t, "error", got[0].SeverityText())
+	assert.Equal(t, log.SeverityDebug, got[1].Severity())
+	assert.Equal(t, "debug", got[1].SeverityText())
+}
+
+func TestWriterCaller(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l := zerolog.New(NewWriter("name", WithLoggerProvider(rec)))
+	_, file, line, _ := runtime.Caller(0)
+	l.Info().Caller().Msg("msg")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.String(string(semconv.CodeFilepathKey), file),
+		log.Int(string(semconv.CodeLineNumberKey), line+1),
+	}, logtest.Attributes(got[0]))
+}
+
+func TestWriterTimeFieldFormat(t *testing.T) {
+	orig := zerolog.TimeFieldFormat
+	t.Cleanup(func() { zerolog.TimeFieldFormat = orig })
+
+	now := time.Unix(1700000000, 123000000)
+	for format, want := range map[string]time.Time{
+		time.RFC3339Nano:            now,
+		zerolog.TimeFormatUnix:      now.Truncate(time.Second),
+		zerolog.TimeFormatUnixMs:    now,
+		zerolog.TimeFormatUnixMicro: now,
+		zerolog.TimeFormatUnixNano:  now,
+	} {
+		zerolog.TimeFieldFormat = format
+		rec := logtest.NewRecorder()
+		l := zerolog.New(NewWriter("name", WithLoggerProvider(rec)))
+		l.Info().
+			Time(zerolog.TimestampFieldName, now).
+			Msg("msg")
+
+		got := rec.Records()
+		require.Len(t, got, 1, format)
+		assert.True(t, want.Equal(got[0].Timestamp()), "%q: %v", format, got[0].Timestamp())
+	}
+}
+
+func TestWriterNotJSON(t *testing.T) {
+	rec := logtest.NewRecorder()
+	w := NewWriter("name", WithLoggerProvider(rec))
+	for _, p := range []string{"plain text\n", `{"a":`, `["a"]`} {
+		n, err := w.WriteLevel(zerolog.InfoLevel, []byte(p))
+		assert.NoError(t, err)
+		assert.Equal(t, len(p), n)
+	}
+
+	got := rec.Records()
+	require.Len(t, got, 3)
+	assert.Equal(t, log.StringValue("plain text"), got[0].Body())
+	assert.Equal(t, log.StringValue(`{"a":`), got[1].Body())
+	assert.Equal(t, log.SeverityInfo, got[2].Severity())
+}
+
+func TestWriterEnabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityInfo
+	}))
+	l := zerolog.New(NewWriter("name", WithLoggerProvider(rec)))
+	l.Debug().Msg("debug")
+	l.Info().Msg("info")
+
+	got := rec.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, log.StringValue("info"), got[0].Body())
+}
+
+func TestTraceHook(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rec := logtest.NewRecorder()
+	w := NewWriter("name")
+	w.logger = rec
+	l := zerolog.New(w).Hook(TraceHook{})
+	l.Info().Ctx(ctx).Str("a", "b").Msg("span")
+	l.Info().Msg("no span")
+	l.Info().Str(TraceIDFieldName, "invalid").Msg("invalid")
+
+	require.Len(t, rec.Contexts(), 3)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.Contexts()[0]))
+	assert.Equal(t, []log.KeyValue{log.String("a", "b")}, logtest.Attributes(rec.Records()[0]))
+	assert.Equal(t, context.Background(), rec.Contexts()[1])
+	assert.Equal(t, context.Background(), rec.Contexts()[2])
+	assert.Equal(t, []log.KeyValue{log.String(TraceIDFieldName, "invalid")}, logtest.Attributes(rec.Records()[2]))
+}
+
+type discardLogger struct{ log.Logger }
+
+func (discardLogger) Emit(context.Context, log.Record) {}
+
+func (discardLogger) Enabled(context.Context, log.Record) bool { return true }
+
+func BenchmarkWriter(b *testing.B) {
+	w := NewWriter("name")
+	w.logger = discardLogger{}
+	l := zerolog.New(w).With().Timestamp().Logger()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l.Info().Str("a", "b").Int("c", i).Bool("d", true).Msg("msg" + strconv.Itoa(i%2))
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package correlation // import "go.opentelemetry.io/otel/correlation"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The attribute keys of the trace context, as defined for non-OTLP log
+// formats by the OpenTelemetry specification.
+const (
+	// TraceIDKey is the attribute key of the trace ID.
+	TraceIDKey = attribute.Key("trace_id")
+	// SpanIDKey is the attribute key of the span ID.
+	SpanIDKey = attribute.Key("span_id")
+	// TraceFlagsKey is the attribute key of the trace flags.
+	TraceFlagsKey = attribute.Key("trace_flags")
+)
+
+// DefaultRequestIDKey is the default attribute key of the request ID.
+const DefaultRequestIDKey = attribute.Key("request.id")
+
+type requestIDKeyType int
+
+const requestIDKey requestIDKeyType = 0
+
+// ContextWithRequestID returns a copy of parent holding the request ID id.
+func ContextWithRequestID(parent context.Context, id string) context.Context {
+	return context.WithValue(parent, requestIDKey, id)
+}
+
+// RequestIDFromContext returns the request ID of ctx.
+//
+// If no request ID was set with [ContextWithRequestID], the trace ID of the
+// span context of ctx is returned, in its hexadecimal representation: it is
+// stable for the whole request, including across services. An empty string is
+// returned if ctx holds neither.
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return id
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
+
+// Correlator extracts the [Correlation] of contexts.
+//
+// A Correlator is safe for concurrent use.
+type Correlator struct {
+	baggageKeys  []string
+	requestIDKey attribute.Key
+}
+
+// NewCorrelator returns a new [Correlator] configured with options.
+func NewCorrelator(options ...Option) *Correlator {
+	cfg := config{requestIDKey: DefaultRequestIDKey}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return &Correlator{
+		baggageKeys:  cfg.baggageKeys,
+		requestIDKey: cfg.requestIDKey,
+	}
+}
+
+type config struct {
+	baggageKeys  []string
+	requestIDKey attribute.Key
+}
+
+// Option configures a [Correlator].
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithBaggageKeys returns an [Option] that selects the baggage members of
+// keys to be part of a [Correlation]. By default, no baggage member is
+// selected.
+//
+// The values of the selected members are added as attributes to all signals,
+// including metrics. Only select members of low cardinality.
+func WithBaggageKeys(keys ...string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.baggageKeys = append(cfg.baggageKeys, keys...)
+		return cfg
+	})
+}
+
+// WithRequestIDKey returns an [Option] that sets the attribute key of the
+// request ID. The default is [DefaultRequestIDKey].
+func WithRequestIDKey(key attribute.Key) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.requestIDKey = key
+		return cfg
+	})
+}
+
+// Correlation holds the identifiers correlating the telemetry of a request.
+type Correlation struct {
+	// SpanContext is the span context of the active span.
+	SpanContext trace.SpanContext
+	// RequestID is the request ID, as returned by [RequestIDFromContext].
+	RequestID string
+	// Baggage are the values of the selected baggage members present in the
+	// context, keyed by the baggage member keys, in the selection order.
+	Baggage []attribute.KeyValue
+
+	requestIDKey attribute.Key
+}
+
+// FromContext returns the [Correlation] of ctx.
+func (c *Correlator) FromContext(ctx context.Context) Correlation {
+	out := Correlation{
+		SpanContext:  trace.SpanContextFromContext(ctx),
+		RequestID:    RequestIDFromContext(ctx),
+		requestIDKey: c.requestIDKey,
+	}
+	if len(c.baggageKeys) > 0 {
+		b := baggage.FromContext(ctx)
+		for _, k := range c.baggageKeys {
+			if m := b.Member(k); m.Key() != "" {
+				out.Baggage = append(out.Baggage, attribute.String(k, m.Value()))
+			}
+		}
+	}
+	return out
+}
+
+// LogAttributes returns the attributes correlating a log record: the trace
+// ID, span ID, and trace flags of a valid span context, the request ID, and
+// the baggage.
+//
+// The trace context attributes are meant for the log records that are not
+// emitted using the OpenTelemetry Logs Bridge API, e.g. written as JSON
+// lines. The log records emitted using the Logs Bridge API hold the trace
+// context in dedicated fields instead; use [Correlation.SpanAttributes] for
+// them.
+func (c Correlation) LogAttributes() []attribute.KeyValue {
+	var out []attribute.KeyValue
+	if c.SpanContext.IsValid() {
+		out = append(out,
+			TraceIDKey.String(c.SpanContext.TraceID().String()),
+			SpanIDKey.String(c.SpanContext.SpanID().String()),
+			TraceFlagsKey.String(c.SpanContext.TraceFlags().String()),
+		)
+	}
+	return c.appendCommon(out)
+}
+
+// SpanAttributes returns the attributes correlating a span, or a log record
+// emitted using the OpenTelemetry Logs Bridge API: the request ID and the
+// baggage. The trace context is not included, it is part of these signals.
+func (c Correlation) SpanAttributes() []attribute.KeyValue {
+	return c.appendCommon(nil)
+}
+
+// MetricAttributes returns the attributes correlating a measurement: the
+// baggage. The trace context and the request ID are not included, their
+// cardinality is unbounded.
+func (c Correlation) MetricAttributes() []attribute.KeyValue {
+	if len(c.Baggage) == 0 {
+		return nil
+	}
+	return append([]attribute.KeyValue(nil), c.Baggage...)
+}
+
+func (c Correlation) appendCommon(out []attribute.KeyValue) []attribute.KeyValue {
+	if c.RequestID != "" {
+		key := c.requestIDKey
+		if key == "" {
+			key = DefaultRequestIDKey
+		}
+		out = append(out, key.String(c.RequestID))
+	}
+	return append(out, c.Baggage...)
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package correlation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var sc = trace.NewSpanContext(trace.SpanContextConfig{
+	TraceID:    trace.TraceID{0x01},
+	SpanID:     trace.SpanID{0x02},
+	TraceFlags: trace.FlagsSampled,
+})
+
+func withBaggage(t *testing.T, ctx context.Context, kv ...string) context.Context {
+	t.Helper()
+	var members []baggage.Member
+	for i := 0; i < len(kv); i += 2 {
+		m, err := baggage.NewMember(kv[i], kv[i+1])
+		require.NoError(t, err)
+		members = append(members, m)
+	}
+	b, err := baggage.New(members...)
+	require.NoError(t, err)
+	return baggage.ContextWithBaggage(ctx, b)
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", RequestIDFromContext(ctx), "empty context")
+
+	ctx = trace.ContextWithSpanContext(ctx, sc)
+	assert.Equal(t, sc.TraceID().String(), RequestIDFromContext(ctx), "trace ID")
+
+	ctx = ContextWithRequestID(ctx, "req")
+	assert.Equal(t, "req", RequestIDFromContext(ctx), "request ID")
+}
+
+func TestCorrelator(t *testing.T) {
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	ctx = ContextWithRequestID(ctx, "req")
+	ctx = withBaggage(t, ctx, "tenant", "acme", "region", "eu", "other", "x")
+
+	c := NewCorrelator(
+		WithBaggageKeys("region", "tenant", "missing"),
+		WithRequestIDKey("http.request.id"),
+	).FromContext(ctx)
+
+	assert.Equal(t, sc, c.SpanContext)
+	assert.Equal(t, "req", c.RequestID)
+	bag := []attribute.KeyValue{
+		attribute.String("region", "eu"),
+		attribute.String("tenant", "acme"),
+	}
+	assert.Equal(t, bag, c.Baggage)
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("trace_id", "01000000000000000000000000000000"),
+		attribute.String("span_id", "0200000000000000"),
+		attribute.String("trace_flags", "01"),
+		attribute.String("http.request.id", "req"),
+		bag[0], bag[1],
+	}, c.LogAttributes())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.id", "req"),
+		bag[0], bag[1],
+	}, c.SpanAttributes())
+	assert.Equal(t, bag, c.MetricAttributes())
+}
+
+func TestCorrelatorEmptyContext(t *testing.T) {
+	c := NewCorrelator(WithBaggageKeys("tenant")).FromContext(context.Background())
+	assert.Empty(t, c.LogAttributes())
+	assert.Empty(t, c.SpanAttributes())
+	assert.Empty(t, c.MetricAttributes())
+}
+
+func TestCorrelationZeroValue(t *testing.T) {
+	c := Correlation{RequestID: "req"}
+	assert.Equal(t, []attribute.KeyValue{DefaultRequestIDKey.String("req")}, c.SpanAttributes())
+}
@@ -0,0 +1,13 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package correlation provides the identifiers correlating the telemetry of a
+request across signals: the trace and span IDs of the active span, selected
+baggage members, and a request ID.
+
+A [Correlator] extracts them from a context as a [Correlation]. Its methods
+return them as attributes following the conventions of each signal, so that
+the logs, metrics, and spans of an application are correlated the same way.
+*/
+package correlation // import "go.opentelemetry.io/otel/correlation"
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package correlation_test
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/correlation"
+)
+
+func Example() {
+	// Create a single Correlator used by all the instrumentation of the
+	// application.
+	correlator := correlation.NewCorrelator(correlation.WithBaggageKeys("tenant"))
+
+	ctx := correlation.ContextWithRequestID(context.Background(), "4bf92f35")
+	c := correlator.FromContext(ctx)
+
+	for _, kv := range c.LogAttributes() {
+		fmt.Printf("%s=%s\n", kv.Key, kv.Value.Emit())
+	}
+	// Output:
+	// request.id=4bf92f35
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package experimental provides a registry of the experimental features of
+// the OpenTelemetry Go packages.
+//
+// Experimental features are disabled by default. A feature is enabled by
+// setting its environment variable (e.g. OTEL_GO_X_EXEMPLAR) or
+// programmatically with [Enable]. A value set programmatically takes
+// precedence over the environment variable. Features are expected to be
+// configured during the program initialization, before the packages
+// providing them are used.
+//
+// Only the features of the packages linked into the program are registered.
+// Use [Features] to list them, e.g. in a debug endpoint.
+//
+// Experimental features can change or be removed at any time. They are not
+// covered by the project versioning guarantees.
+package experimental // import "go.opentelemetry.io/otel/experimental"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownFeature is returned when a feature that is not registered is
+// configured.
+var ErrUnknownFeature = errors.New("unknown experimental feature")
+
+// Feature describes the state of a registered experimental feature.
+type Feature struct {
+	// Key is the environment variable key of the feature. It identifies the
+	// feature.
+	Key string
+	// Description describes the feature.
+	Description string
+	// Value is the configured value of the feature. It is the value set with
+	// Enable or Disable if Overridden is true, otherwise the value of the
+	// environment variable.
+	Value string
+	// Overridden is true if the feature was configured programmatically.
+	Overridden bool
+	// Enabled is true if the feature is enabled by Value.
+	Enabled bool
+}
+
+type entry struct {
+	description string
+	valid       func(string) bool
+
+	override   string
+	overridden bool
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]*entry{}
+)
+
+// Register registers the experimental feature identified by the environment
+// variable key. The valid function reports if a value enables the feature.
+//
+// Register is intended to be called by the packages providing experimental
+// features, during their initialization. Registering a key again replaces the
+// description and valid function and keeps any programmatic configuration.
+func Register(key, description string, valid func(value string) bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	e, ok := registry[key]
+	if !ok {
+		e = &entry{}
+		registry[key] = e
+	}
+	e.description = description
+	e.valid = valid
+}
+
+// Enable enables the registered experimental feature identified by key with
+// value. An error is returned if the feature is not registered or value does
+// not enable the feature.
+func Enable(key, value string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	e, ok := registry[key]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFeature, key)
+	}
+	if e.valid != nil && !e.valid(value) {
+		return fmt.Errorf("invalid value for experimental feature %s: %q", key, value)
+	}
+	e.override, e.overridden = value, true
+	return nil
+}
+
+// Disable disables the registered experimental feature identified by key,
+// regardless of its environment variable. An error is returned if the
+// feature is not registered.
+func Disable(key string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	e, ok := registry[key]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFeature, key)
+	}
+	e.override, e.overridden = "", true
+	return nil
+}
+
+// Reset removes the programmatic configuration of the registered
+// experimental feature identified by key. The feature is then configured by
+// its environment variable again. An error is returned if the feature is not
+// registered.
+func Reset(key string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	e, ok := registry[key]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFeature, key)
+	}
+	e.override, e.overridden = "", false
+	return nil
+}
+
+// Value returns the configured value of the experimental feature identified
+// by key. It is the value set with [Enable] or [Disable], if any, otherwise
+// the value of the environment variable key.
+//
+// Value is intended to be used by the packages providing experimental
+// features to look up their configuration.
+func Value(key string) string {
+	mu.RLock()
+	e, ok := registry[key]
+	if ok && e.overridden {
+		v := e.override
+		mu.RUnlock()
+		return v
+	}
+	mu.RUnlock()
+	return os.Getenv(key)
+}
+
+// Features returns the registered experimental features sorted by key.
+func Features() []Feature {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	features := make([]Feature, 0, len(registry))
+	for key, e := range registry {
+		f := Feature{
+			Key:         key,
+			Description: e.description,
+			Overridden:  e.overridden,
+		}
+		if e.overridden {
+			f.Value = e.override
+		} else {
+			f.Value = os.Getenv(key)
+		}
+		// An empty value is interpreted the same way as an unset value.
+		f.Enabled = f.Value != "" && (e.valid == nil || e.valid(f.Value))
+		features = append(features, f)
+	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Key < features[j].Key
+	})
+	return features
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experimental
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isTrue(v string) bool { return v == "true" }
+
+func register(t *testing.T, key string) {
+	t.Helper()
+	Register(key, "test feature", isTrue)
+	t.Cleanup(func() {
+		mu.Lock()
+		delete(registry, key)
+		mu.Unlock()
+	})
+}
+
+func TestValue(t *testing.T) {
+	const key = "OTEL_GO_X_TEST_VALUE"
+	register(t, key)
+
+	assert.Equal(t, "", Value(key), "unset")
+
+	t.Setenv(key, "true")
+	assert.Equal(t, "true", Value(key), "environment")
+
+	require.NoError(t, Disable(key))
+	assert.Equal(t, "", Value(key), "disabled")
+
+	require.NoError(t, Enable(key, "true"))
+	t.Setenv(key, "false")
+	assert.Equal(t, "true", Value(key), "override precedence")
+
+	require.NoError(t, Reset(key))
+	assert.Equal(t, "false", Value(key), "reset")
+}
+
+func TestValueUnregistered(t *testing.T) {
+	const key = "OTEL_GO_X_TEST_UNREGISTERED"
+	t.Setenv(key, "value")
+	assert.Equal(t, "value", Value(key))
+}
+
+func TestUnknownFeature(t *testing.T) {
+	const key = "OTEL_GO_X_TEST_UNKNOWN"
+	assert.ErrorIs(t, Enable(key, "true"), ErrUnknownFeature)
+	assert.ErrorIs(t, Disable(key), ErrUnknownFeature)
+	assert.ErrorIs(t, Reset(key), ErrUnknownFeature)
+}
+
+func TestEnableInvalidValue(t *testing.T) {
+	const key = "OTEL_GO_X_TEST_INVALID"
+	register(t, key)
+
+	assert.Error(t, Enable(key, "false"))
+	assert.Equal(t, "", Value(key), "invalid value applied")
+}
+
+func TestRegisterKeepsOverride(t *testing.T) {
+	const key = "OTEL_GO_X_TEST_REREGISTER"
+	register(t, key)
+	require.NoError(t, Enable(key, "true"))
+
+	Register(key, "new description", isTrue)
+	assert.Equal(t, "true", Value(key))
+}
+
+func TestFeatures(t *testing.T) {
+	const (
+		keyA = "OTEL_GO_X_TEST_A"
+		keyB = "OTEL_GO_X_TEST_B"
+		keyC = "OTEL_GO_X_TEST_C"
+	)
+	register(t, keyC)
+	register(t, keyA)
+	register(t, keyB)
+
+	t.Setenv(keyA, "true")
+	t.Setenv(keyB, "true")
+	require.NoError(t, Disable(keyB))
+	require.NoError(t, Enable(keyC, "true"))
+
+	assert.Equal(t, []Feature{
+		{Key: keyA, Description: "test feature", Value: "true", Enabled: true},
+		{Key: keyB, Description: "test feature", Overridden: true},
+		{Key: keyC, Description: "test feature", Value: "true", Overridden: true, Enabled: true},
+	}, Features())
+}
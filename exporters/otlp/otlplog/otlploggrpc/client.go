@@ -3,12 +3,275 @@
 
 package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/retry"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
 type client struct {
-	// TODO: implement.
+	metadata      metadata.MD
+	exportTimeout time.Duration
+	requestFunc   retry.RequestFunc
+
+	// compressionThreshold is the minimum size of the requests compressed
+	// with gzip. If it is zero, the compression is set by the dial options
+	// instead.
+	compressionThreshold int
+	// responseMetadata is called with the header and trailer metadata of
+	// each export response. It is nil if the metadata is not captured.
+	responseMetadata func(header, trailer metadata.MD)
+
+	// ourConn keeps track of where conn was created: true if created here in
+	// newClient, or false if passed with an option. This is important on
+	// Shutdown as conn should only be closed if created here. Otherwise, it is
+	// up to the processes that passed conn to close it.
+	ourConn bool
+	conn    *grpc.ClientConn
+	lsc     collogpb.LogsServiceClient
+}
+
+// newNoopClient returns a client doing nothing.
+func newNoopClient() *client {
+	return &client{}
 }
 
 // newClient creates a new gRPC log client.
 func newClient(cfg config) (*client, error) {
-	// TODO: implement.
-	return &client{}, nil
+	c := &client{
+		exportTimeout: cfg.timeout.Value,
+		requestFunc:   cfg.retryCfg.Value.RequestFunc(retryable),
+		conn:          cfg.gRPCConn.Value,
+	}
+
+	if len(cfg.headers.Value) > 0 {
+		c.metadata = metadata.New(cfg.headers.Value)
+	}
+	if cfg.compression.Value == gzipCompression && cfg.compressionThreshold.Value > 0 && cfg.gRPCConn.Value == nil {
+		c.compressionThreshold = cfg.compressionThreshold.Value
+	}
+	c.responseMetadata = cfg.responseMetadata.Value
+
+	if c.conn == nil {
+		// If the caller did not provide a ClientConn when the client was
+		// created, create one using the configuration they did provide.
+		conn, err := grpc.NewClient(cfg.endpoint.Value, newGRPCDialOptions(cfg)...)
+		if err != nil {
+			return nil, err
+		}
+		// Keep track that we own the lifecycle of this conn and need to close
+		// it on Shutdown.
+		c.ourConn = true
+		c.conn = conn
+	}
+
+	c.lsc = collogpb.NewLogsServiceClient(c.conn)
+
+	return c, nil
+}
+
+// newGRPCDialOptions returns the options used to create the gRPC ClientConn
+// configured by cfg.
+func newGRPCDialOptions(cfg config) []grpc.DialOption {
+	userAgent := "OTel Go OTLP over gRPC logs exporter/" + Version()
+	dialOpts := []grpc.DialOption{grpc.WithUserAgent(userAgent)}
+
+	// Security.
+	switch {
+	case cfg.tlsCred.Value != nil:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(cfg.tlsCred.Value))
+	case cfg.insecure.Value:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	default:
+		// Default to using the host's root CA.
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(nil)))
+	}
+
+	// Compression. With a threshold, the compressor is set per request by the
+	// client.
+	if cfg.compression.Value == gzipCompression && cfg.compressionThreshold.Value <= 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
+
+	// Service config.
+	if cfg.serviceConfig.Value != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultServiceConfig(cfg.serviceConfig.Value))
+	}
+
+	// Reconnection period.
+	if cfg.reconnectionPeriod.Value != 0 {
+		p := grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: cfg.reconnectionPeriod.Value,
+		}
+		dialOpts = append(dialOpts, grpc.WithConnectParams(p))
+	}
+
+	// The user options are last, so they take precedence.
+	return append(dialOpts, cfg.dialOptions.Value...)
+}
+
+// UploadLogs sends proto logs to connected endpoint.
+//
+// Retryable errors from the server will be handled according to any
+// RetryConfig the client was created with.
+func (c *client) UploadLogs(ctx context.Context, rl []*logpb.ResourceLogs) error {
+	if c.lsc == nil {
+		// The client is a no-op.
+		return nil
+	}
+
+	ctx, cancel := c.exportContext(ctx)
+	defer cancel()
+
+	req := &collogpb.ExportLogsServiceRequest{ResourceLogs: rl}
+	callOpts := c.callOptions(req)
+	return c.requestFunc(ctx, func(ctx context.Context) error {
+		resp, err := c.export(ctx, req, callOpts)
+		if resp != nil && resp.PartialSuccess != nil {
+			msg := resp.PartialSuccess.GetErrorMessage()
+			n := resp.PartialSuccess.GetRejectedLogRecords()
+			if n != 0 || msg != "" {
+				otel.Handle(PartialSuccessError{
+					RejectedLogRecords: n,
+					Message:            msg,
+				})
+			}
+		}
+		// nil is converted to OK.
+		if status.Code(err) == codes.OK {
+			// Success.
+			return nil
+		}
+		return err
+	})
+}
+
+// export sends req with the call options opts. If c captures the response
+// metadata, the header and trailer metadata of the response are passed to
+// its function, even if the export fails.
+func (c *client) export(ctx context.Context, req *collogpb.ExportLogsServiceRequest, opts []grpc.CallOption) (*collogpb.ExportLogsServiceResponse, error) {
+	if c.responseMetadata == nil {
+		return c.lsc.Export(ctx, req, opts...)
+	}
+	var header, trailer metadata.MD
+	opts = append(slices.Clip(opts), grpc.Header(&header), grpc.Trailer(&trailer))
+	resp, err := c.lsc.Export(ctx, req, opts...)
+	c.responseMetadata(header, trailer)
+	return resp, err
+}
+
+// callOptions returns the options of the call exporting req: the gzip
+// compressor if req is not smaller than the compression threshold of c.
+func (c *client) callOptions(req proto.Message) []grpc.CallOption {
+	if c.compressionThreshold > 0 && proto.Size(req) >= c.compressionThreshold {
+		return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}
+	}
+	return nil
+}
+
+// Shutdown shuts down the client, freeing all resources.
+//
+// Any active connections to a remote endpoint are closed if they were created
+// by the client. Any gRPC connection passed during creation using
+// WithGRPCConn will not be closed. It is the caller's responsibility to
+// handle cleanup of that resource.
+//
+// The Exporter ensures this is called only once, and that no new uploads are
+// started after it is called. Uploads in progress are canceled if the
+// connection is closed.
+func (c *client) Shutdown(ctx context.Context) error {
+	err := ctx.Err()
+	if c.ourConn {
+		closeErr := c.conn.Close()
+		// A context timeout error takes precedence over this error.
+		if err == nil && closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// exportContext returns a copy of parent with an appropriate deadline and
+// cancellation function based on the clients configured export timeout.
+//
+// It is the callers responsibility to cancel the returned context once its
+// use is complete, via the parent or directly with the returned CancelFunc, to
+// ensure all resources are correctly released.
+func (c *client) exportContext(parent context.Context) (context.Context, context.CancelFunc) {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	if c.exportTimeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, c.exportTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+
+	if c.metadata.Len() > 0 {
+		md := c.metadata
+		if outMD, ok := metadata.FromOutgoingContext(ctx); ok {
+			md = metadata.Join(md, outMD)
+		}
+
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	return ctx, cancel
+}
+
+// retryable returns if err identifies a request that can be retried and a
+// duration to wait for if an explicit throttle time is included in err.
+func retryable(err error) (bool, time.Duration) {
+	s := status.Convert(err)
+	return retryableGRPCStatus(s)
+}
+
+func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
+	switch s.Code() {
+	case codes.Canceled,
+		codes.DeadlineExceeded,
+		codes.Aborted,
+		codes.OutOfRange,
+		codes.Unavailable,
+		codes.DataLoss:
+		// Additionally, handle RetryInfo.
+		_, d := throttleDelay(s)
+		return true, d
+	case codes.ResourceExhausted:
+		// Retry only if the server signals that the recovery from resource exhaustion is possible.
+		return throttleDelay(s)
+	}
+
+	// Not a retry-able error.
+	return false, 0
+}
+
+// throttleDelay returns if the status is RetryInfo
+// and the duration to wait for if an explicit throttle time is included.
+func throttleDelay(s *status.Status) (bool, time.Duration) {
+	for _, detail := range s.Details() {
+		if t, ok := detail.(*errdetails.RetryInfo); ok {
+			return true, t.RetryDelay.AsDuration()
+		}
+	}
+	return false, 0
 }
@@ -0,0 +1,425 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlploggrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.opentelemetry.io/otel"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+var resourceLogs = []*lpb.ResourceLogs{{
+	ScopeLogs: []*lpb.ScopeLogs{{
+		Scope: &cpb.InstrumentationScope{Name: "test"},
+		LogRecords: []*lpb.LogRecord{{
+			TimeUnixNano: 1,
+			Body: &cpb.AnyValue{
+				Value: &cpb.AnyValue_StringValue{StringValue: "a"},
+			},
+		}},
+	}},
+}}
+
+// exportResult is the response of the collector to an export request.
+type exportResult struct {
+	Response *collogpb.ExportLogsServiceResponse
+	Err      error
+}
+
+// grpcCollector is an OTLP gRPC logs collector recording the requests it
+// receives. It responds to the requests with the results sent to its result
+// channel, or with an empty response if none is sent.
+type grpcCollector struct {
+	collogpb.UnimplementedLogsServiceServer
+
+	listener net.Listener
+	srv      *grpc.Server
+
+	resultCh <-chan exportResult
+
+	mu       sync.Mutex
+	requests []*collogpb.ExportLogsServiceRequest
+	headers  metadata.MD
+}
+
+// newGRPCCollector returns a new grpcCollector listening on a random local
+// port. The collector is stopped when t completes.
+func newGRPCCollector(t *testing.T, resultCh <-chan exportResult) *grpcCollector {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "listen")
+
+	c := &grpcCollector{
+		listener: l,
+		srv:      grpc.NewServer(),
+		resultCh: resultCh,
+	}
+	collogpb.RegisterLogsServiceServer(c.srv, c)
+	go func() { _ = c.srv.Serve(l) }()
+	t.Cleanup(c.srv.Stop)
+
+	return c
+}
+
+// Export records req and responds with the next result.
+func (c *grpcCollector) Export(ctx context.Context, req *collogpb.ExportLogsServiceRequest) (*collogpb.ExportLogsServiceResponse, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, req)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		c.headers = metadata.Join(c.headers, md)
+	}
+	c.mu.Unlock()
+
+	if c.resultCh != nil {
+		select {
+		case r, ok := <-c.resultCh:
+			if ok {
+				return r.Response, r.Err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return &collogpb.ExportLogsServiceResponse{}, nil
+}
+
+// Endpoint returns the endpoint the collector listens on.
+func (c *grpcCollector) Endpoint() string {
+	return c.listener.Addr().String()
+}
+
+// Requests returns the export requests the collector received.
+func (c *grpcCollector) Requests() []*collogpb.ExportLogsServiceRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.requests
+}
+
+// Headers returns the metadata of the export requests the collector received.
+func (c *grpcCollector) Headers() metadata.MD {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.headers
+}
+
+// newTestClient returns a client exporting insecurely to the collector, and
+// configured with the default retry policy using short intervals.
+func newTestClient(t *testing.T, coll *grpcCollector, opts ...Option) *client {
+	t.Helper()
+
+	opts = append([]Option{
+		WithEndpoint(coll.Endpoint()),
+		WithInsecure(),
+		WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+	}, opts...)
+	c, err := newClient(newConfig(opts))
+	require.NoError(t, err, "newClient")
+	t.Cleanup(func() { _ = c.Shutdown(context.Background()) })
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	coll := newGRPCCollector(t, nil)
+	c := newTestClient(t, coll, WithHeaders(map[string]string{"key": "value"}))
+
+	require.NoError(t, c.UploadLogs(ctx, resourceLogs))
+
+	got := coll.Requests()
+	require.Len(t, got, 1)
+	want := &collogpb.ExportLogsServiceRequest{ResourceLogs: resourceLogs}
+	assert.True(t, proto.Equal(want, got[0]), "exported request")
+
+	h := coll.Headers()
+	assert.Equal(t, []string{"value"}, h.Get("key"), "user header")
+	if assert.Len(t, h.Get("user-agent"), 1) {
+		assert.Contains(t, h.Get("user-agent")[0], "OTel Go OTLP over gRPC logs exporter/"+Version())
+	}
+}
+
+func TestClientContextMetadata(t *testing.T) {
+	coll := newGRPCCollector(t, nil)
+	c := newTestClient(t, coll, WithHeaders(map[string]string{"key": "value"}))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "ctx-key", "ctx-value")
+	require.NoError(t, c.UploadLogs(ctx, resourceLogs))
+
+	h := coll.Headers()
+	assert.Equal(t, []string{"value"}, h.Get("key"), "user header")
+	assert.Equal(t, []string{"ctx-value"}, h.Get("ctx-key"), "context header")
+}
+
+func TestClientRetry(t *testing.T) {
+	throttle := status.New(codes.ResourceExhausted, "throttled")
+	throttle, err := throttle.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Millisecond),
+	})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name    string
+		results []exportResult
+		wantErr codes.Code
+		wantN   int
+	}{
+		{
+			name: "Retryable",
+			results: []exportResult{
+				{Err: status.Error(codes.Unavailable, "unavailable")},
+				{Err: status.Error(codes.DeadlineExceeded, "deadline")},
+			},
+			wantErr: codes.OK,
+			wantN:   3,
+		},
+		{
+			name: "NonRetryable",
+			results: []exportResult{
+				{Err: status.Error(codes.InvalidArgument, "invalid")},
+			},
+			wantErr: codes.InvalidArgument,
+			wantN:   1,
+		},
+		{
+			name:    "Throttled",
+			results: []exportResult{{Err: throttle.Err()}},
+			wantErr: codes.OK,
+			wantN:   2,
+		},
+		{
+			name: "ResourceExhausted",
+			results: []exportResult{
+				{Err: status.Error(codes.ResourceExhausted, "exhausted")},
+			},
+			wantErr: codes.ResourceExhausted,
+			wantN:   1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			resultCh := make(chan exportResult, len(tc.results))
+			for _, r := range tc.results {
+				resultCh <- r
+			}
+			close(resultCh)
+
+			coll := newGRPCCollector(t, resultCh)
+			c := newTestClient(t, coll)
+
+			err := c.UploadLogs(context.Background(), resourceLogs)
+			assert.Equal(t, tc.wantErr, status.Code(err), "error code")
+			assert.Len(t, coll.Requests(), tc.wantN, "export requests")
+		})
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	// Never respond.
+	resultCh := make(chan exportResult)
+	coll := newGRPCCollector(t, resultCh)
+	c := newTestClient(t, coll, WithTimeout(time.Millisecond), WithRetry(RetryConfig{}))
+
+	err := c.UploadLogs(context.Background(), resourceLogs)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestClientPartialSuccess(t *testing.T) {
+	var errs []error
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			errs = append(errs, err)
+		}))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	resultCh := make(chan exportResult, 1)
+	resultCh <- exportResult{Response: &collogpb.ExportLogsServiceResponse{
+		PartialSuccess: &collogpb.ExportLogsPartialSuccess{
+			RejectedLogRecords: 2,
+			ErrorMessage:       "partially successful",
+		},
+	}}
+	coll := newGRPCCollector(t, resultCh)
+	c := newTestClient(t, coll)
+
+	require.NoError(t, c.UploadLogs(context.Background(), resourceLogs))
+	require.Len(t, errs, 1)
+	want := PartialSuccessError{RejectedLogRecords: 2, Message: "partially successful"}
+	var got PartialSuccessError
+	require.True(t, errors.As(errs[0], &got), "PartialSuccessError")
+	assert.Equal(t, want, got)
+}
+
+func TestClientCompressionThreshold(t *testing.T) {
+	small := &collogpb.ExportLogsServiceRequest{}
+	large := &collogpb.ExportLogsServiceRequest{
+		ResourceLogs: []*lpb.ResourceLogs{{SchemaUrl: strings.Repeat("a", 100)}},
+	}
+	coll := newGRPCCollector(t, nil)
+
+	c := newTestClient(t, coll, WithCompressor("gzip"), WithCompressionThreshold(100))
+	assert.Equal(t, 100, c.compressionThreshold)
+	assert.Empty(t, c.callOptions(small), "small request compressed")
+	assert.Len(t, c.callOptions(large), 1, "large request not compressed")
+	require.NoError(t, c.UploadLogs(context.Background(), large.ResourceLogs))
+
+	c = newTestClient(t, coll, WithCompressor("gzip"))
+	assert.Empty(t, c.callOptions(large), "compressor set per request without threshold")
+
+	c = newTestClient(t, coll, WithCompressionThreshold(100))
+	assert.Empty(t, c.callOptions(large), "compressed without compressor")
+}
+
+// metadataServer is a logs service sending header and trailer metadata.
+type metadataServer struct {
+	collogpb.UnimplementedLogsServiceServer
+
+	code codes.Code
+}
+
+func (s *metadataServer) Export(ctx context.Context, _ *collogpb.ExportLogsServiceRequest) (*collogpb.ExportLogsServiceResponse, error) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-collector-version", "1.2.3"))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-ratelimit-remaining", "0"))
+	if s.code != codes.OK {
+		return nil, status.Error(s.code, "export failed")
+	}
+	return &collogpb.ExportLogsServiceResponse{}, nil
+}
+
+func TestClientResponseMetadataFunc(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &metadataServer{}
+	gs := grpc.NewServer()
+	collogpb.RegisterLogsServiceServer(gs, srv)
+	go func() { _ = gs.Serve(ln) }()
+	t.Cleanup(gs.Stop)
+
+	var headers, trailers []metadata.MD
+	ctx := context.Background()
+	c, err := newClient(newConfig([]Option{
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithResponseMetadataFunc(func(header, trailer metadata.MD) {
+			headers = append(headers, header)
+			trailers = append(trailers, trailer)
+		}),
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Shutdown(ctx) })
+
+	require.NoError(t, c.UploadLogs(ctx, resourceLogs))
+	srv.code = codes.InvalidArgument
+	require.Error(t, c.UploadLogs(ctx, resourceLogs))
+
+	require.Len(t, headers, 2)
+	for i := range headers {
+		assert.Equal(t, []string{"1.2.3"}, headers[i].Get("x-collector-version"))
+		assert.Equal(t, []string{"0"}, trailers[i].Get("x-ratelimit-remaining"))
+	}
+}
+
+func TestClientShutdown(t *testing.T) {
+	coll := newGRPCCollector(t, nil)
+
+	t.Run("OwnConn", func(t *testing.T) {
+		c := newTestClient(t, coll)
+		require.NoError(t, c.Shutdown(context.Background()))
+		assert.Equal(t, connectivity.Shutdown, c.conn.GetState(), "connection not closed")
+	})
+
+	t.Run("GRPCConn", func(t *testing.T) {
+		conn, err := grpc.NewClient(coll.Endpoint(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+
+		c, err := newClient(newConfig([]Option{WithGRPCConn(conn)}))
+		require.NoError(t, err)
+		require.NoError(t, c.UploadLogs(context.Background(), resourceLogs))
+		require.NoError(t, c.Shutdown(context.Background()))
+		assert.NotEqual(t, connectivity.Shutdown, conn.GetState(), "passed connection closed")
+	})
+
+	t.Run("ContextError", func(t *testing.T) {
+		c := newTestClient(t, coll)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, c.Shutdown(ctx), context.Canceled)
+		assert.Equal(t, connectivity.Shutdown, c.conn.GetState(), "connection not closed")
+	})
+}
+
+func TestNoopClient(t *testing.T) {
+	c := newNoopClient()
+	ctx := context.Background()
+	assert.NoError(t, c.UploadLogs(ctx, resourceLogs))
+	assert.NoError(t, c.Shutdown(ctx))
+}
+
+func TestRetryable(t *testing.T) {
+	retryableCodes := map[codes.Code]bool{
+		codes.OK:                 false,
+		codes.Canceled:           true,
+		codes.Unknown:            false,
+		codes.InvalidArgument:    false,
+		codes.DeadlineExceeded:   true,
+		codes.NotFound:           false,
+		codes.AlreadyExists:      false,
+		codes.PermissionDenied:   false,
+		codes.ResourceExhausted:  false,
+		codes.FailedPrecondition: false,
+		codes.Aborted:            true,
+		codes.OutOfRange:         true,
+		codes.Unimplemented:      false,
+		codes.Internal:           false,
+		codes.Unavailable:        true,
+		codes.DataLoss:           true,
+		codes.Unauthenticated:    false,
+	}
+
+	for c, want := range retryableCodes {
+		got, _ := retryable(status.Error(c, ""))
+		assert.Equalf(t, want, got, "evaluate(%s)", c)
+	}
+}
+
+func TestRetryableGRPCStatusResourceExhaustedWithRetryInfo(t *testing.T) {
+	delay := 15 * time.Millisecond
+	st, err := status.New(codes.ResourceExhausted, "").WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+	)
+	require.NoError(t, err)
+
+	ok, d := retryableGRPCStatus(st)
+	assert.True(t, ok)
+	assert.Equal(t, delay, d)
+}
@@ -4,25 +4,152 @@
 package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/retry"
+)
+
+// Default values.
+var (
+	defaultEndpoint = "localhost:4317"
+	defaultTimeout  = 10 * time.Second
+	defaultRetryCfg = retry.DefaultConfig
+)
+
+// Environment variable keys.
+var (
+	envEndpoint = []string{
+		"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_ENDPOINT",
+	}
+	envInsecure = envEndpoint
+
+	envHeaders = []string{
+		"OTEL_EXPORTER_OTLP_LOGS_HEADERS",
+		"OTEL_EXPORTER_OTLP_HEADERS",
+	}
+
+	envCompression = []string{
+		"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION",
+		"OTEL_EXPORTER_OTLP_COMPRESSION",
+	}
+
+	envTimeout = []string{
+		"OTEL_EXPORTER_OTLP_LOGS_TIMEOUT",
+		"OTEL_EXPORTER_OTLP_TIMEOUT",
+	}
+
+	envTLSCert = []string{
+		"OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE",
+		"OTEL_EXPORTER_OTLP_CERTIFICATE",
+	}
+	envTLSClient = []struct {
+		Certificate string
+		Key         string
+	}{
+		{
+			"OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE",
+			"OTEL_EXPORTER_OTLP_LOGS_CLIENT_KEY",
+		},
+		{
+			"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE",
+			"OTEL_EXPORTER_OTLP_CLIENT_KEY",
+		},
+	}
 )
 
 // Option applies an option to the Exporter.
 type Option interface {
-	applyHTTPOption(config) config
+	applyOption(config) config
 }
 
+type fnOpt func(config) config
+
+func (f fnOpt) applyOption(c config) config { return f(c) }
+
 type config struct {
-	// TODO: implement.
+	endpoint    setting[string]
+	insecure    setting[bool]
+	tlsCred     setting[credentials.TransportCredentials]
+	headers     setting[map[string]string]
+	compression setting[compression]
+	timeout     setting[time.Duration]
+	retryCfg    setting[retry.Config]
+
+	compressionThreshold setting[int]
+	responseMetadata     setting[func(header, trailer metadata.MD)]
+
+	// gRPC configurations.
+	serviceConfig      setting[string]
+	reconnectionPeriod setting[time.Duration]
+	dialOptions        setting[[]grpc.DialOption]
+	gRPCConn           setting[*grpc.ClientConn]
 }
 
 func newConfig(options []Option) config {
 	var c config
 	for _, opt := range options {
-		c = opt.applyHTTPOption(c)
+		c = opt.applyOption(c)
+	}
+
+	c.endpoint = c.endpoint.Resolve(
+		getenv[string](envEndpoint, convEndpoint),
+		fallback[string](defaultEndpoint),
+	)
+	c.insecure = c.insecure.Resolve(
+		getenv[bool](envInsecure, convInsecure),
+	)
+	c.tlsCred = c.tlsCred.Resolve(
+		loadEnvTLS(),
+	)
+	c.headers = c.headers.Resolve(
+		getenv[map[string]string](envHeaders, convHeaders),
+	)
+	c.compression = c.compression.Resolve(
+		getenv[compression](envCompression, convCompression),
+	)
+	c.timeout = c.timeout.Resolve(
+		getenv[time.Duration](envTimeout, convDuration),
+		fallback[time.Duration](defaultTimeout),
+	)
+	c.retryCfg = c.retryCfg.Resolve(
+		fallback[retry.Config](defaultRetryCfg),
+	)
+
+	return c
+}
+
+// compression describes the compression used for exported payloads.
+type compression int
+
+const (
+	// noCompression represents that no compression should be used.
+	noCompression compression = iota
+	// gzipCompression represents that gzip compression should be used.
+	gzipCompression
+)
+
+// compressorToCompression returns the compression identified by compressor.
+// If compressor is not supported, an error is passed to the OTel
+// ErrorHandler and no compression is returned.
+func compressorToCompression(compressor string) compression {
+	c, err := convCompression(compressor)
+	if err != nil {
+		otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
 	}
 	return c
 }
@@ -32,9 +159,7 @@ func newConfig(options []Option) config {
 //
 // This configuration does not define any network retry strategy. That is
 // entirely handled by the gRPC ClientConn.
-type RetryConfig struct {
-	// TODO: implement.
-}
+type RetryConfig retry.Config
 
 // WithInsecure disables client transport security for the Exporter's gRPC
 // connection, just like grpc.WithInsecure()
@@ -51,8 +176,10 @@ type RetryConfig struct {
 //
 // This option has no effect if WithGRPCConn is used.
 func WithInsecure() Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.insecure = newSetting(true)
+		return c
+	})
 }
 
 // WithEndpoint sets the target endpoint the Exporter will connect to.
@@ -70,8 +197,10 @@ func WithInsecure() Option {
 //
 // This option has no effect if WithGRPCConn is used.
 func WithEndpoint(endpoint string) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.endpoint = newSetting(endpoint)
+		return c
+	})
 }
 
 // WithEndpointURL sets the target endpoint URL the Exporter will connect to.
@@ -91,8 +220,16 @@ func WithEndpoint(endpoint string) Option {
 //
 // This option has no effect if WithGRPCConn is used.
 func WithEndpointURL(u string) Option {
-	// TODO: implement.
-	return nil
+	parsed, err := url.Parse(u)
+	if err != nil {
+		global.Error(err, "otlplog: parse endpoint url", "url", u)
+		return fnOpt(func(c config) config { return c })
+	}
+	return fnOpt(func(c config) config {
+		c.endpoint = newSetting(parsed.Host)
+		c.insecure = newSetting(parsed.Scheme != "https")
+		return c
+	})
 }
 
 // WithReconnectionPeriod set the minimum amount of time between connection
@@ -100,8 +237,10 @@ func WithEndpointURL(u string) Option {
 //
 // This option has no effect if WithGRPCConn is used.
 func WithReconnectionPeriod(rp time.Duration) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.reconnectionPeriod = newSetting(rp)
+		return c
+	})
 }
 
 // WithCompressor sets the compressor the gRPC client uses.
@@ -118,8 +257,42 @@ func WithReconnectionPeriod(rp time.Duration) Option {
 //
 // This option has no effect if WithGRPCConn is used.
 func WithCompressor(compressor string) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.compression = newSetting(compressorToCompression(compressor))
+		return c
+	})
+}
+
+// WithCompressionThreshold sets the minimum size, in bytes, of the serialized
+// export requests compressed with the compressor set with WithCompressor.
+// The smaller requests are sent uncompressed: compressing tiny requests costs
+// more CPU than the bandwidth it saves.
+//
+// If bytes is zero or negative, all requests are compressed. By default, if
+// this option is not passed, all requests are compressed.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithCompressionThreshold(bytes int) Option {
+	return fnOpt(func(c config) config {
+		c.compressionThreshold = newSetting(bytes)
+		return c
+	})
+}
+
+// WithResponseMetadataFunc sets f to be called with the header and trailer
+// metadata of each response to an export request, e.g. to read the
+// rate-limit hints or the version of the collector. It is called for each
+// attempt, the failed ones included, once the response is received. It is
+// called concurrently if exports are, and must not retain or modify the
+// metadata.
+//
+// By default, if this option is not passed, the response metadata is not
+// captured.
+func WithResponseMetadataFunc(f func(header, trailer metadata.MD)) Option {
+	return fnOpt(func(c config) config {
+		c.responseMetadata = newSetting(f)
+		return c
+	})
 }
 
 // WithHeaders will send the provided headers with each gRPC requests.
@@ -134,8 +307,10 @@ func WithCompressor(compressor string) Option {
 // By default, if an environment variable is not set, and this option is not
 // passed, no user headers will be set.
 func WithHeaders(headers map[string]string) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.headers = newSetting(headers)
+		return c
+	})
 }
 
 // WithTLSCredentials sets the gRPC connection to use creds.
@@ -146,21 +321,27 @@ func WithHeaders(headers map[string]string) Option {
 // be parsed the filepath of the TLS certificate chain to use. If both are
 // set, OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE will take precedence.
 //
-// By default, if an environment variable is not set, and this option is not
-// passed, no TLS credentials will be used.
+// The credentials take precedence over WithInsecure. By default, if an
+// environment variable is not set, and this option is not passed, the
+// credentials using the host's root CA set are used, unless the connection is
+// insecure.
 //
 // This option has no effect if WithGRPCConn is used.
-func WithTLSCredentials(_ credentials.TransportCredentials) Option {
-	// TODO: implement.
-	return nil
+func WithTLSCredentials(credential credentials.TransportCredentials) Option {
+	return fnOpt(func(c config) config {
+		c.tlsCred = newSetting(credential)
+		return c
+	})
 }
 
 // WithServiceConfig defines the default gRPC service config used.
 //
 // This option has no effect if WithGRPCConn is used.
 func WithServiceConfig(serviceConfig string) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.serviceConfig = newSetting(serviceConfig)
+		return c
+	})
 }
 
 // WithDialOption sets explicit grpc.DialOptions to use when establishing a
@@ -171,9 +352,11 @@ func WithServiceConfig(serviceConfig string) Option {
 // grpc.DialOptions are ignored.
 //
 // This option has no effect if WithGRPCConn is used.
-func WithDialOption(_ ...grpc.DialOption) Option {
-	// TODO: implement.
-	return nil
+func WithDialOption(opts ...grpc.DialOption) Option {
+	return fnOpt(func(c config) config {
+		c.dialOptions = newSetting(opts)
+		return c
+	})
 }
 
 // WithGRPCConn sets conn as the gRPC ClientConn used for all communication.
@@ -184,9 +367,11 @@ func WithDialOption(_ ...grpc.DialOption) Option {
 //
 // It is the callers responsibility to close the passed conn. The Exporter
 // Shutdown method will not close this connection.
-func WithGRPCConn(_ *grpc.ClientConn) Option {
-	// TODO: implement.
-	return nil
+func WithGRPCConn(conn *grpc.ClientConn) Option {
+	return fnOpt(func(c config) config {
+		c.gRPCConn = newSetting(conn)
+		return c
+	})
 }
 
 // WithTimeout sets the max amount of time an Exporter will attempt an export.
@@ -204,8 +389,10 @@ func WithGRPCConn(_ *grpc.ClientConn) Option {
 // By default, if an environment variable is not set, and this option is not
 // passed, a timeout of 10 seconds will be used.
 func WithTimeout(duration time.Duration) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.timeout = newSetting(duration)
+		return c
+	})
 }
 
 // WithRetry sets the retry policy for transient retryable errors that are
@@ -222,6 +409,240 @@ func WithTimeout(duration time.Duration) Option {
 // 5 seconds after receiving a retryable error and increase exponentially
 // after each error for no more than a total time of 1 minute.
 func WithRetry(settings RetryConfig) Option {
-	// TODO: implement.
-	return nil
+	return fnOpt(func(c config) config {
+		c.retryCfg = newSetting(retry.Config(settings))
+		return c
+	})
+}
+
+// setting is a configuration setting value.
+type setting[T any] struct {
+	Value T
+	Set   bool
+}
+
+// newSetting returns a new setting with the value set.
+func newSetting[T any](value T) setting[T] {
+	return setting[T]{Value: value, Set: true}
+}
+
+// resolver returns an updated setting after applying an resolution operation.
+type resolver[T any] func(setting[T]) setting[T]
+
+// Resolve returns a resolved version of s.
+//
+// It will apply all the passed fn in the order provided, chaining together the
+// return setting to the next input. The setting s is used as the initial
+// argument to the first fn.
+//
+// Each fn needs to validate if it should apply given the Set state of the
+// setting. This will not perform any checks on the set state when chaining
+// function.
+func (s setting[T]) Resolve(fn ...resolver[T]) setting[T] {
+	for _, f := range fn {
+		s = f(s)
+	}
+	return s
+}
+
+// loadEnvTLS returns a resolver that loads the transport credentials from
+// files defined by the OTLP TLS environment variables. This will load both
+// the rootCAs and certificates used for mTLS.
+//
+// If the filepath defined is invalid or does not contain valid TLS files, an
+// error is passed to the OTel ErrorHandler and no credentials are provided.
+func loadEnvTLS() resolver[credentials.TransportCredentials] {
+	return func(s setting[credentials.TransportCredentials]) setting[credentials.TransportCredentials] {
+		if s.Set {
+			// Passed, valid, options have precedence.
+			return s
+		}
+
+		var rootCAs *x509.CertPool
+		var err error
+		for _, key := range envTLSCert {
+			if v := os.Getenv(key); v != "" {
+				rootCAs, err = loadCertPool(v)
+				break
+			}
+		}
+
+		var certs []tls.Certificate
+		for _, pair := range envTLSClient {
+			cert := os.Getenv(pair.Certificate)
+			key := os.Getenv(pair.Key)
+			if cert != "" && key != "" {
+				var e error
+				certs, e = loadCertificates(cert, key)
+				err = errors.Join(err, e)
+				break
+			}
+		}
+
+		if err != nil {
+			err = fmt.Errorf("failed to load TLS: %w", err)
+			otel.Handle(err)
+		} else if rootCAs != nil || certs != nil {
+			s.Set = true
+			s.Value = credentials.NewTLS(&tls.Config{RootCAs: rootCAs, Certificates: certs})
+		}
+		return s
+	}
+}
+
+// readFile is used for testing.
+var readFile = os.ReadFile
+
+// loadCertPool loads and returns the *x509.CertPool found at path if it exists
+// and is valid. Otherwise, nil and an error is returned.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cp := x509.NewCertPool()
+	if ok := cp.AppendCertsFromPEM(b); !ok {
+		return nil, errors.New("certificate not added")
+	}
+	return cp, nil
+}
+
+// loadCertificates loads and returns the tls.Certificate found at path if it
+// exists and is valid. Otherwise, nil and an error is returned.
+func loadCertificates(certPath, keyPath string) ([]tls.Certificate, error) {
+	cert, err := readFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := readFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	crt, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return nil, err
+	}
+	return []tls.Certificate{crt}, nil
+}
+
+// getenv returns a resolver that will apply an environment variable value
+// associated with the first set key to a setting value. The conv function is
+// used to convert between the environment variable value and the setting type.
+//
+// If the input setting to the resolver is set, the environment variable will
+// not be applied.
+//
+// Any error returned from conv is sent to the OTel ErrorHandler and the
+// setting will not be updated.
+func getenv[T any](keys []string, conv func(string) (T, error)) resolver[T] {
+	return func(s setting[T]) setting[T] {
+		if s.Set {
+			// Passed, valid, options have precedence.
+			return s
+		}
+
+		for _, key := range keys {
+			if vStr := os.Getenv(key); vStr != "" {
+				v, err := conv(vStr)
+				if err == nil {
+					s.Value = v
+					s.Set = true
+					break
+				}
+				otel.Handle(fmt.Errorf("invalid %s value %s: %w", key, vStr, err))
+			}
+		}
+		return s
+	}
+}
+
+// convEndpoint converts s from a URL string to an endpoint if s is a valid
+// URL. Otherwise, "" and an error are returned.
+func convEndpoint(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return u.Host, nil
+}
+
+// convInsecure parses s as a URL string and returns if the connection should
+// use client transport security or not. If s is an invalid URL, false and an
+// error are returned.
+func convInsecure(s string) (bool, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false, err
+	}
+	return u.Scheme != "https", nil
+}
+
+// convHeaders converts the OTel environment variable header value s into a
+// mapping of header key to value. If s is invalid a partial result and error
+// are returned.
+func convHeaders(s string) (map[string]string, error) {
+	out := make(map[string]string)
+	var err error
+	for _, header := range strings.Split(s, ",") {
+		rawKey, rawVal, found := strings.Cut(header, "=")
+		if !found {
+			err = errors.Join(err, fmt.Errorf("invalid header: %s", header))
+			continue
+		}
+
+		escKey, e := url.PathUnescape(rawKey)
+		if e != nil {
+			err = errors.Join(err, fmt.Errorf("invalid header key: %s", rawKey))
+			continue
+		}
+		key := strings.TrimSpace(escKey)
+
+		escVal, e := url.PathUnescape(rawVal)
+		if e != nil {
+			err = errors.Join(err, fmt.Errorf("invalid header value: %s", rawVal))
+			continue
+		}
+		val := strings.TrimSpace(escVal)
+
+		out[key] = val
+	}
+	return out, err
+}
+
+// convCompression returns the parsed compression encoded in s. noCompression
+// and an errors are returned if s is unknown.
+func convCompression(s string) (compression, error) {
+	switch s {
+	case "gzip":
+		return gzipCompression, nil
+	case "none", "":
+		return noCompression, nil
+	}
+	return noCompression, fmt.Errorf("unknown compression: %s", s)
+}
+
+// convDuration converts s into a duration of milliseconds. If s does not
+// contain an integer, 0 and an error are returned.
+func convDuration(s string) (time.Duration, error) {
+	d, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	// OTel durations are defined in milliseconds.
+	return time.Duration(d) * time.Millisecond, nil
+}
+
+// fallback returns a resolve that will set a setting value to val if it is not
+// already set.
+//
+// This is usually passed at the end of a resolver chain to ensure a default is
+// applied if the setting has not already been set.
+func fallback[T any](val T) resolver[T] {
+	return func(s setting[T]) setting[T] {
+		if !s.Set {
+			s.Value = val
+			s.Set = true
+		}
+		return s
+	}
 }
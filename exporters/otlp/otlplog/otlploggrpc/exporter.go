@@ -5,20 +5,26 @@ package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/o
 
 import (
 	"context"
+	"sync/atomic"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/transform"
 	"go.opentelemetry.io/otel/sdk/log"
 )
 
 // Exporter is a OpenTelemetry log Exporter. It transports log data encoded as
 // OTLP protobufs using gRPC.
 type Exporter struct {
-	// TODO: implement.
+	client  atomic.Pointer[client]
+	stopped atomic.Bool
 }
 
 // Compile-time check Exporter implements [log.Exporter].
 var _ log.Exporter = (*Exporter)(nil)
 
 // New returns a new [Exporter].
+//
+// It is recommended to use it with a [log.BatchProcessor]
+// or other processor exporting records asynchronously.
 func New(_ context.Context, options ...Option) (*Exporter, error) {
 	cfg := newConfig(options)
 	c, err := newClient(cfg)
@@ -28,26 +34,38 @@ func New(_ context.Context, options ...Option) (*Exporter, error) {
 	return newExporter(c, cfg)
 }
 
-func newExporter(*client, config) (*Exporter, error) {
-	// TODO: implement
-	return &Exporter{}, nil
+func newExporter(c *client, _ config) (*Exporter, error) {
+	e := &Exporter{}
+	e.client.Store(c)
+	return e, nil
 }
 
+// Used for testing.
+var transformResourceLogs = transform.ResourceLogs
+
 // Export transforms and transmits log records to an OTLP receiver.
 func (e *Exporter) Export(ctx context.Context, records []log.Record) error {
-	// TODO: implement.
-	return nil
+	if e.stopped.Load() {
+		return nil
+	}
+	otlp := transformResourceLogs(records)
+	if otlp == nil {
+		return nil
+	}
+	return e.client.Load().UploadLogs(ctx, otlp)
 }
 
 // Shutdown shuts down the Exporter. Calls to Export or ForceFlush will perform
 // no operation after this is called.
 func (e *Exporter) Shutdown(ctx context.Context) error {
-	// TODO: implement.
-	return nil
+	if e.stopped.Swap(true) {
+		return nil
+	}
+
+	return e.client.Swap(newNoopClient()).Shutdown(ctx)
 }
 
 // ForceFlush does nothing. The Exporter holds no state.
 func (e *Exporter) ForceFlush(ctx context.Context) error {
-	// TODO: implement.
 	return nil
 }
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlploggrpc
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/otel/sdk/log"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// newTestExporter returns an Exporter exporting insecurely to coll.
+func newTestExporter(t *testing.T, coll *grpcCollector, opts ...Option) *Exporter {
+	t.Helper()
+
+	opts = append([]Option{
+		WithEndpoint(coll.Endpoint()),
+		WithInsecure(),
+	}, opts...)
+	e, err := New(context.Background(), opts...)
+	require.NoError(t, err, "New")
+	t.Cleanup(func() { _ = e.Shutdown(context.Background()) })
+	return e
+}
+
+func TestExporterExportErrors(t *testing.T) {
+	resultCh := make(chan exportResult, 1)
+	resultCh <- exportResult{Err: status.Error(codes.InvalidArgument, "invalid")}
+	e := newTestExporter(t, newGRPCCollector(t, resultCh))
+
+	err := e.Export(context.Background(), make([]log.Record, 1))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExporterExport(t *testing.T) {
+	coll := newGRPCCollector(t, nil)
+	e := newTestExporter(t, coll)
+
+	orig := transformResourceLogs
+	var got []log.Record
+	transformResourceLogs = func(r []log.Record) []*logpb.ResourceLogs {
+		got = r
+		return resourceLogs
+	}
+	t.Cleanup(func() { transformResourceLogs = orig })
+
+	ctx := context.Background()
+	want := make([]log.Record, 1)
+	assert.NoError(t, e.Export(ctx, want))
+
+	assert.Len(t, coll.Requests(), 1, "export requests")
+	assert.Equal(t, want, got, "transformed log records")
+}
+
+func TestExporterExportEmpty(t *testing.T) {
+	coll := newGRPCCollector(t, nil)
+	e := newTestExporter(t, coll)
+
+	assert.NoError(t, e.Export(context.Background(), nil))
+	assert.Empty(t, coll.Requests(), "empty export sent")
+}
+
+func TestExporterShutdown(t *testing.T) {
+	ctx := context.Background()
+	coll := newGRPCCollector(t, nil)
+	e := newTestExporter(t, coll)
+	assert.NoError(t, e.Shutdown(ctx), "Shutdown Exporter")
+
+	// After Shutdown is called, calls to Export, Shutdown, or ForceFlush
+	// should perform no operation and return nil error.
+	r := make([]log.Record, 1)
+	assert.NoError(t, e.Export(ctx, r), "Export on Shutdown Exporter")
+	assert.NoError(t, e.ForceFlush(ctx), "ForceFlush on Shutdown Exporter")
+	assert.NoError(t, e.Shutdown(ctx), "Shutdown on Shutdown Exporter")
+	assert.Empty(t, coll.Requests(), "export after Shutdown sent")
+}
+
+func TestExporterForceFlush(t *testing.T) {
+	ctx := context.Background()
+	e := newTestExporter(t, newGRPCCollector(t, nil))
+
+	assert.NoError(t, e.ForceFlush(ctx), "ForceFlush")
+}
+
+func TestExporterConcurrentSafe(t *testing.T) {
+	e := newTestExporter(t, newGRPCCollector(t, nil))
+
+	const goroutines = 10
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := new(uint64)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			r := make([]log.Record, 1)
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+					_ = e.Export(ctx, r)
+					_ = e.ForceFlush(ctx)
+					atomic.AddUint64(runs, 1)
+				}
+			}
+		}()
+	}
+
+	for atomic.LoadUint64(runs) == 0 {
+		runtime.Gosched()
+	}
+
+	_ = e.Shutdown(ctx)
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,56 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/transform/fuzz_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+import (
+	"context"
+	"testing"
+
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+)
+
+// protoExporter is a log.Exporter asserting the records it exports are
+// transformed into a valid OTLP export request.
+type protoExporter struct{ t testing.TB }
+
+func (e protoExporter) Export(_ context.Context, records []log.Record) error {
+	req := &collogpb.ExportLogsServiceRequest{ResourceLogs: ResourceLogs(records)}
+	b, err := proto.Marshal(req)
+	if err != nil {
+		e.t.Errorf("marshal: %v", err)
+		return nil
+	}
+
+	var got collogpb.ExportLogsServiceRequest
+	if err := proto.Unmarshal(b, &got); err != nil {
+		e.t.Errorf("unmarshal: %v", err)
+		return nil
+	}
+	var n int
+	for _, rl := range got.ResourceLogs {
+		for _, sl := range rl.ScopeLogs {
+			n += len(sl.LogRecords)
+		}
+	}
+	if n != len(records) {
+		e.t.Errorf("transformed %d log records, want %d", n, len(records))
+	}
+	return nil
+}
+
+func (protoExporter) Shutdown(context.Context) error   { return nil }
+func (protoExporter) ForceFlush(context.Context) error { return nil }
+
+func FuzzResourceLogs(f *testing.F) {
+	logtest.FuzzExporter(f, func(t testing.TB) log.Exporter {
+		return protoExporter{t: t}
+	})
+}
@@ -20,10 +20,12 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk/retry"
 	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
 	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
-
-	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
 )
 
 type client struct {
@@ -84,21 +86,77 @@ func newHTTPClient(cfg config) (*client, error) {
 	}
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
+	rejected, err := newRejectedCounter(cfg.meterProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &httpClient{
-		compression: cfg.compression.Value,
-		req:         req,
-		requestFunc: cfg.retryCfg.Value.RequestFunc(evaluate),
-		client:      hc,
+		compression:          cfg.compression.Value,
+		compressionThreshold: cfg.compressionThreshold.Value,
+		req:                  req,
+		requestFunc:          cfg.retryCfg.Value.RequestFunc(evaluate),
+		client:               hc,
+		responseHeader:       cfg.responseHeader,
+
+		baggageHeaders: cfg.baggageHeaders,
+		rejected:       rejected,
+	}
+	return &client{uploadLogs: limitConcurrency(c.uploadLogs, cfg.maxConcurrentExports.Value)}, nil
+}
+
+// newRejectedCounter returns the counter of the log records rejected by the
+// receiver created with a Meter from mp. If mp is nil, a no-op counter is
+// returned.
+func newRejectedCounter(mp metric.MeterProvider) (metric.Int64Counter, error) {
+	if mp == nil {
+		mp = noop.NewMeterProvider()
+	}
+	meter := mp.Meter(
+		"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp",
+		metric.WithInstrumentationVersion(Version()),
+	)
+	return meter.Int64Counter(
+		"otel.exporter.otlp.log.rejected",
+		metric.WithDescription("The number of log records rejected by the OTLP receiver in partial success responses."),
+		metric.WithUnit("{record}"),
+	)
+}
+
+// limitConcurrency returns upload wrapped so no more than n calls are made
+// concurrently. If n is less than one, upload is returned directly.
+func limitConcurrency(upload func(context.Context, []*logpb.ResourceLogs) error, n int) func(context.Context, []*logpb.ResourceLogs) error {
+	if n < 1 {
+		return upload
+	}
+
+	sem := newSemaphore(n)
+	return func(ctx context.Context, rl []*logpb.ResourceLogs) error {
+		if err := sem.Acquire(ctx); err != nil {
+			return err
+		}
+		defer sem.Release()
+		return upload(ctx, rl)
 	}
-	return &client{uploadLogs: c.uploadLogs}, nil
 }
 
 type httpClient struct {
 	// req is cloned for every upload the client makes.
 	req         *http.Request
 	compression Compression
-	requestFunc retry.RequestFunc
-	client      *http.Client
+	// compressionThreshold is the minimum size of the compressed bodies.
+	compressionThreshold int
+	requestFunc          retry.RequestFunc
+	client               *http.Client
+	// responseHeader is called with the status code and header of each
+	// export response. It is nil if the header is not captured.
+	responseHeader func(statusCode int, header http.Header)
+
+	// baggageHeaders are the baggage members set as headers of a request.
+	baggageHeaders []baggageHeader
+
+	// rejected counts the log records rejected by the receiver.
+	rejected metric.Int64Counter
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -148,6 +206,9 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 		if err != nil {
 			return err
 		}
+		if c.responseHeader != nil {
+			c.responseHeader(resp.StatusCode, resp.Header)
+		}
 
 		var rErr error
 		switch sc := resp.StatusCode; {
@@ -173,8 +234,13 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 					msg := respProto.PartialSuccess.GetErrorMessage()
 					n := respProto.PartialSuccess.GetRejectedLogRecords()
 					if n != 0 || msg != "" {
-						err := fmt.Errorf("OTLP partial success: %s (%d log records rejected)", msg, n)
-						otel.Handle(err)
+						otel.Handle(PartialSuccessError{
+							RejectedLogRecords: n,
+							Message:            msg,
+						})
+					}
+					if n > 0 {
+						c.rejected.Add(iCtx, n)
 					}
 				}
 			}
@@ -213,7 +279,22 @@ func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, erro
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
-	switch c.compression {
+	if len(c.baggageHeaders) > 0 {
+		bag := baggage.FromContext(ctx)
+		for _, bh := range c.baggageHeaders {
+			m := bag.Member(bh.member)
+			if m.Key() == "" || !validHeaderValue(m.Value()) {
+				continue
+			}
+			r.Header.Set(bh.header, m.Value())
+		}
+	}
+
+	compression := c.compression
+	if len(body) < c.compressionThreshold {
+		compression = NoCompression
+	}
+	switch compression {
 	case NoCompression:
 		r.ContentLength = (int64)(len(body))
 		req.bodyReader = bodyReader(body)
@@ -242,6 +323,17 @@ func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, erro
 	return req, nil
 }
 
+// validHeaderValue reports whether v can be sent as an HTTP header value. It
+// cannot contain control characters other than horizontal tabs.
+func validHeaderValue(v string) bool {
+	for i := 0; i < len(v); i++ {
+		if b := v[i]; (b < ' ' && b != '\t') || b == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
 // bodyReader returns a closure returning a new reader for buf.
 func bodyReader(buf []byte) func() io.ReadCloser {
 	return func() io.ReadCloser {
@@ -20,6 +20,7 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"sync"
@@ -32,12 +33,15 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
 	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
 	cpb "go.opentelemetry.io/proto/otlp/common/v1"
 	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
 	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
 
 	"go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 )
 
@@ -472,13 +476,13 @@ func newWeakCertificate() (tls.Certificate, error) {
 }
 
 func TestClient(t *testing.T) {
-	factory := func(rCh <-chan exportResult) (*client, *httpCollector) {
+	factory := func(rCh <-chan exportResult, o ...Option) (*client, *httpCollector) {
 		coll, err := newHTTPCollector("", rCh)
 		require.NoError(t, err)
 
 		addr := coll.Addr().String()
 		opts := []Option{WithEndpoint(addr), WithInsecure()}
-		cfg := newConfig(opts)
+		cfg := newConfig(append(opts, o...))
 		client, err := newHTTPClient(cfg)
 		require.NoError(t, err)
 		return client, coll
@@ -544,7 +548,9 @@ func TestClient(t *testing.T) {
 		}
 
 		ctx := context.Background()
-		client, _ := factory(rCh)
+		reader := sdkmetric.NewManualReader()
+		mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+		client, _ := factory(rCh, WithMeterProvider(mp))
 
 		defer func(orig otel.ErrorHandler) {
 			otel.SetErrorHandler(orig)
@@ -561,6 +567,20 @@ func TestClient(t *testing.T) {
 		require.Equal(t, 1, len(errs))
 		want := fmt.Sprintf("%s (%d log records rejected)", msg, n)
 		assert.ErrorContains(t, errs[0], want)
+		var psErr PartialSuccessError
+		require.ErrorAs(t, errs[0], &psErr)
+		assert.Equal(t, PartialSuccessError{RejectedLogRecords: n, Message: msg}, psErr)
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		m := rm.ScopeMetrics[0].Metrics[0]
+		assert.Equal(t, "otel.exporter.otlp.log.rejected", m.Name)
+		sum, ok := m.Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, sum.DataPoints, 1)
+		assert.Equal(t, int64(n), sum.DataPoints[0].Value)
 	})
 }
 
@@ -635,6 +655,32 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[key], []string{headers[key]})
 	})
 
+	t.Run("WithBaggageHeader", func(t *testing.T) {
+		tenant := http.CanonicalHeaderKey("x-scope-orgid")
+		exp, coll := factoryFunc(
+			"",
+			nil,
+			WithHeaders(map[string]string{tenant: "static"}),
+			WithBaggageHeader("tenant", tenant),
+			WithBaggageHeader("missing", "X-Missing"),
+		)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+
+		m, err := baggage.NewMember("tenant", "acme")
+		require.NoError(t, err)
+		bag, err := baggage.New(m)
+		require.NoError(t, err)
+		require.NoError(t, exp.Export(baggage.ContextWithBaggage(ctx, bag), make([]log.Record, 1)))
+		// Without the member, the static header is sent.
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		require.NoError(t, exp.Shutdown(ctx))
+
+		got := coll.Headers()
+		assert.Equal(t, []string{"acme", "static"}, got[tenant])
+		assert.NotContains(t, got, "X-Missing")
+	})
+
 	t.Run("WithTimeout", func(t *testing.T) {
 		// Do not send on rCh so the Collector never responds to the client.
 		rCh := make(chan exportResult)
@@ -755,3 +801,73 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[headerKeySetInProxy], []string{headerValueSetInProxy})
 	})
 }
+
+func TestClientCompressionThreshold(t *testing.T) {
+	encodings := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings <- r.Header.Get("Content-Encoding")
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	newTestClient := func(opts ...Option) *client {
+		t.Helper()
+		opts = append(opts, WithEndpointURL(srv.URL))
+		c, err := newHTTPClient(newConfig(opts))
+		require.NoError(t, err)
+		return c
+	}
+	encoding := func(c *client, schemaURL string) string {
+		t.Helper()
+		rl := []*lpb.ResourceLogs{{SchemaUrl: schemaURL}}
+		require.NoError(t, c.UploadLogs(context.Background(), rl))
+		return <-encodings
+	}
+	small, large := "", strings.Repeat("a", 100)
+
+	c := newTestClient(WithCompression(GzipCompression), WithCompressionThreshold(100))
+	assert.Equal(t, "", encoding(c, small), "small request compressed")
+	assert.Equal(t, "gzip", encoding(c, large), "large request not compressed")
+
+	c = newTestClient(WithCompression(GzipCompression))
+	assert.Equal(t, "gzip", encoding(c, small), "request not compressed without threshold")
+
+	c = newTestClient(WithCompressionThreshold(100))
+	assert.Equal(t, "", encoding(c, large), "request compressed without compression")
+}
+
+func TestClientResponseHeaderFunc(t *testing.T) {
+	statusCode := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Collector-Version", "1.2.3")
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(srv.Close)
+
+	var (
+		codes   []int
+		headers []http.Header
+	)
+	c, err := newHTTPClient(newConfig([]Option{
+		WithEndpointURL(srv.URL),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithResponseHeaderFunc(func(code int, h http.Header) {
+			codes = append(codes, code)
+			headers = append(headers, h.Clone())
+		}),
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, c.UploadLogs(ctx, []*lpb.ResourceLogs{{}}))
+	statusCode = http.StatusBadRequest
+	require.Error(t, c.UploadLogs(ctx, []*lpb.ResourceLogs{{}}))
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusBadRequest}, codes)
+	require.Len(t, headers, 2)
+	for _, h := range headers {
+		assert.Equal(t, "1.2.3", h.Get("X-Collector-Version"))
+	}
+}
@@ -16,8 +16,9 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/retry"
 )
 
 // Default values.
@@ -94,6 +95,24 @@ type config struct {
 	timeout     setting[time.Duration]
 	proxy       setting[HTTPTransportProxyFunc]
 	retryCfg    setting[retry.Config]
+
+	maxConcurrentExports setting[int]
+	compressionThreshold setting[int]
+
+	// baggageHeaders are the baggage members copied to request headers.
+	baggageHeaders []baggageHeader
+
+	// responseHeader is called with the status code and header of each
+	// export response.
+	responseHeader func(statusCode int, header http.Header)
+
+	meterProvider metric.MeterProvider
+}
+
+// baggageHeader is a baggage member copied to a request header.
+type baggageHeader struct {
+	member string
+	header string
 }
 
 func newConfig(options []Option) config {
@@ -215,6 +234,35 @@ func WithCompression(compression Compression) Option {
 	})
 }
 
+// WithResponseHeaderFunc sets f to be called with the status code and header
+// of each response to an export request, e.g. to read the rate-limit hints or
+// the version of the collector. It is called for each attempt, the failed
+// ones included, once the response is received. It is called concurrently if
+// exports are, and must not retain or modify the header.
+//
+// By default, if this option is not passed, the response header is not
+// captured.
+func WithResponseHeaderFunc(f func(statusCode int, header http.Header)) Option {
+	return fnOpt(func(c config) config {
+		c.responseHeader = f
+		return c
+	})
+}
+
+// WithCompressionThreshold sets the minimum size, in bytes, of the serialized
+// export requests compressed with the compression set with WithCompression.
+// The smaller requests are sent uncompressed: compressing tiny requests costs
+// more CPU than the bandwidth it saves.
+//
+// If bytes is zero or negative, all requests are compressed. By default, if
+// this option is not passed, all requests are compressed.
+func WithCompressionThreshold(bytes int) Option {
+	return fnOpt(func(c config) config {
+		c.compressionThreshold = newSetting(bytes)
+		return c
+	})
+}
+
 // WithURLPath sets the URL path the Exporter will send requests to.
 //
 // If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_LOGS_ENDPOINT
@@ -327,6 +375,72 @@ func WithRetry(rc RetryConfig) Option {
 	})
 }
 
+// WithMaxConcurrentExports sets the maximum number of export requests the
+// Exporter sends concurrently.
+//
+// This option only limits concurrency, it does not add any. The Exporter sends
+// export requests concurrently only when its Export method is called
+// concurrently (e.g. by a SimpleProcessor or by multiple BatchProcessors). A
+// single BatchProcessor calls Export serially and is unaffected by this option.
+//
+// Exports made while this limit is reached are queued until an in-flight
+// export completes. Queued exports are sent in the order they were made. No
+// export is prioritized: an export triggered by a ForceFlush waits behind the
+// ones queued before it. The deadline or cancellation of the context passed
+// to Export is honored while queued.
+//
+// By default, if this option is not passed or n is less than one, the number
+// of concurrent export requests is not limited.
+func WithMaxConcurrentExports(n int) Option {
+	return fnOpt(func(c config) config {
+		c.maxConcurrentExports = newSetting(n)
+		return c
+	})
+}
+
+// WithBaggageHeader copies the value of the baggage member with key member,
+// from the context passed to Export, into the request header. This allows
+// multi-tenant collectors to route requests based on baggage (e.g. a tenant
+// ID) without inspecting their payload.
+//
+// The header is only set for exports whose context baggage contains the
+// member. It is not set if the value of the member is not a valid header
+// value. When set, it overrides any header of the same name set with
+// [WithHeaders] or the OTEL_EXPORTER_OTLP_HEADERS and
+// OTEL_EXPORTER_OTLP_LOGS_HEADERS environment variables.
+//
+// The context passed to Export depends on the log processor used. The
+// SimpleProcessor of go.opentelemetry.io/otel/sdk/log exports with the
+// context a record is emitted with. The BatchProcessor batches records
+// emitted with different contexts and exports them with a context that does
+// not contain their baggage.
+//
+// This option can be passed multiple times to copy multiple members. By
+// default, if this option is not passed, no baggage is copied.
+func WithBaggageHeader(member, header string) Option {
+	return fnOpt(func(c config) config {
+		c.baggageHeaders = append(c.baggageHeaders, baggageHeader{
+			member: member,
+			header: header,
+		})
+		return c
+	})
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics about the
+// Exporter. The number of log records rejected by the OTLP receiver, as
+// reported in partial success responses, is recorded with the
+// otel.exporter.otlp.log.rejected counter.
+//
+// By default, if this option is not passed or mp is nil, no metrics are
+// recorded.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return fnOpt(func(c config) config {
+		c.meterProvider = mp
+		return c
+	})
+}
+
 // HTTPTransportProxyFunc is a function that resolves which URL to use as proxy
 // for a given request. This type is compatible with http.Transport.Proxy and
 // can be used to set a custom proxy function to the OTLP HTTP client.
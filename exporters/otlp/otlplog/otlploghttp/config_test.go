@@ -17,7 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
+	"go.opentelemetry.io/otel/sdk/retry"
 )
 
 const (
@@ -109,17 +109,19 @@ func TestNewConfig(t *testing.T) {
 				WithHeaders(headers),
 				WithTimeout(time.Second),
 				WithRetry(RetryConfig(rc)),
+				WithMaxConcurrentExports(2),
 				// Do not test WithProxy. Requires func comparison.
 			},
 			want: config{
-				endpoint:    newSetting("test"),
-				path:        newSetting("/path"),
-				insecure:    newSetting(true),
-				tlsCfg:      newSetting(tlsCfg),
-				headers:     newSetting(headers),
-				compression: newSetting(GzipCompression),
-				timeout:     newSetting(time.Second),
-				retryCfg:    newSetting(rc),
+				endpoint:             newSetting("test"),
+				path:                 newSetting("/path"),
+				insecure:             newSetting(true),
+				tlsCfg:               newSetting(tlsCfg),
+				headers:              newSetting(headers),
+				compression:          newSetting(GzipCompression),
+				timeout:              newSetting(time.Second),
+				retryCfg:             newSetting(rc),
+				maxConcurrentExports: newSetting(2),
 			},
 		},
 		{
@@ -1,3 +1,6 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/transform/attr_test.go.tmpl
+
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
@@ -181,3 +184,34 @@ func TestAttrTransforms(t *testing.T) {
 		})
 	}
 }
+
+var outKeyValues []*cpb.KeyValue
+
+func BenchmarkAttrs(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attrBool,
+		attrBoolSlice,
+		attrInt,
+		attrIntSlice,
+		attrInt64,
+		attrInt64Slice,
+		attrFloat64,
+		attrFloat64Slice,
+		attrString,
+		attrStringSlice,
+	}
+	set := attribute.NewSet(attrs...)
+
+	b.Run("Attrs", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outKeyValues = Attrs(attrs)
+		}
+	})
+	b.Run("AttrIter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outKeyValues = AttrIter(set.Iter())
+		}
+	})
+}
@@ -1,3 +1,6 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/transform/log.go.tmpl
+
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
@@ -6,7 +9,9 @@
 package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/transform"
 
 import (
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	cpb "go.opentelemetry.io/proto/otlp/common/v1"
 	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
@@ -72,8 +77,9 @@ func scopeLogsMap(records []log.Record) map[instrumentation.Scope]*lpb.ScopeLogs
 			var emptyScope instrumentation.Scope
 			if scope != emptyScope {
 				sl.Scope = &cpb.InstrumentationScope{
-					Name:    scope.Name,
-					Version: scope.Version,
+					Name:       scope.Name,
+					Version:    scope.Version,
+					Attributes: AttrIter(scope.Attributes.Iter()),
 				}
 				sl.SchemaUrl = scope.SchemaURL
 			}
@@ -90,7 +96,7 @@ func LogRecord(record log.Record) *lpb.LogRecord {
 		TimeUnixNano:         timeUnixNano(record.Timestamp()),
 		ObservedTimeUnixNano: timeUnixNano(record.ObservedTimestamp()),
 		SeverityNumber:       SeverityNumber(record.Severity()),
-		SeverityText:         record.SeverityText(),
+		SeverityText:         validUTF8(record.SeverityText()),
 		Body:                 LogAttrValue(record.Body()),
 		Attributes:           make([]*cpb.KeyValue, 0, record.AttributesLen()),
 		Flags:                uint32(record.TraceFlags()),
@@ -100,6 +106,9 @@ func LogRecord(record log.Record) *lpb.LogRecord {
 		r.Attributes = append(r.Attributes, LogAttr(kv))
 		return true
 	})
+	if name := record.EventName(); name != "" {
+		r.Attributes = appendEventName(r.Attributes, validUTF8(name))
+	}
 	if tID := record.TraceID(); tID.IsValid() {
 		r.TraceId = tID[:]
 	}
@@ -109,6 +118,35 @@ func LogRecord(record log.Record) *lpb.LogRecord {
 	return r
 }
 
+// eventNameKey is the attribute key holding the event name of a log record.
+// The version of the OTLP protocol used does not have an event name field.
+const eventNameKey = "event.name"
+
+// appendEventName returns attrs with the event.name attribute set to name
+// appended. If attrs already holds an event.name attribute, attrs is returned
+// unchanged.
+func appendEventName(attrs []*cpb.KeyValue, name string) []*cpb.KeyValue {
+	for _, kv := range attrs {
+		if kv.Key == eventNameKey {
+			return attrs
+		}
+	}
+	return append(attrs, &cpb.KeyValue{
+		Key:   eventNameKey,
+		Value: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: name}},
+	})
+}
+
+// validUTF8 returns s with each invalid UTF-8 byte sequence replaced by the
+// Unicode replacement character. The OTLP string fields must hold valid UTF-8,
+// an export request holding an invalid string cannot be encoded.
+func validUTF8(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+	return strings.ToValidUTF8(s, string(utf8.RuneError))
+}
+
 // timeUnixNano returns t as a Unix time, the number of nanoseconds elapsed
 // since January 1, 1970 UTC as uint64. The result is undefined if the Unix
 // time in nanoseconds cannot be represented by an int64 (a date before the
@@ -269,7 +307,7 @@ func LogAttrs(attrs []api.KeyValue) []*cpb.KeyValue {
 // LogAttr transforms an [api.KeyValue] into an OTLP key-value.
 func LogAttr(attr api.KeyValue) *cpb.KeyValue {
 	return &cpb.KeyValue{
-		Key:   attr.Key,
+		Key:   validUTF8(attr.Key),
 		Value: LogAttrValue(attr.Value),
 	}
 }
@@ -305,7 +343,7 @@ func LogAttrValue(v api.Value) *cpb.AnyValue {
 		}
 	case api.KindString:
 		av.Value = &cpb.AnyValue_StringValue{
-			StringValue: v.AsString(),
+			StringValue: validUTF8(v.AsString()),
 		}
 	case api.KindBytes:
 		av.Value = &cpb.AnyValue_BytesValue{
@@ -1,3 +1,6 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl
+
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
@@ -113,6 +116,16 @@ func TestLogAttrs(t *testing.T) {
 			[]log.KeyValue{logAttrMap},
 			[]*cpb.KeyValue{kvMap},
 		},
+		{
+			"invalid UTF-8",
+			[]log.KeyValue{log.String("k\xff", "v\xc0\xc1")},
+			[]*cpb.KeyValue{{
+				Key: "k\uFFFD",
+				Value: &cpb.AnyValue{
+					Value: &cpb.AnyValue_StringValue{StringValue: "v\uFFFD"},
+				},
+			}},
+		},
 		{
 			"all",
 			[]log.KeyValue{
@@ -1,3 +1,6 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/transform/log_test.go.tmpl
+
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,11 +11,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	cpb "go.opentelemetry.io/proto/otlp/common/v1"
 	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
 	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
 
+	"go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log"
@@ -65,13 +70,22 @@ var (
 	flagsB   = byte(0)
 
 	scope = instrumentation.Scope{
-		Name:      "test/code/path",
-		Version:   "v0.1.0",
-		SchemaURL: semconv.SchemaURL,
+		Name:       "test/code/path",
+		Version:    "v0.1.0",
+		SchemaURL:  semconv.SchemaURL,
+		Attributes: attribute.NewSet(attribute.String("logger.id", "1")),
 	}
 	pbScope = &cpb.InstrumentationScope{
 		Name:    "test/code/path",
 		Version: "v0.1.0",
+		Attributes: []*cpb.KeyValue{
+			{
+				Key: "logger.id",
+				Value: &cpb.AnyValue{
+					Value: &cpb.AnyValue_StringValue{StringValue: "1"},
+				},
+			},
+		},
 	}
 
 	res = resource.NewWithAttributes(
@@ -223,6 +237,43 @@ func TestResourceLogs(t *testing.T) {
 	assert.Equal(t, want, ResourceLogs(records))
 }
 
+func TestLogRecordEventName(t *testing.T) {
+	eventName := &cpb.KeyValue{
+		Key:   "event.name",
+		Value: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: "test.event"}},
+	}
+	userAttr := &cpb.KeyValue{
+		Key:   "event.name",
+		Value: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: "user"}},
+	}
+
+	r := logtest.RecordFactory{EventName: "test.event"}.NewRecord()
+	assert.Equal(t, []*cpb.KeyValue{eventName}, LogRecord(r).Attributes)
+
+	r = logtest.RecordFactory{
+		EventName:  "test.event",
+		Attributes: []api.KeyValue{api.String("event.name", "user")},
+	}.NewRecord()
+	assert.Equal(t, []*cpb.KeyValue{userAttr}, LogRecord(r).Attributes, "event.name attribute overridden")
+
+	r = logtest.RecordFactory{}.NewRecord()
+	assert.Empty(t, LogRecord(r).Attributes)
+}
+
+func TestLogRecordInvalidUTF8(t *testing.T) {
+	r := logtest.RecordFactory{
+		EventName:    "event\xff",
+		SeverityText: "INFO\xff",
+		Body:         api.StringValue("body\xff"),
+	}.NewRecord()
+
+	got := LogRecord(r)
+	assert.Equal(t, "INFO\uFFFD", got.SeverityText)
+	assert.Equal(t, "body\uFFFD", got.Body.GetStringValue())
+	require.Len(t, got.Attributes, 1)
+	assert.Equal(t, "event\uFFFD", got.Attributes[0].Value.GetStringValue())
+}
+
 func TestSeverityNumber(t *testing.T) {
 	for i := 0; i <= int(api.SeverityFatal4); i++ {
 		want := lpb.SeverityNumber(i)
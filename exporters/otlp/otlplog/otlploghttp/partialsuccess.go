@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlploghttp // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+
+import "fmt"
+
+// PartialSuccessError is the error passed to the global error handler when
+// the OTLP receiver responds with a partial success. The export succeeded,
+// but the receiver rejected some of the log records, or has a warning about
+// the request.
+//
+// Use [errors.As] in an error handler to identify this error.
+type PartialSuccessError struct {
+	// RejectedLogRecords is the number of log records rejected by the
+	// receiver.
+	RejectedLogRecords int64
+	// Message is the error or warning message of the receiver.
+	Message string
+}
+
+var _ error = PartialSuccessError{}
+
+// Error implements the error interface.
+func (e PartialSuccessError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = "empty message"
+	}
+	return fmt.Sprintf("OTLP partial success: %s (%d log records rejected)", msg, e.RejectedLogRecords)
+}
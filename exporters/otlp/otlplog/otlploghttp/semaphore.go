@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlploghttp // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// semaphore bounds the number of concurrent holders to a fixed size.
+//
+// Callers waiting to acquire the semaphore are queued and served in the order
+// they called Acquire (first-in first-out). This ensures exports triggered by
+// a ForceFlush and exports made on a schedule are handled fairly.
+type semaphore struct {
+	mu      sync.Mutex
+	avail   int
+	waiters list.List // of chan struct{}
+}
+
+// newSemaphore returns a semaphore that can be held by at most n concurrent
+// holders.
+func newSemaphore(n int) *semaphore {
+	return &semaphore{avail: n}
+}
+
+// Acquire blocks until s is acquired or ctx is done. If ctx is done before s
+// is acquired, the ctx error is returned and s is not held.
+func (s *semaphore) Acquire(ctx context.Context) error {
+	s.mu.Lock()
+	if s.avail > 0 && s.waiters.Len() == 0 {
+		s.avail--
+		s.mu.Unlock()
+		return nil
+	}
+
+	ready := make(chan struct{})
+	elem := s.waiters.PushBack(ready)
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		select {
+		case <-ready:
+			// Acquired concurrently with the cancellation. Release so the
+			// next waiter is not blocked.
+			s.mu.Unlock()
+			s.Release()
+		default:
+			s.waiters.Remove(elem)
+			s.mu.Unlock()
+		}
+		return ctx.Err()
+	}
+}
+
+// Release releases s. It hands s directly to the longest waiting caller of
+// Acquire if there is one.
+func (s *semaphore) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if front := s.waiters.Front(); front != nil {
+		s.waiters.Remove(front)
+		close(front.Value.(chan struct{}))
+		return
+	}
+	s.avail++
+}
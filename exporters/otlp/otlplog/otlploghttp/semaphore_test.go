@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlploghttp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+func TestSemaphore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Limit", func(t *testing.T) {
+		s := newSemaphore(2)
+		require.NoError(t, s.Acquire(ctx))
+		require.NoError(t, s.Acquire(ctx))
+
+		cCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, s.Acquire(cCtx), context.Canceled, "acquired over limit")
+
+		s.Release()
+		assert.NoError(t, s.Acquire(ctx), "not acquired after release")
+	})
+
+	t.Run("FIFO", func(t *testing.T) {
+		s := newSemaphore(1)
+		require.NoError(t, s.Acquire(ctx))
+
+		const n = 5
+		var (
+			mu  sync.Mutex
+			got []int
+			wg  sync.WaitGroup
+		)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if !assert.NoError(t, s.Acquire(ctx)) {
+					return
+				}
+				mu.Lock()
+				got = append(got, i)
+				mu.Unlock()
+				s.Release()
+			}(i)
+
+			// Ensure the waiter is queued before the next one is added.
+			require.Eventually(t, func() bool {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				return s.waiters.Len() == i+1
+			}, time.Second, time.Microsecond)
+		}
+
+		s.Release()
+		wg.Wait()
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, got)
+	})
+
+	t.Run("CanceledWaiter", func(t *testing.T) {
+		s := newSemaphore(1)
+		require.NoError(t, s.Acquire(ctx))
+
+		cCtx, cancel := context.WithCancel(ctx)
+		errCh := make(chan error, 1)
+		go func() { errCh <- s.Acquire(cCtx) }()
+		require.Eventually(t, func() bool {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.waiters.Len() == 1
+		}, time.Second, time.Microsecond)
+
+		cancel()
+		assert.ErrorIs(t, <-errCh, context.Canceled)
+
+		// The canceled waiter must not hold the semaphore.
+		s.Release()
+		assert.NoError(t, s.Acquire(ctx))
+	})
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	const limit = 2
+
+	var (
+		mu         sync.Mutex
+		active, hi int
+	)
+	block := make(chan struct{})
+	upload := limitConcurrency(func(context.Context, []*logpb.ResourceLogs) error {
+		mu.Lock()
+		active++
+		hi = max(hi, active)
+		mu.Unlock()
+
+		<-block
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return nil
+	}, limit)
+
+	const n = 3 * limit
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, upload(context.Background(), nil))
+		}()
+	}
+	close(block)
+	wg.Wait()
+
+	assert.LessOrEqual(t, hi, limit, "concurrent uploads")
+}
@@ -5,13 +5,16 @@ package otlpmetricgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlpme
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
@@ -25,6 +28,13 @@ type client struct {
 	metadata      metadata.MD
 	exportTimeout time.Duration
 	requestFunc   retry.RequestFunc
+	// compressionThreshold is the minimum size of the requests compressed
+	// with gzip. If it is zero, the compression is set by the dial options
+	// instead.
+	compressionThreshold int
+	// responseMetadata is called with the header and trailer metadata of
+	// each export response. It is nil if the metadata is not captured.
+	responseMetadata func(header, trailer metadata.MD)
 
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
@@ -46,6 +56,10 @@ func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 	if len(cfg.Metrics.Headers) > 0 {
 		c.metadata = metadata.New(cfg.Metrics.Headers)
 	}
+	if cfg.Metrics.Compression == oconf.GzipCompression && cfg.Metrics.CompressionThreshold > 0 && cfg.GRPCConn == nil {
+		c.compressionThreshold = cfg.Metrics.CompressionThreshold
+	}
+	c.responseMetadata = cfg.Metrics.ResponseMetadata
 
 	if c.conn == nil {
 		// If the caller did not provide a ClientConn when the client was
@@ -115,10 +129,13 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
+	req := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
+	}
+	callOpts := c.callOptions(req)
+
 	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.msc.Export(iCtx, &colmetricpb.ExportMetricsServiceRequest{
-			ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
-		})
+		resp, err := c.export(iCtx, req, callOpts)
 		if resp != nil && resp.PartialSuccess != nil {
 			msg := resp.PartialSuccess.GetErrorMessage()
 			n := resp.PartialSuccess.GetRejectedDataPoints()
@@ -136,6 +153,29 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	})
 }
 
+// export sends req with the call options opts. If c captures the response
+// metadata, the header and trailer metadata of the response are passed to
+// its function, even if the export fails.
+func (c *client) export(ctx context.Context, req *colmetricpb.ExportMetricsServiceRequest, opts []grpc.CallOption) (*colmetricpb.ExportMetricsServiceResponse, error) {
+	if c.responseMetadata == nil {
+		return c.msc.Export(ctx, req, opts...)
+	}
+	var header, trailer metadata.MD
+	opts = append(slices.Clip(opts), grpc.Header(&header), grpc.Trailer(&trailer))
+	resp, err := c.msc.Export(ctx, req, opts...)
+	c.responseMetadata(header, trailer)
+	return resp, err
+}
+
+// callOptions returns the options of the call exporting req: the gzip
+// compressor if req is not smaller than the compression threshold of c.
+func (c *client) callOptions(req proto.Message) []grpc.CallOption {
+	if c.compressionThreshold > 0 && proto.Size(req) >= c.compressionThreshold {
+		return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}
+	}
+	return nil
+}
+
 // exportContext returns a copy of parent with an appropriate deadline and
 // cancellation function based on the clients configured export timeout.
 //
@@ -5,6 +5,8 @@ package otlpmetricgrpc
 
 import (
 	"context"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -20,6 +23,8 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/otest"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
 func TestThrottleDelay(t *testing.T) {
@@ -248,3 +253,79 @@ func TestConfig(t *testing.T) {
 		assert.Contains(t, got[key][0], customerUserAgent)
 	})
 }
+
+func TestCompressionThreshold(t *testing.T) {
+	small := &colmetricpb.ExportMetricsServiceRequest{}
+	large := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*mpb.ResourceMetrics{{SchemaUrl: strings.Repeat("a", 100)}},
+	}
+	newTestClient := func(opts ...Option) *client {
+		t.Helper()
+		opts = append(opts, WithInsecure())
+		c, err := newClient(context.Background(), oconf.NewGRPCConfig(asGRPCOptions(opts)...))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = c.Shutdown(context.Background()) })
+		return c
+	}
+
+	c := newTestClient(WithCompressor("gzip"), WithCompressionThreshold(100))
+	assert.Equal(t, 100, c.compressionThreshold)
+	assert.Empty(t, c.callOptions(small), "small request compressed")
+	assert.Len(t, c.callOptions(large), 1, "large request not compressed")
+
+	c = newTestClient(WithCompressor("gzip"))
+	assert.Empty(t, c.callOptions(large), "compressor set per request without threshold")
+
+	c = newTestClient(WithCompressionThreshold(100))
+	assert.Empty(t, c.callOptions(large), "compressed without compressor")
+}
+
+// metadataServer is a metrics service sending header and trailer metadata.
+type metadataServer struct {
+	colmetricpb.UnimplementedMetricsServiceServer
+
+	code codes.Code
+}
+
+func (s *metadataServer) Export(ctx context.Context, _ *colmetricpb.ExportMetricsServiceRequest) (*colmetricpb.ExportMetricsServiceResponse, error) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-collector-version", "1.2.3"))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-ratelimit-remaining", "0"))
+	if s.code != codes.OK {
+		return nil, status.Error(s.code, "export failed")
+	}
+	return &colmetricpb.ExportMetricsServiceResponse{}, nil
+}
+
+func TestResponseMetadataFunc(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &metadataServer{}
+	gs := grpc.NewServer()
+	colmetricpb.RegisterMetricsServiceServer(gs, srv)
+	go func() { _ = gs.Serve(ln) }()
+	t.Cleanup(gs.Stop)
+
+	var headers, trailers []metadata.MD
+	ctx := context.Background()
+	c, err := newClient(ctx, oconf.NewGRPCConfig(asGRPCOptions([]Option{
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithResponseMetadataFunc(func(header, trailer metadata.MD) {
+			headers = append(headers, header)
+			trailers = append(trailers, trailer)
+		}),
+	})...))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Shutdown(ctx) })
+
+	require.NoError(t, c.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+	srv.code = codes.InvalidArgument
+	require.Error(t, c.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+
+	require.Len(t, headers, 2)
+	for i := range headers {
+		assert.Equal(t, []string{"1.2.3"}, headers[i].Get("x-collector-version"))
+		assert.Equal(t, []string{"0"}, trailers[i].Get("x-ratelimit-remaining"))
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
@@ -137,6 +138,32 @@ func WithCompressor(compressor string) Option {
 	return wrappedOption{oconf.WithCompression(compressorToCompression(compressor))}
 }
 
+// WithCompressionThreshold sets the minimum size, in bytes, of the serialized
+// export requests compressed with the compressor set with WithCompressor.
+// The smaller requests are sent uncompressed: compressing tiny requests costs
+// more CPU than the bandwidth it saves.
+//
+// If bytes is zero or negative, all requests are compressed. By default, if
+// this option is not passed, all requests are compressed.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithCompressionThreshold(bytes int) Option {
+	return wrappedOption{oconf.WithCompressionThreshold(bytes)}
+}
+
+// WithResponseMetadataFunc sets f to be called with the header and trailer
+// metadata of each response to an export request, e.g. to read the
+// rate-limit hints or the version of the collector. It is called for each
+// attempt, the failed ones included, once the response is received. It is
+// called concurrently if exports are, and must not retain or modify the
+// metadata.
+//
+// By default, if this option is not passed, the response metadata is not
+// captured.
+func WithResponseMetadataFunc(f func(header, trailer metadata.MD)) Option {
+	return wrappedOption{oconf.WithResponseMetadata(f)}
+}
+
 // WithHeaders will send the provided headers with each gRPC requests.
 //
 // If the OTEL_EXPORTER_OTLP_HEADERS or OTEL_EXPORTER_OTLP_METRICS_HEADERS
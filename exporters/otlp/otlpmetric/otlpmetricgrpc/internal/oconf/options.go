@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
 	"go.opentelemetry.io/otel/internal/global"
@@ -56,13 +57,25 @@ type (
 		Timeout     time.Duration
 		URLPath     string
 
+		// CompressionThreshold is the minimum size, in bytes, of the
+		// serialized requests that are compressed. The smaller requests are
+		// sent uncompressed. If it is not positive, all requests are
+		// compressed.
+		CompressionThreshold int
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
+		// ResponseMetadata is called with the header and trailer metadata of
+		// the responses to the gRPC export requests.
+		ResponseMetadata func(header, trailer metadata.MD)
 
 		TemporalitySelector metric.TemporalitySelector
 		AggregationSelector metric.AggregationSelector
 
 		Proxy HTTPTransportProxyFunc
+		// ResponseHeader is called with the status code and header of the
+		// responses to the HTTP export requests.
+		ResponseHeader func(statusCode int, header http.Header)
 	}
 
 	Config struct {
@@ -150,7 +163,8 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 		cfg.Metrics.GRPCCredentials = creds
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithTransportCredentials(creds))
 	}
-	if cfg.Metrics.Compression == GzipCompression {
+	// With a threshold, the compressor is set per request by the client.
+	if cfg.Metrics.Compression == GzipCompression && cfg.Metrics.CompressionThreshold <= 0 {
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
 	}
 	if cfg.ReconnectionPeriod != 0 {
@@ -302,6 +316,27 @@ func WithCompression(compression Compression) GenericOption {
 	})
 }
 
+func WithCompressionThreshold(bytes int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.CompressionThreshold = bytes
+		return cfg
+	})
+}
+
+func WithResponseMetadata(f func(header, trailer metadata.MD)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.ResponseMetadata = f
+		return cfg
+	})
+}
+
+func WithResponseHeader(f func(statusCode int, header http.Header)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.ResponseHeader = f
+		return cfg
+	})
+}
+
 func WithURLPath(urlPath string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Metrics.URLPath = urlPath
@@ -31,8 +31,13 @@ type client struct {
 	// req is cloned for every upload the client makes.
 	req         *http.Request
 	compression Compression
-	requestFunc retry.RequestFunc
-	httpClient  *http.Client
+	// compressionThreshold is the minimum size of the compressed bodies.
+	compressionThreshold int
+	requestFunc          retry.RequestFunc
+	httpClient           *http.Client
+	// responseHeader is called with the status code and header of each
+	// export response. It is nil if the header is not captured.
+	responseHeader func(statusCode int, header http.Header)
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -96,10 +101,12 @@ func newClient(cfg oconf.Config) (*client, error) {
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
 	return &client{
-		compression: Compression(cfg.Metrics.Compression),
-		req:         req,
-		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
-		httpClient:  httpClient,
+		compression:          Compression(cfg.Metrics.Compression),
+		compressionThreshold: cfg.Metrics.CompressionThreshold,
+		req:                  req,
+		requestFunc:          cfg.RetryConfig.RequestFunc(evaluate),
+		httpClient:           httpClient,
+		responseHeader:       cfg.Metrics.ResponseHeader,
 	}, nil
 }
 
@@ -151,6 +158,9 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 		if err != nil {
 			return err
 		}
+		if c.responseHeader != nil {
+			c.responseHeader(resp.StatusCode, resp.Header)
+		}
 
 		var rErr error
 		switch sc := resp.StatusCode; {
@@ -216,7 +226,11 @@ func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
-	switch c.compression {
+	compression := c.compression
+	if len(body) < c.compressionThreshold {
+		compression = NoCompression
+	}
+	switch compression {
 	case NoCompression:
 		r.ContentLength = (int64)(len(body))
 		req.bodyReader = bodyReader(body)
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
@@ -247,3 +248,64 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[headerKeySetInProxy], []string{headerValueSetInProxy})
 	})
 }
+
+func TestClientCompressionThreshold(t *testing.T) {
+	newTestClient := func(opts ...Option) *client {
+		t.Helper()
+		c, err := newClient(oconf.NewHTTPConfig(asHTTPOptions(opts)...))
+		require.NoError(t, err)
+		return c
+	}
+	encoding := func(c *client, body []byte) string {
+		t.Helper()
+		req, err := c.newRequest(context.Background(), body)
+		require.NoError(t, err)
+		return req.Header.Get("Content-Encoding")
+	}
+	small, large := make([]byte, 99), make([]byte, 100)
+
+	c := newTestClient(WithCompression(GzipCompression), WithCompressionThreshold(100))
+	assert.Equal(t, "", encoding(c, small), "small body compressed")
+	assert.Equal(t, "gzip", encoding(c, large), "large body not compressed")
+
+	c = newTestClient(WithCompression(GzipCompression))
+	assert.Equal(t, "gzip", encoding(c, small), "body not compressed without threshold")
+
+	c = newTestClient(WithCompressionThreshold(100))
+	assert.Equal(t, "", encoding(c, large), "body compressed without compression")
+}
+
+func TestClientResponseHeaderFunc(t *testing.T) {
+	statusCode := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Collector-Version", "1.2.3")
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(srv.Close)
+
+	var (
+		codes   []int
+		headers []http.Header
+	)
+	c, err := newClient(oconf.NewHTTPConfig(asHTTPOptions([]Option{
+		WithEndpointURL(srv.URL),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithResponseHeaderFunc(func(code int, h http.Header) {
+			codes = append(codes, code)
+			headers = append(headers, h.Clone())
+		}),
+	})...))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, c.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+	statusCode = http.StatusBadRequest
+	require.Error(t, c.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusBadRequest}, codes)
+	require.Len(t, headers, 2)
+	for _, h := range headers {
+		assert.Equal(t, "1.2.3", h.Get("X-Collector-Version"))
+	}
+}
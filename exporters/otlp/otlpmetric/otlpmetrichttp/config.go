@@ -107,6 +107,29 @@ func WithCompression(compression Compression) Option {
 	return wrappedOption{oconf.WithCompression(oconf.Compression(compression))}
 }
 
+// WithCompressionThreshold sets the minimum size, in bytes, of the serialized
+// export requests compressed with the compression set with WithCompression.
+// The smaller requests are sent uncompressed: compressing tiny requests costs
+// more CPU than the bandwidth it saves.
+//
+// If bytes is zero or negative, all requests are compressed. By default, if
+// this option is not passed, all requests are compressed.
+func WithCompressionThreshold(bytes int) Option {
+	return wrappedOption{oconf.WithCompressionThreshold(bytes)}
+}
+
+// WithResponseHeaderFunc sets f to be called with the status code and header
+// of each response to an export request, e.g. to read the rate-limit hints or
+// the version of the collector. It is called for each attempt, the failed
+// ones included, once the response is received. It is called concurrently if
+// exports are, and must not retain or modify the header.
+//
+// By default, if this option is not passed, the response header is not
+// captured.
+func WithResponseHeaderFunc(f func(statusCode int, header http.Header)) Option {
+	return wrappedOption{oconf.WithResponseHeader(f)}
+}
+
 // WithURLPath sets the URL path the Exporter will send requests to.
 //
 // If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT
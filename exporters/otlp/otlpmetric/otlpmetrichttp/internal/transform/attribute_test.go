@@ -184,3 +184,34 @@ func TestAttributeTransforms(t *testing.T) {
 		})
 	}
 }
+
+var outKeyValues []*cpb.KeyValue
+
+func BenchmarkKeyValues(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attrBool,
+		attrBoolSlice,
+		attrInt,
+		attrIntSlice,
+		attrInt64,
+		attrInt64Slice,
+		attrFloat64,
+		attrFloat64Slice,
+		attrString,
+		attrStringSlice,
+	}
+	set := attribute.NewSet(attrs...)
+
+	b.Run("KeyValues", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outKeyValues = KeyValues(attrs)
+		}
+	})
+	b.Run("AttrIter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outKeyValues = AttrIter(set.Iter())
+		}
+	})
+}
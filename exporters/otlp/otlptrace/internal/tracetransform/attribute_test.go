@@ -245,3 +245,55 @@ func newOTelArray(key string, arrayValues []*commonpb.AnyValue) *commonpb.KeyVal
 		},
 	}
 }
+
+var outAnyValue *commonpb.AnyValue
+
+func BenchmarkValue(b *testing.B) {
+	for _, kv := range []attribute.KeyValue{
+		attribute.Bool("bool", true),
+		attribute.Int64("int64", 1),
+		attribute.Float64("float64", 1.5),
+		attribute.String("string", "value"),
+		attribute.BoolSlice("boolslice", []bool{true, false}),
+		attribute.Int64Slice("int64slice", []int64{1, 2}),
+		attribute.Float64Slice("float64slice", []float64{1.5, 2.5}),
+		attribute.StringSlice("stringslice", []string{"a", "b"}),
+	} {
+		v := kv.Value
+		b.Run(v.Type().String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				outAnyValue = Value(v)
+			}
+		})
+	}
+}
+
+var outKeyValues []*commonpb.KeyValue
+
+func BenchmarkKeyValues(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attribute.Bool("bool", true),
+		attribute.Int64("int64", 1),
+		attribute.Float64("float64", 1.5),
+		attribute.String("string", "value"),
+		attribute.BoolSlice("boolslice", []bool{true, false}),
+		attribute.Int64Slice("int64slice", []int64{1, 2}),
+		attribute.Float64Slice("float64slice", []float64{1.5, 2.5}),
+		attribute.StringSlice("stringslice", []string{"a", "b"}),
+	}
+	set := attribute.NewSet(attrs...)
+
+	b.Run("KeyValues", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outKeyValues = KeyValues(attrs)
+		}
+	})
+	b.Run("Iterator", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outKeyValues = Iterator(set.Iter())
+		}
+	})
+}
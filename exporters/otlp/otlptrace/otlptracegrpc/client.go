@@ -6,14 +6,17 @@ package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptra
 import (
 	"context"
 	"errors"
+	"slices"
 	"sync"
 	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
@@ -30,6 +33,12 @@ type client struct {
 	metadata      metadata.MD
 	exportTimeout time.Duration
 	requestFunc   retry.RequestFunc
+	// compressionThreshold is the minimum size of the requests compressed
+	// with gzip. If it is zero, the compression is set by dialOpts instead.
+	compressionThreshold int
+	// responseMetadata is called with the header and trailer metadata of
+	// each export response. It is nil if the metadata is not captured.
+	responseMetadata func(header, trailer metadata.MD)
 
 	// stopCtx is used as a parent context for all exports. Therefore, when it
 	// is canceled with the stopFunc all exports are canceled.
@@ -73,6 +82,10 @@ func newClient(opts ...Option) *client {
 	if len(cfg.Traces.Headers) > 0 {
 		c.metadata = metadata.New(cfg.Traces.Headers)
 	}
+	if cfg.Traces.Compression == otlpconfig.GzipCompression && cfg.Traces.CompressionThreshold > 0 && cfg.GRPCConn == nil {
+		c.compressionThreshold = cfg.Traces.CompressionThreshold
+	}
+	c.responseMetadata = cfg.Traces.ResponseMetadata
 
 	return c
 }
@@ -189,10 +202,11 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
+	req := &coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans}
+	callOpts := c.callOptions(req)
+
 	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.tsc.Export(iCtx, &coltracepb.ExportTraceServiceRequest{
-			ResourceSpans: protoSpans,
-		})
+		resp, err := c.export(iCtx, req, callOpts)
 		if resp != nil && resp.PartialSuccess != nil {
 			msg := resp.PartialSuccess.GetErrorMessage()
 			n := resp.PartialSuccess.GetRejectedSpans()
@@ -210,6 +224,29 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	})
 }
 
+// export sends req with the call options opts. If c captures the response
+// metadata, the header and trailer metadata of the response are passed to
+// its function, even if the export fails.
+func (c *client) export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest, opts []grpc.CallOption) (*coltracepb.ExportTraceServiceResponse, error) {
+	if c.responseMetadata == nil {
+		return c.tsc.Export(ctx, req, opts...)
+	}
+	var header, trailer metadata.MD
+	opts = append(slices.Clip(opts), grpc.Header(&header), grpc.Trailer(&trailer))
+	resp, err := c.tsc.Export(ctx, req, opts...)
+	c.responseMetadata(header, trailer)
+	return resp, err
+}
+
+// callOptions returns the options of the call exporting req: the gzip
+// compressor if req is not smaller than the compression threshold of c.
+func (c *client) callOptions(req proto.Message) []grpc.CallOption {
+	if c.compressionThreshold > 0 && proto.Size(req) >= c.compressionThreshold {
+		return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}
+	}
+	return nil
+}
+
 // exportContext returns a copy of parent with an appropriate deadline and
 // cancellation function.
 //
@@ -5,15 +5,22 @@ package otlptracegrpc
 
 import (
 	"context"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
 func TestThrottleDelay(t *testing.T) {
@@ -202,3 +209,71 @@ func TestExportContextLinksStopSignal(t *testing.T) {
 		return false
 	}, 10*time.Second, time.Microsecond)
 }
+
+func TestCompressionThreshold(t *testing.T) {
+	small := &coltracepb.ExportTraceServiceRequest{}
+	large := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{SchemaUrl: strings.Repeat("a", 100)}},
+	}
+
+	c := newClient(WithCompressor("gzip"), WithCompressionThreshold(100))
+	assert.Equal(t, 100, c.compressionThreshold)
+	assert.Empty(t, c.callOptions(small), "small request compressed")
+	assert.Len(t, c.callOptions(large), 1, "large request not compressed")
+
+	c = newClient(WithCompressor("gzip"))
+	assert.Empty(t, c.callOptions(large), "compressor set per request without threshold")
+
+	c = newClient(WithCompressionThreshold(100))
+	assert.Empty(t, c.callOptions(large), "compressed without compressor")
+}
+
+// metadataServer is a trace service sending header and trailer metadata.
+type metadataServer struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	code codes.Code
+}
+
+func (s *metadataServer) Export(ctx context.Context, _ *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-collector-version", "1.2.3"))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-ratelimit-remaining", "0"))
+	if s.code != codes.OK {
+		return nil, status.Error(s.code, "export failed")
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func TestResponseMetadataFunc(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &metadataServer{}
+	gs := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(gs, srv)
+	go func() { _ = gs.Serve(ln) }()
+	t.Cleanup(gs.Stop)
+
+	var headers, trailers []metadata.MD
+	c := newClient(
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithResponseMetadataFunc(func(header, trailer metadata.MD) {
+			headers = append(headers, header)
+			trailers = append(trailers, trailer)
+		}),
+	)
+	ctx := context.Background()
+	require.NoError(t, c.Start(ctx))
+	t.Cleanup(func() { _ = c.Stop(ctx) })
+
+	require.NoError(t, c.UploadTraces(ctx, []*tracepb.ResourceSpans{{}}))
+	srv.code = codes.InvalidArgument
+	require.Error(t, c.UploadTraces(ctx, []*tracepb.ResourceSpans{{}}))
+
+	require.Len(t, headers, 2)
+	for i := range headers {
+		assert.Equal(t, []string{"1.2.3"}, headers[i].Get("x-collector-version"))
+		assert.Equal(t, []string{"0"}, trailers[i].Get("x-ratelimit-remaining"))
+	}
+}
@@ -156,6 +156,9 @@ func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		if err != nil {
 			return err
 		}
+		if f := d.cfg.ResponseHeader; f != nil {
+			f(resp.StatusCode, resp.Header)
+		}
 
 		if resp != nil && resp.Body != nil {
 			defer func() {
@@ -225,7 +228,11 @@ func (d *client) newRequest(body []byte) (request, error) {
 	r.Header.Set("Content-Type", contentTypeProto)
 
 	req := request{Request: r}
-	switch Compression(d.cfg.Compression) {
+	compression := Compression(d.cfg.Compression)
+	if len(body) < d.cfg.CompressionThreshold {
+		compression = NoCompression
+	}
+	switch compression {
 	case NoCompression:
 		r.ContentLength = (int64)(len(body))
 		req.bodyReader = bodyReader(body)
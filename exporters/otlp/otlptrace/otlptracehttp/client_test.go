@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
@@ -21,6 +22,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlptracetest"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
 const (
@@ -468,3 +470,70 @@ func TestCollectorRespondingNonProtobufContent(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, mc.GetSpans(), 1)
 }
+
+func TestCompressionThreshold(t *testing.T) {
+	encodings := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings <- r.Header.Get("Content-Encoding")
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	encoding := func(schemaURL string, opts ...otlptracehttp.Option) string {
+		t.Helper()
+		opts = append(opts, otlptracehttp.WithEndpointURL(srv.URL))
+		client := otlptracehttp.NewClient(opts...)
+		ctx := context.Background()
+		require.NoError(t, client.Start(ctx))
+		t.Cleanup(func() { _ = client.Stop(ctx) })
+
+		rs := []*tracepb.ResourceSpans{{SchemaUrl: schemaURL}}
+		require.NoError(t, client.UploadTraces(ctx, rs))
+		return <-encodings
+	}
+	small, large := "", strings.Repeat("a", 100)
+	gzip := otlptracehttp.WithCompression(otlptracehttp.GzipCompression)
+	threshold := otlptracehttp.WithCompressionThreshold(100)
+
+	assert.Equal(t, "", encoding(small, gzip, threshold), "small request compressed")
+	assert.Equal(t, "gzip", encoding(large, gzip, threshold), "large request not compressed")
+	assert.Equal(t, "gzip", encoding(small, gzip), "request not compressed without threshold")
+	assert.Equal(t, "", encoding(large, threshold), "request compressed without compression")
+}
+
+func TestResponseHeaderFunc(t *testing.T) {
+	statusCode := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Collector-Version", "1.2.3")
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(srv.Close)
+
+	var (
+		codes   []int
+		headers []http.Header
+	)
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpointURL(srv.URL),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+		otlptracehttp.WithResponseHeaderFunc(func(code int, h http.Header) {
+			codes = append(codes, code)
+			headers = append(headers, h.Clone())
+		}),
+	)
+	ctx := context.Background()
+	require.NoError(t, client.Start(ctx))
+	t.Cleanup(func() { _ = client.Stop(ctx) })
+
+	require.NoError(t, client.UploadTraces(ctx, []*tracepb.ResourceSpans{{}}))
+	statusCode = http.StatusBadRequest
+	require.Error(t, client.UploadTraces(ctx, []*tracepb.ResourceSpans{{}}))
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusBadRequest}, codes)
+	require.Len(t, headers, 2)
+	for _, h := range headers {
+		assert.Equal(t, "1.2.3", h.Get("X-Collector-Version"))
+	}
+}
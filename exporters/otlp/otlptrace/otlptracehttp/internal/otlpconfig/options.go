@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
@@ -49,10 +50,22 @@ type (
 		Timeout     time.Duration
 		URLPath     string
 
+		// CompressionThreshold is the minimum size, in bytes, of the
+		// serialized requests that are compressed. The smaller requests are
+		// sent uncompressed. If it is not positive, all requests are
+		// compressed.
+		CompressionThreshold int
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
+		// ResponseMetadata is called with the header and trailer metadata of
+		// the responses to the gRPC export requests.
+		ResponseMetadata func(header, trailer metadata.MD)
 
 		Proxy HTTPTransportProxyFunc
+		// ResponseHeader is called with the status code and header of the
+		// responses to the HTTP export requests.
+		ResponseHeader func(statusCode int, header http.Header)
 	}
 
 	Config struct {
@@ -136,7 +149,8 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 		cfg.Traces.GRPCCredentials = creds
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithTransportCredentials(creds))
 	}
-	if cfg.Traces.Compression == GzipCompression {
+	// With a threshold, the compressor is set per request by the client.
+	if cfg.Traces.Compression == GzipCompression && cfg.Traces.CompressionThreshold <= 0 {
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
 	}
 	if cfg.ReconnectionPeriod != 0 {
@@ -288,6 +302,27 @@ func WithCompression(compression Compression) GenericOption {
 	})
 }
 
+func WithCompressionThreshold(bytes int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.CompressionThreshold = bytes
+		return cfg
+	})
+}
+
+func WithResponseMetadata(f func(header, trailer metadata.MD)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.ResponseMetadata = f
+		return cfg
+	})
+}
+
+func WithResponseHeader(f func(statusCode int, header http.Header)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.ResponseHeader = f
+		return cfg
+	})
+}
+
 func WithURLPath(urlPath string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.URLPath = urlPath
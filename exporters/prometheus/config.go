@@ -22,6 +22,8 @@ type config struct {
 	disableScopeInfo         bool
 	namespace                string
 	resourceAttributesFilter attribute.Filter
+	withCreatedTimestamps    bool
+	withExemplarActivity     bool
 }
 
 // newConfig creates a validated config configured with options.
@@ -153,3 +155,45 @@ func WithResourceAsConstantLabels(resourceFilter attribute.Filter) Option {
 		return cfg
 	})
 }
+
+// WithCreatedTimestamps configures the Exporter to set the created timestamp
+// of counters and histograms to the start time of their cumulative data
+// points. It allows Prometheus to detect counter resets, e.g. after a process
+// restart, correctly.
+//
+// The created timestamp is exposed as a _created series by the OpenMetrics
+// format, or in the created timestamp field of the Protobuf format, when the
+// handler serving the metrics supports it.
+//
+// By default, created timestamps are not set.
+func WithCreatedTimestamps() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.withCreatedTimestamps = true
+		return cfg
+	})
+}
+
+// WithExemplarActivity configures the Exporter to expose the activity of the
+// exemplar reservoir of the counter and histogram series exposed without
+// exemplars. It allows to tell the series without exemplars because none of
+// their measurements were sampled, e.g. for unsampled traffic, apart from the
+// ones without exemplars because of a broken setup.
+//
+// The activity of a series is exposed as the <name>_exemplar_offered_total
+// and <name>_exemplar_sampled_total counters with the labels of the series,
+// where <name> is the name of the series without the _total suffix. The first
+// counts the measurements offered to the exemplar filter, the second the ones
+// that passed the filter and were offered to the exemplar reservoir.
+//
+// The activity is only exposed when exemplars are recorded, i.e. when the
+// OTEL_GO_X_EXEMPLAR environment variable is set to true and the
+// OTEL_METRICS_EXEMPLAR_FILTER environment variable is not set to
+// always_off.
+//
+// By default, the activity of the exemplar reservoirs is not exposed.
+func WithExemplarActivity() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.withExemplarActivity = true
+		return cfg
+	})
+}
@@ -11,12 +11,14 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -86,6 +88,8 @@ type collector struct {
 	disableScopeInfo         bool
 	namespace                string
 	resourceAttributesFilter attribute.Filter
+	withCreatedTimestamps    bool
+	withExemplarActivity     bool
 
 	mu                sync.Mutex // mu protects all members below from the concurrent access.
 	disableTargetInfo bool
@@ -120,6 +124,8 @@ func New(opts ...Option) (*Exporter, error) {
 		metricFamilies:           make(map[string]*dto.MetricFamily),
 		namespace:                cfg.namespace,
 		resourceAttributesFilter: cfg.resourceAttributesFilter,
+		withCreatedTimestamps:    cfg.withCreatedTimestamps,
+		withExemplarActivity:     cfg.withExemplarActivity,
 	}
 
 	if err := cfg.registerer.Register(collector); err != nil {
@@ -225,13 +231,13 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 
 			switch v := m.Data.(type) {
 			case metricdata.Histogram[int64]:
-				addHistogramMetric(ch, v, m, keys, values, name, c.resourceKeyVals)
+				addHistogramMetric(ch, v, m, keys, values, name, c.resourceKeyVals, c.withCreatedTimestamps, c.withExemplarActivity)
 			case metricdata.Histogram[float64]:
-				addHistogramMetric(ch, v, m, keys, values, name, c.resourceKeyVals)
+				addHistogramMetric(ch, v, m, keys, values, name, c.resourceKeyVals, c.withCreatedTimestamps, c.withExemplarActivity)
 			case metricdata.Sum[int64]:
-				addSumMetric(ch, v, m, keys, values, name, c.resourceKeyVals)
+				addSumMetric(ch, v, m, keys, values, name, c.resourceKeyVals, c.withCreatedTimestamps, c.withExemplarActivity)
 			case metricdata.Sum[float64]:
-				addSumMetric(ch, v, m, keys, values, name, c.resourceKeyVals)
+				addSumMetric(ch, v, m, keys, values, name, c.resourceKeyVals, c.withCreatedTimestamps, c.withExemplarActivity)
 			case metricdata.Gauge[int64]:
 				addGaugeMetric(ch, v, m, keys, values, name, c.resourceKeyVals)
 			case metricdata.Gauge[float64]:
@@ -241,7 +247,7 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	}
 }
 
-func addHistogramMetric[N int64 | float64](ch chan<- prometheus.Metric, histogram metricdata.Histogram[N], m metricdata.Metrics, ks, vs [2]string, name string, resourceKV keyVals) {
+func addHistogramMetric[N int64 | float64](ch chan<- prometheus.Metric, histogram metricdata.Histogram[N], m metricdata.Metrics, ks, vs [2]string, name string, resourceKV keyVals, created, activity bool) {
 	for _, dp := range histogram.DataPoints {
 		keys, values := getAttrs(dp.Attributes, ks, vs, resourceKV)
 
@@ -258,12 +264,18 @@ func addHistogramMetric[N int64 | float64](ch chan<- prometheus.Metric, histogra
 			otel.Handle(err)
 			continue
 		}
+		if created {
+			m = withCreatedTimestamp(m, dp.StartTime)
+		}
 		m = addExemplars(m, dp.Exemplars)
 		ch <- m
+		if activity && len(dp.Exemplars) == 0 {
+			addExemplarActivity(ch, dp.ExemplarActivity, name, keys, values)
+		}
 	}
 }
 
-func addSumMetric[N int64 | float64](ch chan<- prometheus.Metric, sum metricdata.Sum[N], m metricdata.Metrics, ks, vs [2]string, name string, resourceKV keyVals) {
+func addSumMetric[N int64 | float64](ch chan<- prometheus.Metric, sum metricdata.Sum[N], m metricdata.Metrics, ks, vs [2]string, name string, resourceKV keyVals, created, activity bool) {
 	valueType := prometheus.CounterValue
 	if !sum.IsMonotonic {
 		valueType = prometheus.GaugeValue
@@ -278,8 +290,39 @@ func addSumMetric[N int64 | float64](ch chan<- prometheus.Metric, sum metricdata
 			otel.Handle(err)
 			continue
 		}
+		if created && sum.IsMonotonic {
+			m = withCreatedTimestamp(m, dp.StartTime)
+		}
 		m = addExemplars(m, dp.Exemplars)
 		ch <- m
+		if activity && len(dp.Exemplars) == 0 {
+			addExemplarActivity(ch, dp.ExemplarActivity, name, keys, values)
+		}
+	}
+}
+
+// addExemplarActivity sends the counters of the exemplar reservoir activity
+// of the series name with the keys and values labels to ch. Nothing is sent if
+// activity is nil.
+func addExemplarActivity(ch chan<- prometheus.Metric, activity *metricdata.ExemplarActivity, name string, keys, values []string) {
+	if activity == nil {
+		return
+	}
+	base := strings.TrimSuffix(name, counterSuffix)
+	for _, c := range []struct {
+		suffix, help string
+		value        uint64
+	}{
+		{"_exemplar_offered", "Measurements offered to the exemplar filter of " + name, activity.Offered},
+		{"_exemplar_sampled", "Measurements offered to the exemplar reservoir of " + name, activity.Sampled},
+	} {
+		desc := prometheus.NewDesc(base+c.suffix+counterSuffix, c.help, keys, nil)
+		m, err := prometheus.NewConstMetric(desc, prometheus.CounterValue, float64(c.value), values...)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		ch <- m
 	}
 }
 
@@ -555,6 +598,37 @@ func (c *collector) validateMetrics(name, description string, metricType *dto.Me
 	return false, ""
 }
 
+// createdTimestampMetric is a counter or histogram prometheus.Metric with
+// its created timestamp set.
+type createdTimestampMetric struct {
+	prometheus.Metric
+
+	created *timestamppb.Timestamp
+}
+
+// withCreatedTimestamp returns m with its created timestamp set to start. If
+// start is the zero time, m is returned unmodified.
+func withCreatedTimestamp(m prometheus.Metric, start time.Time) prometheus.Metric {
+	if start.IsZero() {
+		return m
+	}
+	return createdTimestampMetric{Metric: m, created: timestamppb.New(start)}
+}
+
+// Write implements prometheus.Metric.
+func (m createdTimestampMetric) Write(out *dto.Metric) error {
+	if err := m.Metric.Write(out); err != nil {
+		return err
+	}
+	switch {
+	case out.Counter != nil:
+		out.Counter.CreatedTimestamp = m.created
+	case out.Histogram != nil:
+		out.Histogram.CreatedTimestamp = m.created
+	}
+	return nil
+}
+
 func addExemplars[N int64 | float64](m prometheus.Metric, exemplars []metricdata.Exemplar[N]) prometheus.Metric {
 	if len(exemplars) == 0 {
 		return m
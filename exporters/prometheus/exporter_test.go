@@ -10,6 +10,7 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
@@ -1015,3 +1016,143 @@ func TestExemplars(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatedTimestamps(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		options []Option
+		want    bool
+	}{
+		{name: "Default", want: false},
+		{name: "WithCreatedTimestamps", options: []Option{WithCreatedTimestamps()}, want: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			registry := prometheus.NewRegistry()
+			opts := append([]Option{WithRegisterer(registry), WithoutTargetInfo(), WithoutScopeInfo()}, tc.options...)
+			exporter, err := New(opts...)
+			require.NoError(t, err)
+
+			start := time.Now()
+			provider := metric.NewMeterProvider(metric.WithReader(exporter))
+			meter := provider.Meter("TestCreatedTimestamps")
+
+			counter, err := meter.Int64Counter("counter")
+			require.NoError(t, err)
+			counter.Add(ctx, 1)
+			hist, err := meter.Float64Histogram("histogram")
+			require.NoError(t, err)
+			hist.Record(ctx, 1)
+			upDown, err := meter.Int64UpDownCounter("updown")
+			require.NoError(t, err)
+			upDown.Add(ctx, 1)
+
+			got, err := registry.Gather()
+			require.NoError(t, err)
+			require.Len(t, got, 3)
+
+			for _, family := range got {
+				require.Len(t, family.GetMetric(), 1)
+				m := family.GetMetric()[0]
+				switch family.GetType() {
+				case dto.MetricType_COUNTER:
+					ct := m.GetCounter().GetCreatedTimestamp()
+					if !tc.want {
+						assert.Nil(t, ct, "counter created timestamp")
+						continue
+					}
+					require.NotNil(t, ct, "counter created timestamp")
+					assert.False(t, ct.AsTime().Before(start), "counter created before start")
+				case dto.MetricType_HISTOGRAM:
+					ct := m.GetHistogram().GetCreatedTimestamp()
+					if !tc.want {
+						assert.Nil(t, ct, "histogram created timestamp")
+						continue
+					}
+					require.NotNil(t, ct, "histogram created timestamp")
+					assert.False(t, ct.AsTime().Before(start), "histogram created before start")
+				case dto.MetricType_GAUGE:
+					// Gauges, including non-monotonic sums, have no created
+					// timestamp.
+					assert.NotNil(t, m.GetGauge())
+				default:
+					t.Errorf("unexpected metric type: %v", family.GetType())
+				}
+			}
+		})
+	}
+}
+
+func TestExemplarActivity(t *testing.T) {
+	t.Setenv("OTEL_GO_X_EXEMPLAR", "true")
+
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	for _, tc := range []struct {
+		name    string
+		options []Option
+		ctx     context.Context
+		want    map[string]float64
+	}{
+		{
+			name: "Default",
+			ctx:  context.Background(),
+			want: map[string]float64{"counter_total": 2, "histogram": 2},
+		},
+		{
+			name:    "Unsampled",
+			options: []Option{WithExemplarActivity()},
+			ctx:     context.Background(),
+			want: map[string]float64{
+				"counter_total":                    2,
+				"counter_exemplar_offered_total":   2,
+				"counter_exemplar_sampled_total":   0,
+				"histogram":                        2,
+				"histogram_exemplar_offered_total": 2,
+				"histogram_exemplar_sampled_total": 0,
+			},
+		},
+		{
+			name:    "Sampled",
+			options: []Option{WithExemplarActivity()},
+			ctx:     sampled,
+			want:    map[string]float64{"counter_total": 2, "histogram": 2},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := prometheus.NewRegistry()
+			opts := append([]Option{WithRegisterer(registry), WithoutTargetInfo(), WithoutScopeInfo()}, tc.options...)
+			exporter, err := New(opts...)
+			require.NoError(t, err)
+
+			meter := metric.NewMeterProvider(metric.WithReader(exporter)).Meter("TestExemplarActivity")
+			counter, err := meter.Int64Counter("counter")
+			require.NoError(t, err)
+			hist, err := meter.Float64Histogram("histogram")
+			require.NoError(t, err)
+			for i := 0; i < 2; i++ {
+				counter.Add(tc.ctx, 1)
+				hist.Record(tc.ctx, 1)
+			}
+
+			got, err := registry.Gather()
+			require.NoError(t, err)
+			values := make(map[string]float64, len(got))
+			for _, family := range got {
+				require.Len(t, family.GetMetric(), 1)
+				m := family.GetMetric()[0]
+				switch family.GetType() {
+				case dto.MetricType_COUNTER:
+					values[family.GetName()] = m.GetCounter().GetValue()
+				case dto.MetricType_HISTOGRAM:
+					values[family.GetName()] = float64(m.GetHistogram().GetSampleCount())
+				}
+			}
+			assert.Equal(t, tc.want, values)
+		})
+	}
+}
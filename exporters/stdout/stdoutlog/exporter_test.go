@@ -183,7 +183,7 @@ func getJSON(now *time.Time) string {
 		timestamps = "\"Timestamp\":" + string(serializedNow) + ",\"ObservedTimestamp\":" + string(serializedNow) + ","
 	}
 
-	return "{" + timestamps + "\"Severity\":9,\"SeverityText\":\"INFO\",\"Body\":{},\"Attributes\":[{\"Key\":\"key\",\"Value\":{}},{\"Key\":\"key2\",\"Value\":{}},{\"Key\":\"key3\",\"Value\":{}},{\"Key\":\"key4\",\"Value\":{}},{\"Key\":\"key5\",\"Value\":{}},{\"Key\":\"bool\",\"Value\":{}}],\"TraceID\":\"0102030405060708090a0b0c0d0e0f10\",\"SpanID\":\"0102030405060708\",\"TraceFlags\":\"01\",\"Resource\":[{\"Key\":\"foo\",\"Value\":{\"Type\":\"STRING\",\"Value\":\"bar\"}}],\"Scope\":{\"Name\":\"name\",\"Version\":\"version\",\"SchemaURL\":\"https://example.com/custom-schema\"},\"DroppedAttributes\":10}\n"
+	return "{" + timestamps + "\"EventName\":\"test.event\",\"Severity\":9,\"SeverityText\":\"INFO\",\"Body\":{},\"Attributes\":[{\"Key\":\"key\",\"Value\":{}},{\"Key\":\"key2\",\"Value\":{}},{\"Key\":\"key3\",\"Value\":{}},{\"Key\":\"key4\",\"Value\":{}},{\"Key\":\"key5\",\"Value\":{}},{\"Key\":\"bool\",\"Value\":{}}],\"TraceID\":\"0102030405060708090a0b0c0d0e0f10\",\"SpanID\":\"0102030405060708\",\"TraceFlags\":\"01\",\"Resource\":[{\"Key\":\"foo\",\"Value\":{\"Type\":\"STRING\",\"Value\":\"bar\"}}],\"Scope\":{\"Name\":\"name\",\"Version\":\"version\",\"SchemaURL\":\"https://example.com/custom-schema\",\"Attributes\":{}},\"DroppedAttributes\":10}\n"
 }
 
 func getJSONs(now *time.Time) string {
@@ -198,6 +198,7 @@ func getPrettyJSON(now *time.Time) string {
 	}
 
 	return `{` + timestamps + `
+	"EventName": "test.event",
 	"Severity": 9,
 	"SeverityText": "INFO",
 	"Body": {},
@@ -242,7 +243,8 @@ func getPrettyJSON(now *time.Time) string {
 	"Scope": {
 		"Name": "name",
 		"Version": "version",
-		"SchemaURL": "https://example.com/custom-schema"
+		"SchemaURL": "https://example.com/custom-schema",
+		"Attributes": {}
 	},
 	"DroppedAttributes": 10
 }
@@ -272,6 +274,7 @@ func getRecord(now time.Time) sdklog.Record {
 	spanID, _ := trace.SpanIDFromHex("0102030405060708")
 
 	rf := logtest.RecordFactory{
+		EventName:         "test.event",
 		Timestamp:         now,
 		ObservedTimestamp: now,
 		Severity:          log.SeverityInfo1,
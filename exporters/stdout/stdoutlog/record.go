@@ -17,6 +17,7 @@ import (
 type recordJSON struct {
 	Timestamp         *time.Time `json:",omitempty"`
 	ObservedTimestamp *time.Time `json:",omitempty"`
+	EventName         string     `json:",omitempty"`
 	Severity          log.Severity
 	SeverityText      string
 	Body              log.Value
@@ -32,6 +33,7 @@ type recordJSON struct {
 func (e *Exporter) newRecordJSON(r sdklog.Record) recordJSON {
 	res := r.Resource()
 	newRecord := recordJSON{
+		EventName:    r.EventName(),
 		Severity:     r.Severity(),
 		SeverityText: r.SeverityText(),
 		Body:         r.Body(),
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
+
+import "go.opentelemetry.io/otel/log"
+
+// attrBatchSize is the number of attributes an AttrBatch buffers.
+const attrBatchSize = 16
+
+// AttrBatch adds attributes to a [log.Record] in batches.
+//
+// Adding the attributes of a log entry to a Record one at a time, as they are
+// converted, grows the Record each time. An AttrBatch buffers the attributes
+// and adds them with a single call to [log.Record.AddAttributes] when its
+// buffer is full or when it is flushed.
+//
+// Use [NewAttrBatch] to create an AttrBatch. An AttrBatch is meant to be
+// declared as a local variable, it is not safe for concurrent use.
+type AttrBatch struct {
+	record *log.Record
+	buf    [attrBatchSize]log.KeyValue
+	n      int
+}
+
+// NewAttrBatch returns a new [AttrBatch] adding the attributes to r.
+func NewAttrBatch(r *log.Record) AttrBatch {
+	return AttrBatch{record: r}
+}
+
+// Add adds kv to the batch. The batch is flushed if its buffer is full.
+func (b *AttrBatch) Add(kv log.KeyValue) {
+	if b.n == len(b.buf) {
+		b.Flush()
+	}
+	b.buf[b.n] = kv
+	b.n++
+}
+
+// Flush adds the buffered attributes to the [log.Record] of b. It needs to be
+// called once all the attributes are added.
+func (b *AttrBatch) Flush() {
+	if b.n == 0 {
+		return
+	}
+	b.record.AddAttributes(b.buf[:b.n]...)
+	// Release the references to the values.
+	clear(b.buf[:b.n])
+	b.n = 0
+}
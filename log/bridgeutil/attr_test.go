@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+)
+
+func TestAttrBatch(t *testing.T) {
+	var (
+		r    log.Record
+		want []log.KeyValue
+	)
+	b := NewAttrBatch(&r)
+	for i := 0; i < attrBatchSize+3; i++ {
+		kv := log.Int(strconv.Itoa(i), i)
+		b.Add(kv)
+		want = append(want, kv)
+	}
+	assert.Equal(t, attrBatchSize, r.AttributesLen(), "full batch flushed")
+
+	b.Flush()
+	assert.Equal(t, want, logtest.Attributes(r))
+
+	b.Flush()
+	assert.Equal(t, want, logtest.Attributes(r), "empty flush")
+}
+
+func BenchmarkAttrBatch(b *testing.B) {
+	kvs := make([]log.KeyValue, 10)
+	for i := range kvs {
+		kvs[i] = log.Int(strconv.Itoa(i), i)
+	}
+
+	b.Run("AddAttributes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var r log.Record
+			for _, kv := range kvs {
+				r.AddAttributes(kv)
+			}
+		}
+	})
+
+	b.Run("AttrBatch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var r log.Record
+			batch := NewAttrBatch(&r)
+			for _, kv := range kvs {
+				batch.Add(kv)
+			}
+			batch.Flush()
+		}
+	})
+}
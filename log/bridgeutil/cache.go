@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// LoggerCache caches the [log.Logger] of each instrumentation scope name
+// of a [log.LoggerProvider].
+//
+// Bridges mapping the names of the loggers of a logging library, e.g. the
+// names added with logr.Logger.WithName or zap.Logger.Named, to
+// instrumentation scopes use it to not get a Logger from the provider each
+// time a named logger is created.
+//
+// A LoggerCache is safe for concurrent use.
+type LoggerCache struct {
+	provider log.LoggerProvider
+	opts     []log.LoggerOption
+
+	loggers sync.Map // map[string]log.Logger
+}
+
+// NewLoggerCache returns a new [LoggerCache] getting the loggers from
+// provider with opts.
+func NewLoggerCache(provider log.LoggerProvider, opts ...log.LoggerOption) *LoggerCache {
+	return &LoggerCache{provider: provider, opts: opts}
+}
+
+// Logger returns the [log.Logger] named name. The Logger is only got from the
+// provider the first time name is used.
+func (c *LoggerCache) Logger(name string) log.Logger {
+	if l, ok := c.loggers.Load(name); ok {
+		return l.(log.Logger)
+	}
+	l, _ := c.loggers.LoadOrStore(name, c.provider.Logger(name, c.opts...))
+	return l.(log.Logger)
+}
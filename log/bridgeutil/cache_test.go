@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+)
+
+type countingProvider struct {
+	log.LoggerProvider
+
+	calls atomic.Int64
+}
+
+func (p *countingProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	p.calls.Add(1)
+	return p.LoggerProvider.Logger(name, opts...)
+}
+
+func TestLoggerCache(t *testing.T) {
+	p := &countingProvider{LoggerProvider: logtest.NewRecorder()}
+	c := NewLoggerCache(p, log.WithInstrumentationVersion("v1"))
+
+	l := c.Logger("a")
+	assert.Same(t, l, c.Logger("a"))
+	assert.NotSame(t, l, c.Logger("b"))
+	assert.Equal(t, int64(2), p.calls.Load())
+
+	rec, ok := l.(*logtest.Recorder)
+	if assert.True(t, ok) {
+		assert.Equal(t, "v1", rec.Result()[0].Version)
+	}
+}
+
+func TestLoggerCacheConcurrentSafe(t *testing.T) {
+	c := NewLoggerCache(logtest.NewRecorder())
+
+	const goroutines = 10
+	loggers := make([]log.Logger, goroutines)
+	var wg sync.WaitGroup
+	for i := range loggers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			loggers[i] = c.Logger("name")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, l := range loggers[1:] {
+		assert.Same(t, loggers[0], l)
+	}
+}
+
+var outLogger log.Logger
+
+func BenchmarkLoggerCache(b *testing.B) {
+	c := NewLoggerCache(logtest.NewRecorder())
+	c.Logger("name")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		outLogger = c.Logger("name")
+	}
+}
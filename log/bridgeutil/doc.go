@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bridgeutil provides the primitives shared by the log bridges, the
+// packages emitting the log records of a logging library using the
+// [go.opentelemetry.io/otel/log] API.
+//
+// It is intended to be used by the authors of bridges, not by the users of
+// the logging libraries. Using it keeps the conversions of the bridges
+// consistent:
+//
+//   - [OffsetSeverity] maps the numeric levels of a logging library to a
+//     [log.Severity]. [SlogLevelSeverity], [LogrusLevelSeverity],
+//     [ZapLevelSeverity], [ApexLevelSeverity], and [VerbositySeverity] map
+//     the levels of common logging libraries.
+//   - [ConvertAny] converts arbitrary Go values to a [log.Value].
+//     [TimeValue], [Uint64Value], [ErrorString], and [StringerString] convert
+//     the values that need care.
+//   - [LoggerCache] reuses the [log.Logger] of each instrumentation scope.
+//   - [AttrBatch] adds attributes to a [log.Record] in batches.
+//   - [FieldMapping] renames the fields of the log records to a target
+//     schema, e.g. with [SemconvFieldMapping] or [ECSFieldMapping].
+//   - [TraceContext] correlates the log records with the span of a context,
+//     optionally only with the sampled ones.
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
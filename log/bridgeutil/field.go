@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
+
+import "go.opentelemetry.io/otel/log"
+
+// BodyField is the target of a [FieldMapping] entry mapping a field to the
+// body of the log record instead of an attribute.
+const BodyField = "@body"
+
+// FieldMapping maps the keys of the fields of a logging library to the keys
+// of the attributes of a target schema, e.g. the OpenTelemetry semantic
+// conventions or the Elastic Common Schema. A field can be mapped to the body
+// of the log record with [BodyField].
+//
+// The fields with a key that is not in the mapping are kept as is. The keys
+// of the fields nested in groups are not mapped.
+//
+// A FieldMapping is a map so it can be extended, e.g. starting from the one
+// returned by [SemconvFieldMapping]. It must not be modified once it is
+// passed to a bridge.
+type FieldMapping map[string]string
+
+// SemconvFieldMapping returns a new [FieldMapping] of the common field keys
+// to the OpenTelemetry semantic conventions:
+//
+//   - "msg" and "message" are mapped to the body.
+//   - "err" and "error" are mapped to "exception.message".
+//   - "stack" and "stacktrace" are mapped to "exception.stacktrace".
+//   - "user_id" is mapped to "enduser.id".
+func SemconvFieldMapping() FieldMapping {
+	return FieldMapping{
+		"msg":        BodyField,
+		"message":    BodyField,
+		"err":        "exception.message",
+		"error":      "exception.message",
+		"stack":      "exception.stacktrace",
+		"stacktrace": "exception.stacktrace",
+		"user_id":    "enduser.id",
+	}
+}
+
+// ECSFieldMapping returns a new [FieldMapping] of the common field keys to
+// the Elastic Common Schema:
+//
+//   - "msg" is mapped to "message".
+//   - "err" and "error" are mapped to "error.message".
+//   - "stack" and "stacktrace" are mapped to "error.stack_trace".
+//   - "logger" is mapped to "log.logger".
+//   - "user_id" is mapped to "user.id".
+func ECSFieldMapping() FieldMapping {
+	return FieldMapping{
+		"msg":        "message",
+		"err":        "error.message",
+		"error":      "error.message",
+		"stack":      "error.stack_trace",
+		"stacktrace": "error.stack_trace",
+		"logger":     "log.logger",
+		"user_id":    "user.id",
+	}
+}
+
+// Adder returns a function calling f with the attributes passed to it,
+// renamed as defined by m. If m is empty, f is returned.
+//
+// An attribute mapped to the body sets the body of record instead, unless
+// record already has a non-empty body, e.g. the message of the log record.
+// In that case, the attribute is passed to f with its original key so no
+// data is lost.
+func (m FieldMapping) Adder(record *log.Record, f func(log.KeyValue)) func(log.KeyValue) {
+	if len(m) == 0 {
+		return f
+	}
+	return func(kv log.KeyValue) {
+		key, ok := m[kv.Key]
+		if !ok {
+			f(kv)
+			return
+		}
+		if key == BodyField {
+			if body := record.Body(); body.Empty() || (body.Kind() == log.KindString && body.AsString() == "") {
+				record.SetBody(kv.Value)
+				return
+			}
+			f(kv)
+			return
+		}
+		kv.Key = key
+		f(kv)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestFieldMappingAdder(t *testing.T) {
+	m := SemconvFieldMapping()
+	m["uid"] = "enduser.id"
+
+	var record log.Record
+	var got []log.KeyValue
+	add := m.Adder(&record, func(kv log.KeyValue) { got = append(got, kv) })
+	add(log.String("msg", "body"))
+	add(log.String("err", "boom"))
+	add(log.Int("uid", 1))
+	add(log.String("other", "v"))
+	add(log.String("message", "second body"))
+
+	assert.Equal(t, log.StringValue("body"), record.Body())
+	assert.Equal(t, []log.KeyValue{
+		log.String("exception.message", "boom"),
+		log.Int("enduser.id", 1),
+		log.String("other", "v"),
+		log.String("message", "second body"),
+	}, got)
+}
+
+func TestFieldMappingAdderEmptyBody(t *testing.T) {
+	var record log.Record
+	record.SetBody(log.StringValue(""))
+	add := SemconvFieldMapping().Adder(&record, func(log.KeyValue) { t.Error("attribute added") })
+	add(log.String("msg", "body"))
+	assert.Equal(t, log.StringValue("body"), record.Body())
+}
+
+func TestFieldMappingAdderECS(t *testing.T) {
+	var record log.Record
+	var got []log.KeyValue
+	add := ECSFieldMapping().Adder(&record, func(kv log.KeyValue) { got = append(got, kv) })
+	add(log.String("msg", "m"))
+	add(log.String("error", "boom"))
+	add(log.String("stack", "st"))
+
+	assert.True(t, record.Body().Empty())
+	assert.Equal(t, []log.KeyValue{
+		log.String("message", "m"),
+		log.String("error.message", "boom"),
+		log.String("error.stack_trace", "st"),
+	}, got)
+}
+
+func TestFieldMappingAdderEmpty(t *testing.T) {
+	var n int
+	f := func(log.KeyValue) { n++ }
+	var m FieldMapping
+	m.Adder(nil, f)(log.String("msg", "v"))
+	assert.Equal(t, 1, n)
+}
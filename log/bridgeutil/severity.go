@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
+
+import (
+	"log/slog"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+const (
+	minSeverity = log.SeverityTrace1
+	maxSeverity = log.SeverityFatal4
+)
+
+// OffsetSeverity returns base offset by delta, clamped to the range of the
+// defined severities, [log.SeverityTrace1] to [log.SeverityFatal4].
+//
+// It maps the numeric levels of a logging library, e.g. the verbosity of a
+// logr.Logger, to a [log.Severity] without overflowing into undefined values.
+func OffsetSeverity(base log.Severity, delta int) log.Severity {
+	s := int(base) + delta
+	switch {
+	case s < int(minSeverity):
+		return minSeverity
+	case s > int(maxSeverity):
+		return maxSeverity
+	}
+	return log.Severity(s)
+}
+
+// VerbositySeverity returns the [log.Severity] of the verbosity level v, e.g.
+// of a logr.Logger or of klog. Level 0 is [log.SeverityInfo], and each
+// greater level is the severity below it, down to [log.SeverityTrace1]: level
+// 1 is [log.SeverityDebug4], level 4 is [log.SeverityDebug1], and level 8
+// and greater levels are [log.SeverityTrace1].
+func VerbositySeverity(v int) log.Severity {
+	return OffsetSeverity(log.SeverityInfo, -v)
+}
+
+// slogOffset is the difference between a log.Severity and the slog.Level of
+// the same severity (e.g. log.SeverityInfo and slog.LevelInfo).
+const slogOffset = int(log.SeverityInfo) - int(slog.LevelInfo)
+
+// SlogLevelSeverity returns the [log.Severity] of the log/slog level:
+// slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, and slog.LevelError are
+// [log.SeverityDebug], [log.SeverityInfo], [log.SeverityWarn], and
+// [log.SeverityError], and the levels between them the severities between
+// them. The levels beyond the defined severities are clamped, see
+// [OffsetSeverity].
+//
+// [SeveritySlogLevel] is its inverse.
+func SlogLevelSeverity(level slog.Level) log.Severity {
+	return OffsetSeverity(log.SeverityInfo, int(level-slog.LevelInfo))
+}
+
+// SeveritySlogLevel returns the log/slog level of severity, the inverse of
+// [SlogLevelSeverity], e.g. to implement the Enabled method of a slog.Handler
+// wrapping a [log.Logger]. [log.SeverityUndefined] is a level lower than all
+// the levels of the defined severities.
+func SeveritySlogLevel(severity log.Severity) slog.Level {
+	return slog.Level(int(severity) - slogOffset)
+}
+
+// The github.com/sirupsen/logrus levels. The package is not imported, the
+// bridge module provides it.
+const (
+	logrusPanic = iota
+	logrusFatal
+	logrusError
+	logrusWarn
+	logrusInfo
+	logrusDebug
+)
+
+// LogrusLevelSeverity returns the [log.Severity] of the
+// github.com/sirupsen/logrus level: PanicLevel is [log.SeverityFatal2],
+// FatalLevel [log.SeverityFatal], ErrorLevel [log.SeverityError], WarnLevel
+// [log.SeverityWarn], InfoLevel [log.SeverityInfo], DebugLevel
+// [log.SeverityDebug], and TraceLevel [log.SeverityTrace].
+//
+// The level is generic so the logrus.Level type can be passed without this
+// package depending on logrus.
+func LogrusLevelSeverity[L ~uint32](level L) log.Severity {
+	switch level {
+	case logrusPanic:
+		return log.SeverityFatal2
+	case logrusFatal:
+		return log.SeverityFatal
+	case logrusError:
+		return log.SeverityError
+	case logrusWarn:
+		return log.SeverityWarn
+	case logrusInfo:
+		return log.SeverityInfo
+	case logrusDebug:
+		return log.SeverityDebug
+	default:
+		return log.SeverityTrace
+	}
+}
+
+// The go.uber.org/zap/zapcore levels. The package is not imported, the
+// bridge module provides it.
+const (
+	zapDebug = iota - 1
+	zapInfo
+	zapWarn
+	zapError
+	zapDPanic
+	zapPanic
+)
+
+// ZapLevelSeverity returns the [log.Severity] of the go.uber.org/zap/zapcore
+// level: DebugLevel, InfoLevel, WarnLevel, and ErrorLevel are
+// [log.SeverityDebug], [log.SeverityInfo], [log.SeverityWarn], and
+// [log.SeverityError], and DPanicLevel, PanicLevel, and FatalLevel are
+// [log.SeverityFatal1], [log.SeverityFatal2], and [log.SeverityFatal3].
+//
+// The level is generic so the zapcore.Level type can be passed without this
+// package depending on zap.
+func ZapLevelSeverity[L ~int8](level L) log.Severity {
+	switch {
+	case level <= zapDebug:
+		return log.SeverityDebug
+	case level == zapInfo:
+		return log.SeverityInfo
+	case level == zapWarn:
+		return log.SeverityWarn
+	case level == zapError:
+		return log.SeverityError
+	case level == zapDPanic:
+		return log.SeverityFatal1
+	case level == zapPanic:
+		return log.SeverityFatal2
+	default:
+		return log.SeverityFatal3
+	}
+}
+
+// The github.com/apex/log levels. The package is not imported, the bridge
+// module provides it.
+const (
+	apexDebug = iota
+	apexInfo
+	apexWarn
+	apexError
+)
+
+// ApexLevelSeverity returns the [log.Severity] of the github.com/apex/log
+// level: DebugLevel, InfoLevel, WarnLevel, ErrorLevel, and FatalLevel are
+// [log.SeverityDebug], [log.SeverityInfo], [log.SeverityWarn],
+// [log.SeverityError], and [log.SeverityFatal]. InvalidLevel, and any other
+// level below DebugLevel, is [log.SeverityUndefined].
+//
+// The level is generic so the log.Level type of apex can be passed without
+// this package depending on apex.
+func ApexLevelSeverity[L ~int](level L) log.Severity {
+	switch {
+	case level < apexDebug:
+		return log.SeverityUndefined
+	case level == apexDebug:
+		return log.SeverityDebug
+	case level == apexInfo:
+		return log.SeverityInfo
+	case level == apexWarn:
+		return log.SeverityWarn
+	case level == apexError:
+		return log.SeverityError
+	default:
+		return log.SeverityFatal
+	}
+}
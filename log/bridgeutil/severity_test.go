@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestOffsetSeverity(t *testing.T) {
+	tests := []struct {
+		base  log.Severity
+		delta int
+		want  log.Severity
+	}{
+		{log.SeverityInfo, 0, log.SeverityInfo},
+		{log.SeverityInfo, 1, log.SeverityInfo2},
+		{log.SeverityInfo, -1, log.SeverityDebug4},
+		{log.SeverityInfo, -100, log.SeverityTrace1},
+		{log.SeverityInfo, 100, log.SeverityFatal4},
+		{log.SeverityUndefined, 0, log.SeverityTrace1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, OffsetSeverity(tt.base, tt.delta), "%s%+d", tt.base, tt.delta)
+	}
+}
+
+func TestVerbositySeverity(t *testing.T) {
+	assert.Equal(t, log.SeverityInfo, VerbositySeverity(0))
+	assert.Equal(t, log.SeverityDebug4, VerbositySeverity(1))
+	assert.Equal(t, log.SeverityDebug1, VerbositySeverity(4))
+	assert.Equal(t, log.SeverityTrace1, VerbositySeverity(8))
+	assert.Equal(t, log.SeverityTrace1, VerbositySeverity(100))
+	assert.Equal(t, log.SeverityInfo2, VerbositySeverity(-1))
+}
+
+func TestSlogLevelSeverity(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  log.Severity
+	}{
+		{slog.LevelDebug - 100, log.SeverityTrace1},
+		{slog.LevelDebug, log.SeverityDebug},
+		{slog.LevelDebug + 1, log.SeverityDebug2},
+		{slog.LevelInfo, log.SeverityInfo},
+		{slog.LevelWarn, log.SeverityWarn},
+		{slog.LevelError, log.SeverityError},
+		{slog.LevelError + 4, log.SeverityFatal},
+		{slog.LevelError + 100, log.SeverityFatal4},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, SlogLevelSeverity(tt.level), tt.level.String())
+	}
+
+	for s := log.SeverityTrace1; s <= log.SeverityFatal4; s++ {
+		assert.Equal(t, s, SlogLevelSeverity(SeveritySlogLevel(s)), s.String())
+	}
+	assert.Less(t, SeveritySlogLevel(log.SeverityUndefined), SeveritySlogLevel(log.SeverityTrace1))
+}
+
+// The levels of the logging libraries, declared with their own types.
+type (
+	logrusLevel uint32
+	zapLevel    int8
+	apexLevel   int
+)
+
+func TestLogrusLevelSeverity(t *testing.T) {
+	for level, want := range map[logrusLevel]log.Severity{
+		0: log.SeverityFatal2, // PanicLevel
+		1: log.SeverityFatal,  // FatalLevel
+		2: log.SeverityError,  // ErrorLevel
+		3: log.SeverityWarn,   // WarnLevel
+		4: log.SeverityInfo,   // InfoLevel
+		5: log.SeverityDebug,  // DebugLevel
+		6: log.SeverityTrace,  // TraceLevel
+	} {
+		assert.Equal(t, want, LogrusLevelSeverity(level), "level %d", level)
+	}
+}
+
+func TestZapLevelSeverity(t *testing.T) {
+	for level, want := range map[zapLevel]log.Severity{
+		-2: log.SeverityDebug,
+		-1: log.SeverityDebug,  // DebugLevel
+		0:  log.SeverityInfo,   // InfoLevel
+		1:  log.SeverityWarn,   // WarnLevel
+		2:  log.SeverityError,  // ErrorLevel
+		3:  log.SeverityFatal1, // DPanicLevel
+		4:  log.SeverityFatal2, // PanicLevel
+		5:  log.SeverityFatal3, // FatalLevel
+	} {
+		assert.Equal(t, want, ZapLevelSeverity(level), "level %d", level)
+	}
+}
+
+func TestApexLevelSeverity(t *testing.T) {
+	for level, want := range map[apexLevel]log.Severity{
+		-1: log.SeverityUndefined, // InvalidLevel
+		0:  log.SeverityDebug,     // DebugLevel
+		1:  log.SeverityInfo,      // InfoLevel
+		2:  log.SeverityWarn,      // WarnLevel
+		3:  log.SeverityError,     // ErrorLevel
+		4:  log.SeverityFatal,     // FatalLevel
+	} {
+		assert.Equal(t, want, ApexLevelSeverity(level), "level %d", level)
+	}
+}
+
+var outS log.Severity
+
+func BenchmarkSeverity(b *testing.B) {
+	b.Run("Slog", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outS = SlogLevelSeverity(slog.LevelWarn)
+		}
+	})
+	b.Run("Logrus", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outS = LogrusLevelSeverity(logrusLevel(3))
+		}
+	})
+	b.Run("Zap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outS = ZapLevelSeverity(zapLevel(1))
+		}
+	})
+	b.Run("Apex", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outS = ApexLevelSeverity(apexLevel(2))
+		}
+	})
+	b.Run("Verbosity", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			outS = VerbositySeverity(2)
+		}
+	})
+}
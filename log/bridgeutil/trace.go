@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceContext returns the context a bridge passes to a [log.Logger] to emit
+// a log record correlated with the span of ctx. The [log.Logger]
+// implementations, e.g. the one of go.opentelemetry.io/otel/sdk/log, set the
+// trace context of the log records from the span context held by the context
+// they are emitted with.
+//
+// If ctx is nil, [context.Background] is returned, so the log record is not
+// correlated. If sampledOnly is true and the span context of ctx is not
+// sampled, the returned context holds no span context, so the log record is
+// not correlated either. Other values of ctx, e.g. the baggage, are kept.
+func TraceContext(ctx context.Context, sampledOnly bool) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	if sampledOnly {
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() && !sc.IsSampled() {
+			return trace.ContextWithSpanContext(ctx, trace.SpanContext{})
+		}
+	}
+	return ctx
+}
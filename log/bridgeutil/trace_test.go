@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ctxKey struct{}
+
+func TestTraceContext(t *testing.T) {
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	notSampled := sampled.WithTraceFlags(0)
+
+	base := context.WithValue(context.Background(), ctxKey{}, "value")
+	sampledCtx := trace.ContextWithSpanContext(base, sampled)
+	notSampledCtx := trace.ContextWithSpanContext(base, notSampled)
+
+	//nolint:staticcheck // Passing a nil context is tested.
+	assert.Equal(t, context.Background(), TraceContext(nil, false))
+	assert.Equal(t, base, TraceContext(base, true))
+
+	assert.Equal(t, sampled, trace.SpanContextFromContext(TraceContext(sampledCtx, false)))
+	assert.Equal(t, sampled, trace.SpanContextFromContext(TraceContext(sampledCtx, true)))
+	assert.Equal(t, notSampled, trace.SpanContextFromContext(TraceContext(notSampledCtx, false)))
+
+	got := TraceContext(notSampledCtx, true)
+	assert.False(t, trace.SpanContextFromContext(got).IsValid(), "not sampled span context kept")
+	assert.Equal(t, "value", got.Value(ctxKey{}), "context values dropped")
+}
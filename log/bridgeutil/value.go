@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil // import "go.opentelemetry.io/otel/log/bridgeutil"
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// ConvertAny returns the [log.Value] of the Go value v.
+//
+// Booleans, numbers, and strings, including the ones of named types, are
+// converted to the values of the matching kind. Unsigned integers greater
+// than [math.MaxInt64] are converted to their decimal string. Times are
+// converted to their Unix time in nanoseconds, durations to nanoseconds. Byte
+// slices and arrays are converted to [log.KindBytes] values, other slices and
+// arrays to [log.KindSlice] values, and maps to [log.KindMap] values sorted by
+// key. Pointers and interfaces are dereferenced, nil ones are converted to
+// the empty value. Errors and [fmt.Stringer] values are converted to the
+// string they return, or to the panic message if they panic. Other values are
+// converted to their string representation.
+func ConvertAny(v any) log.Value {
+	switch val := v.(type) {
+	case nil:
+		return log.Value{}
+	case log.Value:
+		return val
+	case string:
+		return log.StringValue(val)
+	case bool:
+		return log.BoolValue(val)
+	case int:
+		return log.IntValue(val)
+	case int64:
+		return log.Int64Value(val)
+	case float64:
+		return log.Float64Value(val)
+	case []byte:
+		return log.BytesValue(val)
+	case time.Time:
+		return TimeValue(val)
+	case time.Duration:
+		return log.Int64Value(val.Nanoseconds())
+	case error:
+		return log.StringValue(ErrorString(val))
+	case fmt.Stringer:
+		return log.StringValue(StringerString(val))
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return log.BoolValue(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return log.Int64Value(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Uint64Value(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return log.Float64Value(rv.Float())
+	case reflect.String:
+		return log.StringValue(rv.String())
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return log.BytesValue(b)
+		}
+		s := make([]log.Value, rv.Len())
+		for i := range s {
+			s[i] = ConvertAny(rv.Index(i).Interface())
+		}
+		return log.SliceValue(s...)
+	case reflect.Map:
+		kvs := make([]log.KeyValue, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			kvs = append(kvs, log.KeyValue{
+				Key:   fmt.Sprint(iter.Key().Interface()),
+				Value: ConvertAny(iter.Value().Interface()),
+			})
+		}
+		slices.SortFunc(kvs, func(a, b log.KeyValue) int {
+			return cmp.Compare(a.Key, b.Key)
+		})
+		return log.MapValue(kvs...)
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return log.Value{}
+		}
+		return ConvertAny(rv.Elem().Interface())
+	}
+	return log.StringValue(fmt.Sprintf("%+v", v))
+}
+
+// Uint64Value returns the [log.Value] of u: an int64 value, or its decimal
+// string if it overflows an int64.
+func Uint64Value(u uint64) log.Value {
+	if u > math.MaxInt64 {
+		return log.StringValue(strconv.FormatUint(u, 10))
+	}
+	return log.Int64Value(int64(u))
+}
+
+// minTime and maxTime are the times representable in Unix nanoseconds.
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
+
+// TimeValue returns the [log.Value] of t: its Unix time in nanoseconds, or
+// its RFC 3339 representation if it cannot be represented in Unix
+// nanoseconds.
+func TimeValue(t time.Time) log.Value {
+	if t.Before(minTime) || t.After(maxTime) {
+		return log.StringValue(t.Format(time.RFC3339Nano))
+	}
+	return log.Int64Value(t.UnixNano())
+}
+
+// ErrorString returns the message of err. If calling the Error method of err
+// panics, the string "<PANIC=...>" holding the panic value is returned
+// instead, or "<nil>" if err is a nil pointer.
+func ErrorString(err error) string {
+	return safeString(err, err.Error)
+}
+
+// StringerString returns the string s returns. If calling the String method
+// of s panics, the string "<PANIC=...>" holding the panic value is returned
+// instead, or "<nil>" if s is a nil pointer.
+func StringerString(s fmt.Stringer) string {
+	return safeString(s, s.String)
+}
+
+// safeString returns the string returned by f, the Error or String method of
+// v, or the string describing its panic.
+func safeString(v any, f func() string) (s string) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+				s = "<nil>"
+				return
+			}
+			s = fmt.Sprintf("<PANIC=%v>", r)
+		}
+	}()
+	return f()
+}
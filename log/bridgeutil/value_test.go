@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bridgeutil
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+type panicStringer struct{}
+
+func (panicStringer) String() string { panic("boom") }
+
+type valueStringer struct{ s string }
+
+func (v valueStringer) String() string { return v.s }
+
+func TestConvertAny(t *testing.T) {
+	i := 1
+	var nilPtr *int
+	var nilStringer *valueStringer
+	tests := []struct {
+		name string
+		v    any
+		want log.Value
+	}{
+		{"nil", nil, log.Value{}},
+		{"value", log.StringValue("v"), log.StringValue("v")},
+		{"bool", true, log.BoolValue(true)},
+		{"int", 1, log.Int64Value(1)},
+		{"int8", int8(1), log.Int64Value(1)},
+		{"uint", uint8(1), log.Int64Value(1)},
+		{"uint overflow", uint64(math.MaxUint64), log.StringValue("18446744073709551615")},
+		{"float", float32(1.5), log.Float64Value(1.5)},
+		{"string", "s", log.StringValue("s")},
+		{"bytes", []byte{1}, log.BytesValue([]byte{1})},
+		{"byte array", [1]byte{1}, log.BytesValue([]byte{1})},
+		{"time", time.Unix(0, 1000), log.Int64Value(1000)},
+		{"time overflow", time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC), log.StringValue("3000-01-01T00:00:00Z")},
+		{"duration", time.Second, log.Int64Value(int64(time.Second))},
+		{"slice", []any{1, "a"}, log.SliceValue(log.Int64Value(1), log.StringValue("a"))},
+		{"map", map[int]bool{2: false, 1: true}, log.MapValue(log.Bool("1", true), log.Bool("2", false))},
+		{"pointer", &i, log.Int64Value(1)},
+		{"nil pointer", nilPtr, log.Value{}},
+		{"error", errors.New("err"), log.StringValue("err")},
+		{"stringer", stringer{}, log.StringValue("stringer")},
+		{"panic stringer", panicStringer{}, log.StringValue("<PANIC=boom>")},
+		{"nil stringer", nilStringer, log.StringValue("<nil>")},
+		{"struct", struct{ A int }{1}, log.StringValue("{A:1}")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ConvertAny(tt.v))
+		})
+	}
+}
+
+type panicError struct{}
+
+func (*panicError) Error() string { panic("boom") }
+
+func TestErrorString(t *testing.T) {
+	assert.Equal(t, "err", ErrorString(errors.New("err")))
+	assert.Equal(t, "<PANIC=boom>", ErrorString(&panicError{}))
+	var nilErr *panicError
+	assert.Equal(t, "<nil>", ErrorString(nilErr))
+}
+
+func TestStringerString(t *testing.T) {
+	assert.Equal(t, "stringer", StringerString(stringer{}))
+	assert.Equal(t, "<PANIC=boom>", StringerString(panicStringer{}))
+	var nilStringer *valueStringer
+	assert.Equal(t, "<nil>", StringerString(nilStringer))
+}
+
+func TestUint64Value(t *testing.T) {
+	assert.Equal(t, log.Int64Value(1), Uint64Value(1))
+	assert.Equal(t, log.Int64Value(math.MaxInt64), Uint64Value(math.MaxInt64))
+	assert.Equal(t, log.StringValue("9223372036854775808"), Uint64Value(math.MaxInt64+1))
+}
+
+func TestTimeValue(t *testing.T) {
+	assert.Equal(t, log.Int64Value(1000), TimeValue(time.Unix(0, 1000)))
+	early := time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, log.StringValue("1000-01-01T00:00:00Z"), TimeValue(early))
+}
+
+var outV log.Value
+
+func BenchmarkConvertAny(b *testing.B) {
+	for _, bb := range []struct {
+		name string
+		v    any
+	}{
+		{"String", "value"},
+		{"Int", 42},
+		{"Uint32", uint32(42)},
+		{"Time", time.Unix(0, 0)},
+		{"Stringer", stringer{}},
+		{"Slice", []int{1, 2, 3}},
+		{"Map", map[string]int{"a": 1, "b": 2}},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				outV = ConvertAny(bb.v)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ func TestRecordFactory(t *testing.T) {
 	}
 
 	got := RecordFactory{
+		EventName:         "testing event",
 		Timestamp:         now,
 		ObservedTimestamp: observed,
 		Severity:          severity,
@@ -34,6 +35,7 @@ func TestRecordFactory(t *testing.T) {
 		Attributes:        attrs,
 	}.NewRecord()
 
+	assert.Equal(t, "testing event", got.EventName())
 	assert.Equal(t, now, got.Timestamp())
 	assert.Equal(t, observed, got.ObservedTimestamp())
 	assert.Equal(t, severity, got.Severity())
@@ -76,6 +76,9 @@ type ScopeRecords struct {
 
 	// Records are the log records this instrumentation scope recorded.
 	Records []log.Record
+	// Contexts are the contexts passed to Emit with the Records. The context
+	// of Records[i] is Contexts[i].
+	Contexts []context.Context
 }
 
 // Recorder is a recorder that stores all received log records
@@ -127,12 +130,13 @@ func (r *Recorder) Enabled(ctx context.Context, record log.Record) bool {
 	return r.enabledFn(ctx, record)
 }
 
-// Emit stores the log record.
-func (r *Recorder) Emit(_ context.Context, record log.Record) {
+// Emit stores the log record and the context it is emitted with.
+func (r *Recorder) Emit(ctx context.Context, record log.Record) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	r.currentScopeRecord.Records = append(r.currentScopeRecord.Records, record)
+	r.currentScopeRecord.Contexts = append(r.currentScopeRecord.Contexts, ctx)
 }
 
 // Result returns the current in-memory recorder log records.
@@ -148,6 +152,26 @@ func (r *Recorder) Result() []*ScopeRecords {
 	return ret
 }
 
+// Records returns the log records stored by r and all its Loggers, in the
+// order of Result.
+func (r *Recorder) Records() []log.Record {
+	var out []log.Record
+	for _, sr := range r.Result() {
+		out = append(out, sr.Records...)
+	}
+	return out
+}
+
+// Contexts returns the contexts the log records returned by Records are
+// emitted with, in the same order.
+func (r *Recorder) Contexts() []context.Context {
+	var out []context.Context
+	for _, sr := range r.Result() {
+		out = append(out, sr.Contexts...)
+	}
+	return out
+}
+
 // Reset clears the in-memory log records.
 func (r *Recorder) Reset() {
 	r.mu.Lock()
@@ -155,8 +179,19 @@ func (r *Recorder) Reset() {
 
 	if r.currentScopeRecord != nil {
 		r.currentScopeRecord.Records = nil
+		r.currentScopeRecord.Contexts = nil
 	}
 	for _, l := range r.loggers {
 		l.Reset()
 	}
 }
+
+// Attributes returns the attributes of record, in order.
+func Attributes(record log.Record) []log.KeyValue {
+	out := make([]log.KeyValue, 0, record.AttributesLen())
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		out = append(out, kv)
+		return true
+	})
+	return out
+}
@@ -129,6 +129,35 @@ func TestRecorderEmitAndReset(t *testing.T) {
 	r.Reset()
 	assert.Empty(t, r.Result()[0].Records)
 	assert.Empty(t, r.Result()[1].Records)
+	assert.Empty(t, r.Result()[0].Contexts)
+	assert.Empty(t, r.Result()[1].Contexts)
+}
+
+type ctxKey struct{}
+
+func TestRecorderRecordsAndContexts(t *testing.T) {
+	ctx0 := context.WithValue(context.Background(), ctxKey{}, 0)
+	ctx1 := context.WithValue(context.Background(), ctxKey{}, 1)
+
+	r := NewRecorder()
+	var r0, r1 log.Record
+	r0.SetBody(log.IntValue(0))
+	r1.SetBody(log.IntValue(1))
+	r.Logger("a").Emit(ctx0, r0)
+	r.Logger("b").Emit(ctx1, r1)
+
+	assert.Equal(t, []log.Record{r0, r1}, r.Records())
+	assert.Equal(t, []context.Context{ctx0, ctx1}, r.Contexts())
+	assert.Equal(t, []context.Context{ctx1}, r.Result()[2].Contexts)
+}
+
+func TestAttributes(t *testing.T) {
+	var r log.Record
+	assert.Empty(t, Attributes(r))
+
+	attrs := []log.KeyValue{log.String("a", "1"), log.Int("b", 2)}
+	r.AddAttributes(attrs...)
+	assert.Equal(t, attrs, Attributes(r))
 }
 
 func TestRecorderConcurrentSafe(t *testing.T) {
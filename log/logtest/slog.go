@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/log/logtest"
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// severityOffset is the difference between a log.Severity and the
+// slog.Level of the same severity (e.g. log.SeverityInfo and slog.LevelInfo).
+const severityOffset = log.SeverityInfo - log.Severity(slog.LevelInfo)
+
+// ToSlogRecord returns a [slog.Record] converted from r. It allows reusing
+// assertions written for slog (e.g. with [testing/slogtest]) on the records
+// emitted to a [Recorder].
+//
+// The body of r is used as the message if it is a string. Otherwise, the
+// string representation of the body is used. The severity of r is converted
+// to the slog.Level of the same severity (e.g. [log.SeverityWarn] to
+// [slog.LevelWarn]). The attributes of r are converted to slog attributes,
+// with map values converted to groups. The observed timestamp and severity
+// text of r are not converted.
+func ToSlogRecord(r log.Record) slog.Record {
+	var msg string
+	if body := r.Body(); body.Kind() == log.KindString {
+		msg = body.AsString()
+	} else if body.Kind() != log.KindEmpty {
+		msg = body.String()
+	}
+
+	level := slog.Level(r.Severity() - severityOffset)
+	record := slog.NewRecord(r.Timestamp(), level, msg, 0)
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		record.AddAttrs(toSlogAttr(kv))
+		return true
+	})
+	return record
+}
+
+func toSlogAttr(kv log.KeyValue) slog.Attr {
+	if kv.Value.Kind() == log.KindMap {
+		m := kv.Value.AsMap()
+		attrs := make([]any, len(m))
+		for i, v := range m {
+			attrs[i] = toSlogAttr(v)
+		}
+		return slog.Group(kv.Key, attrs...)
+	}
+	return slog.Any(kv.Key, toAny(kv.Value))
+}
+
+// toAny returns the Go value of v.
+func toAny(v log.Value) any {
+	switch v.Kind() {
+	case log.KindBool:
+		return v.AsBool()
+	case log.KindFloat64:
+		return v.AsFloat64()
+	case log.KindInt64:
+		return v.AsInt64()
+	case log.KindString:
+		return v.AsString()
+	case log.KindBytes:
+		return v.AsBytes()
+	case log.KindSlice:
+		s := v.AsSlice()
+		out := make([]any, len(s))
+		for i, e := range s {
+			out[i] = toAny(e)
+		}
+		return out
+	case log.KindMap:
+		m := v.AsMap()
+		out := make(map[string]any, len(m))
+		for _, kv := range m {
+			out[kv.Key] = toAny(kv.Value)
+		}
+		return out
+	}
+	return nil
+}
+
+// FromSlogRecord returns a [log.Record] converted from r. It allows using
+// slog records as the expected records of assertions on the records emitted
+// to a [Recorder].
+//
+// The message of r is used as a string body. The level of r is converted to
+// the [log.Severity] of the same severity (e.g. [slog.LevelWarn] to
+// [log.SeverityWarn]) and its string representation is used as the severity
+// text. The attributes of r are converted to log attributes, with groups
+// converted to map values. Time values are converted to their Unix time in
+// nanoseconds and durations to their count of nanoseconds. Values of other
+// types are converted to their string representation.
+func FromSlogRecord(r slog.Record) log.Record {
+	var record log.Record
+	record.SetTimestamp(r.Time)
+	record.SetBody(log.StringValue(r.Message))
+	record.SetSeverity(log.Severity(r.Level) + severityOffset)
+	record.SetSeverityText(r.Level.String())
+	r.Attrs(func(a slog.Attr) bool {
+		record.AddAttributes(fromSlogAttr(a))
+		return true
+	})
+	return record
+}
+
+func fromSlogAttr(a slog.Attr) log.KeyValue {
+	return log.KeyValue{Key: a.Key, Value: fromSlogValue(a.Value)}
+}
+
+func fromSlogValue(v slog.Value) log.Value {
+	switch v.Kind() {
+	case slog.KindBool:
+		return log.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return log.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindFloat64:
+		return log.Float64Value(v.Float64())
+	case slog.KindInt64:
+		return log.Int64Value(v.Int64())
+	case slog.KindString:
+		return log.StringValue(v.String())
+	case slog.KindTime:
+		return log.Int64Value(v.Time().UnixNano())
+	case slog.KindUint64:
+		u := v.Uint64()
+		if u > math.MaxInt64 {
+			return log.StringValue(v.String())
+		}
+		return log.Int64Value(int64(u))
+	case slog.KindGroup:
+		attrs := v.Group()
+		kvs := make([]log.KeyValue, len(attrs))
+		for i, a := range attrs {
+			kvs[i] = fromSlogAttr(a)
+		}
+		return log.MapValue(kvs...)
+	case slog.KindLogValuer:
+		return fromSlogValue(v.Resolve())
+	}
+	return fromAny(v.Any())
+}
+
+// fromAny returns the log.Value of the Go value v.
+func fromAny(v any) log.Value {
+	switch val := v.(type) {
+	case nil:
+		return log.Value{}
+	case []byte:
+		return log.BytesValue(val)
+	case []any:
+		s := make([]log.Value, len(val))
+		for i, e := range val {
+			s[i] = fromAny(e)
+		}
+		return log.SliceValue(s...)
+	case map[string]any:
+		kvs := make([]log.KeyValue, 0, len(val))
+		for k, e := range val {
+			kvs = append(kvs, log.KeyValue{Key: k, Value: fromAny(e)})
+		}
+		return log.MapValue(kvs...)
+	case bool:
+		return log.BoolValue(val)
+	case float64:
+		return log.Float64Value(val)
+	case int64:
+		return log.Int64Value(val)
+	case int:
+		return log.IntValue(val)
+	case string:
+		return log.StringValue(val)
+	case time.Duration:
+		return log.Int64Value(val.Nanoseconds())
+	case time.Time:
+		return log.Int64Value(val.UnixNano())
+	}
+	return log.StringValue(fmt.Sprint(v))
+}
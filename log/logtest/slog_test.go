@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"log/slog"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func slogAttrs(r slog.Record) []slog.Attr {
+	var attrs []slog.Attr
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
+
+func logAttrs(r log.Record) []log.KeyValue {
+	var attrs []log.KeyValue
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+	return attrs
+}
+
+func TestToSlogRecord(t *testing.T) {
+	ts := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	r := RecordFactory{
+		Timestamp:    ts,
+		Severity:     log.SeverityWarn,
+		SeverityText: "WARN",
+		Body:         log.StringValue("msg"),
+		Attributes: []log.KeyValue{
+			log.Bool("bool", true),
+			log.Float64("float64", 1.5),
+			log.Int64("int64", -1),
+			log.String("string", "str"),
+			log.Bytes("bytes", []byte("b")),
+			log.Slice("slice", log.IntValue(1), log.StringValue("s")),
+			log.Map("map", log.Int("a", 1), log.Map("b", log.Bool("c", true))),
+			log.Empty("empty"),
+		},
+	}.NewRecord()
+
+	got := ToSlogRecord(r)
+	assert.Equal(t, ts, got.Time)
+	assert.Equal(t, slog.LevelWarn, got.Level)
+	assert.Equal(t, "msg", got.Message)
+	assert.Equal(t, []slog.Attr{
+		slog.Bool("bool", true),
+		slog.Float64("float64", 1.5),
+		slog.Int64("int64", -1),
+		slog.String("string", "str"),
+		slog.Any("bytes", []byte("b")),
+		slog.Any("slice", []any{int64(1), "s"}),
+		slog.Group("map", slog.Int64("a", 1), slog.Group("b", slog.Bool("c", true))),
+		slog.Any("empty", nil),
+	}, slogAttrs(got))
+}
+
+func TestToSlogRecordBody(t *testing.T) {
+	r := RecordFactory{Body: log.IntValue(1)}.NewRecord()
+	assert.Equal(t, "1", ToSlogRecord(r).Message)
+
+	r = RecordFactory{}.NewRecord()
+	assert.Equal(t, "", ToSlogRecord(r).Message)
+}
+
+func TestSeverityConversion(t *testing.T) {
+	for level, sev := range map[slog.Level]log.Severity{
+		slog.LevelDebug: log.SeverityDebug,
+		slog.LevelInfo:  log.SeverityInfo,
+		slog.LevelWarn:  log.SeverityWarn,
+		slog.LevelError: log.SeverityError,
+	} {
+		r := RecordFactory{Severity: sev}.NewRecord()
+		assert.Equal(t, level, ToSlogRecord(r).Level, "ToSlogRecord %v", sev)
+
+		sr := slog.NewRecord(time.Time{}, level, "", 0)
+		got := FromSlogRecord(sr)
+		assert.Equal(t, sev, got.Severity(), "FromSlogRecord %v", level)
+		assert.Equal(t, level.String(), got.SeverityText(), "FromSlogRecord %v", level)
+	}
+}
+
+type valuer struct{}
+
+func (valuer) LogValue() slog.Value { return slog.StringValue("resolved") }
+
+func TestFromSlogRecord(t *testing.T) {
+	ts := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	r := slog.NewRecord(ts, slog.LevelInfo, "msg", 0)
+	r.AddAttrs(
+		slog.Bool("bool", true),
+		slog.Duration("duration", time.Second),
+		slog.Float64("float64", 1.5),
+		slog.Int64("int64", -1),
+		slog.String("string", "str"),
+		slog.Time("time", ts),
+		slog.Uint64("uint64", 1),
+		slog.Uint64("uint64Overflow", math.MaxUint64),
+		slog.Group("group", slog.Int("a", 1)),
+		slog.Any("valuer", valuer{}),
+		slog.Any("bytes", []byte("b")),
+		slog.Any("slice", []any{1, "s"}),
+		slog.Any("nil", nil),
+		slog.Any("struct", struct{ A int }{A: 1}),
+	)
+
+	got := FromSlogRecord(r)
+	assert.Equal(t, ts, got.Timestamp())
+	assert.Equal(t, log.SeverityInfo, got.Severity())
+	assert.Equal(t, log.StringValue("msg"), got.Body())
+
+	want := []log.KeyValue{
+		log.Bool("bool", true),
+		log.Int64("duration", int64(time.Second)),
+		log.Float64("float64", 1.5),
+		log.Int64("int64", -1),
+		log.String("string", "str"),
+		log.Int64("time", ts.UnixNano()),
+		log.Int64("uint64", 1),
+		log.String("uint64Overflow", "18446744073709551615"),
+		log.Map("group", log.Int("a", 1)),
+		log.String("valuer", "resolved"),
+		log.Bytes("bytes", []byte("b")),
+		log.Slice("slice", log.IntValue(1), log.StringValue("s")),
+		log.Empty("nil"),
+		log.String("struct", "{1}"),
+	}
+	attrs := logAttrs(got)
+	require.Len(t, attrs, len(want))
+	for i := range want {
+		assert.Truef(t, want[i].Equal(attrs[i]), "%d: want %v, got %v", i, want[i], attrs[i])
+	}
+}
+
+func TestSlogRoundTrip(t *testing.T) {
+	r := RecordFactory{
+		Timestamp: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Severity:  log.SeverityError,
+		Body:      log.StringValue("msg"),
+		Attributes: []log.KeyValue{
+			log.String("key", "value"),
+			log.Map("map", log.Int("a", 1)),
+			log.Slice("slice", log.BoolValue(true)),
+		},
+	}.NewRecord()
+
+	got := FromSlogRecord(ToSlogRecord(r))
+	assert.Equal(t, r.Timestamp(), got.Timestamp())
+	assert.Equal(t, r.Severity(), got.Severity())
+	assert.True(t, r.Body().Equal(got.Body()))
+
+	want, attrs := logAttrs(r), logAttrs(got)
+	require.Len(t, attrs, len(want))
+	for i := range want {
+		assert.Truef(t, want[i].Equal(attrs[i]), "%d: want %v, got %v", i, want[i], attrs[i])
+	}
+}
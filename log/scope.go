@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import "go.opentelemetry.io/otel"
+
+// ScopeLogger returns a [Logger] with the name, version, schema URL, and
+// attributes of s from p.
+//
+// Use it with [otel.ScopeFromContext] to emit log records with the
+// instrumentation scope carried by a context.
+func ScopeLogger(p LoggerProvider, s otel.Scope) Logger {
+	var opts []LoggerOption
+	if s.Version != "" {
+		opts = append(opts, WithInstrumentationVersion(s.Version))
+	}
+	if s.SchemaURL != "" {
+		opts = append(opts, WithSchemaURL(s.SchemaURL))
+	}
+	if len(s.Attributes) > 0 {
+		opts = append(opts, WithInstrumentationAttributes(s.Attributes...))
+	}
+	return p.Logger(s.Name, opts...)
+}
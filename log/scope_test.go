@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+type scopeLoggerProvider struct {
+	noop.LoggerProvider
+
+	name string
+	cfg  log.LoggerConfig
+}
+
+func (p *scopeLoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	p.name, p.cfg = name, log.NewLoggerConfig(opts...)
+	return p.LoggerProvider.Logger(name, opts...)
+}
+
+func TestScopeLogger(t *testing.T) {
+	s := otel.Scope{
+		Name:       "go.opentelemetry.io/otel/log/test",
+		Version:    "v1.2.3",
+		SchemaURL:  "https://opentelemetry.io/schemas/1.0.0",
+		Attributes: []attribute.KeyValue{attribute.String("k", "v")},
+	}
+	p := &scopeLoggerProvider{}
+	_ = log.ScopeLogger(p, s)
+
+	assert.Equal(t, s.Name, p.name)
+	assert.Equal(t, s.Version, p.cfg.InstrumentationVersion())
+	assert.Equal(t, s.SchemaURL, p.cfg.SchemaURL())
+	assert.Equal(t, attribute.NewSet(s.Attributes...), p.cfg.InstrumentationAttributes())
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package instrumentx provides convenience wrappers around the synchronous
+// instruments of [go.opentelemetry.io/otel/metric].
+//
+// The wrappers take the context first and are bound to a set of attributes
+// when they are created. The attributes are converted to an
+// [attribute.Set] once, instead of for each measurement. The attributes
+// passed as options to a measurement are merged with the bound attributes,
+// the ones passed to the measurement taking precedence.
+//
+//	requests := instrumentx.NewCounter(counter, attribute.String("method", "GET"))
+//	requests.Inc(ctx)
+//
+//	latency := instrumentx.NewHistogram(histogram, attribute.String("method", "GET"))
+//	start := time.Now()
+//	// ...
+//	latency.Since(ctx, start)
+package instrumentx // import "go.opentelemetry.io/otel/metric/instrumentx"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// merge returns the union of set and attrs. Attributes of attrs take
+// precedence over the ones of set with the same key.
+func merge(set attribute.Set, attrs []attribute.KeyValue) attribute.Set {
+	kvs := make([]attribute.KeyValue, 0, set.Len()+len(attrs))
+	kvs = append(kvs, set.ToSlice()...)
+	// NewSet keeps the last value of duplicate keys.
+	kvs = append(kvs, attrs...)
+	return attribute.NewSet(kvs...)
+}
+
+// Counter is an [metric.Int64Counter] bound to a set of attributes.
+//
+// A Counter must be created with [NewCounter].
+type Counter struct {
+	inst metric.Int64Counter
+	set  attribute.Set
+	opts []metric.AddOption
+}
+
+// NewCounter returns a [Counter] recording measurements with inst and attrs.
+// If inst is nil, the measurements are dropped.
+func NewCounter(inst metric.Int64Counter, attrs ...attribute.KeyValue) Counter {
+	if inst == nil {
+		inst = noop.Int64Counter{}
+	}
+	return newCounter(inst, attribute.NewSet(attrs...))
+}
+
+func newCounter(inst metric.Int64Counter, set attribute.Set) Counter {
+	return Counter{
+		inst: inst,
+		set:  set,
+		opts: []metric.AddOption{metric.WithAttributeSet(set)},
+	}
+}
+
+// With returns a copy of c also bound to attrs. Attributes of attrs take
+// precedence over the bound attributes of c with the same key.
+func (c Counter) With(attrs ...attribute.KeyValue) Counter {
+	return newCounter(c.inst, merge(c.set, attrs))
+}
+
+// Inc increments the counter by one.
+func (c Counter) Inc(ctx context.Context) {
+	c.inst.Add(ctx, 1, c.opts...)
+}
+
+// Add increments the counter by incr. The incr value must be non-negative.
+func (c Counter) Add(ctx context.Context, incr int64, opts ...metric.AddOption) {
+	c.inst.Add(ctx, incr, addOptions(c.opts, opts)...)
+}
+
+// UpDownCounter is an [metric.Int64UpDownCounter] bound to a set of
+// attributes.
+//
+// An UpDownCounter must be created with [NewUpDownCounter].
+type UpDownCounter struct {
+	inst metric.Int64UpDownCounter
+	set  attribute.Set
+	opts []metric.AddOption
+}
+
+// NewUpDownCounter returns an [UpDownCounter] recording measurements with
+// inst and attrs. If inst is nil, the measurements are dropped.
+func NewUpDownCounter(inst metric.Int64UpDownCounter, attrs ...attribute.KeyValue) UpDownCounter {
+	if inst == nil {
+		inst = noop.Int64UpDownCounter{}
+	}
+	return newUpDownCounter(inst, attribute.NewSet(attrs...))
+}
+
+func newUpDownCounter(inst metric.Int64UpDownCounter, set attribute.Set) UpDownCounter {
+	return UpDownCounter{
+		inst: inst,
+		set:  set,
+		opts: []metric.AddOption{metric.WithAttributeSet(set)},
+	}
+}
+
+// With returns a copy of c also bound to attrs. Attributes of attrs take
+// precedence over the bound attributes of c with the same key.
+func (c UpDownCounter) With(attrs ...attribute.KeyValue) UpDownCounter {
+	return newUpDownCounter(c.inst, merge(c.set, attrs))
+}
+
+// Inc increments the counter by one.
+func (c UpDownCounter) Inc(ctx context.Context) {
+	c.inst.Add(ctx, 1, c.opts...)
+}
+
+// Dec decrements the counter by one.
+func (c UpDownCounter) Dec(ctx context.Context) {
+	c.inst.Add(ctx, -1, c.opts...)
+}
+
+// Add adds incr to the counter. The incr value can be negative.
+func (c UpDownCounter) Add(ctx context.Context, incr int64, opts ...metric.AddOption) {
+	c.inst.Add(ctx, incr, addOptions(c.opts, opts)...)
+}
+
+// addOptions returns bound followed by opts. The bound slice is not modified.
+func addOptions(bound, opts []metric.AddOption) []metric.AddOption {
+	if len(opts) == 0 {
+		return bound
+	}
+	all := make([]metric.AddOption, 0, len(bound)+len(opts))
+	all = append(all, bound...)
+	return append(all, opts...)
+}
+
+// Histogram is a [metric.Float64Histogram] bound to a set of attributes.
+//
+// A Histogram must be created with [NewHistogram].
+type Histogram struct {
+	inst metric.Float64Histogram
+	set  attribute.Set
+	opts []metric.RecordOption
+}
+
+// NewHistogram returns a [Histogram] recording measurements with inst and
+// attrs. If inst is nil, the measurements are dropped.
+func NewHistogram(inst metric.Float64Histogram, attrs ...attribute.KeyValue) Histogram {
+	if inst == nil {
+		inst = noop.Float64Histogram{}
+	}
+	return newHistogram(inst, attribute.NewSet(attrs...))
+}
+
+func newHistogram(inst metric.Float64Histogram, set attribute.Set) Histogram {
+	return Histogram{
+		inst: inst,
+		set:  set,
+		opts: []metric.RecordOption{metric.WithAttributeSet(set)},
+	}
+}
+
+// With returns a copy of h also bound to attrs. Attributes of attrs take
+// precedence over the bound attributes of h with the same key.
+func (h Histogram) With(attrs ...attribute.KeyValue) Histogram {
+	return newHistogram(h.inst, merge(h.set, attrs))
+}
+
+// Record records incr.
+func (h Histogram) Record(ctx context.Context, incr float64, opts ...metric.RecordOption) {
+	h.inst.Record(ctx, incr, recordOptions(h.opts, opts)...)
+}
+
+// Since records the time elapsed since start, in seconds. It is intended to
+// be used with a histogram with the "s" unit.
+//
+//	defer h.Since(ctx, time.Now())
+func (h Histogram) Since(ctx context.Context, start time.Time, opts ...metric.RecordOption) {
+	h.Record(ctx, time.Since(start).Seconds(), opts...)
+}
+
+// recordOptions returns bound followed by opts. The bound slice is not
+// modified.
+func recordOptions(bound, opts []metric.RecordOption) []metric.RecordOption {
+	if len(opts) == 0 {
+		return bound
+	}
+	all := make([]metric.RecordOption, 0, len(bound)+len(opts))
+	all = append(all, bound...)
+	return append(all, opts...)
+}
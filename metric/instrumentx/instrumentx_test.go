@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package instrumentx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type measurement[N int64 | float64] struct {
+	value N
+	attrs attribute.Set
+}
+
+type counter struct {
+	noop.Int64Counter
+
+	got []measurement[int64]
+}
+
+func (c *counter) Add(_ context.Context, v int64, opts ...metric.AddOption) {
+	cfg := metric.NewAddConfig(opts)
+	c.got = append(c.got, measurement[int64]{v, cfg.Attributes()})
+}
+
+type upDownCounter struct {
+	noop.Int64UpDownCounter
+
+	got []measurement[int64]
+}
+
+func (c *upDownCounter) Add(_ context.Context, v int64, opts ...metric.AddOption) {
+	cfg := metric.NewAddConfig(opts)
+	c.got = append(c.got, measurement[int64]{v, cfg.Attributes()})
+}
+
+type histogram struct {
+	noop.Float64Histogram
+
+	got []measurement[float64]
+}
+
+func (h *histogram) Record(_ context.Context, v float64, opts ...metric.RecordOption) {
+	cfg := metric.NewRecordConfig(opts)
+	h.got = append(h.got, measurement[float64]{v, cfg.Attributes()})
+}
+
+var (
+	ctx = context.Background()
+
+	a = attribute.String("a", "a")
+	b = attribute.String("b", "b")
+)
+
+func TestCounter(t *testing.T) {
+	inst := &counter{}
+	c := NewCounter(inst, a)
+	c.Inc(ctx)
+	c.Add(ctx, 2, metric.WithAttributes(b))
+	c.With(b).Inc(ctx)
+
+	assert.Equal(t, []measurement[int64]{
+		{1, attribute.NewSet(a)},
+		{2, attribute.NewSet(a, b)},
+		{1, attribute.NewSet(a, b)},
+	}, inst.got)
+}
+
+func TestUpDownCounter(t *testing.T) {
+	inst := &upDownCounter{}
+	c := NewUpDownCounter(inst, a)
+	c.Inc(ctx)
+	c.Dec(ctx)
+	c.Add(ctx, -3, metric.WithAttributes(b))
+
+	assert.Equal(t, []measurement[int64]{
+		{1, attribute.NewSet(a)},
+		{-1, attribute.NewSet(a)},
+		{-3, attribute.NewSet(a, b)},
+	}, inst.got)
+}
+
+func TestHistogram(t *testing.T) {
+	inst := &histogram{}
+	h := NewHistogram(inst, a)
+	h.Record(ctx, 1.5)
+	h.Since(ctx, time.Now().Add(-time.Second))
+
+	require.Len(t, inst.got, 2)
+	assert.Equal(t, measurement[float64]{1.5, attribute.NewSet(a)}, inst.got[0])
+	assert.GreaterOrEqual(t, inst.got[1].value, 1.0)
+	assert.Equal(t, attribute.NewSet(a), inst.got[1].attrs)
+}
+
+func TestWithPrecedence(t *testing.T) {
+	inst := &histogram{}
+	override := attribute.String("a", "override")
+	h := NewHistogram(inst, a, b)
+	h.With(override).Record(ctx, 1)
+	h.Record(ctx, 2, metric.WithAttributes(override))
+	// The parent is not modified.
+	h.Record(ctx, 3)
+
+	assert.Equal(t, []measurement[float64]{
+		{1, attribute.NewSet(override, b)},
+		{2, attribute.NewSet(override, b)},
+		{3, attribute.NewSet(a, b)},
+	}, inst.got)
+}
+
+func TestNilInstrument(t *testing.T) {
+	assert.NotPanics(t, func() {
+		NewCounter(nil).Inc(ctx)
+		NewUpDownCounter(nil).Dec(ctx)
+		NewHistogram(nil).Since(ctx, time.Now())
+	})
+}
+
+func TestBoundAllocs(t *testing.T) {
+	c := NewCounter(noop.Int64Counter{}, a, b)
+	assert.Zero(t, testing.AllocsPerRun(10, func() { c.Inc(ctx) }))
+}
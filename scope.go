@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel // import "go.opentelemetry.io/otel"
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Scope is an instrumentation scope: the identity of the instrumentation
+// library producing telemetry.
+//
+// A Scope can be carried by a context using ContextWithScope. Helpers called
+// by an instrumentation library can then create Tracers and Meters with the
+// scope of their caller instead of their own, using ScopeFromContext.
+type Scope struct {
+	// Name is the name of the instrumentation scope, e.g. the import path
+	// of the instrumentation library.
+	Name string
+	// Version is the version of the instrumentation scope.
+	Version string
+	// SchemaURL is the schema URL of the telemetry emitted by the scope.
+	SchemaURL string
+	// Attributes are the attributes of the instrumentation scope.
+	Attributes []attribute.KeyValue
+}
+
+// Tracer returns a Tracer with the name, version, schema URL, and
+// attributes of s from tp. If tp is nil, the global TracerProvider is used.
+func (s Scope) Tracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = GetTracerProvider()
+	}
+	var opts []trace.TracerOption
+	if s.Version != "" {
+		opts = append(opts, trace.WithInstrumentationVersion(s.Version))
+	}
+	if s.SchemaURL != "" {
+		opts = append(opts, trace.WithSchemaURL(s.SchemaURL))
+	}
+	if len(s.Attributes) > 0 {
+		opts = append(opts, trace.WithInstrumentationAttributes(s.Attributes...))
+	}
+	return tp.Tracer(s.Name, opts...)
+}
+
+// Meter returns a Meter with the name, version, schema URL, and attributes
+// of s from mp. If mp is nil, the global MeterProvider is used.
+func (s Scope) Meter(mp metric.MeterProvider) metric.Meter {
+	if mp == nil {
+		mp = GetMeterProvider()
+	}
+	var opts []metric.MeterOption
+	if s.Version != "" {
+		opts = append(opts, metric.WithInstrumentationVersion(s.Version))
+	}
+	if s.SchemaURL != "" {
+		opts = append(opts, metric.WithSchemaURL(s.SchemaURL))
+	}
+	if len(s.Attributes) > 0 {
+		opts = append(opts, metric.WithInstrumentationAttributes(s.Attributes...))
+	}
+	return mp.Meter(s.Name, opts...)
+}
+
+type scopeKeyType int
+
+const scopeKey scopeKeyType = 0
+
+// ContextWithScope returns a copy of parent carrying s.
+func ContextWithScope(parent context.Context, s Scope) context.Context {
+	// Do not share the attributes with the caller.
+	s.Attributes = slices.Clone(s.Attributes)
+	return context.WithValue(parent, scopeKey, s)
+}
+
+// ScopeFromContext returns the Scope carried by ctx, and true. If ctx does
+// not carry a Scope, an empty Scope and false are returned. The attributes of
+// the returned Scope must not be modified.
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	s, ok := ctx.Value(scopeKey).(Scope)
+	return s, ok
+}
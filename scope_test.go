@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	mnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tnoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var testScope = Scope{
+	Name:       "go.opentelemetry.io/otel/test",
+	Version:    "v1.2.3",
+	SchemaURL:  "https://opentelemetry.io/schemas/1.0.0",
+	Attributes: []attribute.KeyValue{attribute.String("k", "v")},
+}
+
+type scopeTracerProvider struct {
+	tnoop.TracerProvider
+
+	name string
+	cfg  trace.TracerConfig
+}
+
+func (p *scopeTracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
+	p.name, p.cfg = name, trace.NewTracerConfig(opts...)
+	return p.TracerProvider.Tracer(name, opts...)
+}
+
+type scopeMeterProvider struct {
+	mnoop.MeterProvider
+
+	name string
+	cfg  metric.MeterConfig
+}
+
+func (p *scopeMeterProvider) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	p.name, p.cfg = name, metric.NewMeterConfig(opts...)
+	return p.MeterProvider.Meter(name, opts...)
+}
+
+func TestScopeTracer(t *testing.T) {
+	tp := &scopeTracerProvider{}
+	_ = testScope.Tracer(tp)
+
+	assert.Equal(t, testScope.Name, tp.name)
+	assert.Equal(t, testScope.Version, tp.cfg.InstrumentationVersion())
+	assert.Equal(t, testScope.SchemaURL, tp.cfg.SchemaURL())
+	assert.Equal(t, attribute.NewSet(testScope.Attributes...), tp.cfg.InstrumentationAttributes())
+}
+
+func TestScopeMeter(t *testing.T) {
+	mp := &scopeMeterProvider{}
+	_ = testScope.Meter(mp)
+
+	assert.Equal(t, testScope.Name, mp.name)
+	assert.Equal(t, testScope.Version, mp.cfg.InstrumentationVersion())
+	assert.Equal(t, testScope.SchemaURL, mp.cfg.SchemaURL())
+	assert.Equal(t, attribute.NewSet(testScope.Attributes...), mp.cfg.InstrumentationAttributes())
+}
+
+func TestScopeGlobalProviders(t *testing.T) {
+	tp, mp := &scopeTracerProvider{}, &scopeMeterProvider{}
+	t.Cleanup(func(origTP trace.TracerProvider, origMP metric.MeterProvider) func() {
+		SetTracerProvider(tp)
+		SetMeterProvider(mp)
+		return func() {
+			SetTracerProvider(origTP)
+			SetMeterProvider(origMP)
+		}
+	}(GetTracerProvider(), GetMeterProvider()))
+
+	_ = testScope.Tracer(nil)
+	_ = testScope.Meter(nil)
+	assert.Equal(t, testScope.Name, tp.name, "global TracerProvider not used")
+	assert.Equal(t, testScope.Name, mp.name, "global MeterProvider not used")
+}
+
+func TestContextWithScope(t *testing.T) {
+	ctx := context.Background()
+	_, ok := ScopeFromContext(ctx)
+	assert.False(t, ok)
+
+	s := testScope
+	s.Attributes = []attribute.KeyValue{attribute.String("k", "v")}
+	ctx = ContextWithScope(ctx, s)
+	s.Attributes[0] = attribute.String("k", "modified")
+
+	got, ok := ScopeFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, testScope, got)
+}
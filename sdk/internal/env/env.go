@@ -54,9 +54,9 @@ const (
 	SpanLinkAttributeCountKey = "OTEL_LINK_ATTRIBUTE_COUNT_LIMIT"
 )
 
-// firstInt returns the value of the first matching environment variable from
-// keys. If the value is not an integer or no match is found, defaultValue is
-// returned.
+// firstInt returns the value of the first environment variable from keys that
+// is set to a valid integer. Invalid values are reported and skipped. If no
+// match is found, defaultValue is returned.
 func firstInt(defaultValue int, keys ...string) int {
 	for _, key := range keys {
 		value := os.Getenv(key)
@@ -67,7 +67,7 @@ func firstInt(defaultValue int, keys ...string) int {
 		intValue, err := strconv.Atoi(value)
 		if err != nil {
 			global.Info("Got invalid value, number value expected.", key, value)
-			return defaultValue
+			continue
 		}
 
 		return intValue
@@ -145,10 +145,11 @@ func SpanEventCount(defaultValue int) int {
 }
 
 // SpanEventAttributeCount returns the environment variable value for the
-// OTEL_EVENT_ATTRIBUTE_COUNT_LIMIT key if it exists, otherwise defaultValue
-// is returned.
+// OTEL_EVENT_ATTRIBUTE_COUNT_LIMIT key if it exists. Otherwise, the
+// environment variable value for OTEL_ATTRIBUTE_COUNT_LIMIT is returned or
+// defaultValue if that is not set.
 func SpanEventAttributeCount(defaultValue int) int {
-	return IntEnvOr(SpanEventAttributeCountKey, defaultValue)
+	return firstInt(defaultValue, SpanEventAttributeCountKey, AttributeCountKey)
 }
 
 // SpanLinkCount returns the environment variable value for the
@@ -159,8 +160,9 @@ func SpanLinkCount(defaultValue int) int {
 }
 
 // SpanLinkAttributeCount returns the environment variable value for the
-// OTEL_LINK_ATTRIBUTE_COUNT_LIMIT key if it exists, otherwise defaultValue is
-// returned.
+// OTEL_LINK_ATTRIBUTE_COUNT_LIMIT key if it exists. Otherwise, the
+// environment variable value for OTEL_ATTRIBUTE_COUNT_LIMIT is returned or
+// defaultValue if that is not set.
 func SpanLinkAttributeCount(defaultValue int) int {
-	return IntEnvOr(SpanLinkAttributeCountKey, defaultValue)
+	return firstInt(defaultValue, SpanLinkAttributeCountKey, AttributeCountKey)
 }
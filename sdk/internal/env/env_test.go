@@ -63,7 +63,7 @@ func TestEnvParse(t *testing.T) {
 
 		{
 			name: "SpanEventAttributeCount",
-			keys: []string{SpanEventAttributeCountKey},
+			keys: []string{SpanEventAttributeCountKey, AttributeCountKey},
 			f:    SpanEventAttributeCount,
 		},
 
@@ -75,7 +75,7 @@ func TestEnvParse(t *testing.T) {
 
 		{
 			name: "SpanLinkAttributeCount",
-			keys: []string{SpanLinkAttributeCountKey},
+			keys: []string{SpanLinkAttributeCountKey, AttributeCountKey},
 			f:    SpanLinkAttributeCount,
 		},
 	}
@@ -111,3 +111,40 @@ func TestEnvParse(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvPrecedence(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		general string
+		f       func(int) int
+	}{
+		{"SpanAttributeValueLength", SpanAttributeValueLengthKey, AttributeValueLengthKey, SpanAttributeValueLength},
+		{"SpanAttributeCount", SpanAttributeCountKey, AttributeCountKey, SpanAttributeCount},
+		{"SpanEventAttributeCount", SpanEventAttributeCountKey, AttributeCountKey, SpanEventAttributeCount},
+		{"SpanLinkAttributeCount", SpanLinkAttributeCountKey, AttributeCountKey, SpanLinkAttributeCount},
+	}
+
+	const defVal = 500
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			envStore := ottest.NewEnvStore()
+			t.Cleanup(func() { require.NoError(t, envStore.Restore()) })
+			envStore.Record(tc.key)
+			envStore.Record(tc.general)
+
+			require.NoError(t, os.Setenv(tc.general, "10"))
+			assert.Equal(t, 10, tc.f(defVal), "general only")
+
+			require.NoError(t, os.Setenv(tc.key, "20"))
+			assert.Equal(t, 20, tc.f(defVal), "signal specific overrides general")
+
+			require.NoError(t, os.Setenv(tc.key, "invalid"))
+			assert.Equal(t, 10, tc.f(defVal), "invalid signal specific falls back to general")
+
+			require.NoError(t, os.Setenv(tc.general, "invalid"))
+			assert.Equal(t, defVal, tc.f(defVal), "both invalid")
+		})
+	}
+}
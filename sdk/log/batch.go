@@ -6,10 +6,15 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 import (
 	"context"
 	"errors"
-	"slices"
+	"fmt"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
 )
 
 const (
@@ -63,10 +68,10 @@ type BatchProcessor struct {
 	//
 	//
 	// The "release valve" in this processing is the record queue. This queue
-	// is a ring buffer. It will overwrite the oldest records first when writes
-	// to OnEmit are made faster than the queue can be flushed. If batches
-	// cannot be flushed to the export buffer, the records will remain in the
-	// queue.
+	// is a ring buffer. By default, it will overwrite the oldest records first
+	// when writes to OnEmit are made faster than the queue can be flushed
+	// (see QueueFullPolicy for the alternatives). If batches cannot be
+	// flushed to the export buffer, the records will remain in the queue.
 
 	// exporter is the bufferedExporter all batches are exported with.
 	exporter *bufferExporter
@@ -94,18 +99,55 @@ type BatchProcessor struct {
 
 	// stopped holds the stopped state of the BatchProcessor.
 	stopped atomic.Bool
+
+	// batchPool holds the reusable batches of records the poll goroutine
+	// dequeues into and exports.
+	batchPool sync.Pool
+
+	// metrics records the health of the BatchProcessor. It is nil if no
+	// MeterProvider is configured.
+	metrics *batchMetrics
+
+	// policy is the behavior of OnEmit when the queue is full.
+	policy QueueFullPolicy
+
+	// wal persists the records of q. It is nil if no persistent queue is
+	// configured.
+	wal *wal
+
+	// rejected handles the records not accepted by OnEmit. It is nil if no
+	// RejectedHandler is configured.
+	rejected RejectedHandler
 }
 
 // NewBatchProcessor decorates the provided exporter
 // so that the log records are batched before exporting.
 //
-// All of the exporter's methods are called synchronously.
+// All of the exporter's methods are called synchronously, unless
+// [WithExportConcurrency] is used to export concurrently.
 func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchProcessor {
 	cfg := newBatchConfig(opts)
 	if exporter == nil {
 		// Do not panic on nil export.
 		exporter = defaultNoopExporter
 	}
+	q := newQueue(cfg.maxQSize.Value)
+	var replayed []Record
+	if cfg.walDir != "" {
+		w, recs, err := openWAL(cfg.walDir, cfg.walMaxSize)
+		if err != nil {
+			otel.Handle(err)
+		}
+		if w != nil {
+			q.wal = w
+			replayed = recs
+		}
+	}
+	metrics, err := newBatchMetrics(cfg.meterProvider, q, cfg.queueFullPolicy)
+	if err != nil {
+		otel.Handle(err)
+	}
+	exporter = newMetricsExporter(exporter, metrics)
 	// Order is important here. Wrap the timeoutExporter with the chunkExporter
 	// to ensure each export completes in timeout (instead of all chuncked
 	// exports).
@@ -116,12 +158,19 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 
 	b := &BatchProcessor{
 		// TODO: explore making the size of this configurable.
-		exporter: newBufferExporter(exporter, 1),
+		exporter: newBufferExporter(exporter, 1, cfg.expConcurrency),
 
-		q:           newQueue(cfg.maxQSize.Value),
+		q:           q,
 		batchSize:   cfg.expMaxBatchSize.Value,
 		pollTrigger: make(chan struct{}, 1),
 		pollKill:    make(chan struct{}),
+		metrics:     metrics,
+		policy:      cfg.queueFullPolicy,
+		wal:         q.wal,
+		rejected:    cfg.rejectedHandler,
+	}
+	if n := q.Replay(replayed); n >= b.batchSize {
+		b.pollTrigger <- struct{}{}
 	}
 	b.pollDone = b.poll(cfg.expInterval.Value)
 	return b
@@ -133,8 +182,7 @@ func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
 	done = make(chan struct{})
 
 	ticker := time.NewTicker(interval)
-	// TODO: investigate using a sync.Pool instead of cloning.
-	buf := make([]Record, b.batchSize)
+	buf := b.getBatch()
 	go func() {
 		defer close(done)
 		defer ticker.Stop()
@@ -148,10 +196,15 @@ func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
 				return
 			}
 
-			qLen := b.q.TryDequeue(buf, func(r []Record) bool {
-				ok := b.exporter.EnqueueExport(r)
-				if ok {
-					buf = slices.Clone(buf)
+			qLen := b.q.TryDequeue(*buf, func(r []Record) bool {
+				g := b.wal.next(len(r))
+				// Exporters do not retain the exported records. Return the
+				// batch to be reused once the export completes.
+				ok := b.exporter.EnqueueExport(r, b.releaseFunc(buf, g))
+				if ok && len(r) > 0 {
+					buf = b.getBatch()
+				} else {
+					b.wal.cancel(g)
 				}
 				return ok
 			})
@@ -169,12 +222,88 @@ func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
 	return done
 }
 
+// getBatch returns a batch from the batchPool able to hold b.batchSize
+// records.
+func (b *BatchProcessor) getBatch() *[]Record {
+	if buf, ok := b.batchPool.Get().(*[]Record); ok {
+		return buf
+	}
+	buf := make([]Record, b.batchSize)
+	return &buf
+}
+
+// releaseFunc returns a function that acknowledges the persisted entries of
+// g and returns buf to the batchPool.
+func (b *BatchProcessor) releaseFunc(buf *[]Record, g *walGroup) func() {
+	return func() {
+		b.wal.ack(g)
+		// Do not hold references to the exported records.
+		clear(*buf)
+		b.batchPool.Put(buf)
+	}
+}
+
 // OnEmit batches provided log record.
-func (b *BatchProcessor) OnEmit(_ context.Context, r Record) error {
-	if b.stopped.Load() || b.q == nil {
+//
+// If the queue is full, the record is handled according to the
+// QueueFullPolicy of b. With QueueFullBlock, an error is returned if ctx is
+// done before the record could be queued.
+//
+// The records not accepted, because the queue is full or b is shut down, are
+// passed to the RejectedHandler of b, if any.
+func (b *BatchProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if b.q == nil {
 		return nil
 	}
-	if n := b.q.Enqueue(r); n >= b.batchSize {
+	if b.stopped.Load() {
+		b.reject(ctx, r.Clone(), ErrProcessorShutdown)
+		return nil
+	}
+	// The record is retained after OnEmit returns. Clone it so it shares no
+	// state with the record later modified by other processors.
+	rec := r.Clone()
+	var n int
+	switch b.policy {
+	case QueueFullDropNewest:
+		var ok bool
+		if n, ok = b.q.TryEnqueue(rec); !ok {
+			b.reject(ctx, rec, ErrQueueFull)
+			return nil
+		}
+	case QueueFullBlock:
+		var err error
+		if n, err = b.q.EnqueueWait(ctx, rec, b.pollKill); err != nil {
+			b.reject(ctx, rec, err)
+			return err
+		}
+		if n == 0 {
+			// Shut down while waiting.
+			b.reject(ctx, rec, ErrProcessorShutdown)
+			return nil
+		}
+	case QueueFullDropBySeverity:
+		if rec.Severity() < log.SeverityWarn1 {
+			var ok bool
+			if n, ok = b.q.TryEnqueue(rec); !ok {
+				b.reject(ctx, rec, ErrQueueFull)
+				return nil
+			}
+			break
+		}
+		var ok bool
+		n, ok = b.q.EnqueueEvictBelow(rec, log.SeverityWarn1, func(evicted Record) {
+			b.reject(ctx, evicted, ErrQueueFull)
+		})
+		if !ok {
+			b.reject(ctx, rec, ErrQueueFull)
+			return nil
+		}
+	default:
+		n = b.q.EnqueueEvict(rec, func(evicted Record) {
+			b.reject(ctx, evicted, ErrQueueFull)
+		})
+	}
+	if n >= b.batchSize {
 		select {
 		case b.pollTrigger <- struct{}{}:
 		default:
@@ -186,12 +315,23 @@ func (b *BatchProcessor) OnEmit(_ context.Context, r Record) error {
 	return nil
 }
 
-// Enabled returns if b is enabled.
-func (b *BatchProcessor) Enabled(context.Context, Record) bool {
-	return !b.stopped.Load() && b.q != nil
+// reject passes r, not accepted because of err, to the RejectedHandler of b.
+func (b *BatchProcessor) reject(ctx context.Context, r Record, err error) {
+	if b.rejected != nil {
+		b.rejected(ctx, r, err)
+	}
 }
 
 // Shutdown flushes queued log records and shuts down the decorated exporter.
+//
+// Shutdown drains the queue and waits for the in-flight exports to complete
+// until ctx is done. If ctx is done before all the log records are exported,
+// the returned error is a [*DrainError] reporting the number of log records
+// abandoned. A nil error is returned if all the log records were drained.
+//
+// The decorated exporter is shut down even if ctx is done. It is then passed
+// a context not canceled with ctx that is done after a short timeout, so it
+// can still release its resources.
 func (b *BatchProcessor) Shutdown(ctx context.Context) error {
 	if b.stopped.Swap(true) || b.q == nil {
 		return nil
@@ -202,13 +342,47 @@ func (b *BatchProcessor) Shutdown(ctx context.Context) error {
 	select {
 	case <-b.pollDone:
 	case <-ctx.Done():
-		// Out of time.
-		return errors.Join(ctx.Err(), b.exporter.Shutdown(ctx))
+		// Out of time. The persisted records not exported are replayed by
+		// the next BatchProcessor using the same persistent queue.
+		queued, _ := b.q.Stats()
+		err := b.exporter.Shutdown(ctx)
+		return errors.Join(
+			&DrainError{Abandoned: queued + b.exporter.Abandoned(), Err: ctx.Err()},
+			err, b.metrics.unregister(), b.wal.close(),
+		)
 	}
 
 	// Flush remaining queued before exporter shutdown.
-	err := b.exporter.Export(ctx, b.q.Flush())
-	return errors.Join(err, b.exporter.Shutdown(ctx))
+	recs := b.q.Flush()
+	g := b.wal.next(len(recs))
+	err := b.exporter.Export(ctx, recs)
+	b.wal.ack(g)
+	err = errors.Join(err, b.exporter.Shutdown(ctx))
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if n := b.exporter.Abandoned(); n > 0 {
+			err = errors.Join(&DrainError{Abandoned: n, Err: ctxErr}, err)
+		}
+	}
+	return errors.Join(err, b.metrics.unregister(), b.wal.close())
+}
+
+// DrainError is returned by the Shutdown method of a [BatchProcessor] when
+// the passed context is done before all log records are exported.
+type DrainError struct {
+	// Abandoned is the number of log records not exported when Shutdown
+	// returned.
+	Abandoned int
+	// Err is the error of the context passed to Shutdown.
+	Err error
+}
+
+func (e *DrainError) Error() string {
+	return fmt.Sprintf("log processor shutdown: %d log records abandoned: %v", e.Abandoned, e.Err)
+}
+
+// Unwrap returns the error of the context passed to Shutdown.
+func (e *DrainError) Unwrap() error {
+	return e.Err
 }
 
 var errPartialFlush = errors.New("partial flush: export buffer full")
@@ -228,7 +402,11 @@ func (b *BatchProcessor) ForceFlush(ctx context.Context) error {
 	notFlushed := func() bool {
 		var flushed bool
 		_ = b.q.TryDequeue(buf, func(r []Record) bool {
-			flushed = b.exporter.EnqueueExport(r)
+			g := b.wal.next(len(r))
+			flushed = b.exporter.EnqueueExport(r, func() { b.wal.ack(g) })
+			if !flushed || len(r) == 0 {
+				b.wal.cancel(g)
+			}
 			return flushed
 		})
 		return !flushed
@@ -243,27 +421,77 @@ func (b *BatchProcessor) ForceFlush(ctx context.Context) error {
 			break
 		}
 	}
-	return errors.Join(err, b.exporter.ForceFlush(ctx))
+	return errors.Join(err, b.exporter.ForceFlush(ctx), b.wal.sync())
 }
 
 // queue holds a queue of logging records.
 //
+// The queue is a bounded multi-producer ring buffer. Records are added
+// without locking: producers claim a slot by atomically advancing the tail of
+// the queue and publish the record by updating the sequence number of the
+// slot. The consumers (the poll goroutine, ForceFlush, Shutdown, and the
+// producers evicting the oldest record) are serialized by readMu so that
+// TryDequeue can pass the records to write before removing them.
+//
+// When the queue is persisted, the producers are serialized by walMu so that
+// the records are persisted in the order they are added to the queue.
+//
 // When the queue becomes full, the oldest records in the queue are
-// overwritten.
+// overwritten by Enqueue. TryEnqueue and EnqueueWait do not overwrite
+// records.
 type queue struct {
-	sync.Mutex
+	cap   int
+	slots []slot
+
+	// head is the position of the next slot to read. It is only modified
+	// while readMu is held.
+	head atomic.Uint64
+	_    [cacheLineSize - 8]byte
+	// tail is the position of the next slot to write.
+	tail atomic.Uint64
+	_    [cacheLineSize - 8]byte
 
-	cap, len    int
-	read, write *ring
+	readMu sync.Mutex
+
+	// droppedOldest is the number of Records dropped from the queue to make
+	// room for newer ones because the queue was full.
+	droppedOldest atomic.Int64
+	// droppedNewest is the number of Records dropped instead of being added
+	// because the queue was full.
+	droppedNewest atomic.Int64
+
+	waitMu sync.Mutex
+	// dequeued is closed, and reset, when Records are removed from the
+	// queue. It is lazily created by EnqueueWait.
+	dequeued chan struct{}
+
+	walMu sync.Mutex
+	// wal persists the Records added to the queue. It is nil if the queue is
+	// not persisted.
+	wal *wal
+}
+
+// cacheLineSize is the assumed size of a CPU cache line. The head and tail of
+// a queue are padded to not share one.
+const cacheLineSize = 64
+
+// slot holds a Record of a queue.
+//
+// The sequence number of a slot is twice the position a producer can write to
+// when the slot is free, and that plus one once the Record is written and can
+// be read. Doubling the position keeps the two states distinct when the queue
+// holds a single slot.
+type slot struct {
+	seq atomic.Uint64
+	rec Record
 }
 
 func newQueue(size int) *queue {
-	r := newRing(size)
-	return &queue{
-		cap:   size,
-		read:  r,
-		write: r,
+	q := &queue{cap: size, slots: make([]slot, size)}
+	for i := range q.slots {
+		q.slots[i].seq.Store(2 * uint64(i))
 	}
+	return q
 }
 
 // Enqueue adds r to the queue. The queue size, including the addition of r, is
@@ -272,19 +500,290 @@ func newQueue(size int) *queue {
 // If enqueueing r will exceed the capacity of q, the oldest Record held in q
 // will be dropped and r retained.
 func (q *queue) Enqueue(r Record) int {
-	q.Lock()
-	defer q.Unlock()
+	return q.EnqueueEvict(r, nil)
+}
+
+// EnqueueEvict adds r to the queue the same way as Enqueue. The oldest
+// Records dropped to make room for r are passed to evicted, if it is not nil.
+// More than one Record is dropped if other goroutines fill the queue
+// concurrently.
+func (q *queue) EnqueueEvict(r Record, evicted func(Record)) int {
+	for {
+		if n, ok := q.tryPush(r); ok {
+			return n
+		}
+
+		// Overflow. Drop the oldest Record to make room for r.
+		q.readMu.Lock()
+		old, ok := q.pop()
+		if ok {
+			q.droppedOldest.Add(1)
+			q.wal.drop(1)
+		}
+		q.readMu.Unlock()
+
+		if !ok {
+			// The oldest Record is still being written. Let its producer
+			// finish.
+			runtime.Gosched()
+			continue
+		}
+		q.notifyDequeued()
+		if evicted != nil {
+			evicted(old)
+		}
+	}
+}
+
+// EnqueueEvictBelow adds r to the queue. If the queue is full, the oldest
+// Record with a severity less than sev is dropped to make room for r and
+// passed to evicted, if it is not nil. The queue size, including the addition
+// of r, and true are returned.
+//
+// If the queue is full and holds no Record with a severity less than sev, r
+// is dropped and the queue size and false are returned.
+func (q *queue) EnqueueEvictBelow(r Record, sev log.Severity, evicted func(Record)) (int, bool) {
+	for {
+		if n, ok := q.tryPush(r); ok {
+			return n, true
+		}
+
+		q.readMu.Lock()
+		old, found, busy := q.removeBelow(sev)
+		if found {
+			q.droppedOldest.Add(1)
+		}
+		q.readMu.Unlock()
+
+		switch {
+		case found:
+			q.notifyDequeued()
+			if evicted != nil {
+				evicted(old)
+			}
+		case busy:
+			// A Record is still being written. Let its producer finish.
+			runtime.Gosched()
+		default:
+			q.drop()
+			return q.len(), false
+		}
+	}
+}
+
+// removeBelow removes and returns the oldest Record of the queue with a
+// severity less than sev. The Records before it are moved up one slot to keep
+// the queue order. If no such Record is found, false is returned. Busy is
+// true if the search stopped at a Record still being written. The readMu of q
+// must be held.
+func (q *queue) removeBelow(sev log.Severity) (r Record, found, busy bool) {
+	head, tail := q.head.Load(), q.tail.Load()
+	pos := head
+	for ; pos < tail; pos++ {
+		s := &q.slots[pos%uint64(q.cap)]
+		if s.seq.Load() != 2*pos+1 {
+			return Record{}, false, true
+		}
+		if s.rec.Severity() < sev {
+			break
+		}
+	}
+	if pos == tail {
+		return Record{}, false, false
+	}
+
+	r = q.slots[pos%uint64(q.cap)].rec
+	for p := pos; p > head; p-- {
+		q.slots[p%uint64(q.cap)].rec = q.slots[(p-1)%uint64(q.cap)].rec
+	}
+	q.release(head, 1)
+	q.wal.dropAt(int(pos - head))
+	return r, true, false
+}
+
+// TryEnqueue adds r to the queue if it is not full. The queue size, including
+// the addition of r, and true are returned if r was added. Otherwise, r is
+// dropped and the queue size and false are returned.
+func (q *queue) TryEnqueue(r Record) (int, bool) {
+	n, ok := q.tryPush(r)
+	if !ok {
+		q.drop()
+	}
+	return n, ok
+}
+
+// EnqueueWait adds r to the queue, waiting for Records to be removed from it
+// while it is full. The queue size, including the addition of r, is returned.
+//
+// If ctx is done before r is added, r is dropped and the ctx error is
+// returned. If stop is closed before r is added, r is dropped and a nil error
+// is returned.
+func (q *queue) EnqueueWait(ctx context.Context, r Record, stop <-chan struct{}) (int, error) {
+	for {
+		if n, ok := q.tryPush(r); ok {
+			return n, nil
+		}
+
+		q.waitMu.Lock()
+		if q.dequeued == nil {
+			q.dequeued = make(chan struct{})
+		}
+		dequeued := q.dequeued
+		q.waitMu.Unlock()
+
+		// Records may have been removed before dequeued was registered.
+		if n, ok := q.tryPush(r); ok {
+			return n, nil
+		}
+
+		select {
+		case <-dequeued:
+		case <-ctx.Done():
+			q.drop()
+			return 0, ctx.Err()
+		case <-stop:
+			q.drop()
+			return 0, nil
+		}
+	}
+}
 
-	q.write.Value = r
-	q.write = q.write.Next()
+// tryPush adds r to the queue, persisting it, if the queue is not full. The
+// queue size, including the addition of r, and true are returned if r was
+// added. Otherwise, the queue size and false are returned.
+func (q *queue) tryPush(r Record) (int, bool) {
+	if q.wal == nil {
+		return q.push(r)
+	}
 
-	q.len++
-	if q.len > q.cap {
-		// Overflow. Advance read to be the new "oldest".
-		q.len = q.cap
-		q.read = q.read.Next()
+	q.walMu.Lock()
+	defer q.walMu.Unlock()
+
+	s, pos, ok := q.claim()
+	if !ok {
+		return q.len(), false
+	}
+	rec := r
+	if err := q.wal.append(&rec); err != nil {
+		otel.Handle(err)
 	}
-	return q.len
+	q.publish(s, pos, r)
+	return q.lenAfter(pos), true
+}
+
+// push adds r to the queue without persisting it if the queue is not full.
+// The queue size, including the addition of r, and true are returned if r was
+// added. Otherwise, the queue size and false are returned.
+func (q *queue) push(r Record) (int, bool) {
+	s, pos, ok := q.claim()
+	if !ok {
+		return q.len(), false
+	}
+	q.publish(s, pos, r)
+	return q.lenAfter(pos), true
+}
+
+// claim reserves the slot at the tail of the queue. The slot and its position
+// are returned with true. If the queue is full, false is returned.
+func (q *queue) claim() (*slot, uint64, bool) {
+	pos := q.tail.Load()
+	for {
+		s := &q.slots[pos%uint64(q.cap)]
+		seq := s.seq.Load()
+		switch {
+		case seq == 2*pos:
+			if q.tail.CompareAndSwap(pos, pos+1) {
+				return s, pos, true
+			}
+			pos = q.tail.Load()
+		case seq < 2*pos:
+			// The slot still holds a Record not yet read.
+			return nil, 0, false
+		default:
+			// Another producer claimed the slot.
+			pos = q.tail.Load()
+		}
+	}
+}
+
+// publish writes r to s, claimed at pos, and makes it available to read.
+func (q *queue) publish(s *slot, pos uint64, r Record) {
+	s.rec = r
+	s.seq.Store(2*pos + 1)
+}
+
+// pop removes and returns the oldest Record of the queue with true. If the
+// queue is empty, or the oldest Record is still being written, false is
+// returned. The readMu of q must be held.
+func (q *queue) pop() (Record, bool) {
+	head := q.head.Load()
+	s := &q.slots[head%uint64(q.cap)]
+	if s.seq.Load() != 2*head+1 {
+		return Record{}, false
+	}
+	r := s.rec
+	s.rec = Record{}
+	s.seq.Store(2 * (head + uint64(q.cap)))
+	q.head.Store(head + 1)
+	return r, true
+}
+
+// len returns the number of Records held in q, including the ones still being
+// written.
+func (q *queue) len() int {
+	head := q.head.Load()
+	return min(int(q.tail.Load()-head), q.cap)
+}
+
+// lenAfter returns the number of Records held in q once the Record at pos is
+// added.
+func (q *queue) lenAfter(pos uint64) int {
+	return min(max(int(pos+1-q.head.Load()), 1), q.cap)
+}
+
+// Replay adds the Records replayed from the wal of q to the queue. They are
+// not persisted again. If they exceed the capacity of q, the oldest ones are
+// dropped. The queue size is returned.
+func (q *queue) Replay(recs []Record) int {
+	if over := len(recs) - q.cap; over > 0 {
+		q.droppedOldest.Add(int64(over))
+		q.wal.drop(over)
+		recs = recs[over:]
+	}
+	for _, r := range recs {
+		q.push(r)
+	}
+	return q.len()
+}
+
+// drop counts a Record dropped instead of being added because q was full.
+func (q *queue) drop() {
+	q.droppedNewest.Add(1)
+}
+
+// notifyDequeued wakes up all EnqueueWait calls waiting for Records to be
+// removed from q.
+func (q *queue) notifyDequeued() {
+	q.waitMu.Lock()
+	defer q.waitMu.Unlock()
+	if q.dequeued != nil {
+		close(q.dequeued)
+		q.dequeued = nil
+	}
+}
+
+// Stats returns the number of Records held in q and the number of Records
+// dropped by q because it was full.
+func (q *queue) Stats() (n, dropped int) {
+	oldest, newest := q.Dropped()
+	return q.len(), oldest + newest
+}
+
+// Dropped returns the number of Records dropped by q because it was full:
+// the oldest Records dropped to make room for newer ones, and the newest
+// Records dropped instead of being added.
+func (q *queue) Dropped() (oldest, newest int) {
+	return int(q.droppedOldest.Load()), int(q.droppedNewest.Load())
 }
 
 // TryDequeue attempts to dequeue up to len(buf) Records. The available Records
@@ -293,42 +792,65 @@ func (q *queue) Enqueue(r Record) int {
 // returning true, the dequeued Records are removed from the queue. The number
 // of Records remaining in the queue are returned.
 //
-// When write is called the lock of q is held. The write function must not call
-// other methods of this q that acquire the lock.
+// When write is called the readMu of q is held. The write function must not
+// call other methods of this q that dequeue Records.
 func (q *queue) TryDequeue(buf []Record, write func([]Record) bool) int {
-	q.Lock()
-	defer q.Unlock()
+	q.readMu.Lock()
+	head := q.head.Load()
+	n := q.peek(head, buf)
+	ok := write(buf[:n])
+	if ok {
+		q.release(head, n)
+	}
+	q.readMu.Unlock()
 
-	origRead := q.read
+	if ok && n > 0 {
+		q.notifyDequeued()
+	}
+	return q.len()
+}
 
-	n := min(len(buf), q.len)
-	for i := 0; i < n; i++ {
-		buf[i] = q.read.Value
-		q.read = q.read.Next()
+// peek copies up to len(buf) of the Records available to read, starting at
+// head, into buf. The number of Records copied is returned. The readMu of q
+// must be held.
+func (q *queue) peek(head uint64, buf []Record) int {
+	var n int
+	for ; n < len(buf); n++ {
+		pos := head + uint64(n)
+		s := &q.slots[pos%uint64(q.cap)]
+		if s.seq.Load() != 2*pos+1 {
+			// Empty, or the Record is still being written.
+			break
+		}
+		buf[n] = s.rec
 	}
+	return n
+}
 
-	if write(buf[:n]) {
-		q.len -= n
-	} else {
-		q.read = origRead
+// release frees the n slots starting at head for the producers to write to.
+// The readMu of q must be held.
+func (q *queue) release(head uint64, n int) {
+	for i := 0; i < n; i++ {
+		pos := head + uint64(i)
+		s := &q.slots[pos%uint64(q.cap)]
+		s.rec = Record{}
+		s.seq.Store(2 * (pos + uint64(q.cap)))
 	}
-	return q.len
+	q.head.Store(head + uint64(n))
 }
 
 // Flush returns all the Records held in the queue and resets it to be
-// empty.
+// empty. Records still being written by concurrent calls are not returned.
 func (q *queue) Flush() []Record {
-	q.Lock()
-	defer q.Unlock()
-
-	out := make([]Record, q.len)
-	for i := range out {
-		out[i] = q.read.Value
-		q.read = q.read.Next()
-	}
-	q.len = 0
+	q.readMu.Lock()
+	head := q.head.Load()
+	out := make([]Record, q.len())
+	n := q.peek(head, out)
+	q.release(head, n)
+	q.readMu.Unlock()
 
-	return out
+	q.notifyDequeued()
+	return out[:n]
 }
 
 type batchConfig struct {
@@ -336,6 +858,12 @@ type batchConfig struct {
 	expInterval     setting[time.Duration]
 	expTimeout      setting[time.Duration]
 	expMaxBatchSize setting[int]
+	meterProvider   metric.MeterProvider
+	queueFullPolicy QueueFullPolicy
+	walDir          string
+	walMaxSize      int64
+	rejectedHandler RejectedHandler
+	expConcurrency  int
 }
 
 func newBatchConfig(options []BatchProcessorOption) batchConfig {
@@ -385,7 +913,8 @@ func (fn batchOptionFunc) apply(c batchConfig) batchConfig {
 }
 
 // WithMaxQueueSize sets the maximum queue size used by the Batcher.
-// After the size is reached log records are dropped.
+// After the size is reached log records are dropped, or the caller is
+// blocked, according to the policy set with [WithQueueFullPolicy].
 //
 // If the OTEL_BLRP_MAX_QUEUE_SIZE environment variable is set,
 // and this option is not passed, that variable value will be used.
@@ -445,3 +974,174 @@ func WithExportMaxBatchSize(size int) BatchProcessorOption {
 		return cfg
 	})
 }
+
+// WithMeterProvider sets the MeterProvider used to record metrics about the
+// health of the BatchProcessor. These are the length and capacity of its
+// queue, the number of log records dropped because the queue was full, and
+// the size, duration, and failures of its exports. They allow alerting on the
+// saturation of the log pipeline.
+//
+// The dropped log records are counted per "policy", the name of the
+// [QueueFullPolicy] used (e.g. "drop_oldest"), and per "record", "oldest" for
+// the log records dropped from the queue to make room for newer ones and
+// "newest" for the emitted log records dropped instead of being queued.
+//
+// By default, if this option is not passed or mp is nil, no metrics are
+// recorded.
+func WithMeterProvider(mp metric.MeterProvider) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.meterProvider = mp
+		return cfg
+	})
+}
+
+// QueueFullPolicy is the behavior of a [BatchProcessor] when a log record is
+// emitted while its queue is full.
+type QueueFullPolicy int
+
+const (
+	// QueueFullDropOldest drops the oldest log record in the queue to make
+	// room for the emitted log record. This is the default.
+	QueueFullDropOldest QueueFullPolicy = iota
+	// QueueFullDropNewest drops the emitted log record. The log records
+	// already in the queue are retained.
+	QueueFullDropNewest
+	// QueueFullBlock blocks the caller emitting the log record until there is
+	// room in the queue. The log record is dropped if the context passed to
+	// OnEmit is done, or the BatchProcessor is shut down, while waiting.
+	//
+	// Use a context with a deadline to bound the time spent waiting. This
+	// policy should be used when log records must not be lost, e.g. audit
+	// logs, and the caller can tolerate being slowed down by the export.
+	QueueFullBlock
+	// QueueFullDropBySeverity prefers keeping the log records with a
+	// severity of [log.SeverityWarn1] or greater. An emitted log record of a
+	// lower severity, including an unset severity, is dropped. An emitted
+	// log record of a Warn or greater severity is retained by dropping the
+	// oldest log record in the queue with a lower severity. If the queue only
+	// holds Warn or greater log records, the emitted one is dropped: the
+	// queued Warn or greater log records are never dropped.
+	//
+	// This policy should be used when the warnings and errors are the log
+	// records to keep during a burst of logs, e.g. caused by an incident.
+	QueueFullDropBySeverity
+)
+
+// name returns the name of p used as the value of the policy attribute of
+// the metrics of a BatchProcessor.
+func (p QueueFullPolicy) name() string {
+	switch p {
+	case QueueFullDropNewest:
+		return "drop_newest"
+	case QueueFullBlock:
+		return "block"
+	case QueueFullDropBySeverity:
+		return "drop_by_severity"
+	default:
+		return "drop_oldest"
+	}
+}
+
+// WithQueueFullPolicy sets the behavior of the BatchProcessor when a log
+// record is emitted while its queue is full.
+//
+// By default, if this option is not passed, [QueueFullDropOldest] will be
+// used. It will also be used if policy is not a valid QueueFullPolicy.
+func WithQueueFullPolicy(policy QueueFullPolicy) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.queueFullPolicy = policy
+		return cfg
+	})
+}
+
+// WithPersistentQueue persists the queue of the BatchProcessor in a
+// write-ahead log stored in dir. The log records are written to it when they
+// are queued and removed from it once their export completes, or they are
+// dropped. The log records not exported when the process stops (e.g. it
+// crashed or the collector was unavailable) are replayed, and exported, by the
+// next BatchProcessor created with the same dir. Only one BatchProcessor can
+// use dir at a time.
+//
+// The write-ahead log file does not grow past maxSize bytes. Once it is
+// reached, log records are queued in memory only until exports make room. If
+// maxSize is less than one, 64 MiB is used.
+//
+// The records are removed from the write-ahead log once their export
+// completes, even if it failed. Use an exporter retrying failed exports to
+// not lose records during transient collector outages.
+//
+// Corrupted entries found when replaying the write-ahead log, e.g. an entry
+// partially written when the process crashed, are discarded along with the
+// entries following them. An error is reported to the global error handler in
+// that case. If dir cannot be used, the error is reported to the global error
+// handler and the queue is not persisted.
+//
+// The log file is synced to stable storage on ForceFlush and Shutdown.
+//
+// By default, if this option is not passed, the queue is held in memory only.
+func WithPersistentQueue(dir string, maxSize int64) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.walDir = dir
+		cfg.walMaxSize = maxSize
+		return cfg
+	})
+}
+
+var (
+	// ErrQueueFull is the error passed to a RejectedHandler for a log record
+	// dropped because the queue of the BatchProcessor was full.
+	ErrQueueFull = errors.New("log record queue full")
+	// ErrProcessorShutdown is the error passed to a RejectedHandler for a log
+	// record emitted after the BatchProcessor was shut down.
+	ErrProcessorShutdown = errors.New("log processor shut down")
+)
+
+// RejectedHandler handles a log record not accepted by a [BatchProcessor].
+// The err describes why r was not accepted. It is [ErrQueueFull],
+// [ErrProcessorShutdown], or the error of the context passed to OnEmit when
+// [QueueFullBlock] is used.
+//
+// The handler is called synchronously by OnEmit with the context it is
+// passed. When a queued log record is dropped to make room for the emitted one
+// ([QueueFullDropOldest] or [QueueFullDropBySeverity]), r is that log
+// record, not the one emitted with the context.
+type RejectedHandler func(ctx context.Context, r Record, err error)
+
+// WithRejectedHandler sets the handler of the log records not accepted by
+// the BatchProcessor. It provides a guaranteed delivery mode for log records
+// that must not be silently lost, e.g. audit logs: the handler can write the
+// rejected log records to a secondary sink.
+//
+// The handler is called synchronously when a log record is emitted. It needs
+// to return quickly to not block the emitting caller.
+//
+// Log records accepted by the BatchProcessor but failing to be exported are
+// not passed to the handler. The errors of the exporter are passed to the
+// global error handler.
+//
+// By default, if this option is not passed, the log records not accepted are
+// dropped silently.
+func WithRejectedHandler(h RejectedHandler) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.rejectedHandler = h
+		return cfg
+	})
+}
+
+// WithExportConcurrency sets the maximum number of exports run in parallel.
+// Concurrent exports improve the throughput of exporters with a high latency,
+// e.g. sending to a remote OTLP endpoint.
+//
+// If n is greater than one, the Export method of the exporter is called
+// concurrently, and batches can be exported out of order. The exporter needs
+// to be safe to call concurrently. ForceFlush and Shutdown of the exporter
+// are still only called once all prior exports have completed.
+//
+// By default, if this option is not passed, or n is less than one, exports
+// are run one at a time and the exporter methods are called synchronously.
+func WithExportConcurrency(n int) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.expConcurrency = n
+		return cfg
+	})
+}
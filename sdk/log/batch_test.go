@@ -5,9 +5,13 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime"
 	"slices"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 	"unsafe"
@@ -24,8 +28,7 @@ func TestEmptyBatchConfig(t *testing.T) {
 		var bp BatchProcessor
 		ctx := context.Background()
 		var record Record
-		assert.NoError(t, bp.OnEmit(ctx, record), "OnEmit")
-		assert.False(t, bp.Enabled(ctx, record), "Enabled")
+		assert.NoError(t, bp.OnEmit(ctx, &record), "OnEmit")
 		assert.NoError(t, bp.ForceFlush(ctx), "ForceFlush")
 		assert.NoError(t, bp.Shutdown(ctx), "Shutdown")
 	})
@@ -58,12 +61,16 @@ func TestNewBatchConfig(t *testing.T) {
 				WithExportInterval(time.Microsecond),
 				WithExportTimeout(time.Hour),
 				WithExportMaxBatchSize(2),
+				WithQueueFullPolicy(QueueFullBlock),
+				WithExportConcurrency(4),
 			},
 			want: batchConfig{
 				maxQSize:        newSetting(10),
 				expInterval:     newSetting(time.Microsecond),
 				expTimeout:      newSetting(time.Hour),
 				expMaxBatchSize: newSetting(2),
+				queueFullPolicy: QueueFullBlock,
+				expConcurrency:  4,
 			},
 		},
 		{
@@ -176,7 +183,7 @@ func TestBatchProcessor(t *testing.T) {
 			WithExportTimeout(time.Hour),
 		)
 		for _, r := range make([]Record, size) {
-			assert.NoError(t, b.OnEmit(ctx, r))
+			assert.NoError(t, b.OnEmit(ctx, &r))
 		}
 		var got []Record
 		assert.Eventually(t, func() bool {
@@ -199,7 +206,7 @@ func TestBatchProcessor(t *testing.T) {
 			WithExportTimeout(time.Hour),
 		)
 		for _, r := range make([]Record, 10*batch) {
-			assert.NoError(t, b.OnEmit(ctx, r))
+			assert.NoError(t, b.OnEmit(ctx, &r))
 		}
 		assert.Eventually(t, func() bool {
 			return e.ExportN() > 1
@@ -209,6 +216,37 @@ func TestBatchProcessor(t *testing.T) {
 		assert.GreaterOrEqual(t, e.ExportN(), 10)
 	})
 
+	t.Run("BatchReuse", func(t *testing.T) {
+		const batch, n = 10, 100
+		e := newTestExporter(nil)
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(n),
+			WithExportMaxBatchSize(batch),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+		)
+
+		want := make([]log.Value, n)
+		for i := range want {
+			want[i] = log.IntValue(i)
+
+			var r Record
+			r.SetBody(want[i])
+			assert.NoError(t, b.OnEmit(ctx, &r))
+		}
+		assert.NoError(t, b.Shutdown(ctx))
+
+		// Reused batches must not overwrite already exported records.
+		var got []log.Value
+		for _, records := range e.Records() {
+			for _, r := range records {
+				got = append(got, r.Body())
+			}
+		}
+		assert.ElementsMatch(t, want, got)
+	})
+
 	t.Run("RetriggerFlushNonBlocking", func(t *testing.T) {
 		e := newTestExporter(nil)
 		e.ExportTrigger = make(chan struct{})
@@ -222,7 +260,7 @@ func TestBatchProcessor(t *testing.T) {
 			WithExportTimeout(time.Hour),
 		)
 		for _, r := range make([]Record, 2*batch) {
-			assert.NoError(t, b.OnEmit(ctx, r))
+			assert.NoError(t, b.OnEmit(ctx, &r))
 		}
 
 		var n int
@@ -233,7 +271,7 @@ func TestBatchProcessor(t *testing.T) {
 
 		var err error
 		require.Eventually(t, func() bool {
-			err = b.OnEmit(ctx, Record{})
+			err = b.OnEmit(ctx, new(Record))
 			return true
 		}, time.Second, time.Microsecond, "OnEmit blocked")
 		assert.NoError(t, err)
@@ -248,12 +286,301 @@ func TestBatchProcessor(t *testing.T) {
 		assert.Equal(t, 3, e.ExportN())
 	})
 
-	t.Run("Enabled", func(t *testing.T) {
-		b := NewBatchProcessor(defaultNoopExporter)
-		assert.True(t, b.Enabled(ctx, Record{}))
+	t.Run("QueueFullBlock", func(t *testing.T) {
+		e := newTestExporter(nil)
+		e.ExportTrigger = make(chan struct{})
+
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(1),
+			WithExportMaxBatchSize(1),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+			WithQueueFullPolicy(QueueFullBlock),
+		)
+
+		// Fill the export goroutine, the export buffer, and the queue.
+		require.Eventually(t, func() bool {
+			ctx, cancel := context.WithTimeout(ctx, time.Millisecond)
+			defer cancel()
+			err := b.OnEmit(ctx, new(Record))
+			return errors.Is(err, context.DeadlineExceeded)
+		}, 2*time.Second, time.Microsecond, "OnEmit did not block")
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- b.OnEmit(ctx, new(Record)) }()
+		select {
+		case <-errCh:
+			t.Fatal("OnEmit did not block on full queue")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		// Unblock the exports, making room in the queue.
+		close(e.ExportTrigger)
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("OnEmit not unblocked by export")
+		}
+		assert.NoError(t, b.Shutdown(ctx))
+	})
+
+	t.Run("QueueFullBlockShutdown", func(t *testing.T) {
+		e := newTestExporter(nil)
+		e.ExportTrigger = make(chan struct{})
+		t.Cleanup(func() { close(e.ExportTrigger) })
 
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(1),
+			WithExportMaxBatchSize(1),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+			WithQueueFullPolicy(QueueFullBlock),
+		)
+		require.Eventually(t, func() bool {
+			ctx, cancel := context.WithTimeout(ctx, time.Millisecond)
+			defer cancel()
+			err := b.OnEmit(ctx, new(Record))
+			return errors.Is(err, context.DeadlineExceeded)
+		}, 2*time.Second, time.Microsecond, "OnEmit did not block")
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- b.OnEmit(ctx, new(Record)) }()
+
+		// Shutdown does not complete while the exports are blocked.
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
 		_ = b.Shutdown(ctx)
-		assert.False(t, b.Enabled(ctx, Record{}))
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("OnEmit not unblocked by shutdown")
+		}
+	})
+
+	t.Run("ExportConcurrency", func(t *testing.T) {
+		const n = 3
+		e := newTestExporter(nil)
+		e.ExportTrigger = make(chan struct{})
+
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(n),
+			WithExportMaxBatchSize(1),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+			WithExportConcurrency(n),
+		)
+		assert.Eventually(t, func() bool {
+			assert.NoError(t, b.OnEmit(ctx, new(Record)))
+			return e.ExportN() >= n
+		}, 2*time.Second, time.Microsecond, "exports not concurrent")
+
+		close(e.ExportTrigger)
+		assert.NoError(t, b.Shutdown(ctx))
+	})
+
+	t.Run("ExportBufferFull", func(t *testing.T) {
+		e := newTestExporter(nil)
+		e.ExportTrigger = make(chan struct{})
+
+		const batch = 1
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(10*batch),
+			WithExportMaxBatchSize(batch),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+		)
+
+		for i := 0; i < 10*batch; i++ {
+			require.NoError(t, b.OnEmit(ctx, new(Record)))
+		}
+		// While an export is blocked, the export buffer is filled.
+		assert.Eventually(t, func() bool {
+			return e.ExportN() > 0 && len(b.exporter.input) == cap(b.exporter.input)
+		}, 2*time.Second, time.Microsecond)
+
+		close(e.ExportTrigger)
+		assert.NoError(t, b.Shutdown(ctx))
+	})
+
+	t.Run("RejectedHandler", func(t *testing.T) {
+		type rejection struct {
+			body log.Value
+			err  error
+		}
+		newHandler := func() (RejectedHandler, func() []rejection) {
+			var (
+				mu  sync.Mutex
+				got []rejection
+			)
+			h := func(_ context.Context, r Record, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				got = append(got, rejection{body: r.Body(), err: err})
+			}
+			return h, func() []rejection {
+				mu.Lock()
+				defer mu.Unlock()
+				return append([]rejection(nil), got...)
+			}
+		}
+
+		for name, policy := range map[string]QueueFullPolicy{
+			"QueueFullDropOldest": QueueFullDropOldest,
+			"QueueFullDropNewest": QueueFullDropNewest,
+		} {
+			policy := policy
+			t.Run(name, func(t *testing.T) {
+				e := newTestExporter(nil)
+				e.ExportTrigger = make(chan struct{})
+
+				h, rejected := newHandler()
+				b := NewBatchProcessor(
+					e,
+					WithMaxQueueSize(1),
+					WithExportMaxBatchSize(1),
+					WithExportInterval(time.Hour),
+					WithExportTimeout(time.Hour),
+					WithQueueFullPolicy(policy),
+					WithRejectedHandler(h),
+				)
+				t.Cleanup(func() {
+					close(e.ExportTrigger)
+					_ = b.Shutdown(ctx)
+				})
+
+				// Fill the export goroutine, the export buffer, and the queue.
+				var i int64
+				require.Eventually(t, func() bool {
+					r := new(Record)
+					r.SetBody(log.Int64Value(i))
+					i++
+					assert.NoError(t, b.OnEmit(ctx, r))
+					return len(rejected()) > 0
+				}, 2*time.Second, time.Microsecond, "no record rejected")
+
+				got := rejected()
+				require.Len(t, got, 1)
+				assert.ErrorIs(t, got[0].err, ErrQueueFull)
+				want := log.Int64Value(i - 1)
+				if policy == QueueFullDropOldest {
+					want = log.Int64Value(i - 2)
+				}
+				assert.Equal(t, want, got[0].body, "rejected record")
+			})
+		}
+
+		t.Run("QueueFullDropBySeverity", func(t *testing.T) {
+			e := newTestExporter(nil)
+			e.ExportTrigger = make(chan struct{})
+
+			h, rejected := newHandler()
+			b := NewBatchProcessor(
+				e,
+				WithMaxQueueSize(1),
+				WithExportMaxBatchSize(1),
+				WithExportInterval(time.Hour),
+				WithExportTimeout(time.Hour),
+				WithQueueFullPolicy(QueueFullDropBySeverity),
+				WithRejectedHandler(h),
+			)
+			t.Cleanup(func() {
+				close(e.ExportTrigger)
+				_ = b.Shutdown(ctx)
+			})
+
+			emit := func(body log.Value, sev log.Severity) {
+				r := new(Record)
+				r.SetBody(body)
+				r.SetSeverity(sev)
+				assert.NoError(t, b.OnEmit(ctx, r))
+			}
+
+			// Block the export goroutine and fill the export buffer. Each
+			// record is emitted only once the previous one left the queue so
+			// none is rejected.
+			var i int64
+			for e.ExportN() == 0 || len(b.exporter.input) < cap(b.exporter.input) {
+				emit(log.Int64Value(i), log.SeverityInfo)
+				i++
+				require.Eventually(t, func() bool {
+					return b.q.len() == 0
+				}, 2*time.Second, time.Microsecond, "record not dequeued")
+			}
+			// Records can no longer leave the queue. Fill it.
+			for b.q.len() < b.q.cap {
+				emit(log.Int64Value(i), log.SeverityInfo)
+				i++
+			}
+			require.Empty(t, rejected(), "rejected before the queue is full")
+
+			// The queue holds i-1. It is evicted for warn, then the queue
+			// only holds warn which is never evicted.
+			emit(log.StringValue("warn"), log.SeverityWarn)
+			emit(log.StringValue("info"), log.SeverityInfo)
+			emit(log.StringValue("error"), log.SeverityError)
+
+			got := rejected()
+			require.Len(t, got, 3)
+			for _, r := range got {
+				assert.ErrorIs(t, r.err, ErrQueueFull)
+			}
+			assert.Equal(t, []log.Value{
+				log.Int64Value(i - 1),
+				log.StringValue("info"),
+				log.StringValue("error"),
+			}, []log.Value{got[0].body, got[1].body, got[2].body})
+		})
+
+		t.Run("QueueFullBlock", func(t *testing.T) {
+			e := newTestExporter(nil)
+			e.ExportTrigger = make(chan struct{})
+
+			h, rejected := newHandler()
+			b := NewBatchProcessor(
+				e,
+				WithMaxQueueSize(1),
+				WithExportMaxBatchSize(1),
+				WithExportInterval(time.Hour),
+				WithExportTimeout(time.Hour),
+				WithQueueFullPolicy(QueueFullBlock),
+				WithRejectedHandler(h),
+			)
+			t.Cleanup(func() {
+				close(e.ExportTrigger)
+				_ = b.Shutdown(ctx)
+			})
+
+			require.Eventually(t, func() bool {
+				ctx, cancel := context.WithTimeout(ctx, time.Millisecond)
+				defer cancel()
+				err := b.OnEmit(ctx, new(Record))
+				return errors.Is(err, context.DeadlineExceeded)
+			}, 2*time.Second, time.Microsecond, "OnEmit did not block")
+
+			got := rejected()
+			require.Len(t, got, 1)
+			assert.ErrorIs(t, got[0].err, context.DeadlineExceeded)
+		})
+
+		t.Run("Shutdown", func(t *testing.T) {
+			h, rejected := newHandler()
+			b := NewBatchProcessor(defaultNoopExporter, WithRejectedHandler(h))
+			require.NoError(t, b.Shutdown(ctx))
+
+			r := new(Record)
+			r.SetBody(log.StringValue("audit"))
+			assert.NoError(t, b.OnEmit(ctx, r))
+			assert.Equal(t, []rejection{{
+				body: log.StringValue("audit"),
+				err:  ErrProcessorShutdown,
+			}}, rejected())
+		})
 	})
 
 	t.Run("Shutdown", func(t *testing.T) {
@@ -281,7 +608,7 @@ func TestBatchProcessor(t *testing.T) {
 			assert.NoError(t, b.Shutdown(ctx))
 
 			want := e.ExportN()
-			assert.NoError(t, b.OnEmit(ctx, Record{}))
+			assert.NoError(t, b.OnEmit(ctx, new(Record)))
 			assert.Equal(t, want, e.ExportN(), "Export called after shutdown")
 		})
 
@@ -289,7 +616,7 @@ func TestBatchProcessor(t *testing.T) {
 			e := newTestExporter(nil)
 			b := NewBatchProcessor(e)
 
-			assert.NoError(t, b.OnEmit(ctx, Record{}))
+			assert.NoError(t, b.OnEmit(ctx, new(Record)))
 			assert.NoError(t, b.Shutdown(ctx))
 
 			assert.NoError(t, b.ForceFlush(ctx))
@@ -308,6 +635,53 @@ func TestBatchProcessor(t *testing.T) {
 
 			assert.ErrorIs(t, b.Shutdown(c), context.Canceled)
 		})
+
+		t.Run("Drained", func(t *testing.T) {
+			e := newTestExporter(nil)
+			b := NewBatchProcessor(e, WithExportInterval(time.Hour))
+
+			const n = 5
+			for i := 0; i < n; i++ {
+				assert.NoError(t, b.OnEmit(ctx, new(Record)))
+			}
+			assert.NoError(t, b.Shutdown(ctx))
+
+			var got int
+			for _, batch := range e.Records() {
+				got += len(batch)
+			}
+			assert.Equal(t, n, got, "records not drained")
+		})
+
+		t.Run("Abandoned", func(t *testing.T) {
+			e := newTestExporter(nil)
+			e.ExportTrigger = make(chan struct{})
+			t.Cleanup(func() { close(e.ExportTrigger) })
+			b := NewBatchProcessor(
+				e,
+				WithMaxQueueSize(10),
+				WithExportMaxBatchSize(1),
+				WithExportInterval(time.Hour),
+				WithExportTimeout(time.Hour),
+			)
+
+			const n = 5
+			for i := 0; i < n; i++ {
+				assert.NoError(t, b.OnEmit(ctx, new(Record)))
+			}
+			// Wait for an in-flight export.
+			require.Eventually(t, func() bool {
+				return e.ExportN() > 0
+			}, 2*time.Second, time.Microsecond, "no export started")
+
+			c, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			err := b.Shutdown(c)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			var dErr *DrainError
+			require.ErrorAs(t, err, &dErr)
+			assert.Equal(t, n, dErr.Abandoned, "abandoned records")
+		})
 	})
 
 	t.Run("ForceFlush", func(t *testing.T) {
@@ -324,7 +698,7 @@ func TestBatchProcessor(t *testing.T) {
 
 			var r Record
 			r.SetBody(log.BoolValue(true))
-			require.NoError(t, b.OnEmit(ctx, r))
+			require.NoError(t, b.OnEmit(ctx, &r))
 
 			assert.ErrorIs(t, b.ForceFlush(ctx), assert.AnError, "exporter error not returned")
 			assert.Equal(t, 1, e.ForceFlushN(), "exporter ForceFlush calls")
@@ -359,10 +733,10 @@ func TestBatchProcessor(t *testing.T) {
 
 			// Enqueue 10 x "batch size" amount of records.
 			for i := 0; i < 10*batch; i++ {
-				require.NoError(t, b.OnEmit(ctx, Record{}))
+				require.NoError(t, b.OnEmit(ctx, new(Record)))
 			}
 			assert.Eventually(t, func() bool {
-				return e.ExportN() > 0
+				return e.ExportN() > 0 && len(b.exporter.input) == cap(b.exporter.input)
 			}, 2*time.Second, time.Microsecond)
 			// 1 export being performed, 1 export in buffer chan, >1 batch
 			// still in queue that an attempt to flush will be made on.
@@ -403,7 +777,7 @@ func TestBatchProcessor(t *testing.T) {
 
 			var r Record
 			r.SetBody(log.BoolValue(true))
-			_ = b.OnEmit(ctx, r)
+			_ = b.OnEmit(ctx, &r)
 			t.Cleanup(func() { _ = b.Shutdown(ctx) })
 			t.Cleanup(func() { close(e.ExportTrigger) })
 
@@ -430,7 +804,7 @@ func TestBatchProcessor(t *testing.T) {
 					case <-ctx.Done():
 						return
 					default:
-						assert.NoError(t, b.OnEmit(ctx, Record{}))
+						assert.NoError(t, b.OnEmit(ctx, new(Record)))
 						// Ignore partial flush errors.
 						_ = b.ForceFlush(ctx)
 					}
@@ -460,10 +834,9 @@ func TestQueue(t *testing.T) {
 	t.Run("newQueue", func(t *testing.T) {
 		const size = 1
 		q := newQueue(size)
-		assert.Equal(t, q.len, 0)
+		assert.Equal(t, 0, q.len())
 		assert.Equal(t, size, q.cap, "capacity")
-		assert.Equal(t, size, q.read.Len(), "read ring")
-		assert.Same(t, q.read, q.write, "different rings")
+		assert.Len(t, q.slots, size, "slots")
 	})
 
 	t.Run("Enqueue", func(t *testing.T) {
@@ -474,44 +847,174 @@ func TestQueue(t *testing.T) {
 		notR.SetBody(log.IntValue(10))
 
 		assert.Equal(t, 1, q.Enqueue(notR), "incomplete batch")
-		assert.Equal(t, 1, q.len, "length")
+		assert.Equal(t, 1, q.len(), "length")
 		assert.Equal(t, size, q.cap, "capacity")
 
 		assert.Equal(t, 2, q.Enqueue(r), "complete batch")
-		assert.Equal(t, 2, q.len, "length")
+		assert.Equal(t, 2, q.len(), "length")
 		assert.Equal(t, size, q.cap, "capacity")
 
 		assert.Equal(t, 2, q.Enqueue(r), "overflow batch")
-		assert.Equal(t, 2, q.len, "length")
+		assert.Equal(t, 2, q.len(), "length")
 		assert.Equal(t, size, q.cap, "capacity")
 
 		assert.Equal(t, []Record{r, r}, q.Flush(), "flushed Records")
 	})
 
+	t.Run("TryEnqueue", func(t *testing.T) {
+		const size = 2
+		q := newQueue(size)
+
+		var notR Record
+		notR.SetBody(log.IntValue(10))
+
+		n, ok := q.TryEnqueue(r)
+		assert.True(t, ok, "not enqueued")
+		assert.Equal(t, 1, n, "incomplete batch")
+		n, ok = q.TryEnqueue(r)
+		assert.True(t, ok, "not enqueued")
+		assert.Equal(t, 2, n, "complete batch")
+
+		n, ok = q.TryEnqueue(notR)
+		assert.False(t, ok, "enqueued to full queue")
+		assert.Equal(t, 2, n, "overflow batch")
+
+		n, dropped := q.Stats()
+		assert.Equal(t, 2, n, "length")
+		assert.Equal(t, 1, dropped, "dropped")
+		assert.Equal(t, []Record{r, r}, q.Flush(), "newest Record not dropped")
+	})
+
+	t.Run("EnqueueEvict", func(t *testing.T) {
+		q := newQueue(1)
+
+		var notR Record
+		notR.SetBody(log.IntValue(10))
+
+		var evicted []Record
+		f := func(r Record) { evicted = append(evicted, r) }
+
+		n := q.EnqueueEvict(notR, f)
+		assert.Empty(t, evicted, "evicted from incomplete batch")
+		assert.Equal(t, 1, n, "complete batch")
+
+		n = q.EnqueueEvict(r, f)
+		assert.Equal(t, 1, n, "overflow batch")
+		assert.Equal(t, []Record{notR}, evicted, "evicted Records")
+
+		_, dropped := q.Stats()
+		assert.Equal(t, 1, dropped, "dropped")
+		assert.Equal(t, []Record{r}, q.Flush(), "oldest Record not dropped")
+	})
+
+	t.Run("EnqueueEvictBelow", func(t *testing.T) {
+		newRecord := func(body string, sev log.Severity) Record {
+			var r Record
+			r.SetBody(log.StringValue(body))
+			r.SetSeverity(sev)
+			return r
+		}
+		error0 := newRecord("error0", log.SeverityError)
+		info := newRecord("info", log.SeverityInfo)
+		error1 := newRecord("error1", log.SeverityError)
+		debug := newRecord("debug", log.SeverityDebug)
+		fatal := newRecord("fatal", log.SeverityFatal)
+		warn0 := newRecord("warn0", log.SeverityWarn)
+		warn1 := newRecord("warn1", log.SeverityWarn)
+		warn2 := newRecord("warn2", log.SeverityWarn)
+
+		q := newQueue(5)
+		for _, r := range []Record{error0, info, error1, debug, fatal} {
+			q.Enqueue(r)
+		}
+
+		var evicted []Record
+		f := func(r Record) { evicted = append(evicted, r) }
+
+		n, ok := q.EnqueueEvictBelow(warn0, log.SeverityWarn1, f)
+		assert.True(t, ok, "warn0 not added")
+		assert.Equal(t, 5, n, "queue size")
+		n, ok = q.EnqueueEvictBelow(warn1, log.SeverityWarn1, f)
+		assert.True(t, ok, "warn1 not added")
+		assert.Equal(t, 5, n, "queue size")
+		assert.Equal(t, []Record{info, debug}, evicted, "evicted Records")
+
+		_, ok = q.EnqueueEvictBelow(warn2, log.SeverityWarn1, f)
+		assert.False(t, ok, "warn2 added to a queue without lower severities")
+		assert.Len(t, evicted, 2, "Warn or greater Record evicted")
+
+		oldest, newest := q.Dropped()
+		assert.Equal(t, 2, oldest, "dropped oldest")
+		assert.Equal(t, 1, newest, "dropped newest")
+		assert.Equal(t, []Record{error0, error1, fatal, warn0, warn1}, q.Flush(), "queue order")
+	})
+
+	t.Run("EnqueueWait", func(t *testing.T) {
+		q := newQueue(1)
+		ctx := context.Background()
+		stop := make(chan struct{})
+
+		n, err := q.EnqueueWait(ctx, r, stop)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		t.Run("Dequeued", func(t *testing.T) {
+			errCh := make(chan error, 1)
+			go func() {
+				_, err := q.EnqueueWait(ctx, r, stop)
+				errCh <- err
+			}()
+			assert.Eventually(t, func() bool {
+				q.waitMu.Lock()
+				defer q.waitMu.Unlock()
+				return q.dequeued != nil
+			}, time.Second, time.Microsecond, "not waiting")
+			assert.Equal(t, []Record{r}, q.Flush())
+			assert.NoError(t, <-errCh)
+			n, dropped := q.Stats()
+			assert.Equal(t, 1, n, "length")
+			assert.Equal(t, 0, dropped, "dropped")
+		})
+
+		t.Run("CanceledContext", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err := q.EnqueueWait(ctx, r, stop)
+			assert.ErrorIs(t, err, context.Canceled)
+			_, dropped := q.Stats()
+			assert.Equal(t, 1, dropped, "dropped")
+		})
+
+		t.Run("Stopped", func(t *testing.T) {
+			close(stop)
+			_, err := q.EnqueueWait(ctx, r, stop)
+			assert.NoError(t, err)
+			_, dropped := q.Stats()
+			assert.Equal(t, 2, dropped, "dropped")
+		})
+	})
+
 	t.Run("Flush", func(t *testing.T) {
 		const size = 2
 		q := newQueue(size)
-		q.write.Value = r
-		q.write = q.write.Next()
-		q.len = 1
+		q.Enqueue(r)
 
 		assert.Equal(t, []Record{r}, q.Flush(), "flushed")
+		assert.Equal(t, 0, q.len(), "length")
 	})
 
 	t.Run("TryFlush", func(t *testing.T) {
 		const size = 3
 		q := newQueue(size)
 		for i := 0; i < size-1; i++ {
-			q.write.Value = r
-			q.write = q.write.Next()
-			q.len++
+			q.Enqueue(r)
 		}
 
 		buf := make([]Record, 1)
 		f := func([]Record) bool { return false }
 		assert.Equal(t, size-1, q.TryDequeue(buf, f), "not flushed")
-		require.Equal(t, size-1, q.len, "length")
-		require.NotSame(t, q.read, q.write, "read ring advanced")
+		require.Equal(t, size-1, q.len(), "length")
+		require.Equal(t, uint64(0), q.head.Load(), "head advanced")
 
 		var flushed []Record
 		f = func(r []Record) bool {
@@ -560,6 +1063,147 @@ func TestQueue(t *testing.T) {
 
 		assert.Len(t, out, goRoutines, "flushed Records")
 	})
+
+	t.Run("WrapAround", func(t *testing.T) {
+		const size = 3
+		q := newQueue(size)
+		buf := make([]Record, size)
+		var got []int64
+		f := func(r []Record) bool {
+			for _, rec := range r {
+				got = append(got, rec.Body().AsInt64())
+			}
+			return true
+		}
+
+		var want []int64
+		for i := int64(0); i < 4*size; i++ {
+			var rec Record
+			rec.SetBody(log.Int64Value(i))
+			_, ok := q.TryEnqueue(rec)
+			require.True(t, ok, "not enqueued")
+			want = append(want, i)
+			if i%2 == 1 {
+				q.TryDequeue(buf, f)
+			}
+		}
+		q.TryDequeue(buf, f)
+		assert.Equal(t, want, got, "Records")
+	})
+
+	t.Run("ConcurrentEvict", func(t *testing.T) {
+		const (
+			goRoutines = 10
+			perRoutine = 100
+		)
+
+		q := newQueue(goRoutines)
+		var evicted atomic.Int64
+		f := func(Record) { evicted.Add(1) }
+
+		var wg sync.WaitGroup
+		wg.Add(goRoutines)
+		for i := 0; i < goRoutines; i++ {
+			go func() {
+				defer wg.Done()
+				for j := 0; j < perRoutine; j++ {
+					q.EnqueueEvict(r, f)
+				}
+			}()
+		}
+		wg.Wait()
+
+		flushed := len(q.Flush())
+		assert.Equal(t, goRoutines, flushed, "flushed Records")
+		assert.Equal(t, goRoutines*perRoutine, flushed+int(evicted.Load()), "lost Records")
+		_, dropped := q.Stats()
+		assert.Equal(t, evicted.Load(), int64(dropped), "dropped")
+	})
+
+	t.Run("ConcurrentEvictBelow", func(t *testing.T) {
+		const (
+			goRoutines = 10
+			perRoutine = 100
+		)
+
+		var info, errRec Record
+		info.SetSeverity(log.SeverityInfo)
+		errRec.SetSeverity(log.SeverityError)
+
+		q := newQueue(goRoutines)
+		var added, evicted atomic.Int64
+		f := func(r Record) {
+			assert.Equal(t, log.SeverityInfo, r.Severity(), "evicted severity")
+			evicted.Add(1)
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(goRoutines)
+		for i := 0; i < goRoutines; i++ {
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < perRoutine; j++ {
+					rec := info
+					if (i+j)%2 == 0 {
+						rec = errRec
+					}
+					if _, ok := q.EnqueueEvictBelow(rec, log.SeverityWarn1, f); ok {
+						added.Add(1)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		flushed := q.Flush()
+		assert.Len(t, flushed, goRoutines, "flushed Records")
+		assert.Equal(t, added.Load(), int64(len(flushed))+evicted.Load(), "lost Records")
+	})
+
+	t.Run("ConcurrentDequeue", func(t *testing.T) {
+		const (
+			goRoutines = 10
+			perRoutine = 100
+		)
+
+		q := newQueue(goRoutines)
+		ctx := context.Background()
+		stop := make(chan struct{})
+
+		var wg sync.WaitGroup
+		wg.Add(goRoutines)
+		for i := 0; i < goRoutines; i++ {
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < perRoutine; j++ {
+					var rec Record
+					rec.SetBody(log.IntValue(i*perRoutine + j))
+					_, err := q.EnqueueWait(ctx, rec, stop)
+					assert.NoError(t, err)
+				}
+			}(i)
+		}
+
+		seen := make(map[int64]bool, goRoutines*perRoutine)
+		buf := make([]Record, 3)
+		f := func(r []Record) bool {
+			for _, rec := range r {
+				v := rec.Body().AsInt64()
+				assert.False(t, seen[v], "duplicate Record")
+				seen[v] = true
+			}
+			return true
+		}
+		for len(seen) < goRoutines*perRoutine {
+			q.TryDequeue(buf, f)
+			runtime.Gosched()
+		}
+		wg.Wait()
+
+		n, dropped := q.Stats()
+		assert.Equal(t, 0, n, "length")
+		assert.Equal(t, 0, dropped, "dropped")
+	})
 }
 
 func BenchmarkBatchProcessorOnEmit(b *testing.B) {
@@ -584,8 +1228,64 @@ func BenchmarkBatchProcessorOnEmit(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		var err error
 		for pb.Next() {
-			err = bp.OnEmit(ctx, r)
+			err = bp.OnEmit(ctx, &r)
 		}
 		_ = err
 	})
 }
+
+func BenchmarkQueue(b *testing.B) {
+	var r Record
+	r.SetBody(log.BoolValue(true))
+
+	for _, size := range []int{64, 2048} {
+		b.Run(fmt.Sprintf("Size/%d", size), func(b *testing.B) {
+			q := newQueue(size)
+
+			// Drain the queue concurrently as the poll goroutine does.
+			stop := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				buf := make([]Record, size)
+				drain := func([]Record) bool { return true }
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+					if q.TryDequeue(buf, drain) == 0 {
+						runtime.Gosched()
+					}
+				}
+			}()
+			b.Cleanup(func() {
+				close(stop)
+				<-done
+			})
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				var n int
+				for pb.Next() {
+					n = q.Enqueue(r)
+				}
+				_ = n
+			})
+		})
+	}
+}
+
+func TestQueueFullPolicyName(t *testing.T) {
+	for policy, want := range map[QueueFullPolicy]string{
+		QueueFullDropOldest:     "drop_oldest",
+		QueueFullDropNewest:     "drop_newest",
+		QueueFullBlock:          "block",
+		QueueFullDropBySeverity: "drop_by_severity",
+		QueueFullPolicy(-1):     "drop_oldest",
+	} {
+		assert.Equal(t, want, policy.name())
+	}
+}
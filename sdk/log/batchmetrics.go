@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// batchMetrics records the metrics about the health of a BatchProcessor.
+type batchMetrics struct {
+	exportSize     metric.Int64Histogram
+	exportDuration metric.Float64Histogram
+	exportFailed   metric.Int64Counter
+
+	reg metric.Registration
+}
+
+// newBatchMetrics returns the batchMetrics of a BatchProcessor with queue q
+// and QueueFullPolicy policy created with a Meter from mp. If mp is nil, nil
+// is returned and no metrics are recorded.
+func newBatchMetrics(mp metric.MeterProvider, q *queue, policy QueueFullPolicy) (*batchMetrics, error) {
+	if mp == nil {
+		return nil, nil
+	}
+
+	meter := mp.Meter("go.opentelemetry.io/otel/sdk/log")
+
+	var err, e error
+	m := new(batchMetrics)
+	m.exportSize, e = meter.Int64Histogram(
+		"otel.sdk.log.batch.export.size",
+		metric.WithDescription("The number of log records per export."),
+		metric.WithUnit("{record}"),
+	)
+	err = errors.Join(err, e)
+	m.exportDuration, e = meter.Float64Histogram(
+		"otel.sdk.log.batch.export.duration",
+		metric.WithDescription("The duration of exports."),
+		metric.WithUnit("s"),
+	)
+	err = errors.Join(err, e)
+	m.exportFailed, e = meter.Int64Counter(
+		"otel.sdk.log.batch.export.failed",
+		metric.WithDescription("The number of log records that failed to be exported."),
+		metric.WithUnit("{record}"),
+	)
+	err = errors.Join(err, e)
+
+	qSize, e := meter.Int64ObservableGauge(
+		"otel.sdk.log.batch.queue.size",
+		metric.WithDescription("The number of log records in the queue."),
+		metric.WithUnit("{record}"),
+	)
+	err = errors.Join(err, e)
+	qCap, e := meter.Int64ObservableGauge(
+		"otel.sdk.log.batch.queue.capacity",
+		metric.WithDescription("The maximum number of log records the queue can hold."),
+		metric.WithUnit("{record}"),
+	)
+	err = errors.Join(err, e)
+	dropped, e := meter.Int64ObservableCounter(
+		"otel.sdk.log.batch.dropped",
+		metric.WithDescription("The number of log records dropped because the queue was full."),
+		metric.WithUnit("{record}"),
+	)
+	err = errors.Join(err, e)
+
+	policyAttr := attribute.String("policy", policy.name())
+	oldestAttrs := metric.WithAttributeSet(attribute.NewSet(policyAttr, attribute.String("record", "oldest")))
+	newestAttrs := metric.WithAttributeSet(attribute.NewSet(policyAttr, attribute.String("record", "newest")))
+	m.reg, e = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		n, _ := q.Stats()
+		o.ObserveInt64(qSize, int64(n))
+		o.ObserveInt64(qCap, int64(q.cap))
+		oldest, newest := q.Dropped()
+		o.ObserveInt64(dropped, int64(oldest), oldestAttrs)
+		o.ObserveInt64(dropped, int64(newest), newestAttrs)
+		return nil
+	}, qSize, qCap, dropped)
+	err = errors.Join(err, e)
+
+	return m, err
+}
+
+// unregister stops observing the queue of the BatchProcessor.
+func (m *batchMetrics) unregister() error {
+	if m == nil || m.reg == nil {
+		return nil
+	}
+	return m.reg.Unregister()
+}
+
+// metricsExporter wraps an Exporter and records the size, duration, and
+// failures of each call to Export.
+type metricsExporter struct {
+	Exporter
+
+	metrics *batchMetrics
+}
+
+// newMetricsExporter wraps exporter with an Exporter that records export
+// metrics to m. If m is nil, exporter is returned directly.
+func newMetricsExporter(exporter Exporter, m *batchMetrics) Exporter {
+	if m == nil {
+		return exporter
+	}
+	return &metricsExporter{Exporter: exporter, metrics: m}
+}
+
+// Export calls the Exporter e wraps and records the export metrics.
+func (e *metricsExporter) Export(ctx context.Context, records []Record) error {
+	start := time.Now()
+	err := e.Exporter.Export(ctx, records)
+	e.metrics.exportDuration.Record(ctx, time.Since(start).Seconds())
+
+	n := int64(len(records))
+	e.metrics.exportSize.Record(ctx, n)
+	if err != nil {
+		e.metrics.exportFailed.Add(ctx, n)
+	}
+	return err
+}
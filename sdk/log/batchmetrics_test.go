@@ -0,0 +1,313 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// testMeterProvider is a metric.MeterProvider recording the instruments
+// created by, and the measurements made with, the Meter it provides. It
+// embeds the noop implementations so only the used methods are overridden.
+type testMeterProvider struct {
+	noop.MeterProvider
+
+	meter *testMeter
+}
+
+func newTestMeterProvider() *testMeterProvider {
+	return &testMeterProvider{meter: &testMeter{
+		desc:      make(map[string]string),
+		unit:      make(map[string]string),
+		sums:      make(map[string]float64),
+		counts:    make(map[string]int),
+		callbacks: make(map[*testRegistration]metric.Callback),
+	}}
+}
+
+func (p *testMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+// collect returns the observations of the registered callbacks keyed by the
+// instrument name followed by the encoded attributes, if any.
+func (p *testMeterProvider) collect(t *testing.T) map[string]int64 {
+	t.Helper()
+
+	p.meter.mu.Lock()
+	callbacks := make([]metric.Callback, 0, len(p.meter.callbacks))
+	for _, f := range p.meter.callbacks {
+		callbacks = append(callbacks, f)
+	}
+	p.meter.mu.Unlock()
+
+	o := &testObserver{values: make(map[string]int64)}
+	for _, f := range callbacks {
+		require.NoError(t, f(context.Background(), o))
+	}
+	return o.values
+}
+
+// sum returns the sum of the measurements recorded with the synchronous
+// instrument name.
+func (p *testMeterProvider) sum(name string) float64 {
+	p.meter.mu.Lock()
+	defer p.meter.mu.Unlock()
+	return p.meter.sums[name]
+}
+
+// count returns the number of measurements recorded with the synchronous
+// instrument name.
+func (p *testMeterProvider) count(name string) int {
+	p.meter.mu.Lock()
+	defer p.meter.mu.Unlock()
+	return p.meter.counts[name]
+}
+
+type testMeter struct {
+	noop.Meter
+
+	mu        sync.Mutex
+	desc      map[string]string
+	unit      map[string]string
+	sums      map[string]float64
+	counts    map[string]int
+	callbacks map[*testRegistration]metric.Callback
+}
+
+func (m *testMeter) instrument(name, desc, unit string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.desc[name], m.unit[name] = desc, unit
+}
+
+func (m *testMeter) record(name string, v float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sums[name] += v
+	m.counts[name]++
+}
+
+func (m *testMeter) Int64Counter(name string, opts ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	c := metric.NewInt64CounterConfig(opts...)
+	m.instrument(name, c.Description(), c.Unit())
+	return testInt64Counter{name: name, meter: m}, nil
+}
+
+func (m *testMeter) Int64Histogram(name string, opts ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	c := metric.NewInt64HistogramConfig(opts...)
+	m.instrument(name, c.Description(), c.Unit())
+	return testInt64Histogram{name: name, meter: m}, nil
+}
+
+func (m *testMeter) Float64Histogram(name string, opts ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	c := metric.NewFloat64HistogramConfig(opts...)
+	m.instrument(name, c.Description(), c.Unit())
+	return testFloat64Histogram{name: name, meter: m}, nil
+}
+
+func (m *testMeter) Int64ObservableCounter(name string, opts ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	c := metric.NewInt64ObservableCounterConfig(opts...)
+	m.instrument(name, c.Description(), c.Unit())
+	return testInt64ObservableCounter{name: name}, nil
+}
+
+func (m *testMeter) Int64ObservableGauge(name string, opts ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	c := metric.NewInt64ObservableGaugeConfig(opts...)
+	m.instrument(name, c.Description(), c.Unit())
+	return testInt64ObservableGauge{name: name}, nil
+}
+
+func (m *testMeter) RegisterCallback(f metric.Callback, _ ...metric.Observable) (metric.Registration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r := &testRegistration{meter: m}
+	m.callbacks[r] = f
+	return r, nil
+}
+
+type testRegistration struct {
+	noop.Registration
+
+	meter *testMeter
+}
+
+func (r *testRegistration) Unregister() error {
+	r.meter.mu.Lock()
+	defer r.meter.mu.Unlock()
+	delete(r.meter.callbacks, r)
+	return nil
+}
+
+type testInt64Counter struct {
+	noop.Int64Counter
+
+	name  string
+	meter *testMeter
+}
+
+func (c testInt64Counter) Add(_ context.Context, v int64, _ ...metric.AddOption) {
+	c.meter.record(c.name, float64(v))
+}
+
+type testInt64Histogram struct {
+	noop.Int64Histogram
+
+	name  string
+	meter *testMeter
+}
+
+func (h testInt64Histogram) Record(_ context.Context, v int64, _ ...metric.RecordOption) {
+	h.meter.record(h.name, float64(v))
+}
+
+type testFloat64Histogram struct {
+	noop.Float64Histogram
+
+	name  string
+	meter *testMeter
+}
+
+func (h testFloat64Histogram) Record(_ context.Context, v float64, _ ...metric.RecordOption) {
+	h.meter.record(h.name, v)
+}
+
+type testInt64ObservableCounter struct {
+	noop.Int64ObservableCounter
+
+	name string
+}
+
+type testInt64ObservableGauge struct {
+	noop.Int64ObservableGauge
+
+	name string
+}
+
+type testObserver struct {
+	noop.Observer
+
+	values map[string]int64
+}
+
+func (o *testObserver) ObserveInt64(obsrv metric.Int64Observable, v int64, opts ...metric.ObserveOption) {
+	var key string
+	switch i := obsrv.(type) {
+	case testInt64ObservableCounter:
+		key = i.name
+	case testInt64ObservableGauge:
+		key = i.name
+	}
+	attrs := metric.NewObserveConfig(opts).Attributes()
+	if attrs.Len() > 0 {
+		key += "{" + attrs.Encoded(attribute.DefaultEncoder()) + "}"
+	}
+	o.values[key] = v
+}
+
+func TestBatchMetricsQueue(t *testing.T) {
+	mp := newTestMeterProvider()
+
+	q := newQueue(2)
+	m, err := newBatchMetrics(mp, q, QueueFullDropBySeverity)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		q.Enqueue(Record{})
+	}
+	q.TryEnqueue(Record{})
+
+	assert.Equal(t, map[string]int64{
+		"otel.sdk.log.batch.queue.size":                                     2,
+		"otel.sdk.log.batch.queue.capacity":                                 2,
+		"otel.sdk.log.batch.dropped{policy=drop_by_severity,record=oldest}": 3,
+		"otel.sdk.log.batch.dropped{policy=drop_by_severity,record=newest}": 1,
+	}, mp.collect(t))
+
+	for name, desc := range map[string]string{
+		"otel.sdk.log.batch.queue.size":     "The number of log records in the queue.",
+		"otel.sdk.log.batch.queue.capacity": "The maximum number of log records the queue can hold.",
+		"otel.sdk.log.batch.dropped":        "The number of log records dropped because the queue was full.",
+	} {
+		assert.Equal(t, desc, mp.meter.desc[name], name)
+		assert.Equal(t, "{record}", mp.meter.unit[name], name)
+	}
+
+	require.NoError(t, m.unregister())
+	assert.Empty(t, mp.collect(t), "queue observed after unregister")
+}
+
+func TestBatchMetricsExporter(t *testing.T) {
+	mp := newTestMeterProvider()
+
+	m, err := newBatchMetrics(mp, newQueue(1), QueueFullDropOldest)
+	require.NoError(t, err)
+
+	exp := newTestExporter(nil)
+	t.Cleanup(exp.Stop)
+	e := newMetricsExporter(exp, m)
+
+	ctx := context.Background()
+	require.NoError(t, e.Export(ctx, make([]Record, 3)))
+	exp.Err = assert.AnError
+	require.ErrorIs(t, e.Export(ctx, make([]Record, 2)), assert.AnError)
+
+	assert.Equal(t, float64(2), mp.sum("otel.sdk.log.batch.export.failed"), "export failed")
+	assert.Equal(t, "The number of log records that failed to be exported.", mp.meter.desc["otel.sdk.log.batch.export.failed"])
+	assert.Equal(t, "{record}", mp.meter.unit["otel.sdk.log.batch.export.failed"])
+
+	assert.Equal(t, 2, mp.count("otel.sdk.log.batch.export.size"), "export size count")
+	assert.Equal(t, float64(5), mp.sum("otel.sdk.log.batch.export.size"), "export size sum")
+
+	assert.Equal(t, "s", mp.meter.unit["otel.sdk.log.batch.export.duration"])
+	assert.Equal(t, 2, mp.count("otel.sdk.log.batch.export.duration"), "export duration count")
+}
+
+func TestBatchMetricsNoMeterProvider(t *testing.T) {
+	m, err := newBatchMetrics(nil, newQueue(1), QueueFullDropOldest)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+	assert.NoError(t, m.unregister())
+
+	exp := newTestExporter(nil)
+	t.Cleanup(exp.Stop)
+	assert.Same(t, exp, newMetricsExporter(exp, m))
+}
+
+func TestBatchMetricsNoopMeterProvider(t *testing.T) {
+	m, err := newBatchMetrics(noop.NewMeterProvider(), newQueue(1), QueueFullDropOldest)
+	require.NoError(t, err)
+
+	exp := newTestExporter(nil)
+	t.Cleanup(exp.Stop)
+	assert.NoError(t, newMetricsExporter(exp, m).Export(context.Background(), make([]Record, 1)))
+	assert.NoError(t, m.unregister())
+}
+
+func TestBatchProcessorMetrics(t *testing.T) {
+	mp := newTestMeterProvider()
+
+	exp := newTestExporter(nil)
+	t.Cleanup(exp.Stop)
+	b := NewBatchProcessor(exp, WithMeterProvider(mp))
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.OnEmit(ctx, new(Record)))
+	}
+	require.NoError(t, b.ForceFlush(ctx))
+	assert.Equal(t, float64(3), mp.sum("otel.sdk.log.batch.export.size"), "exported records")
+
+	require.NoError(t, b.Shutdown(ctx))
+	assert.NotContains(t, mp.collect(t), "otel.sdk.log.batch.queue.size", "queue observed after shutdown")
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerExporter(t *testing.T) {
+	ctx := context.Background()
+	records := make([]Record, 1)
+
+	exp := newTestExporter(assert.AnError)
+	t.Cleanup(exp.Stop)
+
+	now := time.Unix(0, 0)
+	e := NewCircuitBreakerExporter(exp, 2, time.Minute)
+	e.now = func() time.Time { return now }
+
+	// Closed: failures below the threshold are passed through.
+	assert.ErrorIs(t, e.Export(ctx, records), assert.AnError)
+	assert.Equal(t, 1, exp.ExportN())
+
+	// A success resets the consecutive failures.
+	exp.Err = nil
+	assert.NoError(t, e.Export(ctx, records))
+	exp.Err = assert.AnError
+	assert.ErrorIs(t, e.Export(ctx, records), assert.AnError)
+	assert.Equal(t, 3, exp.ExportN())
+
+	// Open after threshold consecutive failures.
+	assert.ErrorIs(t, e.Export(ctx, records), assert.AnError)
+	assert.ErrorIs(t, e.Export(ctx, records), ErrCircuitOpen)
+	now = now.Add(time.Minute - 1)
+	assert.ErrorIs(t, e.Export(ctx, records), ErrCircuitOpen)
+	assert.Equal(t, 4, exp.ExportN(), "exporter called while open")
+
+	// Half-open: a failed probe opens the circuit for another cool-down.
+	now = now.Add(1)
+	assert.ErrorIs(t, e.Export(ctx, records), assert.AnError)
+	assert.Equal(t, 5, exp.ExportN(), "probe not exported")
+	assert.ErrorIs(t, e.Export(ctx, records), ErrCircuitOpen)
+	assert.Equal(t, 5, exp.ExportN(), "exporter called while open")
+
+	// Half-open: a successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	exp.Err = nil
+	assert.NoError(t, e.Export(ctx, records))
+	assert.NoError(t, e.Export(ctx, records))
+	assert.Equal(t, 7, exp.ExportN())
+}
+
+func TestCircuitBreakerExporterSingleProbe(t *testing.T) {
+	ctx := context.Background()
+
+	exp := newTestExporter(assert.AnError)
+	e := NewCircuitBreakerExporter(exp, 1, 0)
+	assert.ErrorIs(t, e.Export(ctx, nil), assert.AnError)
+
+	// Block the probe.
+	exp.ExportTrigger = make(chan struct{})
+	t.Cleanup(func() {
+		close(exp.ExportTrigger)
+		exp.Stop()
+	})
+	errCh := make(chan error, 1)
+	go func() { errCh <- e.Export(ctx, nil) }()
+	assert.Eventually(t, func() bool { return exp.ExportN() == 2 }, time.Second, time.Millisecond)
+
+	assert.ErrorIs(t, e.Export(ctx, nil), ErrCircuitOpen, "concurrent export during probe")
+	exp.ExportTrigger <- struct{}{}
+	assert.ErrorIs(t, <-errCh, assert.AnError)
+}
+
+func TestCircuitBreakerExporterForwards(t *testing.T) {
+	ctx := context.Background()
+	exp := newTestExporter(nil)
+	t.Cleanup(exp.Stop)
+
+	e := NewCircuitBreakerExporter(exp, 1, time.Minute)
+	assert.NoError(t, e.ForceFlush(ctx))
+	assert.Equal(t, 1, exp.ForceFlushN())
+	assert.NoError(t, e.Shutdown(ctx))
+	assert.Equal(t, 1, exp.ShutdownN())
+}
+
+func TestCircuitBreakerExporterNil(t *testing.T) {
+	e := NewCircuitBreakerExporter(nil, 0, -1)
+	assert.NotPanics(t, func() {
+		_ = e.Export(context.Background(), nil)
+		_ = e.ForceFlush(context.Background())
+		_ = e.Shutdown(context.Background())
+	})
+}
@@ -5,5 +5,12 @@
 
 /*
 Package log provides the OpenTelemetry Logs SDK.
+
+# Trace context
+
+The Logger correlates log records with traces. The trace ID, span ID, and
+trace flags of a [Record] are populated from the span context held by the
+context passed to Emit, if any. Bridges emitting with the context of the
+operation being logged get log and trace correlation without extra code.
 */
 package log // import "go.opentelemetry.io/otel/sdk/log"
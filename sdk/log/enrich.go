@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check EnrichmentProcessor implements Processor and
+// FilterProcessor.
+var (
+	_ Processor       = (*EnrichmentProcessor)(nil)
+	_ FilterProcessor = (*EnrichmentProcessor)(nil)
+)
+
+// EnrichFunc returns the attributes to add to a log record emitted with ctx,
+// e.g. a request ID held by ctx. It must not modify r.
+type EnrichFunc func(ctx context.Context, r *Record) []log.KeyValue
+
+// EnrichmentProcessor is a [Processor] decorator that adds attributes to log
+// records, e.g. the name of the Kubernetes pod or the ID of the request being
+// handled.
+type EnrichmentProcessor struct {
+	attrs []log.KeyValue
+	fn    EnrichFunc
+	next  Processor
+}
+
+// NewEnrichmentProcessor returns an [EnrichmentProcessor] that adds attrs and
+// the attributes returned by fn to log records before passing them to next.
+// If fn is nil, only attrs are added.
+//
+// The attributes are added after the ones of the log record, the same way as
+// [Record.AddAttributes]: unless attribute deduplication is disabled, an
+// added attribute replaces the attribute of the log record with the same key,
+// and the attributes returned by fn replace the ones of attrs with the same
+// key. The attribute limits of the log record are applied.
+func NewEnrichmentProcessor(attrs []log.KeyValue, fn EnrichFunc, next Processor) *EnrichmentProcessor {
+	if next == nil {
+		// Do not panic on nil processor.
+		next = NewSimpleProcessor(nil)
+	}
+	return &EnrichmentProcessor{
+		attrs: slices.Clone(attrs),
+		fn:    fn,
+		next:  next,
+	}
+}
+
+// OnEmit adds the enrichment attributes to r and passes it to the decorated
+// processor.
+func (p *EnrichmentProcessor) OnEmit(ctx context.Context, r *Record) error {
+	var dynamic []log.KeyValue
+	if p.fn != nil {
+		dynamic = p.fn(ctx, r)
+	}
+	if n := len(p.attrs) + len(dynamic); n > 0 {
+		// AddAttributes modifies the passed slice. Do not pass p.attrs.
+		attrs := make([]log.KeyValue, 0, n)
+		attrs = append(attrs, p.attrs...)
+		attrs = append(attrs, dynamic...)
+		r.AddAttributes(attrs...)
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+// Enabled returns the result of the decorated processor if it is a
+// [FilterProcessor], or true if it is not.
+func (p *EnrichmentProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if fltr, ok := p.next.(FilterProcessor); ok {
+		return fltr.Enabled(ctx, param)
+	}
+	return true
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *EnrichmentProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *EnrichmentProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+type requestIDKey struct{}
+
+func requestID(ctx context.Context, _ *Record) []log.KeyValue {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return []log.KeyValue{log.String("request.id", id)}
+	}
+	return nil
+}
+
+func TestEnrichmentProcessorOnEmit(t *testing.T) {
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "abc")
+	static := []log.KeyValue{
+		log.String("k8s.pod.name", "pod-1"),
+		log.String("request.id", "static"),
+	}
+
+	testCases := []struct {
+		name         string
+		attrs        []log.KeyValue
+		fn           EnrichFunc
+		allowDupKeys bool
+		recAttrs     []log.KeyValue
+		want         []log.KeyValue
+		wantDropped  int
+	}{
+		{
+			name:     "None",
+			recAttrs: []log.KeyValue{log.String("user", "alice")},
+			want:     []log.KeyValue{log.String("user", "alice")},
+		},
+		{
+			name:     "Static",
+			attrs:    static[:1],
+			recAttrs: []log.KeyValue{log.String("user", "alice")},
+			want: []log.KeyValue{
+				log.String("user", "alice"),
+				log.String("k8s.pod.name", "pod-1"),
+			},
+		},
+		{
+			name:     "Func",
+			fn:       requestID,
+			recAttrs: []log.KeyValue{log.String("user", "alice")},
+			want: []log.KeyValue{
+				log.String("user", "alice"),
+				log.String("request.id", "abc"),
+			},
+		},
+		{
+			name:  "FuncOverridesStatic",
+			attrs: static,
+			fn:    requestID,
+			want: []log.KeyValue{
+				log.String("k8s.pod.name", "pod-1"),
+				log.String("request.id", "abc"),
+			},
+			wantDropped: 1,
+		},
+		{
+			name:     "OverridesRecord",
+			attrs:    static[:1],
+			recAttrs: []log.KeyValue{log.String("k8s.pod.name", "user")},
+			want:     []log.KeyValue{log.String("k8s.pod.name", "pod-1")},
+			// The replaced attribute of the record.
+			wantDropped: 1,
+		},
+		{
+			name:         "AllowDupKeys",
+			attrs:        static[:1],
+			allowDupKeys: true,
+			recAttrs:     []log.KeyValue{log.String("k8s.pod.name", "user")},
+			want: []log.KeyValue{
+				log.String("k8s.pod.name", "user"),
+				log.String("k8s.pod.name", "pod-1"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := newProcessor("next")
+			p := NewEnrichmentProcessor(tc.attrs, tc.fn, next)
+
+			r := Record{
+				attributeValueLengthLimit: -1,
+				attributeCountLimit:       -1,
+				allowDupKeys:              tc.allowDupKeys,
+			}
+			r.AddAttributes(tc.recAttrs...)
+			require.NoError(t, p.OnEmit(ctx, &r))
+
+			require.Len(t, next.records, 1)
+			got := next.records[0]
+			var attrs []log.KeyValue
+			got.WalkAttributes(func(kv log.KeyValue) bool {
+				attrs = append(attrs, kv)
+				return true
+			})
+			assert.Equal(t, tc.want, attrs)
+			assert.Equal(t, tc.wantDropped, got.DroppedAttributes(), "dropped")
+		})
+	}
+}
+
+func TestEnrichmentProcessorAttributesNotModified(t *testing.T) {
+	attrs := []log.KeyValue{log.String("a", "1"), log.String("a", "2")}
+	p := NewEnrichmentProcessor(attrs, nil, newProcessor("next"))
+
+	for i := 0; i < 2; i++ {
+		r := Record{attributeValueLengthLimit: 1, attributeCountLimit: -1}
+		require.NoError(t, p.OnEmit(context.Background(), &r))
+	}
+	assert.Equal(t, []log.KeyValue{log.String("a", "1"), log.String("a", "2")}, attrs)
+	assert.Equal(t, []log.KeyValue{log.String("a", "1"), log.String("a", "2")}, p.attrs)
+}
+
+func TestEnrichmentProcessorEnabled(t *testing.T) {
+	ctx := context.Background()
+	p := NewEnrichmentProcessor(nil, nil, NewSimpleProcessor(nil))
+	assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+
+	next := newProcessor("next")
+	next.enabled = false
+	p = NewEnrichmentProcessor(nil, nil, next)
+	assert.False(t, p.Enabled(ctx, EnabledParameters{}))
+}
+
+func TestEnrichmentProcessorShutdownForceFlush(t *testing.T) {
+	next := newProcessor("next")
+	p := NewEnrichmentProcessor(nil, nil, next)
+
+	ctx := context.Background()
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, 1, next.forceFlushCalls, "ForceFlush not forwarded")
+	assert.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 1, next.shutdownCalls, "Shutdown not forwarded")
+}
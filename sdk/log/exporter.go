@@ -30,7 +30,11 @@ type Exporter interface {
 	// considered unrecoverable and will be reported to a configured error
 	// Handler.
 	//
-	// Implementations must not retain the records slice.
+	// The records slice, and the Records it holds, are only valid for the
+	// duration of the call. The SDK reuses the slice once Export returns so
+	// batches can be exported without copying. Implementations must not
+	// retain the records slice. A Record that needs to be held after Export
+	// returns must be copied (e.g. using Record.Clone) before returning.
 	//
 	// Before modifying a Record, the implementation must use Record.Clone
 	// to create a copy that shares no state with the original.
@@ -119,16 +123,25 @@ func (e *timeoutExporter) Export(ctx context.Context, records []Record) error {
 	return e.Exporter.Export(ctx, records)
 }
 
-// exportSync exports all data from input using exporter in a spawned
-// goroutine. The returned chan will be closed when the spawned goroutine
-// completes.
-func exportSync(input <-chan exportData, exporter Exporter) (done chan struct{}) {
+// exportSync exports all data from input using exporter in workers spawned
+// goroutines. If workers is less than one, one goroutine is spawned. The
+// returned chan will be closed when all the spawned goroutines complete.
+func exportSync(input <-chan exportData, exporter Exporter, workers int) (done chan struct{}) {
+	workers = max(workers, 1)
 	done = make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for data := range input {
+				data.DoExport(exporter.Export)
+			}
+		}()
+	}
 	go func() {
-		defer close(done)
-		for data := range input {
-			data.DoExport(exporter.Export)
-		}
+		wg.Wait()
+		close(done)
 	}()
 	return done
 }
@@ -142,13 +155,36 @@ type exportData struct {
 	// on. If this is nil, and the export error is non-nil, the error will
 	// passed to the OTel error handler.
 	respCh chan<- error
+
+	// release, if not nil, is called once the export has completed and
+	// records are no longer referenced.
+	release func()
+
+	// barrier, if not nil, is waited on before responding. It is used by
+	// flushes to wait for the exports of all the workers.
+	barrier *sync.WaitGroup
+
+	// pending, if not nil, is decremented by the number of records once the
+	// export has completed.
+	pending *atomic.Int64
 }
 
 // DoExport calls exportFn with the data contained in e. The error response
 // will be returned on e's respCh if not nil. The error will be handled by the
 // default OTel error handle if it is not nil and respCh is nil or full.
 func (e exportData) DoExport(exportFn func(context.Context, []Record) error) {
+	if e.release != nil {
+		defer e.release()
+	}
+	if e.pending != nil {
+		defer e.pending.Add(-int64(len(e.records)))
+	}
+
 	if len(e.records) == 0 {
+		if e.barrier != nil {
+			e.barrier.Done()
+			e.barrier.Wait()
+		}
 		e.respond(nil)
 		return
 	}
@@ -167,6 +203,10 @@ func (e exportData) respond(err error) {
 	}
 }
 
+// exporterShutdownTimeout is the time given to an Exporter to shut down once
+// the context passed to the Shutdown of a bufferExporter is done.
+const exporterShutdownTimeout = time.Second
+
 // bufferExporter provides asynchronous and synchronous export functionality by
 // buffering export requests.
 type bufferExporter struct {
@@ -174,33 +214,51 @@ type bufferExporter struct {
 
 	input   chan exportData
 	inputMu sync.Mutex
+	// workers is the number of goroutines exporting concurrently.
+	workers int
+	// flushMu serializes the flush requests so the requests of each flush
+	// are received by distinct workers.
+	flushMu sync.Mutex
 
 	done    chan struct{}
 	stopped atomic.Bool
+
+	// pending is the number of records enqueued and not yet exported.
+	pending atomic.Int64
+	// dropped is the number of records passed to Export and never enqueued.
+	dropped atomic.Int64
 }
 
 // newBufferExporter returns a new bufferExporter that wraps exporter. The
 // returned bufferExporter will buffer at most size number of export requests.
 // If size is less than zero, zero will be used (i.e. only synchronous
 // exporting will be supported).
-func newBufferExporter(exporter Exporter, size int) *bufferExporter {
+//
+// The buffered export requests are exported by workers goroutines. If
+// workers is greater than one, exporter is called concurrently. If workers is
+// less than one, one will be used.
+func newBufferExporter(exporter Exporter, size, workers int) *bufferExporter {
 	if size < 0 {
 		size = 0
 	}
+	workers = max(workers, 1)
 	input := make(chan exportData, size)
 	return &bufferExporter{
 		Exporter: exporter,
 
-		input: input,
-		done:  exportSync(input, exporter),
+		input:   input,
+		workers: workers,
+		done:    exportSync(input, exporter, workers),
 	}
 }
 
 var errStopped = errors.New("exporter stopped")
 
 func (e *bufferExporter) enqueue(ctx context.Context, records []Record, rCh chan<- error) error {
-	data := exportData{ctx, records, rCh}
+	return e.enqueueData(ctx, exportData{ctx: ctx, records: records, respCh: rCh})
+}
 
+func (e *bufferExporter) enqueueData(ctx context.Context, data exportData) error {
 	e.inputMu.Lock()
 	defer e.inputMu.Unlock()
 
@@ -210,26 +268,43 @@ func (e *bufferExporter) enqueue(ctx context.Context, records []Record, rCh chan
 		return errStopped
 	}
 
+	// Count the records as pending before they can be exported.
+	data.pending = &e.pending
+	e.pending.Add(int64(len(data.records)))
 	select {
 	case e.input <- data:
 	case <-ctx.Done():
+		e.pending.Add(-int64(len(data.records)))
 		return ctx.Err()
 	}
 	return nil
 }
 
+// Abandoned returns the number of records enqueued and not yet exported, and
+// records passed to Export that could not be enqueued.
+func (e *bufferExporter) Abandoned() int {
+	return int(e.pending.Load() + e.dropped.Load())
+}
+
 // EnqueueExport enqueues an export of records in the context of ctx to be
 // performed asynchronously. This will return true if the records are
 // successfully enqueued (or the bufferExporter is shut down), false otherwise.
 //
-// The passed records are held after this call returns.
-func (e *bufferExporter) EnqueueExport(records []Record) bool {
+// The passed records are held after this call returns. If release is not nil
+// and the records are enqueued, it is called once the export of records has
+// completed and they are no longer held.
+func (e *bufferExporter) EnqueueExport(records []Record, release func()) bool {
 	if len(records) == 0 {
 		// Nothing to enqueue, do not waste input space.
 		return true
 	}
 
-	data := exportData{ctx: context.Background(), records: records}
+	data := exportData{
+		ctx:     context.Background(),
+		records: records,
+		release: release,
+		pending: &e.pending,
+	}
 
 	e.inputMu.Lock()
 	defer e.inputMu.Unlock()
@@ -240,10 +315,13 @@ func (e *bufferExporter) EnqueueExport(records []Record) bool {
 		return true
 	}
 
+	// Count the records as pending before they can be exported.
+	e.pending.Add(int64(len(records)))
 	select {
 	case e.input <- data:
 		return true
 	default:
+		e.pending.Add(-int64(len(records)))
 		return false
 	}
 }
@@ -261,6 +339,7 @@ func (e *bufferExporter) Export(ctx context.Context, records []Record) error {
 		if errors.Is(err, errStopped) {
 			return nil
 		}
+		e.dropped.Add(int64(len(records)))
 		return fmt.Errorf("%w: dropping %d records", err, len(records))
 	}
 
@@ -275,14 +354,26 @@ func (e *bufferExporter) Export(ctx context.Context, records []Record) error {
 // ForceFlush flushes buffered exports. Any existing exports that is buffered
 // is flushed before this returns.
 func (e *bufferExporter) ForceFlush(ctx context.Context) error {
-	resp := make(chan error, 1)
-	err := e.enqueue(ctx, nil, resp)
-	if err != nil {
-		if errors.Is(err, errStopped) {
-			return nil
+	resp := make(chan error, e.workers)
+	// Enqueue a flush request for each worker. Each worker waits on the
+	// barrier once it has completed the exports it received before. The
+	// first response is sent once all the workers have done so.
+	barrier := new(sync.WaitGroup)
+	barrier.Add(e.workers)
+	e.flushMu.Lock()
+	for i := 0; i < e.workers; i++ {
+		data := exportData{ctx: ctx, respCh: resp, barrier: barrier}
+		if err := e.enqueueData(ctx, data); err != nil {
+			e.flushMu.Unlock()
+			// Release the workers waiting for the requests not enqueued.
+			barrier.Add(i - e.workers)
+			if errors.Is(err, errStopped) {
+				return nil
+			}
+			return err
 		}
-		return err
 	}
+	e.flushMu.Unlock()
 
 	select {
 	case <-resp:
@@ -296,6 +387,10 @@ func (e *bufferExporter) ForceFlush(ctx context.Context) error {
 //
 // Any buffered exports are flushed before this returns.
 //
+// The wrapped Exporter is shut down even if ctx is done. It is then passed a
+// context not canceled with ctx that is done after exporterShutdownTimeout,
+// so it can still release its resources.
+//
 // All calls to EnqueueExport or Exporter will return nil without any export
 // after this is called.
 func (e *bufferExporter) Shutdown(ctx context.Context) error {
@@ -307,10 +402,17 @@ func (e *bufferExporter) Shutdown(ctx context.Context) error {
 
 	// No more sends will be made.
 	close(e.input)
+	var err error
 	select {
 	case <-e.done:
 	case <-ctx.Done():
-		return errors.Join(ctx.Err(), e.Exporter.Shutdown(ctx))
+		err = ctx.Err()
+	}
+
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), exporterShutdownTimeout)
+		defer cancel()
 	}
-	return e.Exporter.Shutdown(ctx)
+	return errors.Join(err, e.Exporter.Shutdown(ctx))
 }
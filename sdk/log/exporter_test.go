@@ -92,7 +92,9 @@ func (e *testExporter) Export(ctx context.Context, r []Record) error {
 	e.inputMu.Lock()
 	defer e.inputMu.Unlock()
 	if !e.stopped.Load() {
-		e.input <- instruction{Record: &r}
+		// Exporters must not retain the records slice.
+		cp := slices.Clone(r)
+		e.input <- instruction{Record: &cp}
 	}
 	return e.Err
 }
@@ -130,6 +132,22 @@ func (e *testExporter) ForceFlushN() int {
 	return int(atomic.LoadInt32(e.forceFlushN))
 }
 
+// shutdownCtxExporter records the state of the context its Shutdown method
+// is called with.
+type shutdownCtxExporter struct {
+	Exporter
+
+	called   bool
+	err      error
+	deadline bool
+}
+
+func (e *shutdownCtxExporter) Shutdown(ctx context.Context) error {
+	e.called, e.err = true, ctx.Err()
+	_, e.deadline = ctx.Deadline()
+	return e.Exporter.Shutdown(ctx)
+}
+
 func TestChunker(t *testing.T) {
 	t.Run("ZeroSize", func(t *testing.T) {
 		exp := newTestExporter(nil)
@@ -216,7 +234,7 @@ func TestExportSync(t *testing.T) {
 		in := make(chan exportData, 1)
 		exp := newTestExporter(assert.AnError)
 		t.Cleanup(exp.Stop)
-		done := exportSync(in, exp)
+		done := exportSync(in, exp, 1)
 
 		var wg sync.WaitGroup
 		wg.Add(1)
@@ -236,11 +254,36 @@ func TestExportSync(t *testing.T) {
 		assert.ErrorIs(t, got, assert.AnError, "error not passed to ErrorHandler")
 	})
 
+	t.Run("Release", func(t *testing.T) {
+		in := make(chan exportData, 1)
+		exp := newTestExporter(nil)
+		t.Cleanup(exp.Stop)
+		done := exportSync(in, exp, 1)
+
+		var released [2]atomic.Bool
+		in <- exportData{
+			ctx:     context.Background(),
+			records: make([]Record, 1),
+			release: func() { released[0].Store(true) },
+		}
+		// Release also needs to be called for empty exports.
+		in <- exportData{
+			ctx:     context.Background(),
+			release: func() { released[1].Store(true) },
+		}
+
+		close(in)
+		eventuallyDone(t, done)
+
+		assert.True(t, released[0].Load(), "export release not called")
+		assert.True(t, released[1].Load(), "empty export release not called")
+	})
+
 	t.Run("ConcurrentSafe", func(t *testing.T) {
 		in := make(chan exportData, 1)
 		exp := newTestExporter(assert.AnError)
 		t.Cleanup(exp.Stop)
-		done := exportSync(in, exp)
+		done := exportSync(in, exp, 1)
 
 		const goRoutines = 10
 		var wg sync.WaitGroup
@@ -331,7 +374,7 @@ func TestBufferExporter(t *testing.T) {
 
 		exp := newTestExporter(nil)
 		t.Cleanup(exp.Stop)
-		e := newBufferExporter(exp, goRoutines)
+		e := newBufferExporter(exp, goRoutines, 1)
 
 		ctx := context.Background()
 		records := make([]Record, 10)
@@ -347,7 +390,7 @@ func TestBufferExporter(t *testing.T) {
 					case <-stop:
 						return
 					default:
-						_ = e.EnqueueExport(records)
+						_ = e.EnqueueExport(records, nil)
 						_ = e.Export(ctx, records)
 						_ = e.ForceFlush(ctx)
 					}
@@ -364,11 +407,52 @@ func TestBufferExporter(t *testing.T) {
 		wg.Wait()
 	})
 
+	t.Run("Workers", func(t *testing.T) {
+		const workers = 3
+
+		exp := newTestExporter(nil)
+		t.Cleanup(exp.Stop)
+		trigger := make(chan struct{})
+		exp.ExportTrigger = trigger
+		e := newBufferExporter(exp, workers, workers)
+
+		for i := 0; i < workers; i++ {
+			require.True(t, e.EnqueueExport(make([]Record, 1), nil))
+		}
+		assert.Eventually(t, func() bool {
+			return exp.ExportN() == workers
+		}, 2*time.Second, time.Microsecond, "exports not concurrent")
+
+		ctx := context.Background()
+		flushed := make(chan error, 2)
+		for i := 0; i < 2; i++ {
+			go func() { flushed <- e.ForceFlush(ctx) }()
+		}
+		select {
+		case <-flushed:
+			t.Fatal("ForceFlush returned before exports completed")
+		case <-time.After(10 * time.Millisecond):
+		}
+		assert.Equal(t, 0, int(atomic.LoadInt32(exp.forceFlushN)), "exporter flushed before exports completed")
+
+		close(trigger)
+		for i := 0; i < 2; i++ {
+			select {
+			case err := <-flushed:
+				assert.NoError(t, err)
+			case <-time.After(2 * time.Second):
+				t.Fatal("ForceFlush not unblocked")
+			}
+		}
+		assert.Len(t, exp.Records(), workers, "exported Record batches")
+		assert.NoError(t, e.Shutdown(ctx))
+	})
+
 	t.Run("Shutdown", func(t *testing.T) {
 		t.Run("Multiple", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			assert.NoError(t, e.Shutdown(context.Background()))
 			assert.Equal(t, 1, exp.ShutdownN(), "first Shutdown")
@@ -391,10 +475,10 @@ func TestBufferExporter(t *testing.T) {
 			trigger := make(chan struct{})
 			exp.ExportTrigger = trigger
 			t.Cleanup(func() { close(trigger) })
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			// Make sure there is something to flush.
-			require.True(t, e.EnqueueExport(make([]Record, 1)))
+			require.True(t, e.EnqueueExport(make([]Record, 1), nil))
 
 			ctx, cancel := context.WithCancel(context.Background())
 			cancel()
@@ -402,13 +486,29 @@ func TestBufferExporter(t *testing.T) {
 			err := e.Shutdown(ctx)
 			assert.ErrorIs(t, err, context.Canceled)
 			assert.ErrorIs(t, err, assert.AnError)
+			assert.Equal(t, 1, exp.ShutdownN(), "exporter not shut down")
+		})
+
+		t.Run("ContextDetached", func(t *testing.T) {
+			te := newTestExporter(nil)
+			t.Cleanup(te.Stop)
+			exp := &shutdownCtxExporter{Exporter: te}
+			e := newBufferExporter(exp, 1, 1)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			assert.ErrorIs(t, e.Shutdown(ctx), context.Canceled)
+			require.True(t, exp.called, "exporter not shut down")
+			assert.NoError(t, exp.err, "exporter shut down with a done context")
+			assert.True(t, exp.deadline, "exporter shut down without a deadline")
 		})
 
 		t.Run("Error", func(t *testing.T) {
 			exp := newTestExporter(assert.AnError)
 			t.Cleanup(exp.Stop)
 
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 			assert.ErrorIs(t, e.Shutdown(context.Background()), assert.AnError)
 		})
 	})
@@ -417,7 +517,7 @@ func TestBufferExporter(t *testing.T) {
 		t.Run("Multiple", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 2)
+			e := newBufferExporter(exp, 2, 1)
 
 			ctx := context.Background()
 			records := make([]Record, 1)
@@ -440,10 +540,10 @@ func TestBufferExporter(t *testing.T) {
 			trigger := make(chan struct{})
 			exp.ExportTrigger = trigger
 			t.Cleanup(func() { close(trigger) })
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			ctx, cancel := context.WithCancel(context.Background())
-			require.True(t, e.EnqueueExport(make([]Record, 1)))
+			require.True(t, e.EnqueueExport(make([]Record, 1), nil))
 
 			got := make(chan error, 1)
 			go func() { got <- e.ForceFlush(ctx) }()
@@ -456,7 +556,7 @@ func TestBufferExporter(t *testing.T) {
 			_ = e.Shutdown(ctx)
 
 			// Zero length buffer
-			e = newBufferExporter(exp, 0)
+			e = newBufferExporter(exp, 0, 1)
 			assert.ErrorIs(t, e.ForceFlush(ctx), context.Canceled, "not enqueued")
 		})
 
@@ -464,7 +564,7 @@ func TestBufferExporter(t *testing.T) {
 			exp := newTestExporter(assert.AnError)
 			t.Cleanup(exp.Stop)
 
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 			assert.ErrorIs(t, e.ForceFlush(context.Background()), assert.AnError)
 		})
 
@@ -472,7 +572,7 @@ func TestBufferExporter(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
 
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			ctx := context.Background()
 			_ = e.Shutdown(ctx)
@@ -484,7 +584,7 @@ func TestBufferExporter(t *testing.T) {
 		t.Run("ZeroRecords", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			assert.NoError(t, e.Export(context.Background(), nil))
 			assert.Equal(t, 0, exp.ExportN())
@@ -493,7 +593,7 @@ func TestBufferExporter(t *testing.T) {
 		t.Run("Multiple", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			ctx := context.Background()
 			records := make([]Record, 1)
@@ -517,7 +617,7 @@ func TestBufferExporter(t *testing.T) {
 			trigger := make(chan struct{})
 			exp.ExportTrigger = trigger
 			t.Cleanup(func() { close(trigger) })
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			records := make([]Record, 1)
 			ctx, cancel := context.WithCancel(context.Background())
@@ -533,7 +633,7 @@ func TestBufferExporter(t *testing.T) {
 			_ = e.Shutdown(ctx)
 
 			// Zero length buffer
-			e = newBufferExporter(exp, 0)
+			e = newBufferExporter(exp, 0, 1)
 			assert.ErrorIs(t, e.Export(ctx, records), context.Canceled, "not enqueued")
 		})
 
@@ -541,7 +641,7 @@ func TestBufferExporter(t *testing.T) {
 			exp := newTestExporter(assert.AnError)
 			t.Cleanup(exp.Stop)
 
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 			ctx, records := context.Background(), make([]Record, 1)
 			assert.ErrorIs(t, e.Export(ctx, records), assert.AnError)
 		})
@@ -550,7 +650,7 @@ func TestBufferExporter(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
 
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			ctx := context.Background()
 			_ = e.Shutdown(ctx)
@@ -563,9 +663,9 @@ func TestBufferExporter(t *testing.T) {
 		t.Run("ZeroRecords", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
-			assert.True(t, e.EnqueueExport(nil))
+			assert.True(t, e.EnqueueExport(nil, nil))
 			e.ForceFlush(context.Background())
 			assert.Equal(t, 0, exp.ExportN(), "empty batch enqueued")
 		})
@@ -573,13 +673,13 @@ func TestBufferExporter(t *testing.T) {
 		t.Run("Multiple", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 2)
+			e := newBufferExporter(exp, 2, 1)
 
 			records := make([]Record, 1)
 			records[0].SetBody(log.BoolValue(true))
 
-			assert.True(t, e.EnqueueExport(records))
-			assert.True(t, e.EnqueueExport(records))
+			assert.True(t, e.EnqueueExport(records, nil))
+			assert.True(t, e.EnqueueExport(records, nil))
 			e.ForceFlush(context.Background())
 
 			n := exp.ExportN()
@@ -590,10 +690,10 @@ func TestBufferExporter(t *testing.T) {
 		t.Run("Stopped", func(t *testing.T) {
 			exp := newTestExporter(nil)
 			t.Cleanup(exp.Stop)
-			e := newBufferExporter(exp, 1)
+			e := newBufferExporter(exp, 1, 1)
 
 			_ = e.Shutdown(context.Background())
-			assert.True(t, e.EnqueueExport(make([]Record, 1)))
+			assert.True(t, e.EnqueueExport(make([]Record, 1), nil))
 		})
 	})
 }
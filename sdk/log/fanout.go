@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"errors"
+)
+
+// Compile-time check FanOutProcessor implements Processor and
+// FilterProcessor.
+var (
+	_ Processor       = (*FanOutProcessor)(nil)
+	_ FilterProcessor = (*FanOutProcessor)(nil)
+)
+
+// FanOutProcessor is a [Processor] that passes log records to multiple
+// processors, each with a record isolated from the others.
+//
+// Each decorated processor is passed its own copy of the record. A
+// modification made by a decorated processor is not seen by the other
+// processors or the caller of OnEmit. The record is only deeply copied when
+// needed: a record with no more than 5 attributes is isolated by a value
+// copy, without any allocation.
+type FanOutProcessor struct {
+	processors     []Processor
+	fltrProcessors []FilterProcessor
+}
+
+// NewFanOutProcessor returns a [FanOutProcessor] that passes log records to
+// all processors, in the order they are provided. Nil processors are ignored.
+func NewFanOutProcessor(processors ...Processor) *FanOutProcessor {
+	p := &FanOutProcessor{}
+	for _, proc := range processors {
+		if proc == nil {
+			continue
+		}
+		p.processors = append(p.processors, proc)
+		if f, ok := proc.(FilterProcessor); ok {
+			p.fltrProcessors = append(p.fltrProcessors, f)
+		}
+	}
+	return p
+}
+
+// OnEmit passes an isolated copy of r to each decorated processor. The
+// errors returned by the processors are joined and returned.
+func (p *FanOutProcessor) OnEmit(ctx context.Context, r *Record) error {
+	var err error
+	for _, proc := range p.processors {
+		rec := isolate(r)
+		err = errors.Join(err, proc.OnEmit(ctx, &rec))
+	}
+	return err
+}
+
+// isolate returns a copy of r that shares no modifiable state with r.
+func isolate(r *Record) Record {
+	if len(r.back) == 0 {
+		// All attributes are stored inline. A value copy of r is enough.
+		return *r
+	}
+	return r.Clone()
+}
+
+// Enabled returns true if any decorated processor will process for ctx and
+// param. A decorated processor that is not a [FilterProcessor] is considered
+// to process all records.
+func (p *FanOutProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if len(p.processors) > len(p.fltrProcessors) {
+		return true
+	}
+	for _, f := range p.fltrProcessors {
+		if f.Enabled(ctx, param) {
+			return true
+		}
+	}
+	return false
+}
+
+// Shutdown shuts down all decorated processors. The errors returned by the
+// processors are joined and returned.
+func (p *FanOutProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	for _, proc := range p.processors {
+		err = errors.Join(err, proc.Shutdown(ctx))
+	}
+	return err
+}
+
+// ForceFlush flushes all decorated processors. The errors returned by the
+// processors are joined and returned.
+func (p *FanOutProcessor) ForceFlush(ctx context.Context) error {
+	var err error
+	for _, proc := range p.processors {
+		err = errors.Join(err, proc.ForceFlush(ctx))
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// mutatingProcessor modifies every record it is passed without cloning it.
+type mutatingProcessor struct {
+	*processor
+}
+
+func (p mutatingProcessor) OnEmit(ctx context.Context, r *Record) error {
+	r.SetBody(log.StringValue("mutated"))
+	// Overwrite all existing attributes in place.
+	var keys []string
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		keys = append(keys, kv.Key)
+		return true
+	})
+	for _, k := range keys {
+		r.AddAttributes(log.String(k, "mutated"))
+	}
+	r.AddAttributes(log.String("new", "attr"))
+	return p.processor.OnEmit(ctx, r)
+}
+
+func TestFanOutProcessorOnEmitIsolation(t *testing.T) {
+	for _, n := range []int{1, attributesInlineCount, attributesInlineCount + 3} {
+		var r Record
+		r.attributeCountLimit = -1
+		r.SetBody(log.StringValue("original"))
+		for i := 0; i < n; i++ {
+			r.AddAttributes(log.Int("k"+string(rune('0'+i)), i))
+		}
+		orig := r.Clone()
+
+		mut := mutatingProcessor{newProcessor("mutating")}
+		after := newProcessor("after")
+		p := NewFanOutProcessor(mut, after)
+		require.NoError(t, p.OnEmit(context.Background(), &r))
+
+		require.Len(t, mut.records, 1)
+		assert.Equal(t, "mutated", mut.records[0].Body().AsString())
+
+		require.Len(t, after.records, 1)
+		assert.Equal(t, orig, after.records[0], "record modified by a previous processor")
+		assert.Equal(t, orig, r, "record of caller modified")
+	}
+}
+
+func TestFanOutProcessorNil(t *testing.T) {
+	p := NewFanOutProcessor(nil, newProcessor("0"), nil)
+	assert.Len(t, p.processors, 1)
+}
+
+func TestFanOutProcessorEnabled(t *testing.T) {
+	ctx := context.Background()
+	disabled := newProcessor("disabled")
+	disabled.enabled = false
+
+	assert.False(t, NewFanOutProcessor().Enabled(ctx, EnabledParameters{}), "no processors")
+	assert.False(t, NewFanOutProcessor(disabled).Enabled(ctx, EnabledParameters{}), "disabled")
+	assert.True(t, NewFanOutProcessor(disabled, newProcessor("enabled")).Enabled(ctx, EnabledParameters{}), "enabled")
+	assert.True(t, NewFanOutProcessor(disabled, NewSimpleProcessor(nil)).Enabled(ctx, EnabledParameters{}), "non-filter")
+}
+
+func TestFanOutProcessorShutdownForceFlush(t *testing.T) {
+	ctx := context.Background()
+	p0, p1 := newProcessor("0"), newProcessor("1")
+	p1.Err = assert.AnError
+	p := NewFanOutProcessor(p0, p1)
+
+	assert.ErrorIs(t, p.OnEmit(ctx, new(Record)), assert.AnError)
+	assert.Len(t, p0.records, 1, "error stopped fan-out")
+
+	assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError)
+	assert.Equal(t, 1, p0.forceFlushCalls)
+	assert.Equal(t, 1, p1.forceFlushCalls)
+
+	assert.ErrorIs(t, p.Shutdown(ctx), assert.AnError)
+	assert.Equal(t, 1, p0.shutdownCalls)
+	assert.Equal(t, 1, p1.shutdownCalls)
+}
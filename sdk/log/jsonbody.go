@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check JSONBodyProcessor implements Processor and
+// FilterProcessor.
+var (
+	_ Processor       = (*JSONBodyProcessor)(nil)
+	_ FilterProcessor = (*JSONBodyProcessor)(nil)
+)
+
+// JSONBodyProcessor is a [Processor] decorator that parses the log record
+// bodies holding a JSON object serialized as a string into a structured map
+// body. Backends receive structured data instead of an opaque string.
+type JSONBodyProcessor struct {
+	promote map[string]struct{}
+	next    Processor
+}
+
+// NewJSONBodyProcessor returns a [JSONBodyProcessor] that parses string
+// bodies holding a JSON object into a [log.KindMap] body before passing log
+// records to next. Bodies that are not a string holding a valid JSON object
+// are passed unchanged.
+//
+// The top-level fields of the object with a key in promote are removed from
+// the body and added as attributes of the log record instead.
+//
+// JSON numbers are converted to [log.KindInt64] values if they are integers
+// that fit in an int64, and to [log.KindFloat64] values otherwise. JSON null
+// values are converted to empty values. The order of the object fields is
+// preserved.
+func NewJSONBodyProcessor(next Processor, promote ...string) *JSONBodyProcessor {
+	if next == nil {
+		// Do not panic on nil processor.
+		next = NewSimpleProcessor(nil)
+	}
+	p := &JSONBodyProcessor{next: next}
+	if len(promote) > 0 {
+		p.promote = make(map[string]struct{}, len(promote))
+		for _, key := range promote {
+			p.promote[key] = struct{}{}
+		}
+	}
+	return p
+}
+
+// OnEmit parses the body of r if it is a string holding a JSON object and
+// passes r to the decorated processor.
+func (p *JSONBodyProcessor) OnEmit(ctx context.Context, r *Record) error {
+	body := r.Body()
+	if body.Kind() == log.KindString {
+		if kvs, ok := parseJSONObject(body.AsString()); ok {
+			kvs = p.promoteFields(r, kvs)
+			r.SetBody(log.MapValue(kvs...))
+		}
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+// promoteFields adds the fields of kvs to promote as attributes of r. The
+// remaining fields are returned.
+func (p *JSONBodyProcessor) promoteFields(r *Record, kvs []log.KeyValue) []log.KeyValue {
+	if len(p.promote) == 0 {
+		return kvs
+	}
+	n := 0
+	for _, kv := range kvs {
+		if _, ok := p.promote[kv.Key]; ok {
+			r.AddAttributes(kv)
+			continue
+		}
+		kvs[n] = kv
+		n++
+	}
+	return kvs[:n]
+}
+
+// Enabled returns the result of the decorated processor if it is a
+// [FilterProcessor], or true if it is not.
+func (p *JSONBodyProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if fltr, ok := p.next.(FilterProcessor); ok {
+		return fltr.Enabled(ctx, param)
+	}
+	return true
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *JSONBodyProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *JSONBodyProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+var errInvalidJSON = errors.New("invalid JSON")
+
+// parseJSONObject returns the fields of the JSON object held by s. If s does
+// not hold a single valid JSON object, false is returned.
+func parseJSONObject(s string) ([]log.KeyValue, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		// Fast path: do not try to parse what cannot be an object.
+		return nil, false
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	v, err := decodeJSONValue(dec)
+	if err != nil || v.Kind() != log.KindMap {
+		return nil, false
+	}
+	if _, err := dec.Token(); err == nil {
+		// Trailing data after the object.
+		return nil, false
+	}
+	return v.AsMap(), true
+}
+
+// decodeJSONValue decodes the next JSON value of dec.
+func decodeJSONValue(dec *json.Decoder) (log.Value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return log.Value{}, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			var kvs []log.KeyValue
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return log.Value{}, err
+				}
+				key, ok := keyTok.(string)
+				if !ok {
+					return log.Value{}, errInvalidJSON
+				}
+				v, err := decodeJSONValue(dec)
+				if err != nil {
+					return log.Value{}, err
+				}
+				kvs = append(kvs, log.KeyValue{Key: key, Value: v})
+			}
+			if _, err := dec.Token(); err != nil {
+				return log.Value{}, err
+			}
+			return log.MapValue(kvs...), nil
+		case '[':
+			var vals []log.Value
+			for dec.More() {
+				v, err := decodeJSONValue(dec)
+				if err != nil {
+					return log.Value{}, err
+				}
+				vals = append(vals, v)
+			}
+			if _, err := dec.Token(); err != nil {
+				return log.Value{}, err
+			}
+			return log.SliceValue(vals...), nil
+		}
+		return log.Value{}, errInvalidJSON
+	case string:
+		return log.StringValue(t), nil
+	case bool:
+		return log.BoolValue(t), nil
+	case json.Number:
+		if !strings.ContainsAny(string(t), ".eE") {
+			if i, err := t.Int64(); err == nil {
+				return log.Int64Value(i), nil
+			}
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return log.Value{}, err
+		}
+		return log.Float64Value(f), nil
+	case nil:
+		return log.Value{}, nil
+	}
+	return log.Value{}, errInvalidJSON
+}
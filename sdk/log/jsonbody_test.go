@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestJSONBodyProcessorOnEmit(t *testing.T) {
+	testCases := []struct {
+		name      string
+		body      log.Value
+		promote   []string
+		wantBody  log.Value
+		wantAttrs []log.KeyValue
+	}{
+		{
+			name: "Object",
+			body: log.StringValue(` {"msg": "hello", "n": 1, "big": 18446744073709551616, "f": 1.5, "e": 1e3, "ok": true, "nil": null, "list": [1, "a"], "obj": {"k": "v"}} `),
+			wantBody: log.MapValue(
+				log.String("msg", "hello"),
+				log.Int64("n", 1),
+				log.Float64("big", 18446744073709551616),
+				log.Float64("f", 1.5),
+				log.Float64("e", 1000),
+				log.Bool("ok", true),
+				log.Empty("nil"),
+				log.Slice("list", log.Int64Value(1), log.StringValue("a")),
+				log.Map("obj", log.String("k", "v")),
+			),
+		},
+		{
+			name:     "EmptyObject",
+			body:     log.StringValue(`{}`),
+			wantBody: log.MapValue(),
+		},
+		{
+			name:      "Promote",
+			body:      log.StringValue(`{"msg": "hello", "user": "alice", "code": 42}`),
+			promote:   []string{"user", "code", "missing"},
+			wantBody:  log.MapValue(log.String("msg", "hello")),
+			wantAttrs: []log.KeyValue{log.String("user", "alice"), log.Int64("code", 42)},
+		},
+		{
+			name:     "NotJSON",
+			body:     log.StringValue("hello"),
+			wantBody: log.StringValue("hello"),
+		},
+		{
+			name:     "Array",
+			body:     log.StringValue(`[1, 2]`),
+			wantBody: log.StringValue(`[1, 2]`),
+		},
+		{
+			name:     "Invalid",
+			body:     log.StringValue(`{"msg": }`),
+			wantBody: log.StringValue(`{"msg": }`),
+		},
+		{
+			name:     "TrailingData",
+			body:     log.StringValue(`{"a": 1} {"b": 2}`),
+			wantBody: log.StringValue(`{"a": 1} {"b": 2}`),
+		},
+		{
+			name:     "NotString",
+			body:     log.Int64Value(1),
+			wantBody: log.Int64Value(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := newProcessor("next")
+			p := NewJSONBodyProcessor(next, tc.promote...)
+
+			r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+			r.SetBody(tc.body)
+			require.NoError(t, p.OnEmit(context.Background(), &r))
+
+			require.Len(t, next.records, 1)
+			got := next.records[0]
+			assert.Truef(t, tc.wantBody.Equal(got.Body()), "body: want %s, got %s", tc.wantBody, got.Body())
+			var attrs []log.KeyValue
+			got.WalkAttributes(func(kv log.KeyValue) bool {
+				attrs = append(attrs, kv)
+				return true
+			})
+			assert.Equal(t, tc.wantAttrs, attrs)
+		})
+	}
+}
+
+func TestJSONBodyProcessorEnabled(t *testing.T) {
+	ctx := context.Background()
+	p := NewJSONBodyProcessor(NewSimpleProcessor(nil))
+	assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+
+	next := newProcessor("next")
+	next.enabled = false
+	p = NewJSONBodyProcessor(next)
+	assert.False(t, p.Enabled(ctx, EnabledParameters{}))
+}
+
+func TestJSONBodyProcessorShutdownForceFlush(t *testing.T) {
+	next := newProcessor("next")
+	p := NewJSONBodyProcessor(next)
+
+	ctx := context.Background()
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, 1, next.forceFlushCalls, "ForceFlush not forwarded")
+	assert.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 1, next.shutdownCalls, "Shutdown not forwarded")
+}
+
+func BenchmarkJSONBodyProcessor(b *testing.B) {
+	p := NewJSONBodyProcessor(NewSimpleProcessor(nil), "user")
+	body := log.StringValue(`{"msg": "hello", "user": "alice", "code": 42, "tags": ["a", "b"]}`)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var r Record
+		r.SetBody(body)
+		_ = p.OnEmit(ctx, &r)
+	}
+}
@@ -5,6 +5,7 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -24,38 +25,85 @@ type logger struct {
 
 	provider             *LoggerProvider
 	instrumentationScope instrumentation.Scope
+	config               LoggerConfig
+	// attrs are the attributes stamped on the log records.
+	attrs []log.KeyValue
 }
 
 func newLogger(p *LoggerProvider, scope instrumentation.Scope) *logger {
 	return &logger{
 		provider:             p,
 		instrumentationScope: scope,
+		config:               loggerConfig(p.loggerConfigs, scope),
+		attrs:                loggerAttributes(p.recordAttrs, p.scopeRecordAttrs, scope, p.attributeValueLengthLimit),
 	}
 }
 
 func (l *logger) Emit(ctx context.Context, r log.Record) {
-	newRecord := l.newRecord(ctx, r)
-	for _, p := range l.provider.processors {
+	if l.provider.stopped.Load() || !l.configEnabled(r.Severity()) {
+		return
+	}
+
+	// Processors are passed a pointer to the record. Reuse it from a pool to
+	// avoid allocating each emitted record on the heap. Processors do not
+	// retain the record after OnEmit returns.
+	newRecord := recordPool.Get().(*Record)
+	*newRecord = l.newRecord(ctx, r)
+	for _, p := range l.provider.getProcessors().processors {
 		if err := p.OnEmit(ctx, newRecord); err != nil {
 			otel.Handle(err)
 		}
 	}
+	*newRecord = Record{}
+	recordPool.Put(newRecord)
+}
+
+var recordPool = sync.Pool{
+	New: func() any { return new(Record) },
 }
 
+// Enabled returns true if at least one Processor held by the LoggerProvider
+// that created the logger will process for the provided context and record.
+//
+// If it is not possible to definitively determine the record will be
+// processed, true will be returned by default. A value of false will only be
+// returned if it can be positively verified that no Processor will process.
 func (l *logger) Enabled(ctx context.Context, r log.Record) bool {
-	newRecord := l.newRecord(ctx, r)
-	for _, p := range l.provider.processors {
-		if enabled := p.Enabled(ctx, newRecord); enabled {
+	if l.provider.stopped.Load() || !l.configEnabled(r.Severity()) {
+		return false
+	}
+
+	procs := l.provider.getProcessors()
+	// If there are more Processors than FilterProcessors we cannot be sure
+	// that all Processors will drop the record. Therefore, return true.
+	if len(procs.processors) > len(procs.fltrs) {
+		return true
+	}
+
+	param := EnabledParameters{
+		Resource:             *l.provider.resource,
+		InstrumentationScope: l.instrumentationScope,
+		Severity:             r.Severity(),
+	}
+	for _, flt := range procs.fltrs {
+		if flt.Enabled(ctx, param) {
 			return true
 		}
 	}
 	return false
 }
 
+// configEnabled returns if the LoggerConfig of l allows emitting a log record
+// with severity.
+func (l *logger) configEnabled(severity log.Severity) bool {
+	return !l.config.Disabled && severity >= l.config.MinSeverity
+}
+
 func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 	sc := trace.SpanContextFromContext(ctx)
 
 	newRecord := Record{
+		eventName:         r.EventName(),
 		timestamp:         r.Timestamp(),
 		observedTimestamp: r.ObservedTimestamp(),
 		severity:          r.Severity(),
@@ -70,17 +118,27 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		scope:                     &l.instrumentationScope,
 		attributeValueLengthLimit: l.provider.attributeValueLengthLimit,
 		attributeCountLimit:       l.provider.attributeCountLimit,
+		allowDupKeys:              l.provider.allowDupKeys,
 	}
 
 	// This field SHOULD be set once the event is observed by OpenTelemetry.
 	if newRecord.observedTimestamp.IsZero() {
-		newRecord.observedTimestamp = now()
+		newRecord.observedTimestamp = l.provider.clock.Now()
 	}
 
+	// Stamp the provider attributes first so the ones of r take precedence.
+	// They are already deduplicated and within the value limits, adding them
+	// does not modify them.
+	newRecord.AddAttributes(l.attrs...)
+
 	r.WalkAttributes(func(kv log.KeyValue) bool {
 		newRecord.AddAttributes(kv)
 		return true
 	})
 
+	if l.provider.recordSizeLimit >= 0 {
+		newRecord.applySizeLimit(l.provider.recordSizeLimit)
+	}
+
 	return newRecord
 }
@@ -14,6 +14,37 @@ import (
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 )
 
+func BenchmarkLoggerEmit(b *testing.B) {
+	provider := NewLoggerProvider(
+		WithProcessor(NewSimpleProcessor(defaultNoopExporter)),
+		WithProcessor(NewBatchProcessor(defaultNoopExporter)),
+	)
+	b.Cleanup(func() { assert.NoError(b, provider.Shutdown(context.Background())) })
+	logger := newLogger(provider, instrumentation.Scope{})
+
+	r := log.Record{}
+	r.SetTimestamp(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.SetObservedTimestamp(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.SetBody(log.StringValue("testing body value"))
+	r.SetSeverity(log.SeverityInfo)
+	r.SetSeverityText("testing text")
+
+	r.AddAttributes(
+		log.String("k1", "str"),
+		log.Float64("k2", 1.0),
+		log.Int("k3", 2),
+		log.Bool("k4", true),
+		log.Bytes("k5", []byte{1}),
+	)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			logger.Emit(context.Background(), r)
+		}
+	})
+}
+
 func BenchmarkLoggerNewRecord(b *testing.B) {
 	logger := newLogger(NewLoggerProvider(), instrumentation.Scope{})
 
@@ -54,4 +85,44 @@ func BenchmarkLoggerNewRecord(b *testing.B) {
 			}
 		})
 	})
+
+	r10Unique := r
+	r10Unique.AddAttributes(
+		log.String("k6", "str"),
+		log.Float64("k7", 1.0),
+		log.Int("k8", 2),
+		log.Bool("k9", true),
+		log.Bytes("k10", []byte{1}),
+	)
+
+	b.Run("10 unique attributes", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				logger.newRecord(context.Background(), r10Unique)
+			}
+		})
+	})
+
+	b.Run("10 unique attributes/no deduplication", func(b *testing.B) {
+		logger := newLogger(NewLoggerProvider(WithAttributeDeduplication(false)), instrumentation.Scope{})
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				logger.newRecord(context.Background(), r10Unique)
+			}
+		})
+	})
+}
+
+func BenchmarkLoggerProviderLogger(b *testing.B) {
+	provider := NewLoggerProvider()
+	_ = provider.Logger("benchmark")
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = provider.Logger("benchmark")
+		}
+	})
 }
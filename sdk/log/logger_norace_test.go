@@ -43,4 +43,22 @@ func TestAllocationLimits(t *testing.T) {
 	assert.Equal(t, 0.0, testing.AllocsPerRun(runs, func() {
 		logger.newRecord(context.Background(), r)
 	}), "newRecord")
+
+	provider := NewLoggerProvider(WithProcessor(NewSimpleProcessor(defaultNoopExporter)))
+	logger = newLogger(provider, instrumentation.Scope{})
+	assert.Equal(t, 0.0, testing.AllocsPerRun(runs, func() {
+		logger.Emit(context.Background(), r)
+	}), "Emit")
+
+	provider = NewLoggerProvider(
+		WithProcessor(NewSimpleProcessor(defaultNoopExporter)),
+		WithRecordAttributes(log.String("deployment.environment", "production")),
+	)
+	logger = newLogger(provider, instrumentation.Scope{})
+	// Stamped attributes are held inline with the ones of the record.
+	var small log.Record
+	small.AddAttributes(log.String("k1", "str"))
+	assert.Equal(t, 0.0, testing.AllocsPerRun(runs, func() {
+		logger.Emit(context.Background(), small)
+	}), "Emit with record attributes")
 }
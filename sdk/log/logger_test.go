@@ -37,6 +37,7 @@ func TestLoggerEmit(t *testing.T) {
 	r.SetBody(log.StringValue("testing body value"))
 	r.SetSeverity(log.SeverityInfo)
 	r.SetSeverityText("testing text")
+	r.SetEventName("testing event")
 	r.AddAttributes(
 		log.String("k1", "str"),
 		log.Float64("k2", 1.0),
@@ -82,6 +83,7 @@ func TestLoggerEmit(t *testing.T) {
 					body:                      r.Body(),
 					severity:                  r.Severity(),
 					severityText:              r.SeverityText(),
+					eventName:                 r.EventName(),
 					observedTimestamp:         r.ObservedTimestamp(),
 					resource:                  resource.NewSchemaless(attribute.String("key", "value")),
 					attributeValueLengthLimit: 3,
@@ -122,6 +124,7 @@ func TestLoggerEmit(t *testing.T) {
 					body:                      r.Body(),
 					severity:                  r.Severity(),
 					severityText:              r.SeverityText(),
+					eventName:                 r.EventName(),
 					observedTimestamp:         r.ObservedTimestamp(),
 					resource:                  resource.NewSchemaless(attribute.String("key", "value")),
 					attributeValueLengthLimit: 3,
@@ -155,6 +158,7 @@ func TestLoggerEmit(t *testing.T) {
 					body:                      r.Body(),
 					severity:                  r.Severity(),
 					severityText:              r.SeverityText(),
+					eventName:                 r.EventName(),
 					observedTimestamp:         r.ObservedTimestamp(),
 					resource:                  resource.NewSchemaless(attribute.String("key", "value")),
 					attributeValueLengthLimit: 3,
@@ -185,6 +189,7 @@ func TestLoggerEmit(t *testing.T) {
 					body:                      rWithNoObservedTimestamp.Body(),
 					severity:                  rWithNoObservedTimestamp.Severity(),
 					severityText:              rWithNoObservedTimestamp.SeverityText(),
+					eventName:                 rWithNoObservedTimestamp.EventName(),
 					observedTimestamp:         nowDate,
 					resource:                  resource.NewSchemaless(attribute.String("key", "value")),
 					attributeValueLengthLimit: 3,
@@ -256,6 +261,23 @@ func TestLoggerEnabled(t *testing.T) {
 			ctx:      context.Background(),
 			expected: true,
 		},
+		{
+			name: "NonFilterProcessor",
+			logger: newLogger(NewLoggerProvider(
+				WithProcessor(NewSimpleProcessor(defaultNoopExporter)),
+			), instrumentation.Scope{}),
+			ctx:      context.Background(),
+			expected: true,
+		},
+		{
+			name: "ContainsNonFilterProcessor",
+			logger: newLogger(NewLoggerProvider(
+				WithProcessor(p2WithDisabled),
+				WithProcessor(NewSimpleProcessor(defaultNoopExporter)),
+			), instrumentation.Scope{}),
+			ctx:      context.Background(),
+			expected: true,
+		},
 		{
 			name: "WithNilContext",
 			logger: newLogger(NewLoggerProvider(
@@ -273,3 +295,26 @@ func TestLoggerEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestLoggerEnabledParameters(t *testing.T) {
+	p := newProcessor("0")
+	p.enabled = false
+	res := resource.NewSchemaless(attribute.String("key", "value"))
+	scope := instrumentation.Scope{Name: "scope", Version: "v0.1.0"}
+	l := newLogger(NewLoggerProvider(
+		WithProcessor(p),
+		WithResource(res),
+	), scope)
+
+	var r log.Record
+	r.SetSeverity(log.SeverityWarn)
+	r.SetBody(log.StringValue("not passed"))
+	assert.False(t, l.Enabled(context.Background(), r))
+
+	want := []EnabledParameters{{
+		Resource:             *res,
+		InstrumentationScope: scope,
+		Severity:             log.SeverityWarn,
+	}}
+	assert.Equal(t, want, p.enabledParams)
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// LoggerConfig is the configuration of the Loggers created by a
+// LoggerProvider for a matching instrumentation scope.
+//
+// The zero value is the default configuration: the Logger is enabled and no
+// minimum severity is applied.
+type LoggerConfig struct {
+	// Disabled disables the Logger. A disabled Logger does not emit log
+	// records and always reports it is not enabled.
+	Disabled bool
+	// MinSeverity is the minimum severity of the log records the Logger
+	// emits. Log records with a lower severity are dropped and the Logger
+	// reports it is not enabled for them.
+	//
+	// Log records with an undefined severity ([log.SeverityUndefined]) are
+	// dropped if MinSeverity is set.
+	MinSeverity log.Severity
+}
+
+// scopeLoggerConfig is a LoggerConfig applied to the Loggers with an
+// instrumentation scope name and version matching the patterns.
+type scopeLoggerConfig struct {
+	name    string
+	version string
+	config  LoggerConfig
+}
+
+func (c scopeLoggerConfig) matches(scope instrumentation.Scope) bool {
+	return globMatch(c.name, scope.Name) && globMatch(c.version, scope.Version)
+}
+
+// loggerConfig returns the LoggerConfig of the last of configs that matches
+// scope. The zero LoggerConfig is returned if none match.
+func loggerConfig(configs []scopeLoggerConfig, scope instrumentation.Scope) LoggerConfig {
+	for i := len(configs) - 1; i >= 0; i-- {
+		if configs[i].matches(scope) {
+			return configs[i].config
+		}
+	}
+	return LoggerConfig{}
+}
+
+// scopeRecordAttributes are the attributes stamped on the log records of the
+// Loggers with an instrumentation scope name matching the pattern.
+type scopeRecordAttributes struct {
+	name  string
+	attrs []log.KeyValue
+}
+
+// loggerAttributes returns the attributes stamped on the log records of the
+// Logger with scope: base followed by the attributes of all of
+// scopeRecordAttrs that match scope. The last value of a duplicate key is used.
+//
+// The returned attributes do not share any data with base or scopeRecordAttrs
+// and have the valueLengthLimit applied. They are shared by all the records the
+// Logger emits and are not modified when added to one.
+func loggerAttributes(base []log.KeyValue, scopeRecordAttrs []scopeRecordAttributes, scope instrumentation.Scope, valueLengthLimit int) []log.KeyValue {
+	attrs := slices.Clone(base)
+	for _, sa := range scopeRecordAttrs {
+		if globMatch(sa.name, scope.Name) {
+			attrs = append(attrs, sa.attrs...)
+		}
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	attrs, _ = dedup(attrs)
+	r := Record{attributeValueLengthLimit: valueLengthLimit}
+	for i, a := range attrs {
+		a.Value = r.applyValueLimits(cloneValue(a.Value))
+		attrs[i] = a
+	}
+	return slices.Clip(attrs)
+}
+
+// cloneValue returns a deep copy of v.
+func cloneValue(v log.Value) log.Value {
+	switch v.Kind() {
+	case log.KindBytes:
+		return log.BytesValue(slices.Clone(v.AsBytes()))
+	case log.KindSlice:
+		sl := v.AsSlice()
+		out := make([]log.Value, len(sl))
+		for i := range sl {
+			out[i] = cloneValue(sl[i])
+		}
+		return log.SliceValue(out...)
+	case log.KindMap:
+		kvs := v.AsMap()
+		out := make([]log.KeyValue, len(kvs))
+		for i, kv := range kvs {
+			out[i] = log.KeyValue{Key: kv.Key, Value: cloneValue(kv.Value)}
+		}
+		return log.MapValue(out...)
+	}
+	return v
+}
+
+// globMatch returns if s matches pattern. The only special character of
+// pattern is '*', which matches any sequence of characters, including an
+// empty one. An empty pattern matches any s.
+func globMatch(pattern, s string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	// The pattern is parts joined by '*'. The first part needs to be a prefix
+	// and the last part a suffix of s. The other parts need to appear in
+	// order between them.
+	first, last := parts[0], parts[len(parts)-1]
+	if len(s) < len(first)+len(last) ||
+		!strings.HasPrefix(s, first) ||
+		!strings.HasSuffix(s, last) {
+		return false
+	}
+	s = s[len(first) : len(s)-len(last)]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func TestGlobMatch(t *testing.T) {
+	testCases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"", "", true},
+		{"", "anything", true},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"exact", "exact", true},
+		{"exact", "exactly", false},
+		{"github.com/example/*", "github.com/example/pkg/sub", true},
+		{"github.com/example/*", "github.com/other/pkg", false},
+		{"*/internal", "github.com/example/internal", true},
+		{"*/internal", "github.com/example/internal/x", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "a-b-c", true},
+		{"a*b*c", "a-c-b", false},
+		{"a*a", "a", false},
+		{"v1.*", "v1.2.3", true},
+		{"v1.*", "v2.0.0", false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equalf(t, tc.want, globMatch(tc.pattern, tc.s), "globMatch(%q, %q)", tc.pattern, tc.s)
+	}
+}
+
+func TestLoggerConfig(t *testing.T) {
+	configs := []scopeLoggerConfig{
+		{name: "github.com/example/*", config: LoggerConfig{MinSeverity: log.SeverityWarn}},
+		{name: "github.com/example/noisy", config: LoggerConfig{Disabled: true}},
+		{name: "github.com/example/*", version: "v0.*", config: LoggerConfig{MinSeverity: log.SeverityError}},
+	}
+
+	testCases := []struct {
+		name  string
+		scope instrumentation.Scope
+		want  LoggerConfig
+	}{
+		{
+			name:  "NoMatch",
+			scope: instrumentation.Scope{Name: "github.com/other"},
+			want:  LoggerConfig{},
+		},
+		{
+			name:  "Name",
+			scope: instrumentation.Scope{Name: "github.com/example/pkg", Version: "v1.0.0"},
+			want:  LoggerConfig{MinSeverity: log.SeverityWarn},
+		},
+		{
+			name:  "NameAndVersion",
+			scope: instrumentation.Scope{Name: "github.com/example/pkg", Version: "v0.1.0"},
+			want:  LoggerConfig{MinSeverity: log.SeverityError},
+		},
+		{
+			name:  "LastMatchWins",
+			scope: instrumentation.Scope{Name: "github.com/example/noisy", Version: "v0.1.0"},
+			want:  LoggerConfig{MinSeverity: log.SeverityError},
+		},
+		{
+			name:  "Disabled",
+			scope: instrumentation.Scope{Name: "github.com/example/noisy", Version: "v1.0.0"},
+			want:  LoggerConfig{Disabled: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, loggerConfig(configs, tc.scope))
+		})
+	}
+}
+
+func TestWithLoggerConfig(t *testing.T) {
+	proc := newProcessor("0")
+	p := NewLoggerProvider(
+		WithProcessor(proc),
+		WithLoggerConfig("debug", "", LoggerConfig{MinSeverity: log.SeverityInfo}),
+		WithLoggerConfig("disabled", "", LoggerConfig{Disabled: true}),
+	)
+
+	ctx := context.Background()
+	var debug, info log.Record
+	debug.SetSeverity(log.SeverityDebug)
+	info.SetSeverity(log.SeverityInfo)
+
+	t.Run("Default", func(t *testing.T) {
+		proc.records = nil
+		l := p.Logger("default")
+		assert.True(t, l.Enabled(ctx, debug))
+		l.Emit(ctx, debug)
+		assert.Len(t, proc.records, 1)
+	})
+
+	t.Run("MinSeverity", func(t *testing.T) {
+		proc.records = nil
+		l := p.Logger("debug")
+		assert.False(t, l.Enabled(ctx, debug))
+		assert.True(t, l.Enabled(ctx, info))
+		l.Emit(ctx, debug)
+		l.Emit(ctx, info)
+		if assert.Len(t, proc.records, 1) {
+			assert.Equal(t, log.SeverityInfo, proc.records[0].Severity())
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		proc.records = nil
+		l := p.Logger("disabled")
+		assert.False(t, l.Enabled(ctx, info))
+		l.Emit(ctx, info)
+		assert.Empty(t, proc.records)
+	})
+}
+
+func TestLoggerAttributes(t *testing.T) {
+	base := []log.KeyValue{log.String("env", "prod")}
+	scopeRecordAttrs := []scopeRecordAttributes{
+		{name: "myco/*", attrs: []log.KeyValue{log.String("team", "core")}},
+		{name: "myco/payments", attrs: []log.KeyValue{log.String("subsystem", "payments")}},
+		{name: "other", attrs: []log.KeyValue{log.String("subsystem", "other")}},
+	}
+
+	got := loggerAttributes(base, scopeRecordAttrs, instrumentation.Scope{Name: "myco/payments"}, -1)
+	assert.Equal(t, []log.KeyValue{
+		log.String("env", "prod"),
+		log.String("team", "core"),
+		log.String("subsystem", "payments"),
+	}, got)
+	assert.Len(t, base, 1, "base modified")
+
+	got = loggerAttributes(base, scopeRecordAttrs, instrumentation.Scope{Name: "myco/users"}, -1)
+	assert.Equal(t, []log.KeyValue{log.String("env", "prod"), log.String("team", "core")}, got)
+
+	got = loggerAttributes(base, scopeRecordAttrs, instrumentation.Scope{Name: "unmatched"}, -1)
+	assert.Equal(t, base, got)
+
+	assert.Nil(t, loggerAttributes(nil, nil, instrumentation.Scope{Name: "myco/payments"}, -1))
+}
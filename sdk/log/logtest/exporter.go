@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// Compile-time check Exporter implements sdklog.Exporter.
+var _ sdklog.Exporter = (*Exporter)(nil)
+
+// exporterConfig contains the configuration of an Exporter.
+type exporterConfig struct {
+	failSeverity log.Severity
+}
+
+// ExporterOption configures an [Exporter].
+type ExporterOption interface {
+	apply(exporterConfig) exporterConfig
+}
+
+type exporterOptionFunc func(exporterConfig) exporterConfig
+
+func (f exporterOptionFunc) apply(c exporterConfig) exporterConfig { return f(c) }
+
+// WithFailSeverity returns an [ExporterOption] that configures an [Exporter]
+// to mark the test as failed, with [testing.TB.Errorf], when it exports a log
+// record with a severity greater than or equal to severity. The test
+// continues to run.
+//
+// By default, if this option is not provided, the test is never marked as
+// failed.
+func WithFailSeverity(severity log.Severity) ExporterOption {
+	return exporterOptionFunc(func(c exporterConfig) exporterConfig {
+		c.failSeverity = severity
+		return c
+	})
+}
+
+// Exporter is an [sdklog.Exporter] writing each exported log record to the
+// log of a test with [testing.TB.Logf], rendered on a single line:
+//
+//	2009-11-10T23:00:00Z ERROR [scope] body key=value trace_id=… span_id=…
+//
+// Use it with a [sdklog.SimpleProcessor] to surface the logs emitted during a
+// test next to the test output, and optionally fail the test when an error
+// is logged, see [WithFailSeverity].
+//
+// The log records exported after the test completes are dropped, the test
+// log cannot be written anymore.
+type Exporter struct {
+	t            testing.TB
+	failSeverity log.Severity
+
+	mu   sync.Mutex
+	done bool
+}
+
+// NewExporter returns a new [Exporter] writing to the log of t.
+func NewExporter(t testing.TB, options ...ExporterOption) *Exporter {
+	var cfg exporterConfig
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	e := &Exporter{t: t, failSeverity: cfg.failSeverity}
+	t.Cleanup(func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.done = true
+	})
+	return e
+}
+
+// Export writes records to the test log. It marks the test as failed if a
+// record has a severity greater than or equal to the one configured with
+// [WithFailSeverity].
+func (e *Exporter) Export(ctx context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.done {
+		return nil
+	}
+	for i := range records {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := format(&records[i])
+		if e.failSeverity != log.SeverityUndefined && records[i].Severity() >= e.failSeverity {
+			e.t.Errorf("%s", line)
+			continue
+		}
+		e.t.Logf("%s", line)
+	}
+	return nil
+}
+
+// Shutdown stops e from writing to the test log.
+func (e *Exporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.done = true
+	return nil
+}
+
+// ForceFlush does nothing, e holds no state.
+func (e *Exporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+// format returns the single line rendering of r.
+func format(r *sdklog.Record) string {
+	var b strings.Builder
+
+	ts := r.Timestamp()
+	if ts.IsZero() {
+		ts = r.ObservedTimestamp()
+	}
+	if !ts.IsZero() {
+		b.WriteString(ts.Format(time.RFC3339Nano))
+		b.WriteByte(' ')
+	}
+
+	switch {
+	case r.SeverityText() != "":
+		b.WriteString(r.SeverityText())
+	case r.Severity() != log.SeverityUndefined:
+		b.WriteString(r.Severity().String())
+	default:
+		b.WriteString("-")
+	}
+
+	if name := r.InstrumentationScope().Name; name != "" {
+		b.WriteString(" [")
+		b.WriteString(name)
+		b.WriteByte(']')
+	}
+
+	if body := r.Body(); !body.Empty() {
+		b.WriteByte(' ')
+		b.WriteString(quote(body.String()))
+	}
+
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		b.WriteByte(' ')
+		b.WriteString(quote(kv.Key))
+		b.WriteByte('=')
+		b.WriteString(quote(kv.Value.String()))
+		return true
+	})
+
+	if tid := r.TraceID(); tid.IsValid() {
+		b.WriteString(" trace_id=")
+		b.WriteString(tid.String())
+	}
+	if sid := r.SpanID(); sid.IsValid() {
+		b.WriteString(" span_id=")
+		b.WriteString(sid.String())
+	}
+
+	return b.String()
+}
+
+// quote returns s quoted if it is empty or holds spaces, quotes, "=", or
+// non-printable characters, which keeps the rendering on a single line and
+// unambiguous.
+func quote(s string) string {
+	if s == "" {
+		return `""`
+	}
+	for _, c := range s {
+		if c == ' ' || c == '"' || c == '=' || !strconv.IsPrint(c) {
+			return strconv.Quote(s)
+		}
+	}
+	return s
+}
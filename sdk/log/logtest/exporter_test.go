@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fakeT is a testing.TB recording the calls made to it.
+type fakeT struct {
+	testing.TB
+
+	logs     []string
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Logf(format string, args ...any) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+
+// cleanup runs the cleanup functions, as done when the test completes.
+func (t *fakeT) cleanup() {
+	for _, f := range t.cleanups {
+		f()
+	}
+}
+
+func TestExporter(t *testing.T) {
+	ft := &fakeT{}
+	exp := NewExporter(ft)
+
+	ts := time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC)
+	records := []sdklog.Record{
+		RecordFactory{
+			Timestamp:            ts,
+			Severity:             log.SeverityError,
+			Body:                 log.StringValue("request failed"),
+			Attributes:           []log.KeyValue{log.Int("status", 500), log.String("path", "/a b")},
+			TraceID:              trace.TraceID{1},
+			SpanID:               trace.SpanID{2},
+			InstrumentationScope: &instrumentation.Scope{Name: "scope"},
+		}.NewRecord(),
+		RecordFactory{
+			ObservedTimestamp: ts,
+			SeverityText:      "warn",
+			Body:              log.StringValue("multi\nline"),
+		}.NewRecord(),
+		RecordFactory{}.NewRecord(),
+	}
+	require.NoError(t, exp.Export(context.Background(), records))
+
+	assert.Equal(t, []string{
+		`2009-11-10T23:00:00Z ERROR [scope] "request failed" status=500 path="/a b" trace_id=01000000000000000000000000000000 span_id=0200000000000000`,
+		`2009-11-10T23:00:00Z warn "multi\nline"`,
+		`-`,
+	}, ft.logs)
+	assert.Empty(t, ft.errors)
+}
+
+func TestExporterFailSeverity(t *testing.T) {
+	ft := &fakeT{}
+	exp := NewExporter(ft, WithFailSeverity(log.SeverityWarn))
+
+	require.NoError(t, exp.Export(context.Background(), []sdklog.Record{
+		RecordFactory{Severity: log.SeverityInfo, Body: log.StringValue("info")}.NewRecord(),
+		RecordFactory{Severity: log.SeverityWarn, Body: log.StringValue("warn")}.NewRecord(),
+		RecordFactory{Severity: log.SeverityFatal, Body: log.StringValue("fatal")}.NewRecord(),
+	}))
+
+	assert.Equal(t, []string{"INFO info"}, ft.logs)
+	assert.Equal(t, []string{"WARN warn", "FATAL fatal"}, ft.errors)
+}
+
+func TestExporterDone(t *testing.T) {
+	r := RecordFactory{Body: log.StringValue("msg")}.NewRecord()
+	ctx := context.Background()
+
+	ft := &fakeT{}
+	exp := NewExporter(ft)
+	ft.cleanup()
+	require.NoError(t, exp.Export(ctx, []sdklog.Record{r}))
+	assert.Empty(t, ft.logs, "export after the test completed")
+
+	ft = &fakeT{}
+	exp = NewExporter(ft)
+	require.NoError(t, exp.ForceFlush(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+	require.NoError(t, exp.Export(ctx, []sdklog.Record{r}))
+	assert.Empty(t, ft.logs, "export after shutdown")
+}
+
+func TestExporterContextCanceled(t *testing.T) {
+	ft := &fakeT{}
+	exp := NewExporter(ft)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := RecordFactory{Body: log.StringValue("msg")}.NewRecord()
+	assert.ErrorIs(t, exp.Export(ctx, []sdklog.Record{r}), context.Canceled)
+	assert.Empty(t, ft.logs)
+}
+
+func TestExporterLoggerProvider(t *testing.T) {
+	ft := &fakeT{}
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(NewExporter(ft))))
+
+	var r log.Record
+	r.SetSeverity(log.SeverityInfo)
+	r.SetBody(log.StringValue("msg"))
+	provider.Logger("scope").Emit(context.Background(), r)
+
+	require.Len(t, ft.logs, 1)
+	assert.Contains(t, ft.logs[0], "INFO [scope] msg")
+}
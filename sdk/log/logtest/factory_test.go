@@ -43,6 +43,7 @@ func TestRecordFactory(t *testing.T) {
 	r := resource.NewSchemaless(attribute.Bool("works", true))
 
 	got := RecordFactory{
+		EventName:            "testing event",
 		Timestamp:            now,
 		ObservedTimestamp:    observed,
 		Severity:             severity,
@@ -57,6 +58,7 @@ func TestRecordFactory(t *testing.T) {
 		Resource:             r,
 	}.NewRecord()
 
+	assert.Equal(t, "testing event", got.EventName())
 	assert.Equal(t, now, got.Timestamp())
 	assert.Equal(t, observed, got.ObservedTimestamp())
 	assert.Equal(t, severity, got.Severity())
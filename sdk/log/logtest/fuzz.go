@@ -0,0 +1,354 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+const (
+	// fuzzMaxDepth is the maximum depth of the slice and map values of a
+	// fuzzed log record. Deeper values are empty.
+	fuzzMaxDepth = 64
+	// fuzzMaxElems is the maximum number of elements of a slice or map value,
+	// and of attributes, read for each length byte.
+	fuzzMaxElems = 32
+	// fuzzHugeBudget is the maximum total size of the huge strings of a
+	// fuzzed log record. It bounds the memory used by each fuzz input.
+	fuzzHugeBudget = 4 << 20
+)
+
+// fuzzConfig contains the configuration of FuzzExporter.
+type fuzzConfig struct {
+	attrCntLim    int
+	attrValLenLim int
+}
+
+// FuzzOption configures [FuzzExporter].
+type FuzzOption interface {
+	apply(fuzzConfig) fuzzConfig
+}
+
+type fuzzOptionFunc func(fuzzConfig) fuzzConfig
+
+func (f fuzzOptionFunc) apply(c fuzzConfig) fuzzConfig { return f(c) }
+
+// WithFuzzLimits returns a [FuzzOption] that configures the attribute count
+// limit and the attribute value length limit of the [sdklog.LoggerProvider]
+// emitting the fuzzed log records, see [sdklog.WithAttributeCountLimit] and
+// [sdklog.WithAttributeValueLengthLimit]. [FuzzExporter] asserts the exported
+// log records comply with them.
+//
+// By default, if this option is not provided, the attribute count limit is
+// 128 and the attribute value length limit is 64.
+func WithFuzzLimits(attributeCount, attributeValueLength int) FuzzOption {
+	return fuzzOptionFunc(func(c fuzzConfig) fuzzConfig {
+		c.attrCntLim = attributeCount
+		c.attrValLenLim = attributeValueLength
+		return c
+	})
+}
+
+// FuzzExporter fuzzes the log records emitted with an [sdklog.LoggerProvider]
+// and exported with the [sdklog.Exporter] returned by newExporter. It is
+// intended to be called by the fuzz tests of exporters:
+//
+//	func FuzzExporter(f *testing.F) {
+//		logtest.FuzzExporter(f, func(t testing.TB) sdklog.Exporter {
+//			return newValidatingExporter(t)
+//		})
+//	}
+//
+// The log records are decoded from the fuzz inputs with [FuzzRecord]. A seed
+// corpus holding empty values, deeply nested maps, invalid UTF-8, and huge
+// strings is added to f.
+//
+// Each log record is emitted with a new LoggerProvider exporting it
+// synchronously with the exporter created for the input. The test fails if
+// the SDK or the exporter panics, if the exporter returns an error, or if the
+// exported log record does not comply with the limits, see
+// [WithFuzzLimits]. The exporter should fail the test itself, e.g. with
+// [testing.TB.Errorf], if its output is invalid.
+func FuzzExporter(f *testing.F, newExporter func(testing.TB) sdklog.Exporter, options ...FuzzOption) {
+	cfg := fuzzConfig{attrCntLim: 128, attrValLenLim: 64}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		exp := &limitExporter{t: t, cfg: cfg, next: newExporter(t)}
+		provider := sdklog.NewLoggerProvider(
+			sdklog.WithProcessor(sdklog.NewSimpleProcessor(exp)),
+			sdklog.WithAttributeCountLimit(cfg.attrCntLim),
+			sdklog.WithAttributeValueLengthLimit(cfg.attrValLenLim),
+		)
+
+		ctx := context.Background()
+		provider.Logger("go.opentelemetry.io/otel/sdk/log/logtest").Emit(ctx, FuzzRecord(data))
+		if err := provider.Shutdown(ctx); err != nil {
+			t.Errorf("shutdown: %v", err)
+		}
+
+		if n := exp.exported(); n != 1 {
+			t.Errorf("exported %d log records, want 1", n)
+		}
+	})
+}
+
+// fuzzSeeds is the seed corpus of FuzzExporter, see FuzzRecord for the
+// encoding.
+var fuzzSeeds = [][]byte{
+	// An empty log record.
+	{},
+	// A string body, severity text, and attribute.
+	{
+		9, 0, 0, 0, 0, 0, 0, 0, 1, // Severity and timestamp.
+		4, 'I', 'N', 'F', 'O', // Severity text.
+		0,              // Event name.
+		4, 2, 'h', 'i', // Body.
+		1, 1, 'k', 4, 1, 'v', // Attributes.
+	},
+	// Invalid UTF-8 strings.
+	{
+		17, 0, 0, 0, 0, 0, 0, 0, 0,
+		2, 0xff, 0xfe,
+		1, 0xc0,
+		4, 3, 'a', 0xe2, 0x82,
+		2, 1, 0xff, 5, 1, 0x80, 2, 0xed, 0xa0, 4, 2, 0xf0, 0x9f,
+	},
+	// Deeply nested maps.
+	append(
+		[]byte{5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		append(
+			[]byte(strings.Repeat("\x07\x01\x01k", fuzzMaxDepth+2)),
+			4, 1, 'v', 0,
+		)...,
+	),
+	// Huge strings, truncated by the attribute value length limit.
+	{
+		13, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0,
+		4, 0xfc, 3, 'a', 'b', 'c',
+		3, 1, 'a', 4, 0xfb, 2, 0xe2, 0x82,
+		1, 'b', 6, 2, 4, 0xfa, 1, 'x', 5, 0xf9, 1, 0xff,
+		1, 'a', 4, 0xff, 0,
+	},
+	// Duplicate keys and special numbers.
+	{
+		1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0, 0,
+		7, 2, 1, 'k', 3, 0x7f, 0xf8, 0, 0, 0, 0, 0, 1, 1, 'k', 3, 0x7f, 0xf0, 0, 0, 0, 0, 0, 0,
+		3, 1, 'k', 1, 1, 1, 'k', 2, 0x80, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+}
+
+// FuzzRecord returns the log record decoded from data, the input of a fuzz
+// test. Any data is decoded, missing bytes are decoded as zeros.
+//
+// The data encodes, in order, the severity (1 byte), the timestamp in Unix
+// nanoseconds (8 bytes), the severity text, the event name, and the body of
+// the log record, followed by the number of attributes (1 byte) and the key
+// and value of each.
+//
+// A string is encoded as its length n (1 byte) followed by its n bytes.
+// Lengths 0xf0 to 0xff encode a huge string, 64 << (n-0xf0) bytes long,
+// repeating the string that follows. The strings can hold invalid UTF-8.
+//
+// A value is encoded as its kind (1 byte, modulo 8): empty, a boolean
+// (1 byte), an int64 (8 bytes), a float64 (8 bytes), a string, a byte slice
+// (encoded as a string), a slice (the number of values, 1 byte, followed by
+// each value), or a map (the number of key-values, 1 byte, followed by the
+// key and value of each). The slices and maps nested deeper than 64 levels
+// are empty values.
+func FuzzRecord(data []byte) log.Record {
+	d := &fuzzDecoder{data: data}
+
+	var r log.Record
+	r.SetSeverity(log.Severity(d.byte()))
+	if ns := int64(d.uint64()); ns != 0 {
+		r.SetTimestamp(time.Unix(0, ns))
+	}
+	r.SetSeverityText(d.string())
+	r.SetEventName(d.string())
+	r.SetBody(d.value(0))
+	for n := d.len(); n > 0 && !d.done(); n-- {
+		r.AddAttributes(log.KeyValue{Key: d.string(), Value: d.value(0)})
+	}
+	return r
+}
+
+// fuzzDecoder decodes the log record values from the input of a fuzz test.
+type fuzzDecoder struct {
+	data []byte
+	// huge is the total size of the huge strings decoded.
+	huge int
+}
+
+// done returns if all the data is decoded.
+func (d *fuzzDecoder) done() bool { return len(d.data) == 0 }
+
+func (d *fuzzDecoder) byte() byte {
+	if d.done() {
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+func (d *fuzzDecoder) bytes(n int) []byte {
+	n = min(n, len(d.data))
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *fuzzDecoder) uint64() uint64 {
+	var buf [8]byte
+	copy(buf[:], d.bytes(8))
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// len returns the decoded number of elements of a slice, map, or the
+// attributes.
+func (d *fuzzDecoder) len() int { return int(d.byte()) % fuzzMaxElems }
+
+func (d *fuzzDecoder) string() string {
+	n := int(d.byte())
+	if n < 0xf0 {
+		return string(d.bytes(n))
+	}
+
+	size := 64 << (n - 0xf0)
+	unit := d.string()
+	if unit == "" {
+		unit = "x"
+	}
+	if d.huge+size > fuzzHugeBudget {
+		return unit
+	}
+	d.huge += size
+	return strings.Repeat(unit, size/len(unit)+1)[:size]
+}
+
+func (d *fuzzDecoder) value(depth int) log.Value {
+	if d.done() {
+		return log.Value{}
+	}
+	switch d.byte() % 8 {
+	case 1:
+		return log.BoolValue(d.byte()&1 == 1)
+	case 2:
+		return log.Int64Value(int64(d.uint64()))
+	case 3:
+		return log.Float64Value(math.Float64frombits(d.uint64()))
+	case 4:
+		return log.StringValue(d.string())
+	case 5:
+		return log.BytesValue([]byte(d.string()))
+	case 6:
+		if depth >= fuzzMaxDepth {
+			return log.Value{}
+		}
+		var vals []log.Value
+		for n := d.len(); n > 0 && !d.done(); n-- {
+			vals = append(vals, d.value(depth+1))
+		}
+		return log.SliceValue(vals...)
+	case 7:
+		if depth >= fuzzMaxDepth {
+			return log.Value{}
+		}
+		var kvs []log.KeyValue
+		for n := d.len(); n > 0 && !d.done(); n-- {
+			kvs = append(kvs, log.KeyValue{Key: d.string(), Value: d.value(depth + 1)})
+		}
+		return log.MapValue(kvs...)
+	default:
+		return log.Value{}
+	}
+}
+
+// limitExporter asserts the log records it exports comply with the limits of
+// cfg before passing them to next.
+type limitExporter struct {
+	t    testing.TB
+	cfg  fuzzConfig
+	next sdklog.Exporter
+
+	mu sync.Mutex
+	n  int
+}
+
+func (e *limitExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	e.n += len(records)
+	e.mu.Unlock()
+
+	for i := range records {
+		r := &records[i]
+		if e.cfg.attrCntLim >= 0 && r.AttributesLen() > e.cfg.attrCntLim {
+			e.t.Errorf("log record has %d attributes, limit is %d", r.AttributesLen(), e.cfg.attrCntLim)
+		}
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			e.checkValueLen(kv.Key, kv.Value)
+			return true
+		})
+	}
+
+	if err := e.next.Export(ctx, records); err != nil {
+		e.t.Errorf("export: %v", err)
+	}
+	return nil
+}
+
+// checkValueLen asserts the strings held by the value of the attribute key
+// comply with the attribute value length limit.
+func (e *limitExporter) checkValueLen(key string, v log.Value) {
+	switch v.Kind() {
+	case log.KindString:
+		if n := len(v.AsString()); e.cfg.attrValLenLim >= 0 && n > e.cfg.attrValLenLim {
+			e.t.Errorf("attribute %q has a value of length %d, limit is %d", key, n, e.cfg.attrValLenLim)
+		}
+	case log.KindSlice:
+		for _, val := range v.AsSlice() {
+			e.checkValueLen(key, val)
+		}
+	case log.KindMap:
+		for _, kv := range v.AsMap() {
+			e.checkValueLen(key, kv.Value)
+		}
+	}
+}
+
+// exported returns the number of log records exported.
+func (e *limitExporter) exported() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.n
+}
+
+func (e *limitExporter) Shutdown(ctx context.Context) error {
+	if err := e.next.Shutdown(ctx); err != nil {
+		e.t.Errorf("exporter shutdown: %v", err)
+	}
+	return nil
+}
+
+func (e *limitExporter) ForceFlush(ctx context.Context) error {
+	return e.next.ForceFlush(ctx)
+}
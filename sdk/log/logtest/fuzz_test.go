@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func TestFuzzRecord(t *testing.T) {
+	r := FuzzRecord(fuzzSeeds[1])
+	assert.Equal(t, log.SeverityInfo1, r.Severity())
+	assert.Equal(t, time.Unix(0, 1), r.Timestamp())
+	assert.Equal(t, "INFO", r.SeverityText())
+	assert.Equal(t, "", r.EventName())
+	assert.Equal(t, log.StringValue("hi"), r.Body())
+	require.Equal(t, 1, r.AttributesLen())
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		assert.Equal(t, log.String("k", "v"), kv)
+		return true
+	})
+}
+
+func TestFuzzRecordEmpty(t *testing.T) {
+	assert.Equal(t, log.Record{}, FuzzRecord(nil))
+}
+
+func TestFuzzRecordHugeString(t *testing.T) {
+	r := FuzzRecord([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0xf4, 2, 'a', 'b'})
+	body := r.Body().AsString()
+	assert.Len(t, body, 64<<4)
+	assert.True(t, strings.HasPrefix(body, "abab"), "repeated string")
+}
+
+func TestFuzzRecordHugeBudget(t *testing.T) {
+	data := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 31}
+	for i := 0; i < 31; i++ {
+		data = append(data, 0, 4, 0xff, 1, 'x')
+	}
+
+	var total int
+	r := FuzzRecord(data)
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		total += len(kv.Value.AsString())
+		return true
+	})
+	assert.LessOrEqual(t, total, fuzzHugeBudget+31)
+}
+
+func TestFuzzRecordMaxDepth(t *testing.T) {
+	depth := func(v log.Value) int {
+		var d int
+		for v.Kind() == log.KindMap {
+			d++
+			v = v.AsMap()[0].Value
+		}
+		return d
+	}
+	r := FuzzRecord(fuzzSeeds[3])
+	assert.Equal(t, fuzzMaxDepth, depth(r.Body()))
+}
+
+func TestLimitExporter(t *testing.T) {
+	ft := &fakeT{}
+	exp := &limitExporter{
+		t:    ft,
+		cfg:  fuzzConfig{attrCntLim: 1, attrValLenLim: 2},
+		next: NewExporter(ft),
+	}
+	records := []sdklog.Record{
+		RecordFactory{Attributes: []log.KeyValue{log.String("a", "ok")}}.NewRecord(),
+		RecordFactory{Attributes: []log.KeyValue{
+			log.String("a", "long"),
+			log.Slice("b", log.StringValue("long")),
+			log.Map("c", log.String("d", "long")),
+		}}.NewRecord(),
+	}
+	require.NoError(t, exp.Export(context.Background(), records))
+
+	assert.Equal(t, 2, exp.exported())
+	assert.Equal(t, []string{
+		"log record has 3 attributes, limit is 1",
+		`attribute "a" has a value of length 4, limit is 2`,
+		`attribute "b" has a value of length 4, limit is 2`,
+		`attribute "c" has a value of length 4, limit is 2`,
+	}, ft.errors)
+}
+
+// errExporter is an sdklog.Exporter failing all its operations.
+type errExporter struct{}
+
+var errFuzz = errors.New("fuzz")
+
+func (errExporter) Export(context.Context, []sdklog.Record) error { return errFuzz }
+func (errExporter) Shutdown(context.Context) error                { return errFuzz }
+func (errExporter) ForceFlush(context.Context) error              { return errFuzz }
+
+func TestLimitExporterErrors(t *testing.T) {
+	ft := &fakeT{}
+	exp := &limitExporter{t: ft, cfg: fuzzConfig{attrCntLim: -1, attrValLenLim: -1}, next: errExporter{}}
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, []sdklog.Record{{}}))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Equal(t, []string{"export: fuzz", "exporter shutdown: fuzz"}, ft.errors)
+}
+
+// formatExporter is an sdklog.Exporter formatting the records it exports,
+// as done by Exporter, without writing them.
+type formatExporter struct{}
+
+func (formatExporter) Export(_ context.Context, records []sdklog.Record) error {
+	for i := range records {
+		_ = format(&records[i])
+	}
+	return nil
+}
+
+func (formatExporter) Shutdown(context.Context) error   { return nil }
+func (formatExporter) ForceFlush(context.Context) error { return nil }
+
+func FuzzSDK(f *testing.F) {
+	FuzzExporter(f, func(testing.TB) sdklog.Exporter {
+		return formatExporter{}
+	}, WithFuzzLimits(16, 32))
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check MinSeverityProcessor implements Processor and
+// FilterProcessor.
+var (
+	_ Processor       = (*MinSeverityProcessor)(nil)
+	_ FilterProcessor = (*MinSeverityProcessor)(nil)
+)
+
+// MinSeverityProcessor is a [Processor] decorator that drops log records with
+// a severity less than a minimum severity.
+type MinSeverityProcessor struct {
+	min  log.Severity
+	next Processor
+}
+
+// NewMinSeverityProcessor returns a [MinSeverityProcessor] that passes log
+// records with a severity greater than or equal to severity to next. All
+// other log records are dropped.
+//
+// Log records with an undefined severity ([log.SeverityUndefined]) are
+// dropped, unless severity is also [log.SeverityUndefined].
+//
+// The returned processor reports it is not enabled for log records it drops.
+// This lets bridges skip building these log records altogether.
+func NewMinSeverityProcessor(severity log.Severity, next Processor) *MinSeverityProcessor {
+	if next == nil {
+		// Do not panic on nil processor.
+		next = NewSimpleProcessor(nil)
+	}
+	return &MinSeverityProcessor{min: severity, next: next}
+}
+
+// OnEmit passes r to the decorated processor if the severity of r is greater
+// than or equal to the minimum severity. Otherwise, r is dropped.
+func (p *MinSeverityProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if r.Severity() < p.min {
+		return nil
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+// Enabled returns false if the severity of param is less than the minimum
+// severity. Otherwise, it returns the result of the decorated processor if it
+// is a [FilterProcessor], or true if it is not.
+func (p *MinSeverityProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if param.Severity < p.min {
+		return false
+	}
+	if fltr, ok := p.next.(FilterProcessor); ok {
+		return fltr.Enabled(ctx, param)
+	}
+	return true
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *MinSeverityProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *MinSeverityProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestMinSeverityProcessorOnEmit(t *testing.T) {
+	next := newProcessor("next")
+	p := NewMinSeverityProcessor(log.SeverityInfo, next)
+
+	ctx := context.Background()
+	for _, sev := range []log.Severity{
+		log.SeverityUndefined,
+		log.SeverityDebug,
+		log.SeverityInfo,
+		log.SeverityError,
+	} {
+		var r Record
+		r.SetSeverity(sev)
+		require.NoError(t, p.OnEmit(ctx, &r))
+	}
+
+	require.Len(t, next.records, 2)
+	assert.Equal(t, log.SeverityInfo, next.records[0].Severity())
+	assert.Equal(t, log.SeverityError, next.records[1].Severity())
+}
+
+func TestMinSeverityProcessorEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotFilterProcessor", func(t *testing.T) {
+		p := NewMinSeverityProcessor(log.SeverityWarn, NewSimpleProcessor(nil))
+		assert.False(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityUndefined}))
+		assert.False(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityInfo}))
+		assert.True(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityWarn}))
+		assert.True(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityFatal}))
+	})
+
+	t.Run("FilterProcessor", func(t *testing.T) {
+		next := newProcessor("next")
+		p := NewMinSeverityProcessor(log.SeverityWarn, next)
+		assert.False(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityInfo}))
+		assert.True(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityWarn}))
+
+		next.enabled = false
+		assert.False(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityWarn}))
+	})
+
+	t.Run("Logger", func(t *testing.T) {
+		p := NewMinSeverityProcessor(log.SeverityWarn, NewSimpleProcessor(nil))
+		l := NewLoggerProvider(WithProcessor(p)).Logger("test")
+
+		var r log.Record
+		r.SetSeverity(log.SeverityDebug)
+		assert.False(t, l.Enabled(ctx, r), "debug enabled")
+
+		r.SetSeverity(log.SeverityError)
+		assert.True(t, l.Enabled(ctx, r), "error disabled")
+	})
+}
+
+func TestMinSeverityProcessorShutdownForceFlush(t *testing.T) {
+	next := newProcessor("next")
+	p := NewMinSeverityProcessor(log.SeverityInfo, next)
+
+	ctx := context.Background()
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, 1, next.forceFlushCalls, "ForceFlush not forwarded")
+	assert.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 1, next.shutdownCalls, "Shutdown not forwarded")
+}
+
+func TestMinSeverityProcessorNilNext(t *testing.T) {
+	assert.NotPanics(t, func() {
+		p := NewMinSeverityProcessor(log.SeverityInfo, nil)
+		ctx := context.Background()
+		var r Record
+		r.SetSeverity(log.SeverityError)
+		assert.NoError(t, p.OnEmit(ctx, &r))
+		assert.True(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityError}))
+		assert.NoError(t, p.ForceFlush(ctx))
+		assert.NoError(t, p.Shutdown(ctx))
+	})
+}
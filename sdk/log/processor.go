@@ -5,6 +5,10 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 
 import (
 	"context"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // Processor handles the processing of log records.
@@ -15,8 +19,9 @@ import (
 type Processor interface {
 	// OnEmit is called when a Record is emitted.
 	//
-	// OnEmit will be called independent of Enabled. Implementations need to
-	// validate the arguments themselves before processing.
+	// OnEmit will be called independent of the Enabled method of a
+	// FilterProcessor. Implementations need to validate the arguments
+	// themselves before processing.
 	//
 	// Implementation should not interrupt the record processing
 	// if the context is canceled.
@@ -26,27 +31,17 @@ type Processor interface {
 	// considered unrecoverable and will be reported to a configured error
 	// Handler.
 	//
-	// Before modifying a Record, the implementation must use Record.Clone
-	// to create a copy that shares no state with the original.
-	OnEmit(ctx context.Context, record Record) error
-	// Enabled returns whether the Processor will process for the given context
-	// and record.
-	//
-	// The passed record is likely to be a partial record with only the
-	// bridge-relevant information being provided (e.g a record with only the
-	// Severity set). If a Logger needs more information than is provided, it
-	// is said to be in an indeterminate state (see below).
-	//
-	// The returned value will be true when the Processor will process for the
-	// provided context and record, and will be false if the Processor will not
-	// process. The returned value may be true or false in an indeterminate
-	// state. An implementation should default to returning true for an
-	// indeterminate state, but may return false if valid reasons in particular
-	// circumstances exist (e.g. performance, correctness).
+	// The SDK invokes the processors sequentially in the same order as they
+	// were registered using WithProcessor. Implementations may synchronously
+	// modify the record so that the changes are visible in the next
+	// registered processor. This allows writing decorators, like redaction or
+	// enrichment, see WrapProcessor.
 	//
-	// Before modifying a Record, the implementation must use Record.Clone
-	// to create a copy that shares no state with the original.
-	Enabled(ctx context.Context, record Record) bool
+	// The record is only valid for the duration of the call. It must not be
+	// retained, or modified asynchronously, after OnEmit returns. Use
+	// Record.Clone to create a copy that shares no state with the original
+	// if the record needs to be retained.
+	OnEmit(ctx context.Context, record *Record) error
 	// Shutdown is called when the SDK shuts down. Any cleanup or release of
 	// resources held by the exporter should be done in this call.
 	//
@@ -63,3 +58,90 @@ type Processor interface {
 	// appropriate error should be returned in these situations.
 	ForceFlush(ctx context.Context) error
 }
+
+// FilterProcessor is a [Processor] that knows, and can identify, what
+// [Record] it will process or drop when it is passed to OnEmit.
+//
+// This is useful for users that want to know if a [log.Record] will be
+// processed or dropped before they perform complex operations to construct
+// the [log.Record].
+//
+// Processor implementations that choose to support this by satisfying this
+// interface are expected to re-evaluate the [Record]s passed to OnEmit, it is
+// not expected that the caller to OnEmit will use the functionality from this
+// interface prior to calling OnEmit.
+//
+// A Processor that does not implement this interface is considered to process
+// every [Record] passed to OnEmit.
+type FilterProcessor interface {
+	// Enabled returns whether the Processor will process for the given
+	// context and param.
+	//
+	// The passed param is likely to be partial with only the
+	// bridge-relevant information being provided (e.g. a param with only the
+	// Severity set). If a Processor needs more information than is provided,
+	// it is said to be in an indeterminate state (see below).
+	//
+	// The returned value will be true when the Processor will process for the
+	// provided context and param, and will be false if the Processor will not
+	// process. The returned value may be true or false in an indeterminate
+	// state. An implementation should default to returning true for an
+	// indeterminate state, but may return false if valid reasons in particular
+	// circumstances exist (e.g. performance, correctness).
+	//
+	// The param should not be held by the implementation. A copy should be
+	// made if the param needs to be held after the call returns.
+	//
+	// Implementations of this method need to be safe for a user to call
+	// concurrently.
+	Enabled(ctx context.Context, param EnabledParameters) bool
+}
+
+// EnabledParameters represents payload for [FilterProcessor]'s Enabled method.
+type EnabledParameters struct {
+	Resource             resource.Resource
+	InstrumentationScope instrumentation.Scope
+	Severity             log.Severity
+}
+
+// WrapProcessor returns a [Processor] that decorates next with onEmit.
+//
+// The OnEmit method of the returned Processor calls onEmit with the emitted
+// record and next. The onEmit function may modify the record in place, for
+// example to enrich or redact it, and then needs to call next.OnEmit to pass
+// it on. It may also drop the record by not calling next.OnEmit. The record
+// is owned by the caller and must not be retained after onEmit returns.
+//
+// The Shutdown and ForceFlush methods of the returned Processor call the ones
+// of next. The returned Processor is a [FilterProcessor] that defers to next
+// if it is a FilterProcessor, and otherwise reports it is enabled.
+func WrapProcessor(next Processor, onEmit func(ctx context.Context, record *Record, next Processor) error) Processor {
+	return &wrappedProcessor{next: next, onEmit: onEmit}
+}
+
+type wrappedProcessor struct {
+	next   Processor
+	onEmit func(context.Context, *Record, Processor) error
+}
+
+// Compile-time check wrappedProcessor implements FilterProcessor.
+var _ FilterProcessor = (*wrappedProcessor)(nil)
+
+func (p *wrappedProcessor) OnEmit(ctx context.Context, r *Record) error {
+	return p.onEmit(ctx, r, p.next)
+}
+
+func (p *wrappedProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if f, ok := p.next.(FilterProcessor); ok {
+		return f.Enabled(ctx, param)
+	}
+	return true
+}
+
+func (p *wrappedProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *wrappedProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
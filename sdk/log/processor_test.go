@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func redact(ctx context.Context, r *Record, next Processor) error {
+	r.SetBody(log.StringValue("REDACTED"))
+	return next.OnEmit(ctx, r)
+}
+
+func TestWrapProcessor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OnEmit", func(t *testing.T) {
+		next := newProcessor("next")
+		p := WrapProcessor(next, redact)
+
+		var r Record
+		r.SetBody(log.StringValue("secret"))
+		require.NoError(t, p.OnEmit(ctx, &r))
+
+		require.Len(t, next.records, 1)
+		assert.Equal(t, "REDACTED", next.records[0].Body().AsString())
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		next := newProcessor("next")
+		p := WrapProcessor(next, func(context.Context, *Record, Processor) error {
+			return nil
+		})
+		require.NoError(t, p.OnEmit(ctx, new(Record)))
+		assert.Empty(t, next.records)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		next := newProcessor("next")
+		p := WrapProcessor(next, redact).(FilterProcessor)
+		assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+		next.enabled = false
+		assert.False(t, p.Enabled(ctx, EnabledParameters{}))
+
+		p = WrapProcessor(NewSimpleProcessor(nil), redact).(FilterProcessor)
+		assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+	})
+
+	t.Run("ShutdownForceFlush", func(t *testing.T) {
+		next := newProcessor("next")
+		next.Err = assert.AnError
+		p := WrapProcessor(next, redact)
+		assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError)
+		assert.Equal(t, 1, next.forceFlushCalls)
+		assert.ErrorIs(t, p.Shutdown(ctx), assert.AnError)
+		assert.Equal(t, 1, next.shutdownCalls)
+	})
+}
+
+func TestProcessorModificationVisibleToNext(t *testing.T) {
+	// A processor that modifies the record in place is registered first.
+	first := WrapProcessor(NewSimpleProcessor(nil), func(ctx context.Context, r *Record, next Processor) error {
+		r.AddAttributes(log.String("enriched", "true"))
+		return next.OnEmit(ctx, r)
+	})
+	second := newProcessor("second")
+	l := NewLoggerProvider(WithProcessor(first), WithProcessor(second)).Logger("test")
+
+	var r log.Record
+	r.SetBody(log.StringValue("body"))
+	l.Emit(context.Background(), r)
+
+	require.Len(t, second.records, 1)
+	var got []log.KeyValue
+	second.records[0].WalkAttributes(func(kv log.KeyValue) bool {
+		got = append(got, kv)
+		return true
+	})
+	assert.Equal(t, []log.KeyValue{log.String("enriched", "true")}, got)
+}
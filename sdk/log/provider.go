@@ -4,10 +4,16 @@
 package log // import "go.opentelemetry.io/otel/sdk/log"
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/log"
@@ -20,16 +26,27 @@ import (
 const (
 	defaultAttrCntLim    = 128
 	defaultAttrValLenLim = -1
+	defaultRecSizeLim    = -1
 
 	envarAttrCntLim    = "OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT"
 	envarAttrValLenLim = "OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT"
+
+	// The general attribute limits, used if the log record ones are not set.
+	envarGenAttrCntLim    = "OTEL_ATTRIBUTE_COUNT_LIMIT"
+	envarGenAttrValLenLim = "OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT"
 )
 
 type providerConfig struct {
-	resource      *resource.Resource
-	processors    []Processor
-	attrCntLim    setting[int]
-	attrValLenLim setting[int]
+	resource         *resource.Resource
+	processors       []Processor
+	attrCntLim       setting[int]
+	attrValLenLim    setting[int]
+	recSizeLim       setting[int]
+	recordAttrs      []log.KeyValue
+	scopeRecordAttrs []scopeRecordAttributes
+	allowDupKeys     bool
+	loggerConfigs    []scopeLoggerConfig
+	clock            Clock
 }
 
 func newProviderConfig(opts []LoggerProviderOption) providerConfig {
@@ -42,16 +59,26 @@ func newProviderConfig(opts []LoggerProviderOption) providerConfig {
 		c.resource = resource.Default()
 	}
 
+	if c.clock == nil {
+		c.clock = defaultClock{}
+	}
+
 	c.attrCntLim = c.attrCntLim.Resolve(
 		getenv[int](envarAttrCntLim),
+		getenv[int](envarGenAttrCntLim),
 		fallback[int](defaultAttrCntLim),
 	)
 
 	c.attrValLenLim = c.attrValLenLim.Resolve(
 		getenv[int](envarAttrValLenLim),
+		getenv[int](envarGenAttrValLenLim),
 		fallback[int](defaultAttrValLenLim),
 	)
 
+	c.recSizeLim = c.recSizeLim.Resolve(
+		fallback[int](defaultRecSizeLim),
+	)
+
 	return c
 }
 
@@ -61,38 +88,161 @@ type LoggerProvider struct {
 	embedded.LoggerProvider
 
 	resource                  *resource.Resource
-	processors                []Processor
 	attributeCountLimit       int
 	attributeValueLengthLimit int
+	recordSizeLimit           int
+	recordAttrs               []log.KeyValue
+	scopeRecordAttrs          []scopeRecordAttributes
+	allowDupKeys              bool
+	loggerConfigs             []scopeLoggerConfig
+	clock                     Clock
 
 	loggersMu sync.Mutex
 	loggers   map[instrumentation.Scope]*logger
 
+	// processorsMu serializes the updates of processors.
+	processorsMu sync.Mutex
+	processors   atomic.Pointer[processorSet]
+
 	stopped atomic.Bool
 }
 
+// processorSet is an immutable set of processors used by a LoggerProvider.
+type processorSet struct {
+	processors []Processor
+	fltrs      []FilterProcessor
+}
+
+func newProcessorSet(processors []Processor) *processorSet {
+	var fltrs []FilterProcessor
+	for _, p := range processors {
+		if f, ok := p.(FilterProcessor); ok {
+			fltrs = append(fltrs, f)
+		}
+	}
+	return &processorSet{processors: processors, fltrs: fltrs}
+}
+
 // Compile-time check LoggerProvider implements log.LoggerProvider.
 var _ log.LoggerProvider = (*LoggerProvider)(nil)
 
 // NewLoggerProvider returns a new and configured LoggerProvider.
 //
 // By default, the returned LoggerProvider is configured with the default
-// Resource and no Processors. Processors cannot be added after a LoggerProvider is
-// created. This means the returned LoggerProvider, one created with no
-// Processors, will perform no operations.
+// Resource and no Processors. A LoggerProvider with no Processors performs no
+// operations. Processors can be added and removed after a LoggerProvider is
+// created using RegisterProcessor and UnregisterProcessor.
 func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
 	cfg := newProviderConfig(opts)
-	return &LoggerProvider{
+
+	p := &LoggerProvider{
 		resource:                  cfg.resource,
-		processors:                cfg.processors,
 		attributeCountLimit:       cfg.attrCntLim.Value,
 		attributeValueLengthLimit: cfg.attrValLenLim.Value,
+		recordSizeLimit:           cfg.recSizeLim.Value,
+		recordAttrs:               cfg.recordAttrs,
+		scopeRecordAttrs:          cfg.scopeRecordAttrs,
+		allowDupKeys:              cfg.allowDupKeys,
+		loggerConfigs:             cfg.loggerConfigs,
+		clock:                     cfg.clock,
+	}
+	p.processors.Store(newProcessorSet(cfg.processors))
+	return p
+}
+
+// getProcessors returns the processors currently used by p.
+func (p *LoggerProvider) getProcessors() *processorSet {
+	return p.processors.Load()
+}
+
+// RegisterProcessor adds processor to the processors used by p. The log
+// records emitted after RegisterProcessor returns are passed to processor,
+// including the ones emitted by the Loggers created before.
+//
+// It can be used to attach a processor at runtime, e.g. one writing verbose
+// log records to the standard output while debugging an issue. Use
+// UnregisterProcessor to detach it.
+//
+// A nil processor is ignored. If p is shut down, RegisterProcessor does
+// nothing.
+//
+// This method can be called concurrently.
+func (p *LoggerProvider) RegisterProcessor(processor Processor) {
+	if processor == nil || p.stopped.Load() {
+		return
+	}
+
+	p.processorsMu.Lock()
+	defer p.processorsMu.Unlock()
+	// This check prevents registering after a shutdown has started.
+	if p.stopped.Load() {
+		return
+	}
+
+	current := p.getProcessors().processors
+	processors := make([]Processor, 0, len(current)+1)
+	processors = append(processors, current...)
+	processors = append(processors, processor)
+	p.processors.Store(newProcessorSet(processors))
+}
+
+// UnregisterProcessor removes processor from the processors used by p and
+// shuts it down. The log records emitted after UnregisterProcessor returns
+// are no longer passed to processor. The error returned by the Shutdown of
+// processor is returned.
+//
+// The processors used by p are compared with processor using ==. If processor
+// was added multiple times, all its occurrences are removed. If processor is
+// not used by p, or p is shut down, UnregisterProcessor does nothing.
+//
+// A processor of a type that is not comparable, e.g. a struct holding a slice,
+// cannot be found. An error is returned for it. Register and unregister a
+// pointer to such a processor instead.
+//
+// This method can be called concurrently.
+func (p *LoggerProvider) UnregisterProcessor(ctx context.Context, processor Processor) error {
+	if processor == nil || p.stopped.Load() {
+		return nil
+	}
+	if !reflect.TypeOf(processor).Comparable() {
+		return fmt.Errorf("unregister processor: type %T is not comparable", processor)
+	}
+
+	p.processorsMu.Lock()
+	// This check prevents unregistering after a shutdown has started.
+	if p.stopped.Load() {
+		p.processorsMu.Unlock()
+		return nil
+	}
+
+	current := p.getProcessors().processors
+	processors := make([]Processor, 0, len(current))
+	for _, proc := range current {
+		if proc != processor {
+			processors = append(processors, proc)
+		}
+	}
+	removed := len(processors) != len(current)
+	if removed {
+		p.processors.Store(newProcessorSet(processors))
 	}
+	p.processorsMu.Unlock()
+
+	if !removed {
+		return nil
+	}
+	return processor.Shutdown(ctx)
 }
 
 // Logger returns a new [log.Logger] with the provided name and configuration.
 //
-// If p is shut down, a [noop.Logger] instace is returned.
+// The same Logger is returned for the same name, version, schema URL, and
+// instrumentation attributes. Its configuration (see [WithLoggerConfig]) is
+// resolved once, when it is first created. Looking up an existing Logger does
+// not allocate.
+//
+// If p is shut down, a [noop.Logger] instace is returned. Loggers returned
+// before p is shut down will stop emitting once p is shut down.
 //
 // This method can be called concurrently.
 func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
@@ -106,9 +256,10 @@ func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logge
 
 	cfg := log.NewLoggerConfig(opts...)
 	scope := instrumentation.Scope{
-		Name:      name,
-		Version:   cfg.InstrumentationVersion(),
-		SchemaURL: cfg.SchemaURL(),
+		Name:       name,
+		Version:    cfg.InstrumentationVersion(),
+		SchemaURL:  cfg.SchemaURL(),
+		Attributes: cfg.InstrumentationAttributes(),
 	}
 
 	p.loggersMu.Lock()
@@ -129,7 +280,8 @@ func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logge
 	return l
 }
 
-// Shutdown shuts down the provider and all processors.
+// Shutdown shuts down the provider and all processors. The errors returned
+// by the processors are joined and returned.
 //
 // This method can be called concurrently.
 func (p *LoggerProvider) Shutdown(ctx context.Context) error {
@@ -138,14 +290,20 @@ func (p *LoggerProvider) Shutdown(ctx context.Context) error {
 		return nil
 	}
 
+	// Wait for any in-progress processor update to complete.
+	p.processorsMu.Lock()
+	processors := p.getProcessors().processors
+	p.processorsMu.Unlock()
+
 	var err error
-	for _, p := range p.processors {
+	for _, p := range processors {
 		err = errors.Join(err, p.Shutdown(ctx))
 	}
 	return err
 }
 
-// ForceFlush flushes all processors.
+// ForceFlush flushes all processors. The errors returned by the processors
+// are joined and returned.
 //
 // This method can be called concurrently.
 func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
@@ -154,7 +312,7 @@ func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
 	}
 
 	var err error
-	for _, p := range p.processors {
+	for _, p := range p.getProcessors().processors {
 		err = errors.Join(err, p.ForceFlush(ctx))
 	}
 	return err
@@ -194,8 +352,13 @@ func WithResource(res *resource.Resource) LoggerProviderOption {
 //
 // For production, use [NewBatchProcessor] to batch log records before they are exported.
 // For testing and debugging, use [NewSimpleProcessor] to synchronously export log records.
+//
+// A nil processor is ignored.
 func WithProcessor(processor Processor) LoggerProviderOption {
 	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		if processor == nil {
+			return cfg
+		}
 		cfg.processors = append(cfg.processors, processor)
 		return cfg
 	})
@@ -209,7 +372,9 @@ func WithProcessor(processor Processor) LoggerProviderOption {
 // Setting this to a negative value means no limit is applied.
 //
 // If the OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT environment variable is set,
-// and this option is not passed, that variable value will be used.
+// and this option is not passed, that variable value will be used. Otherwise,
+// if the OTEL_ATTRIBUTE_COUNT_LIMIT environment variable is set, that
+// variable value will be used.
 //
 // By default, if an environment variable is not set, and this option is not
 // passed, 128 will be used.
@@ -228,7 +393,9 @@ func WithAttributeCountLimit(limit int) LoggerProviderOption {
 // Setting this to a negative value means no limit is applied.
 //
 // If the OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variable is set,
-// and this option is not passed, that variable value will be used.
+// and this option is not passed, that variable value will be used. Otherwise,
+// if the OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variable is set, that
+// variable value will be used.
 //
 // By default, if an environment variable is not set, and this option is not
 // passed, no limit (-1) will be used.
@@ -238,3 +405,174 @@ func WithAttributeValueLengthLimit(limit int) LoggerProviderOption {
 		return cfg
 	})
 }
+
+// WithRecordSizeLimit sets the maximum allowed approximate serialized size,
+// in bytes, of the log records when they are emitted. It protects the
+// exporters and the collectors from multi-megabyte log records.
+//
+// The size of a log record is the size of its event name, severity text,
+// body, and attribute keys and values. The string and byte values of an
+// oversized log record are truncated, starting with its body and then its
+// attributes, until its size complies with the limit. An
+// "otel.log.record.truncated" attribute with a true value is then added to
+// the log record.
+//
+// The limit is applied before the log record is passed to the processors.
+// Modifications made by the processors are not limited.
+//
+// Setting this to a negative value means no limit is applied.
+//
+// By default, if this option is not passed, no limit (-1) will be used.
+func WithRecordSizeLimit(limit int) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.recSizeLim = newSetting(limit)
+		return cfg
+	})
+}
+
+// WithRecordAttributes stamps attrs on every emitted log record, e.g. a
+// deployment environment or a region read from command-line flags.
+//
+// Unlike resource attributes, the attributes are held by each log record.
+// This is intended for the backends that do not index resource attributes
+// well. Keep the attributes few and small as they are repeated in every log
+// record.
+//
+// The attributes are added to a log record before its own attributes, which
+// take precedence over them unless attribute deduplication is disabled. The
+// attribute limits are applied.
+//
+// This option can be used multiple times. The attributes are appended to the
+// ones already configured.
+func WithRecordAttributes(attrs ...log.KeyValue) LoggerProviderOption {
+	attrs = slices.Clone(attrs)
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.recordAttrs = append(cfg.recordAttrs, attrs...)
+		return cfg
+	})
+}
+
+// WithRecordAttributesFromEnv stamps attributes read from environment
+// variables on every emitted log record. The keys of env are the attribute
+// keys and its values the names of the environment variables holding the
+// attribute values, e.g.
+//
+//	WithRecordAttributesFromEnv(map[string]string{
+//		"deployment.environment": "DEPLOY_ENV",
+//		"cloud.region":           "REGION",
+//	})
+//
+// The environment variables are read once, when the option is created. No
+// attribute is stamped for an environment variable that is not set or empty.
+// The attributes are sorted by key. See [WithRecordAttributes] for how the
+// attributes are stamped.
+func WithRecordAttributesFromEnv(env map[string]string) LoggerProviderOption {
+	attrs := make([]log.KeyValue, 0, len(env))
+	for key, name := range env {
+		if v := os.Getenv(name); v != "" {
+			attrs = append(attrs, log.String(key, v))
+		}
+	}
+	slices.SortFunc(attrs, func(a, b log.KeyValue) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return WithRecordAttributes(attrs...)
+}
+
+// WithScopeRecordAttributes stamps attrs on every log record emitted by the
+// Loggers with an instrumentation scope name matching name, e.g.
+// subsystem=payments for the "myco/payments" scope. It keeps the libraries
+// free of the deployment-specific attributes.
+//
+// The attributes are added to the log records, not to the instrumentation
+// scope. The attributes of the instrumentation scope are set by the
+// instrumentation library with [log.WithInstrumentationAttributes].
+//
+// The name is a pattern where '*' matches any sequence of characters (e.g.
+// "myco/*"). An empty pattern matches any name.
+//
+// The attributes of a Logger are determined when it is created. The
+// attributes of all the WithScopeRecordAttributes options matching its
+// instrumentation scope are added, in the order the options are passed,
+// after the ones configured with [WithRecordAttributes] and before the ones
+// of the log record. See [WithRecordAttributes] for how the attributes are
+// stamped.
+//
+// This option can be used multiple times.
+func WithScopeRecordAttributes(name string, attrs ...log.KeyValue) LoggerProviderOption {
+	attrs = slices.Clone(attrs)
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.scopeRecordAttrs = append(cfg.scopeRecordAttrs, scopeRecordAttributes{name: name, attrs: attrs})
+		return cfg
+	})
+}
+
+// WithAttributeDeduplication sets if the attributes of log records are
+// deduplicated.
+//
+// If enabled, attributes with a key already held by a log record replace the
+// existing attribute, as required by the OpenTelemetry specification.
+//
+// Deduplication has a significant CPU overhead. Disabling it is only
+// recommended when the attribute keys added to log records are guaranteed to
+// be unique. Otherwise, log records with duplicate attribute keys will be
+// processed and exported.
+//
+// By default, if this option is not used, attributes are deduplicated.
+func WithAttributeDeduplication(enabled bool) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.allowDupKeys = !enabled
+		return cfg
+	})
+}
+
+// WithLoggerConfig sets the configuration of the Loggers with an
+// instrumentation scope name matching name and version matching version.
+//
+// The name and version are patterns where '*' matches any sequence of
+// characters (e.g. "github.com/example/*"). An empty pattern matches any
+// value.
+//
+// The configuration of a Logger is determined when it is created. If multiple
+// WithLoggerConfig options match the instrumentation scope of a Logger, the
+// last one passed is used.
+//
+// By default, if this option is not used, all Loggers are enabled and no
+// minimum severity is applied.
+func WithLoggerConfig(name, version string, config LoggerConfig) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.loggerConfigs = append(cfg.loggerConfigs, scopeLoggerConfig{
+			name:    name,
+			version: version,
+			config:  config,
+		})
+		return cfg
+	})
+}
+
+// Clock provides the current time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// defaultClock is the Clock returning the current system time.
+type defaultClock struct{}
+
+func (defaultClock) Now() time.Time { return now() }
+
+// WithClock sets the Clock used to determine the current time. The
+// ObservedTimestamp of emitted log records that do not have one set is the
+// time returned by the Clock when they are emitted.
+//
+// This allows tests and simulations to control the timestamps of the log
+// records deterministically.
+//
+// By default, if this option is not used or clock is nil, the current system
+// time is used.
+func WithClock(clock Clock) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.clock = clock
+		return cfg
+	})
+}
@@ -5,9 +5,11 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -17,7 +19,9 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/noop"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -28,24 +32,30 @@ type processor struct {
 	shutdownCalls   int
 	forceFlushCalls int
 
-	records []Record
-	enabled bool
+	records       []Record
+	enabled       bool
+	enabledParams []EnabledParameters
 }
 
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
 func newProcessor(name string) *processor {
 	return &processor{Name: name, enabled: true}
 }
 
-func (p *processor) OnEmit(ctx context.Context, r Record) error {
+func (p *processor) OnEmit(ctx context.Context, r *Record) error {
 	if p.Err != nil {
 		return p.Err
 	}
 
-	p.records = append(p.records, r)
+	p.records = append(p.records, r.Clone())
 	return nil
 }
 
-func (p *processor) Enabled(context.Context, Record) bool {
+func (p *processor) Enabled(_ context.Context, param EnabledParameters) bool {
+	p.enabledParams = append(p.enabledParams, param)
 	return p.enabled
 }
 
@@ -69,14 +79,18 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 
 	res := resource.NewSchemaless(attribute.String("key", "value"))
 	p0, p1 := newProcessor("0"), newProcessor("1")
+	clock := fixedClock(time.Unix(1, 0))
 	attrCntLim := 12
 	attrValLenLim := 21
+	recSizeLim := 1024
 
 	testcases := []struct {
 		name    string
 		envars  map[string]string
 		options []LoggerProviderOption
 		want    *LoggerProvider
+		// wantProcessors are the processors used by the LoggerProvider.
+		wantProcessors []Processor
 	}{
 		{
 			name: "Defaults",
@@ -84,6 +98,8 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				resource:                  resource.Default(),
 				attributeCountLimit:       defaultAttrCntLim,
 				attributeValueLengthLimit: defaultAttrValLenLim,
+				recordSizeLimit:           defaultRecSizeLim,
+				clock:                     defaultClock{},
 			},
 		},
 		{
@@ -94,13 +110,19 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				WithProcessor(p1),
 				WithAttributeCountLimit(attrCntLim),
 				WithAttributeValueLengthLimit(attrValLenLim),
+				WithRecordSizeLimit(recSizeLim),
+				WithAttributeDeduplication(false),
+				WithClock(clock),
 			},
 			want: &LoggerProvider{
 				resource:                  res,
-				processors:                []Processor{p0, p1},
 				attributeCountLimit:       attrCntLim,
 				attributeValueLengthLimit: attrValLenLim,
+				recordSizeLimit:           recSizeLim,
+				allowDupKeys:              true,
+				clock:                     clock,
 			},
+			wantProcessors: []Processor{p0, p1},
 		},
 		{
 			name: "Environment",
@@ -112,6 +134,39 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				resource:                  resource.Default(),
 				attributeCountLimit:       attrCntLim,
 				attributeValueLengthLimit: attrValLenLim,
+				recordSizeLimit:           defaultRecSizeLim,
+				clock:                     defaultClock{},
+			},
+		},
+		{
+			name: "GeneralEnvironment",
+			envars: map[string]string{
+				envarGenAttrCntLim:    strconv.Itoa(attrCntLim),
+				envarGenAttrValLenLim: strconv.Itoa(attrValLenLim),
+			},
+			want: &LoggerProvider{
+				resource:                  resource.Default(),
+				attributeCountLimit:       attrCntLim,
+				attributeValueLengthLimit: attrValLenLim,
+				recordSizeLimit:           defaultRecSizeLim,
+				clock:                     defaultClock{},
+			},
+		},
+		{
+			name: "EnvironmentPrecedence",
+			envars: map[string]string{
+				// The log record specific variables override the general ones.
+				envarAttrCntLim:       strconv.Itoa(attrCntLim),
+				envarAttrValLenLim:    strconv.Itoa(attrValLenLim),
+				envarGenAttrCntLim:    strconv.Itoa(100),
+				envarGenAttrValLenLim: strconv.Itoa(101),
+			},
+			want: &LoggerProvider{
+				resource:                  resource.Default(),
+				attributeCountLimit:       attrCntLim,
+				attributeValueLengthLimit: attrValLenLim,
+				recordSizeLimit:           defaultRecSizeLim,
+				clock:                     defaultClock{},
 			},
 		},
 		{
@@ -124,13 +179,17 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				resource:                  resource.Default(),
 				attributeCountLimit:       defaultAttrCntLim,
 				attributeValueLengthLimit: defaultAttrValLenLim,
+				recordSizeLimit:           defaultRecSizeLim,
+				clock:                     defaultClock{},
 			},
 		},
 		{
 			name: "Precedence",
 			envars: map[string]string{
-				envarAttrCntLim:    strconv.Itoa(100),
-				envarAttrValLenLim: strconv.Itoa(101),
+				envarAttrCntLim:       strconv.Itoa(100),
+				envarAttrValLenLim:    strconv.Itoa(101),
+				envarGenAttrCntLim:    strconv.Itoa(102),
+				envarGenAttrValLenLim: strconv.Itoa(103),
 			},
 			options: []LoggerProviderOption{
 				// These override the environment variables.
@@ -141,6 +200,8 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				resource:                  resource.Default(),
 				attributeCountLimit:       attrCntLim,
 				attributeValueLengthLimit: attrValLenLim,
+				recordSizeLimit:           defaultRecSizeLim,
+				clock:                     defaultClock{},
 			},
 		},
 	}
@@ -150,11 +211,103 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 			for key, value := range tc.envars {
 				t.Setenv(key, value)
 			}
-			assert.Equal(t, tc.want, NewLoggerProvider(tc.options...))
+			got := NewLoggerProvider(tc.options...)
+			assert.Equal(t, tc.wantProcessors, got.getProcessors().processors)
+			// The processors are compared above. The atomic pointer holding
+			// them cannot be compared.
+			got.processors.Store(nil)
+			assert.Equal(t, tc.want, got)
 		})
 	}
 }
 
+func TestWithProcessorNil(t *testing.T) {
+	p := NewLoggerProvider(WithProcessor(nil))
+	assert.Empty(t, p.getProcessors().processors)
+}
+
+func TestLoggerProviderRegisterProcessor(t *testing.T) {
+	ctx := context.Background()
+	p0, p1 := newProcessor("0"), newProcessor("1")
+	p := NewLoggerProvider(WithProcessor(p0))
+	l := p.Logger("test")
+
+	var r log.Record
+	r.SetSeverity(log.SeverityDebug)
+
+	l.Emit(ctx, r)
+	p.RegisterProcessor(p1)
+	p.RegisterProcessor(nil)
+	l.Emit(ctx, r)
+	assert.Len(t, p0.records, 2)
+	assert.Len(t, p1.records, 1, "registered processor not used by existing Logger")
+
+	p1.enabled = false
+	p0.enabled = false
+	assert.False(t, l.Enabled(ctx, r), "registered FilterProcessor not used")
+	p1.enabled = true
+	assert.True(t, l.Enabled(ctx, r), "registered FilterProcessor not used")
+
+	require.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, 1, p1.forceFlushCalls, "registered processor not flushed")
+
+	require.NoError(t, p.UnregisterProcessor(ctx, p1))
+	assert.Equal(t, 1, p1.shutdownCalls, "unregistered processor not shut down")
+	assert.Equal(t, 0, p0.shutdownCalls, "other processor shut down")
+	l.Emit(ctx, r)
+	assert.Len(t, p0.records, 3)
+	assert.Len(t, p1.records, 1, "unregistered processor used")
+	assert.False(t, l.Enabled(ctx, r), "unregistered FilterProcessor used")
+
+	// Unregistering a processor not used is a no-op.
+	require.NoError(t, p.UnregisterProcessor(ctx, p1))
+	assert.Equal(t, 1, p1.shutdownCalls, "processor shut down twice")
+
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 1, p0.shutdownCalls, "processor not shut down")
+	assert.Equal(t, 1, p1.shutdownCalls, "unregistered processor shut down by provider")
+
+	// Updates after shutdown are no-ops.
+	p2 := newProcessor("2")
+	p.RegisterProcessor(p2)
+	assert.Empty(t, p.getProcessors().processors[1:])
+	require.NoError(t, p.UnregisterProcessor(ctx, p0))
+	assert.Equal(t, 1, p0.shutdownCalls, "processor shut down twice")
+}
+
+func TestLoggerProviderUnregisterProcessorError(t *testing.T) {
+	proc := newProcessor("")
+	proc.Err = assert.AnError
+	p := NewLoggerProvider(WithProcessor(proc))
+	assert.ErrorIs(t, p.UnregisterProcessor(context.Background(), proc), assert.AnError)
+}
+
+// sliceProcessor is a processor of a type that is not comparable.
+type sliceProcessor struct {
+	Processor
+
+	names []string
+}
+
+func TestLoggerProviderUnregisterProcessorNotComparable(t *testing.T) {
+	ctx := context.Background()
+	proc := newProcessor("0")
+	sp := sliceProcessor{Processor: newProcessor("1"), names: []string{"a"}}
+	p := NewLoggerProvider(WithProcessor(proc), WithProcessor(sp))
+
+	var err error
+	assert.NotPanics(t, func() { err = p.UnregisterProcessor(ctx, sp) })
+	assert.ErrorContains(t, err, "not comparable")
+	assert.Len(t, p.getProcessors().processors, 2, "processor removed")
+
+	// The processors of a non-comparable type do not prevent unregistering
+	// other ones.
+	assert.NotPanics(t, func() { err = p.UnregisterProcessor(ctx, proc) })
+	assert.NoError(t, err)
+	assert.Equal(t, 1, proc.shutdownCalls, "processor not shut down")
+	assert.Len(t, p.getProcessors().processors, 1, "processor not removed")
+}
+
 func TestLoggerProviderConcurrentSafe(t *testing.T) {
 	const goRoutineN = 10
 
@@ -169,6 +322,9 @@ func TestLoggerProviderConcurrentSafe(t *testing.T) {
 			defer wg.Done()
 
 			_ = p.Logger(name)
+			proc := newProcessor("")
+			p.RegisterProcessor(proc)
+			_ = p.UnregisterProcessor(ctx, proc)
 			_ = p.Shutdown(ctx)
 			_ = p.ForceFlush(ctx)
 		}()
@@ -226,6 +382,53 @@ func TestLoggerProviderLogger(t *testing.T) {
 		assert.Same(t, l0, l2)
 		assert.Same(t, l1, l3)
 	})
+
+	t.Run("SameLoggersConcurrent", func(t *testing.T) {
+		p := NewLoggerProvider()
+
+		const goroutines = 8
+		var wg sync.WaitGroup
+		loggers := make([]log.Logger, goroutines)
+		for i := range loggers {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				loggers[i] = p.Logger("l", log.WithInstrumentationVersion("v"))
+			}(i)
+		}
+		wg.Wait()
+
+		for _, l := range loggers[1:] {
+			assert.Same(t, loggers[0], l)
+		}
+	})
+
+	t.Run("NoAllocs", func(t *testing.T) {
+		p := NewLoggerProvider()
+		_ = p.Logger("l")
+
+		allocs := testing.AllocsPerRun(10, func() { _ = p.Logger("l") })
+		assert.Zero(t, allocs, "existing Logger lookup allocates")
+	})
+
+	t.Run("InstrumentationAttributes", func(t *testing.T) {
+		proc := newProcessor("")
+		p := NewLoggerProvider(WithProcessor(proc))
+
+		attrs := log.WithInstrumentationAttributes(attribute.String("k", "v"))
+		l0, l1 := p.Logger("l"), p.Logger("l", attrs)
+		l2 := p.Logger("l", attrs)
+		assert.NotSame(t, l0, l1, "attributes do not identify the Logger")
+		assert.Same(t, l1, l2)
+
+		l1.Emit(context.Background(), log.Record{})
+		require.Len(t, proc.records, 1)
+		want := instrumentation.Scope{
+			Name:       "l",
+			Attributes: attribute.NewSet(attribute.String("k", "v")),
+		}
+		assert.Equal(t, want, proc.records[0].InstrumentationScope())
+	})
 }
 
 func TestLoggerProviderShutdown(t *testing.T) {
@@ -249,6 +452,34 @@ func TestLoggerProviderShutdown(t *testing.T) {
 		ctx := context.Background()
 		assert.ErrorIs(t, p.Shutdown(ctx), assert.AnError, "processor error not returned")
 	})
+
+	t.Run("MultiError", func(t *testing.T) {
+		err0, err1 := errors.New("0"), errors.New("1")
+		p0, p1 := newProcessor("0"), newProcessor("1")
+		p0.Err, p1.Err = err0, err1
+		p := NewLoggerProvider(WithProcessor(p0), WithProcessor(p1))
+
+		err := p.Shutdown(context.Background())
+		assert.ErrorIs(t, err, err0, "processor 0 error not returned")
+		assert.ErrorIs(t, err, err1, "processor 1 error not returned")
+	})
+
+	t.Run("ExistingLoggers", func(t *testing.T) {
+		proc := newProcessor("")
+		p := NewLoggerProvider(WithProcessor(proc))
+		l := p.Logger("testing")
+
+		ctx := context.Background()
+		var r log.Record
+		r.SetSeverity(log.SeverityInfo)
+		require.True(t, l.Enabled(ctx, r), "Logger disabled before Shutdown")
+
+		require.NoError(t, p.Shutdown(ctx))
+
+		assert.False(t, l.Enabled(ctx, r), "Logger enabled after Shutdown")
+		l.Emit(ctx, r)
+		assert.Empty(t, proc.records, "record emitted after Shutdown")
+	})
 }
 
 func TestLoggerProviderForceFlush(t *testing.T) {
@@ -287,3 +518,274 @@ func TestLoggerProviderForceFlush(t *testing.T) {
 		assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError, "processor error not returned")
 	})
 }
+
+func TestLoggerProviderWithClock(t *testing.T) {
+	observed := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	p := newProcessor("0")
+	lp := NewLoggerProvider(WithProcessor(p), WithClock(fixedClock(observed)))
+
+	l := lp.Logger("TestLoggerProviderWithClock")
+	var r log.Record
+	l.Emit(context.Background(), r)
+	set := observed.Add(time.Hour)
+	r.SetObservedTimestamp(set)
+	l.Emit(context.Background(), r)
+
+	require.Len(t, p.records, 2)
+	assert.Equal(t, observed, p.records[0].ObservedTimestamp(), "unset")
+	assert.Equal(t, set, p.records[1].ObservedTimestamp(), "set")
+}
+
+func TestLoggerProviderRecordAttributes(t *testing.T) {
+	t.Setenv("TEST_DEPLOY_ENV", "production")
+	t.Setenv("TEST_REGION", "")
+
+	proc := newProcessor("")
+	p := NewLoggerProvider(
+		WithProcessor(proc),
+		WithRecordAttributesFromEnv(map[string]string{
+			"deployment.environment": "TEST_DEPLOY_ENV",
+			"cloud.region":           "TEST_REGION",
+			"host.zone":              "TEST_UNSET",
+		}),
+		WithRecordAttributes(log.String("service.tier", "web"), log.String("k", "stamped")),
+	)
+
+	var r log.Record
+	r.AddAttributes(log.String("k", "record"))
+	p.Logger("test").Emit(context.Background(), r)
+
+	require.Len(t, proc.records, 1)
+	var got []log.KeyValue
+	proc.records[0].WalkAttributes(func(kv log.KeyValue) bool {
+		got = append(got, kv)
+		return true
+	})
+	assert.Equal(t, []log.KeyValue{
+		log.String("deployment.environment", "production"),
+		log.String("service.tier", "web"),
+		log.String("k", "record"),
+	}, got)
+}
+
+func TestLoggerProviderAttributeLimits(t *testing.T) {
+	attrs := []log.KeyValue{
+		log.String("a", "abcdef"),
+		log.Slice("b", log.StringValue("abcdef"), log.Int64Value(1)),
+		log.Bool("c", true),
+		log.Int("d", 1),
+	}
+
+	testcases := []struct {
+		name    string
+		envars  map[string]string
+		options []LoggerProviderOption
+
+		want        []log.KeyValue
+		wantDropped int
+	}{
+		{
+			name: "Unlimited",
+			options: []LoggerProviderOption{
+				WithAttributeCountLimit(-1),
+				WithAttributeValueLengthLimit(-1),
+			},
+			want: attrs,
+		},
+		{
+			name: "Options",
+			options: []LoggerProviderOption{
+				WithAttributeCountLimit(2),
+				WithAttributeValueLengthLimit(3),
+			},
+			want: []log.KeyValue{
+				log.String("a", "abc"),
+				log.Slice("b", log.StringValue("abc"), log.Int64Value(1)),
+			},
+			wantDropped: 2,
+		},
+		{
+			name: "Environment",
+			envars: map[string]string{
+				envarAttrCntLim:    "3",
+				envarAttrValLenLim: "2",
+			},
+			want: []log.KeyValue{
+				log.String("a", "ab"),
+				log.Slice("b", log.StringValue("ab"), log.Int64Value(1)),
+				log.Bool("c", true),
+			},
+			wantDropped: 1,
+		},
+		{
+			name: "OptionsOverrideEnvironment",
+			envars: map[string]string{
+				envarAttrCntLim:    "1",
+				envarAttrValLenLim: "1",
+			},
+			options: []LoggerProviderOption{
+				WithAttributeCountLimit(3),
+				WithAttributeValueLengthLimit(4),
+			},
+			want: []log.KeyValue{
+				log.String("a", "abcd"),
+				log.Slice("b", log.StringValue("abcd"), log.Int64Value(1)),
+				log.Bool("c", true),
+			},
+			wantDropped: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for key, value := range tc.envars {
+				t.Setenv(key, value)
+			}
+
+			proc := newProcessor("")
+			opts := append([]LoggerProviderOption{WithProcessor(proc)}, tc.options...)
+			p := NewLoggerProvider(opts...)
+
+			var r log.Record
+			r.AddAttributes(attrs...)
+			p.Logger("test").Emit(context.Background(), r)
+
+			require.Len(t, proc.records, 1)
+			got := proc.records[0]
+			assert.Equal(t, tc.want, recordAttrs(&got), "attributes")
+			assert.Equal(t, tc.wantDropped, got.DroppedAttributes(), "dropped")
+			assert.Equal(t, "abcdef", attrs[1].Value.AsSlice()[0].AsString(), "slice value modified")
+		})
+	}
+}
+
+func TestLoggerProviderRecordAttributesConcurrentSafe(t *testing.T) {
+	newAttr := func() log.KeyValue {
+		return log.Map("m",
+			log.String("dup", "aaaa"),
+			log.String("dup", "bbbb"),
+			log.Slice("s", log.StringValue("cccc")),
+		)
+	}
+	attr := newAttr()
+
+	p := NewLoggerProvider(
+		WithProcessor(NewSimpleProcessor(defaultNoopExporter)),
+		WithAttributeValueLengthLimit(2),
+		WithRecordAttributes(attr),
+	)
+	l := p.Logger("test")
+
+	const goRoutines = 10
+	var wg sync.WaitGroup
+	for i := 0; i < goRoutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.Emit(context.Background(), log.Record{})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, newAttr().Equal(attr), "WithRecordAttributes value modified")
+
+	proc := newProcessor("")
+	p = NewLoggerProvider(
+		WithProcessor(proc),
+		WithAttributeValueLengthLimit(2),
+		WithRecordAttributes(attr),
+	)
+	p.Logger("test").Emit(context.Background(), log.Record{})
+	require.Len(t, proc.records, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.Map("m",
+			log.String("dup", "bb"),
+			log.Slice("s", log.StringValue("cc")),
+		),
+	}, recordAttrs(&proc.records[0]))
+	assert.Zero(t, proc.records[0].DroppedAttributes())
+}
+
+func TestLoggerProviderScopeAttributes(t *testing.T) {
+	proc := newProcessor("")
+	p := NewLoggerProvider(
+		WithProcessor(proc),
+		WithRecordAttributes(log.String("deployment.environment", "production")),
+		WithScopeRecordAttributes("myco/payments", log.String("subsystem", "payments"), log.String("k", "scope")),
+	)
+
+	var r log.Record
+	r.AddAttributes(log.String("k", "record"))
+	p.Logger("myco/payments").Emit(context.Background(), r)
+	p.Logger("myco/users").Emit(context.Background(), r)
+
+	require.Len(t, proc.records, 2)
+	attrs := func(r Record) []log.KeyValue {
+		var got []log.KeyValue
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			got = append(got, kv)
+			return true
+		})
+		return got
+	}
+	assert.Equal(t, []log.KeyValue{
+		log.String("deployment.environment", "production"),
+		log.String("subsystem", "payments"),
+		log.String("k", "record"),
+	}, attrs(proc.records[0]), "matching scope")
+	assert.Equal(t, []log.KeyValue{
+		log.String("deployment.environment", "production"),
+		log.String("k", "record"),
+	}, attrs(proc.records[1]), "other scope")
+}
+
+func TestLoggerProviderScopeAttributesConcurrentSafe(t *testing.T) {
+	newAttr := func() log.KeyValue {
+		return log.Map("m",
+			log.String("dup", "aaaa"),
+			log.String("dup", "bbbb"),
+			log.Slice("s", log.StringValue("cccc")),
+		)
+	}
+	attr := newAttr()
+
+	p := NewLoggerProvider(
+		WithProcessor(NewSimpleProcessor(defaultNoopExporter)),
+		WithAttributeValueLengthLimit(2),
+		WithRecordAttributes(log.String("env", "production")),
+		WithScopeRecordAttributes("myco/*", attr),
+	)
+	l := p.Logger("myco/payments")
+
+	const goRoutines = 10
+	var wg sync.WaitGroup
+	for i := 0; i < goRoutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.Emit(context.Background(), log.Record{})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, newAttr().Equal(attr), "WithScopeRecordAttributes value modified")
+
+	proc := newProcessor("")
+	p = NewLoggerProvider(
+		WithProcessor(proc),
+		WithAttributeValueLengthLimit(2),
+		WithScopeRecordAttributes("myco/*", attr),
+	)
+	p.Logger("myco/payments").Emit(context.Background(), log.Record{})
+	require.Len(t, proc.records, 1)
+	assert.Equal(t, []log.KeyValue{
+		log.Map("m",
+			log.String("dup", "bb"),
+			log.Slice("s", log.StringValue("cc")),
+		),
+	}, recordAttrs(&proc.records[0]))
+}
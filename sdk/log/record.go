@@ -41,6 +41,7 @@ type Record struct {
 	// Do not embed the log.Record. Attributes need to be overwrite-able and
 	// deep-copying needs to be possible.
 
+	eventName         string
 	timestamp         time.Time
 	observedTimestamp time.Time
 	severity          log.Severity
@@ -81,6 +82,21 @@ type Record struct {
 
 	attributeValueLengthLimit int
 	attributeCountLimit       int
+
+	// allowDupKeys disables the deduplication of attributes.
+	allowDupKeys bool
+}
+
+// EventName returns the event name. A log record with a non-empty event name
+// is an Event.
+func (r *Record) EventName() string {
+	return r.eventName
+}
+
+// SetEventName sets the event name. A log record with a non-empty event name
+// is an Event.
+func (r *Record) SetEventName(s string) {
+	r.eventName = s
 }
 
 // Timestamp returns the time when the log record occurred.
@@ -153,6 +169,17 @@ func (r *Record) WalkAttributes(f func(log.KeyValue) bool) {
 // AddAttributes adds attributes to the log record.
 func (r *Record) AddAttributes(attrs ...log.KeyValue) {
 	n := r.AttributesLen()
+	if r.allowDupKeys {
+		// Keys are guaranteed to be unique by the user. Skip deduplication.
+		if r.attributeCountLimit > 0 && n+len(attrs) > r.attributeCountLimit {
+			last := max(0, (r.attributeCountLimit - n))
+			r.dropped += len(attrs) - last
+			attrs = attrs[:last]
+		}
+		r.addAttrs(attrs)
+		return
+	}
+
 	if n == 0 {
 		// Avoid the more complex duplicate map lookups bellow.
 		attrs, r.dropped = dedup(attrs)
@@ -193,6 +220,7 @@ func (r *Record) AddAttributes(attrs ...log.KeyValue) {
 		if found {
 			// New attrs overwrite any existing with the same key.
 			r.dropped++
+			a = r.applyAttrLimits(a)
 			if idx < 0 {
 				r.front[-(idx + 1)] = a
 			} else {
@@ -250,18 +278,18 @@ func (r *Record) addAttrs(attrs []log.KeyValue) {
 		r.nFront++
 	}
 
-	for j, a := range attrs[i:] {
-		attrs[i+j] = r.applyAttrLimits(a)
-	}
 	r.back = slices.Grow(r.back, len(attrs[i:]))
-	r.back = append(r.back, attrs[i:]...)
+	for _, a := range attrs[i:] {
+		r.back = append(r.back, r.applyAttrLimits(a))
+	}
 }
 
 // SetAttributes sets (and overrides) attributes to the log record.
 func (r *Record) SetAttributes(attrs ...log.KeyValue) {
-	// TODO: apply truncation to string and []string values.
-	// TODO: deduplicate map values.
-	attrs, r.dropped = dedup(attrs)
+	r.dropped = 0
+	if !r.allowDupKeys {
+		attrs, r.dropped = dedup(attrs)
+	}
 
 	var drop int
 	attrs, drop = head(attrs, r.attributeCountLimit)
@@ -296,19 +324,40 @@ func dedup(kvs []log.KeyValue) (unique []log.KeyValue, dropped int) {
 	defer putIndex(index)
 
 	unique = kvs[:0] // Use the same underlying array as kvs.
-	for _, a := range kvs {
+	for i, a := range kvs {
 		idx, found := index[a.Key]
 		if found {
 			dropped++
 			unique[idx] = a
 		} else {
-			unique = append(unique, a)
+			if len(unique) == i {
+				// No duplicates so far, a is already in place. Do not write
+				// it so kvs without duplicates are never modified.
+				unique = unique[:i+1]
+			} else {
+				unique = append(unique, a)
+			}
 			index[a.Key] = len(unique) - 1
 		}
 	}
 	return unique, dropped
 }
 
+// hasDuplicates returns whether kvs contains more than one key-value pair with
+// the same key.
+func hasDuplicates(kvs []log.KeyValue) bool {
+	index := getIndex()
+	defer putIndex(index)
+
+	for i, a := range kvs {
+		if _, found := index[a.Key]; found {
+			return true
+		}
+		index[a.Key] = i
+	}
+	return false
+}
+
 // AttributesLen returns the number of attributes in the log record.
 func (r *Record) AttributesLen() int {
 	return r.nFront + len(r.back)
@@ -380,29 +429,60 @@ func (r Record) applyAttrLimits(attr log.KeyValue) log.KeyValue {
 }
 
 func (r Record) applyValueLimits(val log.Value) log.Value {
+	val, _ = r.limitValue(val)
+	return val
+}
+
+// limitValue returns val with the limits of r applied and whether the limits
+// changed it. The data held by val is never written to, a changed slice or map
+// is copied, so val can be safely shared with the caller and between
+// goroutines.
+func (r Record) limitValue(val log.Value) (log.Value, bool) {
 	switch val.Kind() {
 	case log.KindString:
 		s := val.AsString()
 		if len(s) > r.attributeValueLengthLimit {
-			val = log.StringValue(truncate(s, r.attributeValueLengthLimit))
+			if t := truncate(s, r.attributeValueLengthLimit); len(t) != len(s) {
+				return log.StringValue(t), true
+			}
 		}
 	case log.KindSlice:
 		sl := val.AsSlice()
+		var changed bool
 		for i := range sl {
-			sl[i] = r.applyValueLimits(sl[i])
+			if v, ok := r.limitValue(sl[i]); ok {
+				if !changed {
+					sl, changed = slices.Clone(sl), true
+				}
+				sl[i] = v
+			}
+		}
+		if changed {
+			return log.SliceValue(sl...), true
 		}
-		val = log.SliceValue(sl...)
 	case log.KindMap:
 		// Deduplicate then truncate. Do not do at the same time to avoid
 		// wasted truncation operations.
-		kvs, dropped := dedup(val.AsMap())
-		r.dropped += dropped
+		// Only top-level attributes are counted as dropped.
+		kvs := val.AsMap()
+		var changed bool
+		if hasDuplicates(kvs) {
+			kvs, _ = dedup(slices.Clone(kvs))
+			changed = true
+		}
 		for i := range kvs {
-			kvs[i] = r.applyAttrLimits(kvs[i])
+			if v, ok := r.limitValue(kvs[i].Value); ok {
+				if !changed {
+					kvs, changed = slices.Clone(kvs), true
+				}
+				kvs[i].Value = v
+			}
+		}
+		if changed {
+			return log.MapValue(kvs...), true
 		}
-		val = log.MapValue(kvs...)
 	}
-	return val
+	return val, false
 }
 
 // truncate returns a copy of str truncated to have a length of at most n
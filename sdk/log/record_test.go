@@ -5,6 +5,7 @@ package log
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -19,6 +20,13 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+func TestRecordEventName(t *testing.T) {
+	const text = "testing event"
+	r := new(Record)
+	r.SetEventName(text)
+	assert.Equal(t, text, r.EventName())
+}
+
 func TestRecordTimestamp(t *testing.T) {
 	now := time.Now()
 	r := new(Record)
@@ -335,6 +343,54 @@ func TestRecordAttrDeduplication(t *testing.T) {
 	}
 }
 
+// recordAttrs returns all the attributes held by r.
+func recordAttrs(r *Record) []log.KeyValue {
+	var out []log.KeyValue
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		out = append(out, kv)
+		return true
+	})
+	return out
+}
+
+func TestRecordAttrAllowDuplicateKeys(t *testing.T) {
+	attrs := []log.KeyValue{
+		log.Bool("a", true),
+		log.Int("b", 1),
+		log.Bool("a", false),
+		log.Int("c", 2),
+		log.Int("b", 3),
+		log.Int("d", 4),
+	}
+
+	t.Run("SetAttributes", func(t *testing.T) {
+		r := &Record{attributeValueLengthLimit: -1, allowDupKeys: true}
+		r.SetAttributes(attrs...)
+		assert.Equal(t, attrs, recordAttrs(r))
+		assert.Equal(t, 0, r.DroppedAttributes())
+	})
+
+	t.Run("AddAttributes", func(t *testing.T) {
+		r := &Record{attributeValueLengthLimit: -1, allowDupKeys: true}
+		r.AddAttributes(attrs...)
+		r.AddAttributes(attrs...)
+		assert.Equal(t, append(slices.Clone(attrs), attrs...), recordAttrs(r))
+		assert.Equal(t, 0, r.DroppedAttributes())
+	})
+
+	t.Run("CountLimit", func(t *testing.T) {
+		r := &Record{attributeValueLengthLimit: -1, attributeCountLimit: 8, allowDupKeys: true}
+		r.AddAttributes(attrs...)
+		r.AddAttributes(attrs...)
+		assert.Equal(t, append(slices.Clone(attrs), attrs[:2]...), recordAttrs(r))
+		assert.Equal(t, 4, r.DroppedAttributes())
+
+		r.SetAttributes(attrs...)
+		assert.Equal(t, attrs, recordAttrs(r))
+		assert.Equal(t, 0, r.DroppedAttributes())
+	})
+}
+
 func TestApplyAttrLimitsDeduplication(t *testing.T) {
 	testcases := []struct {
 		name        string
@@ -422,11 +478,13 @@ func TestApplyAttrLimitsDeduplication(t *testing.T) {
 			t.Run("AddAttributes", func(t *testing.T) {
 				r.AddAttributes(kv)
 				assertKV(t, r, log.KeyValue{Key: key, Value: tc.want})
+				assert.Zero(t, r.DroppedAttributes())
 			})
 
 			t.Run("SetAttributes", func(t *testing.T) {
 				r.SetAttributes(kv)
 				assertKV(t, r, log.KeyValue{Key: key, Value: tc.want})
+				assert.Zero(t, r.DroppedAttributes())
 			})
 		})
 	}
@@ -539,6 +597,12 @@ func TestApplyAttrLimitsTruncation(t *testing.T) {
 				r.SetAttributes(kv)
 				assertKV(t, r, log.KeyValue{Key: key, Value: tc.want})
 			})
+
+			t.Run("AddAttributesOverwrite", func(t *testing.T) {
+				// The key is already held by r, the value is overwritten.
+				r.AddAttributes(kv)
+				assertKV(t, r, log.KeyValue{Key: key, Value: tc.want})
+			})
 		})
 	}
 }
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check RoutingProcessor implements Processor and
+// FilterProcessor.
+var (
+	_ Processor       = (*RoutingProcessor)(nil)
+	_ FilterProcessor = (*RoutingProcessor)(nil)
+)
+
+// RouteKeyFunc returns the routing key of a log record emitted with ctx.
+type RouteKeyFunc func(ctx context.Context, r *Record) string
+
+// RouteByAttribute returns a [RouteKeyFunc] that uses the value of the record
+// attribute with key as the routing key. The empty string is returned if the
+// record has no such attribute.
+func RouteByAttribute(key string) RouteKeyFunc {
+	return func(_ context.Context, r *Record) string {
+		var route string
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			if kv.Key != key {
+				return true
+			}
+			if kv.Value.Kind() == log.KindString {
+				route = kv.Value.AsString()
+			} else {
+				route = kv.Value.String()
+			}
+			return false
+		})
+		return route
+	}
+}
+
+// RouteByBaggage returns a [RouteKeyFunc] that uses the value of the baggage
+// member with key found in the context as the routing key. The empty string
+// is returned if the context baggage has no such member.
+func RouteByBaggage(key string) RouteKeyFunc {
+	return func(ctx context.Context, _ *Record) string {
+		return baggage.MemberFromContext(ctx, key).Value()
+	}
+}
+
+// RoutingProcessor is a [Processor] that passes each log record to a single
+// processor selected by the routing key of the record.
+//
+// It can be used to multiplex the telemetry of multiple tenants in one
+// process, e.g. routing the records of each tenant to a processor with an
+// exporter configured with a tenant-specific endpoint and headers.
+type RoutingProcessor struct {
+	key      RouteKeyFunc
+	routes   map[string]Processor
+	fallback Processor
+
+	// processors are all the distinct processors held, in no particular
+	// order.
+	processors []Processor
+}
+
+// NewRoutingProcessor returns a [RoutingProcessor] that passes each log
+// record to the processor of routes associated with the routing key returned
+// by key. Records with a routing key not found in routes are passed to
+// fallback. If fallback is nil, those records are dropped.
+//
+// Nil processors in routes are ignored. If key is nil, all records are passed
+// to fallback.
+func NewRoutingProcessor(key RouteKeyFunc, routes map[string]Processor, fallback Processor) *RoutingProcessor {
+	p := &RoutingProcessor{
+		key:      key,
+		routes:   make(map[string]Processor, len(routes)),
+		fallback: fallback,
+	}
+	for k, proc := range routes {
+		if proc == nil {
+			continue
+		}
+		p.routes[k] = proc
+		p.processors = appendDistinct(p.processors, proc)
+	}
+	if fallback != nil {
+		p.processors = appendDistinct(p.processors, fallback)
+	}
+	return p
+}
+
+// appendDistinct appends proc to processors if it is not already contained.
+// Processors that are not comparable are always appended.
+func appendDistinct(processors []Processor, proc Processor) []Processor {
+	for _, p := range processors {
+		if equalProcessor(p, proc) {
+			return processors
+		}
+	}
+	return append(processors, proc)
+}
+
+// equalProcessor returns if a and b are the same processor. Processors that
+// are not comparable are never considered equal.
+func equalProcessor(a, b Processor) (equal bool) {
+	defer func() {
+		if recover() != nil {
+			equal = false
+		}
+	}()
+	return a == b
+}
+
+// route returns the processor r emitted with ctx is routed to. It returns nil
+// if the record is to be dropped.
+func (p *RoutingProcessor) route(ctx context.Context, r *Record) Processor {
+	if p.key == nil {
+		return p.fallback
+	}
+	if proc, ok := p.routes[p.key(ctx, r)]; ok {
+		return proc
+	}
+	return p.fallback
+}
+
+// OnEmit passes r to the processor it is routed to.
+func (p *RoutingProcessor) OnEmit(ctx context.Context, r *Record) error {
+	proc := p.route(ctx, r)
+	if proc == nil {
+		return nil
+	}
+	return proc.OnEmit(ctx, r)
+}
+
+// Enabled returns true if any held processor will process for ctx and param.
+// A held processor that is not a [FilterProcessor] is considered to process
+// all records.
+//
+// The routing key cannot be determined without the record. Therefore, all
+// the held processors are consulted.
+func (p *RoutingProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	for _, proc := range p.processors {
+		f, ok := proc.(FilterProcessor)
+		if !ok || f.Enabled(ctx, param) {
+			return true
+		}
+	}
+	return false
+}
+
+// Shutdown shuts down all held processors. The errors returned by the
+// processors are joined and returned.
+func (p *RoutingProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	for _, proc := range p.processors {
+		err = errors.Join(err, proc.Shutdown(ctx))
+	}
+	return err
+}
+
+// ForceFlush flushes all held processors. The errors returned by the
+// processors are joined and returned.
+func (p *RoutingProcessor) ForceFlush(ctx context.Context) error {
+	var err error
+	for _, proc := range p.processors {
+		err = errors.Join(err, proc.ForceFlush(ctx))
+	}
+	return err
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/log"
+)
+
+// newTenantRecord returns a Record with a tenant attribute of value.
+func newTenantRecord(value log.Value) *Record {
+	r := &Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.AddAttributes(log.String("other", "a"), log.KeyValue{Key: "tenant", Value: value})
+	return r
+}
+
+func TestRouteByAttribute(t *testing.T) {
+	key := RouteByAttribute("tenant")
+	ctx := context.Background()
+
+	assert.Equal(t, "", key(ctx, new(Record)), "missing attribute")
+
+	assert.Equal(t, "b", key(ctx, newTenantRecord(log.StringValue("b"))), "string attribute")
+	assert.Equal(t, "42", key(ctx, newTenantRecord(log.IntValue(42))), "int attribute")
+}
+
+func TestRouteByBaggage(t *testing.T) {
+	key := RouteByBaggage("tenant")
+	r := new(Record)
+
+	assert.Equal(t, "", key(context.Background(), r), "missing baggage")
+
+	m, err := baggage.NewMember("tenant", "a")
+	require.NoError(t, err)
+	b, err := baggage.New(m)
+	require.NoError(t, err)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+	assert.Equal(t, "a", key(ctx, r))
+}
+
+func TestRoutingProcessorOnEmit(t *testing.T) {
+	a, b, fallback := newProcessor("a"), newProcessor("b"), newProcessor("fallback")
+	p := NewRoutingProcessor(RouteByAttribute("tenant"), map[string]Processor{
+		"a":   a,
+		"b":   b,
+		"nil": nil,
+	}, fallback)
+
+	ctx := context.Background()
+	emit := func(tenant string) {
+		require.NoError(t, p.OnEmit(ctx, newTenantRecord(log.StringValue(tenant))))
+	}
+	emit("a")
+	emit("b")
+	emit("b")
+	emit("unknown")
+	emit("nil")
+
+	assert.Len(t, a.records, 1, "tenant a")
+	assert.Len(t, b.records, 2, "tenant b")
+	assert.Len(t, fallback.records, 2, "fallback")
+}
+
+func TestRoutingProcessorOnEmitNoFallback(t *testing.T) {
+	a := newProcessor("a")
+	p := NewRoutingProcessor(RouteByAttribute("tenant"), map[string]Processor{"a": a}, nil)
+
+	assert.NoError(t, p.OnEmit(context.Background(), new(Record)))
+	assert.Empty(t, a.records, "unrouted record not dropped")
+}
+
+func TestRoutingProcessorOnEmitNilKey(t *testing.T) {
+	a, fallback := newProcessor("a"), newProcessor("fallback")
+	p := NewRoutingProcessor(nil, map[string]Processor{"": a}, fallback)
+
+	require.NoError(t, p.OnEmit(context.Background(), new(Record)))
+	assert.Empty(t, a.records)
+	assert.Len(t, fallback.records, 1)
+}
+
+func TestRoutingProcessorOnEmitError(t *testing.T) {
+	a := newProcessor("a")
+	a.Err = assert.AnError
+	p := NewRoutingProcessor(RouteByAttribute("tenant"), nil, a)
+
+	assert.ErrorIs(t, p.OnEmit(context.Background(), new(Record)), assert.AnError)
+}
+
+func TestRoutingProcessorEnabled(t *testing.T) {
+	ctx := context.Background()
+	param := EnabledParameters{Severity: log.SeverityInfo}
+
+	a, b := newProcessor("a"), newProcessor("b")
+	a.enabled, b.enabled = false, false
+	p := NewRoutingProcessor(RouteByAttribute("tenant"), map[string]Processor{"a": a}, b)
+	assert.False(t, p.Enabled(ctx, param), "all disabled")
+	assert.Len(t, a.enabledParams, 1)
+	assert.Len(t, b.enabledParams, 1)
+
+	b.enabled = true
+	assert.True(t, p.Enabled(ctx, param), "fallback enabled")
+
+	p = NewRoutingProcessor(RouteByAttribute("tenant"), map[string]Processor{
+		"a": a,
+		"b": NewSimpleProcessor(nil),
+	}, nil)
+	assert.True(t, p.Enabled(ctx, param), "non-FilterProcessor")
+}
+
+func TestRoutingProcessorShutdownForceFlush(t *testing.T) {
+	a, b := newProcessor("a"), newProcessor("b")
+	b.Err = assert.AnError
+	// a is used by multiple routes and as fallback.
+	p := NewRoutingProcessor(RouteByAttribute("tenant"), map[string]Processor{
+		"a0": a,
+		"a1": a,
+		"b":  b,
+	}, a)
+
+	ctx := context.Background()
+	assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError)
+	assert.Equal(t, 1, a.forceFlushCalls)
+	assert.Equal(t, 1, b.forceFlushCalls)
+
+	assert.ErrorIs(t, p.Shutdown(ctx), assert.AnError)
+	assert.Equal(t, 1, a.shutdownCalls)
+	assert.Equal(t, 1, b.shutdownCalls)
+}
@@ -5,6 +5,7 @@ package log // import "go.opentelemetry.io/otel/sdk/log"
 
 import (
 	"context"
+	"sync"
 )
 
 // Compile-time check SimpleProcessor implements Processor.
@@ -30,14 +31,26 @@ func NewSimpleProcessor(exporter Exporter, _ ...SimpleProcessorOption) *SimplePr
 	return &SimpleProcessor{exporter: exporter}
 }
 
-// OnEmit batches provided log record.
-func (s *SimpleProcessor) OnEmit(ctx context.Context, r Record) error {
-	return s.exporter.Export(ctx, []Record{r})
+var simpleProcRecordsPool = sync.Pool{
+	New: func() any {
+		records := make([]Record, 1)
+		return &records
+	},
 }
 
-// Enabled returns true.
-func (s *SimpleProcessor) Enabled(context.Context, Record) bool {
-	return true
+// OnEmit batches provided log record.
+func (s *SimpleProcessor) OnEmit(ctx context.Context, r *Record) error {
+	// Exporters do not retain the records slice. Reuse it to avoid an
+	// allocation for every emitted record.
+	records := simpleProcRecordsPool.Get().(*[]Record)
+	defer func() {
+		// Do not hold references to the exported record.
+		(*records)[0] = Record{}
+		simpleProcRecordsPool.Put(records)
+	}()
+
+	(*records)[0] = *r
+	return s.exporter.Export(ctx, *records)
 }
 
 // Shutdown shuts down the expoter.
@@ -5,6 +5,7 @@ package log_test
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"testing"
 
@@ -23,7 +24,8 @@ type exporter struct {
 }
 
 func (e *exporter) Export(_ context.Context, r []log.Record) error {
-	e.records = r
+	// The Export contract does not allow retaining r after the call returns.
+	e.records = slices.Clone(r)
 	e.exportCalled = true
 	return nil
 }
@@ -44,17 +46,12 @@ func TestSimpleProcessorOnEmit(t *testing.T) {
 
 	var r log.Record
 	r.SetSeverityText("test")
-	_ = s.OnEmit(context.Background(), r)
+	_ = s.OnEmit(context.Background(), &r)
 
 	require.True(t, e.exportCalled, "exporter Export not called")
 	assert.Equal(t, []log.Record{r}, e.records)
 }
 
-func TestSimpleProcessorEnabled(t *testing.T) {
-	s := log.NewSimpleProcessor(nil)
-	assert.True(t, s.Enabled(context.Background(), log.Record{}))
-}
-
 func TestSimpleProcessorShutdown(t *testing.T) {
 	e := new(exporter)
 	s := log.NewSimpleProcessor(e)
@@ -83,8 +80,7 @@ func TestSimpleProcessorConcurrentSafe(t *testing.T) {
 		go func() {
 			defer wg.Done()
 
-			_ = s.OnEmit(ctx, r)
-			_ = s.Enabled(ctx, r)
+			_ = s.OnEmit(ctx, &r)
 			_ = s.Shutdown(ctx)
 			_ = s.ForceFlush(ctx)
 		}()
@@ -105,7 +101,7 @@ func BenchmarkSimpleProcessorOnEmit(b *testing.B) {
 		var out error
 
 		for pb.Next() {
-			out = s.OnEmit(ctx, r)
+			out = s.OnEmit(ctx, &r)
 		}
 
 		_ = out
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// recordTruncatedKey is the key of the attribute added to the log records
+// truncated to comply with the record size limit.
+const recordTruncatedKey = "otel.log.record.truncated"
+
+// recordSize returns the approximate serialized size of r in bytes: the size
+// of its event name, severity text, body, and attribute keys and values. The
+// fixed-size fields and the overhead of the encoding are not accounted for.
+func recordSize(r *Record) int {
+	n := len(r.eventName) + len(r.severityText) + valueSize(r.body)
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		n += len(kv.Key) + valueSize(kv.Value)
+		return true
+	})
+	return n
+}
+
+// valueSize returns the approximate serialized size of v in bytes.
+func valueSize(v log.Value) int {
+	switch v.Kind() {
+	case log.KindBool:
+		return 1
+	case log.KindInt64, log.KindFloat64:
+		return 8
+	case log.KindString:
+		return len(v.AsString())
+	case log.KindBytes:
+		return len(v.AsBytes())
+	case log.KindSlice:
+		var n int
+		for _, e := range v.AsSlice() {
+			n += valueSize(e)
+		}
+		return n
+	case log.KindMap:
+		var n int
+		for _, kv := range v.AsMap() {
+			n += len(kv.Key) + valueSize(kv.Value)
+		}
+		return n
+	}
+	return 0
+}
+
+// applySizeLimit truncates the string and byte values of r, starting with its
+// body and then its attributes, until the size of r does not exceed limit. If
+// r is truncated, the recordTruncatedKey attribute is added to it and true is
+// returned.
+//
+// Only string and byte values are truncated. If the size of r still exceeds
+// limit once they are empty, r is left as is.
+func (r *Record) applySizeLimit(limit int) bool {
+	excess := recordSize(r) - limit
+	if excess <= 0 {
+		return false
+	}
+	// Make room for the attribute recording the truncation.
+	excess += len(recordTruncatedKey) + valueSize(log.BoolValue(true))
+	orig := excess
+
+	r.body, excess = shrinkValue(r.body, excess)
+	for i := 0; i < r.nFront && excess > 0; i++ {
+		r.front[i].Value, excess = shrinkValue(r.front[i].Value, excess)
+	}
+	for i := 0; i < len(r.back) && excess > 0; i++ {
+		r.back[i].Value, excess = shrinkValue(r.back[i].Value, excess)
+	}
+
+	if excess == orig {
+		return false
+	}
+	r.AddAttributes(log.Bool(recordTruncatedKey, true))
+	return true
+}
+
+// shrinkValue returns v with its string and byte values truncated by up to
+// excess bytes, and the number of bytes still to be removed. The values held
+// by v are not modified.
+func shrinkValue(v log.Value, excess int) (log.Value, int) {
+	if excess <= 0 {
+		return v, excess
+	}
+	switch v.Kind() {
+	case log.KindString:
+		s := v.AsString()
+		t := truncate(s, max(len(s)-excess, 0))
+		return log.StringValue(t), excess - (len(s) - len(t))
+	case log.KindBytes:
+		b := v.AsBytes()
+		n := max(len(b)-excess, 0)
+		// Limit the capacity so appends do not overwrite the original bytes.
+		return log.BytesValue(b[:n:n]), excess - (len(b) - n)
+	case log.KindSlice:
+		orig := excess
+		s := slices.Clone(v.AsSlice())
+		for i := 0; i < len(s) && excess > 0; i++ {
+			s[i], excess = shrinkValue(s[i], excess)
+		}
+		if excess == orig {
+			return v, excess
+		}
+		return log.SliceValue(s...), excess
+	case log.KindMap:
+		orig := excess
+		m := slices.Clone(v.AsMap())
+		for i := 0; i < len(m) && excess > 0; i++ {
+			m[i].Value, excess = shrinkValue(m[i].Value, excess)
+		}
+		if excess == orig {
+			return v, excess
+		}
+		return log.MapValue(m...), excess
+	}
+	return v, excess
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestRecordSize(t *testing.T) {
+	r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetEventName("event")
+	r.SetSeverityText("INFO")
+	r.SetBody(log.StringValue("body"))
+	r.AddAttributes(
+		log.Bool("b", true),
+		log.Int("i", 1),
+		log.Bytes("bytes", []byte{1, 2}),
+		log.Slice("s", log.StringValue("a"), log.Float64Value(1)),
+		log.Map("m", log.String("k", "v")),
+	)
+	// 5 + 4 + 4 + (1+1) + (1+8) + (5+2) + (1+1+8) + (1+1+1)
+	assert.Equal(t, 44, recordSize(&r))
+}
+
+func TestRecordApplySizeLimit(t *testing.T) {
+	const extra = len(recordTruncatedKey) + 1
+
+	newRecord := func() Record {
+		r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+		r.SetBody(log.StringValue(strings.Repeat("b", 100)))
+		r.AddAttributes(
+			log.Int("i", 1),
+			log.Bytes("bytes", []byte(strings.Repeat("x", 100))),
+			log.Slice("s", log.StringValue(strings.Repeat("s", 100))),
+		)
+		return r
+	}
+	// body: 100, i: 9, bytes: 105, s: 101
+	const size = 315
+
+	t.Run("NotExceeded", func(t *testing.T) {
+		r := newRecord()
+		assert.False(t, r.applySizeLimit(size))
+		assert.Equal(t, size, recordSize(&r))
+		assert.Equal(t, 3, r.AttributesLen())
+	})
+
+	t.Run("Body", func(t *testing.T) {
+		r := newRecord()
+		limit := size - 40 + extra
+		require.True(t, r.applySizeLimit(limit))
+		assert.Equal(t, log.StringValue(strings.Repeat("b", 60)), r.Body())
+		assert.Equal(t, limit, recordSize(&r))
+	})
+
+	t.Run("Attributes", func(t *testing.T) {
+		r := newRecord()
+		bytes := []byte(strings.Repeat("x", 100))
+		r.AddAttributes(log.Bytes("bytes", bytes))
+
+		limit := size - 250 + extra
+		require.True(t, r.applySizeLimit(limit))
+		assert.Empty(t, r.Body().AsString())
+
+		got := map[string]log.Value{}
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			got[kv.Key] = kv.Value
+			return true
+		})
+		assert.Equal(t, log.Int64Value(1), got["i"])
+		assert.Empty(t, got["bytes"].AsBytes())
+		assert.Equal(t, log.SliceValue(log.StringValue(strings.Repeat("s", 50))), got["s"])
+		assert.Equal(t, log.BoolValue(true), got[recordTruncatedKey])
+		assert.Equal(t, limit, recordSize(&r))
+		assert.Equal(t, strings.Repeat("x", 100), string(bytes), "original bytes modified")
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		r := newRecord()
+		require.True(t, r.applySizeLimit(0))
+		assert.Equal(t, 1+8+5+1+extra, recordSize(&r))
+	})
+
+	t.Run("NothingToTruncate", func(t *testing.T) {
+		r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+		r.AddAttributes(log.Int("i", 1))
+		assert.False(t, r.applySizeLimit(1))
+		assert.Equal(t, 1, r.AttributesLen())
+	})
+}
+
+func TestLoggerProviderRecordSizeLimit(t *testing.T) {
+	p := newProcessor("size")
+	lp := NewLoggerProvider(WithProcessor(p), WithRecordSizeLimit(50))
+
+	var r log.Record
+	r.SetBody(log.StringValue(strings.Repeat("b", 100)))
+	lp.Logger("test").Emit(context.Background(), r)
+
+	require.Len(t, p.records, 1)
+	got := p.records[0]
+	assert.Equal(t, 50, recordSize(&got))
+	assert.Equal(t, log.StringValue(strings.Repeat("b", 24)), got.Body())
+}
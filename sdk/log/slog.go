@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"log/slog"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// slogBodyKey is the key of the attribute holding a body that is not a string
+// in a converted [slog.Record].
+const slogBodyKey = "body"
+
+// SlogRecord returns r converted to a [slog.Record]. It can be used to pass
+// the log records processed by the SDK to an existing [slog.Handler], e.g. one
+// writing JSON files.
+//
+// The conversion is as follows:
+//
+//   - The time is the timestamp of r, or its observed timestamp if the
+//     timestamp is not set.
+//   - The level is the severity of r mapped to the slog levels, the inverse of
+//     the mapping used by the OpenTelemetry slog bridge: [log.SeverityDebug]
+//     is [slog.LevelDebug], [log.SeverityInfo] is [slog.LevelInfo],
+//     [log.SeverityWarn] is [slog.LevelWarn], [log.SeverityError] is
+//     [slog.LevelError], and the other severities are offset accordingly. An
+//     undefined severity is [slog.LevelInfo].
+//   - The message is the body of r if it is a string. Any other non-empty body
+//     is added as the "body" attribute.
+//   - The attributes of r are added in order. [log.KindMap] values are
+//     converted to [slog.Group] values, so the grouping of attributes is
+//     preserved. [log.KindSlice] values are converted to []any values,
+//     [log.KindBytes] values to []byte values, and empty values to nil.
+//
+// The severity text, trace context, resource, and instrumentation scope of r
+// are not part of the returned record.
+func SlogRecord(r *Record) slog.Record {
+	t := r.Timestamp()
+	if t.IsZero() {
+		t = r.ObservedTimestamp()
+	}
+
+	var msg string
+	var body *slog.Attr
+	switch b := r.Body(); b.Kind() {
+	case log.KindEmpty:
+	case log.KindString:
+		msg = b.AsString()
+	default:
+		a := slog.Attr{Key: slogBodyKey, Value: slogValue(b)}
+		body = &a
+	}
+
+	out := slog.NewRecord(t, slogLevel(r.Severity()), msg, 0)
+	if body != nil {
+		out.AddAttrs(*body)
+	}
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		out.AddAttrs(slogAttr(kv))
+		return true
+	})
+	return out
+}
+
+// slogLevel returns the slog level of sev.
+func slogLevel(sev log.Severity) slog.Level {
+	if sev == log.SeverityUndefined {
+		return slog.LevelInfo
+	}
+	// The offset maps SeverityInfo (9) to LevelInfo (0) and keeps a distance
+	// of 4 between the base severities, the same as the slog levels.
+	return slog.Level(int(sev) - int(log.SeverityInfo))
+}
+
+// slogAttr returns kv converted to a slog attribute.
+func slogAttr(kv log.KeyValue) slog.Attr {
+	return slog.Attr{Key: kv.Key, Value: slogValue(kv.Value)}
+}
+
+// slogValue returns v converted to a slog value.
+func slogValue(v log.Value) slog.Value {
+	switch v.Kind() {
+	case log.KindBool:
+		return slog.BoolValue(v.AsBool())
+	case log.KindFloat64:
+		return slog.Float64Value(v.AsFloat64())
+	case log.KindInt64:
+		return slog.Int64Value(v.AsInt64())
+	case log.KindString:
+		return slog.StringValue(v.AsString())
+	case log.KindBytes:
+		return slog.AnyValue(v.AsBytes())
+	case log.KindSlice:
+		vals := v.AsSlice()
+		out := make([]any, len(vals))
+		for i, val := range vals {
+			out[i] = slogValue(val).Any()
+		}
+		return slog.AnyValue(out)
+	case log.KindMap:
+		kvs := v.AsMap()
+		attrs := make([]slog.Attr, len(kvs))
+		for i, kv := range kvs {
+			attrs[i] = slogAttr(kv)
+		}
+		return slog.GroupValue(attrs...)
+	}
+	return slog.AnyValue(nil)
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestSlogRecord(t *testing.T) {
+	now := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetTimestamp(now)
+	r.SetObservedTimestamp(now.Add(time.Second))
+	r.SetSeverity(log.SeverityWarn2)
+	r.SetBody(log.StringValue("hello"))
+	r.AddAttributes(
+		log.String("user", "alice"),
+		log.Map("http",
+			log.String("method", "GET"),
+			log.Map("response", log.Int("status", 200)),
+		),
+		log.Slice("ids", log.IntValue(1), log.StringValue("two")),
+		log.Bytes("raw", []byte("hi")),
+		log.Bool("ok", true),
+		log.Float64("ratio", 0.5),
+		log.Empty("none"),
+	)
+
+	got := SlogRecord(&r)
+	assert.Equal(t, now, got.Time)
+	assert.Equal(t, slog.LevelWarn+1, got.Level)
+	assert.Equal(t, "hello", got.Message)
+
+	var buf bytes.Buffer
+	h := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	require.NoError(t, h.Handle(context.Background(), got))
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, map[string]any{
+		"time":  "2000-01-02T03:04:05.000000006Z",
+		"level": "WARN+1",
+		"msg":   "hello",
+		"user":  "alice",
+		"http": map[string]any{
+			"method":   "GET",
+			"response": map[string]any{"status": float64(200)},
+		},
+		"ids":   []any{float64(1), "two"},
+		"raw":   "aGk=",
+		"ok":    true,
+		"ratio": 0.5,
+		"none":  nil,
+	}, out)
+}
+
+func TestSlogRecordTimestamp(t *testing.T) {
+	now := time.Now()
+	r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetObservedTimestamp(now)
+	assert.Equal(t, now, SlogRecord(&r).Time, "observed timestamp not used")
+}
+
+func TestSlogRecordBody(t *testing.T) {
+	r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetBody(log.MapValue(log.String("k", "v")))
+	r.AddAttributes(log.String("a", "b"))
+
+	got := SlogRecord(&r)
+	assert.Equal(t, "", got.Message)
+
+	var attrs []slog.Attr
+	got.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	assert.Equal(t, []slog.Attr{
+		slog.Group("body", slog.String("k", "v")),
+		slog.String("a", "b"),
+	}, attrs)
+}
+
+func TestSlogLevel(t *testing.T) {
+	testCases := []struct {
+		sev  log.Severity
+		want slog.Level
+	}{
+		{log.SeverityUndefined, slog.LevelInfo},
+		{log.SeverityTrace1, slog.LevelDebug - 4},
+		{log.SeverityDebug, slog.LevelDebug},
+		{log.SeverityDebug4, slog.LevelDebug + 3},
+		{log.SeverityInfo, slog.LevelInfo},
+		{log.SeverityWarn, slog.LevelWarn},
+		{log.SeverityError, slog.LevelError},
+		{log.SeverityFatal4, slog.LevelError + 7},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, slogLevel(tc.sev), tc.sev.String())
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys of the log records emitted by a SpanFallbackExporter.
+const (
+	spanTraceIDKey           = "span.trace_id"
+	spanKindKey              = "span.kind"
+	spanDurationKey          = "span.duration"
+	spanStatusCodeKey        = "span.status.code"
+	spanStatusDescriptionKey = "span.status.description"
+)
+
+// Compile-time check SpanFallbackExporter implements sdktrace.SpanExporter.
+var _ sdktrace.SpanExporter = (*SpanFallbackExporter)(nil)
+
+// SpanFallbackExporter is a [sdktrace.SpanExporter] decorator converting the
+// spans to log records when the span exporter it wraps rejects them with
+// [sdktrace.ErrCircuitOpen]. A minimal request visibility is then kept while
+// the tracing backend is unavailable.
+//
+// It is meant to wrap a [sdktrace.CircuitBreakerExporter]:
+//
+//	exp := log.NewSpanFallbackExporter(
+//		sdktrace.NewCircuitBreakerExporter(spanExporter, 5, time.Minute),
+//		loggerProvider,
+//	)
+//
+// Each rejected span is emitted as a condensed log record by a logger named
+// after the instrumentation scope of the span. The record is emitted in a
+// context containing the span context, so its trace and span IDs are the ones
+// of the span. Its body is the span name, its timestamp is the span end time,
+// and its severity is ERROR if the span status is Error, INFO otherwise. Its
+// attributes are the trace ID as a hex string, the span kind, the span
+// duration in nanoseconds, the span status code, and the span status
+// description if it is set. The trace ID attribute keeps the record
+// searchable by trace ID in the log backends not indexing the trace context
+// of the log records.
+//
+// SpanFallbackExporter is part of this package, not of
+// go.opentelemetry.io/otel/sdk/trace, because it emits the log records using
+// the Logs Bridge API (go.opentelemetry.io/otel/log). The stable trace SDK
+// module must not depend on the experimental log modules.
+type SpanFallbackExporter struct {
+	exporter sdktrace.SpanExporter
+	provider log.LoggerProvider
+}
+
+// NewSpanFallbackExporter returns a [SpanFallbackExporter] wrapping exporter
+// and emitting the rejected spans with loggers from provider. If provider is
+// nil, the global LoggerProvider is used.
+func NewSpanFallbackExporter(exporter sdktrace.SpanExporter, provider log.LoggerProvider) *SpanFallbackExporter {
+	if provider == nil {
+		provider = global.GetLoggerProvider()
+	}
+	return &SpanFallbackExporter{exporter: exporter, provider: provider}
+}
+
+// ExportSpans exports spans with the wrapped exporter. If they are rejected
+// with [sdktrace.ErrCircuitOpen], they are emitted as log records instead and
+// nil is returned.
+func (e *SpanFallbackExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	err := e.exporter.ExportSpans(ctx, spans)
+	if !errors.Is(err, sdktrace.ErrCircuitOpen) {
+		return err
+	}
+
+	for _, s := range spans {
+		e.emit(ctx, s)
+	}
+	return nil
+}
+
+// emit emits s as a log record.
+func (e *SpanFallbackExporter) emit(ctx context.Context, s sdktrace.ReadOnlySpan) {
+	scope := s.InstrumentationScope()
+	logger := e.provider.Logger(
+		scope.Name,
+		log.WithInstrumentationVersion(scope.Version),
+		log.WithSchemaURL(scope.SchemaURL),
+	)
+
+	ctx = trace.ContextWithSpanContext(ctx, s.SpanContext())
+	status := s.Status()
+
+	var r log.Record
+	r.SetTimestamp(s.EndTime())
+	r.SetBody(log.StringValue(s.Name()))
+	if status.Code == codes.Error {
+		r.SetSeverity(log.SeverityError)
+		r.SetSeverityText("ERROR")
+	} else {
+		r.SetSeverity(log.SeverityInfo)
+		r.SetSeverityText("INFO")
+	}
+	r.AddAttributes(
+		log.String(spanTraceIDKey, s.SpanContext().TraceID().String()),
+		log.String(spanKindKey, s.SpanKind().String()),
+		log.Int64(spanDurationKey, s.EndTime().Sub(s.StartTime()).Nanoseconds()),
+		log.String(spanStatusCodeKey, status.Code.String()),
+	)
+	if status.Description != "" {
+		r.AddAttributes(log.String(spanStatusDescriptionKey, status.Description))
+	}
+	logger.Emit(ctx, r)
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *SpanFallbackExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// spanExporter is a sdktrace.SpanExporter returning err from its exports.
+type spanExporter struct {
+	err      error
+	exported int
+	shutdown int
+}
+
+func (e *spanExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.err == nil {
+		e.exported += len(spans)
+	}
+	return e.err
+}
+
+func (e *spanExporter) Shutdown(context.Context) error {
+	e.shutdown++
+	return nil
+}
+
+func TestSpanFallbackExporter(t *testing.T) {
+	start := time.Unix(10, 0)
+	scope := instrumentation.Scope{Name: "scope", Version: "v0.1.0", SchemaURL: "https://example.com"}
+	spans := tracetest.SpanStubs{
+		{
+			Name:                   "ok",
+			SpanContext:            sampledSC,
+			SpanKind:               trace.SpanKindServer,
+			StartTime:              start,
+			EndTime:                start.Add(time.Second),
+			InstrumentationLibrary: scope,
+		},
+		{
+			Name:                   "failed",
+			SpanContext:            notSampledSC,
+			SpanKind:               trace.SpanKindClient,
+			StartTime:              start,
+			EndTime:                start.Add(time.Millisecond),
+			Status:                 sdktrace.Status{Code: codes.Error, Description: "boom"},
+			InstrumentationLibrary: scope,
+		},
+	}.Snapshots()
+	ctx := context.Background()
+
+	t.Run("Exported", func(t *testing.T) {
+		p := newProcessor("fallback")
+		exp := &spanExporter{}
+		e := NewSpanFallbackExporter(exp, NewLoggerProvider(WithProcessor(p)))
+
+		require.NoError(t, e.ExportSpans(ctx, spans))
+		assert.Equal(t, 2, exp.exported)
+		assert.Empty(t, p.records)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		p := newProcessor("fallback")
+		exp := &spanExporter{err: assert.AnError}
+		e := NewSpanFallbackExporter(exp, NewLoggerProvider(WithProcessor(p)))
+
+		assert.ErrorIs(t, e.ExportSpans(ctx, spans), assert.AnError)
+		assert.Empty(t, p.records, "error other than ErrCircuitOpen converted")
+	})
+
+	t.Run("CircuitOpen", func(t *testing.T) {
+		p := newProcessor("fallback")
+		exp := &spanExporter{err: sdktrace.ErrCircuitOpen}
+		e := NewSpanFallbackExporter(exp, NewLoggerProvider(WithProcessor(p)))
+
+		require.NoError(t, e.ExportSpans(ctx, spans))
+		require.Len(t, p.records, 2)
+
+		r := p.records[0]
+		assert.Equal(t, scope, r.InstrumentationScope())
+		assert.Equal(t, sampledSC.TraceID(), r.TraceID())
+		assert.Equal(t, sampledSC.SpanID(), r.SpanID())
+		assert.Equal(t, start.Add(time.Second), r.Timestamp())
+		assert.Equal(t, log.SeverityInfo, r.Severity())
+		assert.Equal(t, "INFO", r.SeverityText())
+		assert.Equal(t, log.StringValue("ok"), r.Body())
+		assert.Equal(t, []log.KeyValue{
+			log.String(spanTraceIDKey, sampledSC.TraceID().String()),
+			log.String(spanKindKey, "server"),
+			log.Int64(spanDurationKey, int64(time.Second)),
+			log.String(spanStatusCodeKey, "Unset"),
+		}, recordAttrs(&r))
+
+		r = p.records[1]
+		assert.Equal(t, notSampledSC.TraceID(), r.TraceID())
+		assert.Equal(t, log.SeverityError, r.Severity())
+		assert.Equal(t, "ERROR", r.SeverityText())
+		assert.Equal(t, log.StringValue("failed"), r.Body())
+		assert.Equal(t, []log.KeyValue{
+			log.String(spanTraceIDKey, notSampledSC.TraceID().String()),
+			log.String(spanKindKey, "client"),
+			log.Int64(spanDurationKey, int64(time.Millisecond)),
+			log.String(spanStatusCodeKey, "Error"),
+			log.String(spanStatusDescriptionKey, "boom"),
+		}, recordAttrs(&r))
+	})
+}
+
+func TestSpanFallbackExporterCircuitBreaker(t *testing.T) {
+	p := newProcessor("fallback")
+	exp := &spanExporter{err: assert.AnError}
+	e := NewSpanFallbackExporter(
+		sdktrace.NewCircuitBreakerExporter(exp, 1, time.Hour),
+		NewLoggerProvider(WithProcessor(p)),
+	)
+
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+	ctx := context.Background()
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), assert.AnError)
+	assert.Empty(t, p.records)
+	assert.NoError(t, e.ExportSpans(ctx, spans))
+	assert.Len(t, p.records, 1)
+}
+
+func TestSpanFallbackExporterShutdown(t *testing.T) {
+	exp := &spanExporter{}
+	e := NewSpanFallbackExporter(exp, nil)
+	assert.NoError(t, e.Shutdown(context.Background()))
+	assert.Equal(t, 1, exp.shutdown)
+}
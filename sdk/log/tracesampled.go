@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Compile-time check TraceSampledProcessor implements Processor and
+// FilterProcessor.
+var (
+	_ Processor       = (*TraceSampledProcessor)(nil)
+	_ FilterProcessor = (*TraceSampledProcessor)(nil)
+)
+
+// TraceSampledProcessor is a [Processor] decorator that drops log records
+// associated with a span that is not sampled. It keeps the log volume
+// proportional to the trace sampling.
+type TraceSampledProcessor struct {
+	floor log.Severity
+	next  Processor
+}
+
+// NewTraceSampledProcessor returns a [TraceSampledProcessor] that passes log
+// records to next only if they are associated with a sampled span. Log
+// records not associated with any span, i.e. with an invalid trace ID or span
+// ID, are always passed to next.
+//
+// Log records with a severity greater than or equal to floor are always
+// passed to next, regardless of the sampling decision (e.g. use
+// [log.SeverityWarn] to always pass warnings and errors). If floor is
+// [log.SeverityUndefined], no severity floor is applied.
+//
+// The returned processor reports it is not enabled for log records it drops.
+// This lets bridges skip building these log records altogether.
+func NewTraceSampledProcessor(floor log.Severity, next Processor) *TraceSampledProcessor {
+	if next == nil {
+		// Do not panic on nil processor.
+		next = NewSimpleProcessor(nil)
+	}
+	return &TraceSampledProcessor{floor: floor, next: next}
+}
+
+// OnEmit passes r to the decorated processor unless r is associated with a
+// span that is not sampled and its severity is less than the severity floor.
+func (p *TraceSampledProcessor) OnEmit(ctx context.Context, r *Record) error {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    r.TraceID(),
+		SpanID:     r.SpanID(),
+		TraceFlags: r.TraceFlags(),
+	})
+	if p.drop(sc, r.Severity()) {
+		return nil
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+// Enabled returns false if the span context in ctx is of a span that is not
+// sampled and the severity of param is less than the severity floor.
+// Otherwise, it returns the result of the decorated processor if it is a
+// [FilterProcessor], or true if it is not.
+func (p *TraceSampledProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.drop(trace.SpanContextFromContext(ctx), param.Severity) {
+		return false
+	}
+	if fltr, ok := p.next.(FilterProcessor); ok {
+		return fltr.Enabled(ctx, param)
+	}
+	return true
+}
+
+// drop returns true if a log record associated with sc and with severity
+// needs to be dropped.
+func (p *TraceSampledProcessor) drop(sc trace.SpanContext, severity log.Severity) bool {
+	if !sc.IsValid() || sc.IsSampled() {
+		return false
+	}
+	return p.floor == log.SeverityUndefined || severity < p.floor
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *TraceSampledProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *TraceSampledProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	sampledSC = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	notSampledSC = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x02},
+		SpanID:  trace.SpanID{0x02},
+	})
+)
+
+func TestTraceSampledProcessorOnEmit(t *testing.T) {
+	testcases := []struct {
+		name     string
+		floor    log.Severity
+		sc       trace.SpanContext
+		severity log.Severity
+		want     bool
+	}{
+		{name: "NoSpan", sc: trace.SpanContext{}, severity: log.SeverityDebug, want: true},
+		{name: "Sampled", sc: sampledSC, severity: log.SeverityDebug, want: true},
+		{name: "NotSampled", sc: notSampledSC, severity: log.SeverityError, want: false},
+		{name: "NotSampledBelowFloor", floor: log.SeverityWarn, sc: notSampledSC, severity: log.SeverityInfo, want: false},
+		{name: "NotSampledAtFloor", floor: log.SeverityWarn, sc: notSampledSC, severity: log.SeverityWarn, want: true},
+		{name: "NotSampledAboveFloor", floor: log.SeverityWarn, sc: notSampledSC, severity: log.SeverityFatal, want: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := newProcessor("next")
+			p := NewTraceSampledProcessor(tc.floor, next)
+
+			var r Record
+			r.SetTraceID(tc.sc.TraceID())
+			r.SetSpanID(tc.sc.SpanID())
+			r.SetTraceFlags(tc.sc.TraceFlags())
+			r.SetSeverity(tc.severity)
+			require.NoError(t, p.OnEmit(context.Background(), &r))
+
+			if tc.want {
+				assert.Len(t, next.records, 1, "record dropped")
+			} else {
+				assert.Empty(t, next.records, "record not dropped")
+			}
+		})
+	}
+}
+
+func TestTraceSampledProcessorEnabled(t *testing.T) {
+	sampledCtx := trace.ContextWithSpanContext(context.Background(), sampledSC)
+	notSampledCtx := trace.ContextWithSpanContext(context.Background(), notSampledSC)
+	info := EnabledParameters{Severity: log.SeverityInfo}
+	warn := EnabledParameters{Severity: log.SeverityWarn}
+
+	t.Run("NotFilterProcessor", func(t *testing.T) {
+		p := NewTraceSampledProcessor(log.SeverityWarn, NewSimpleProcessor(nil))
+		assert.True(t, p.Enabled(context.Background(), info), "no span")
+		assert.True(t, p.Enabled(sampledCtx, info), "sampled")
+		assert.False(t, p.Enabled(notSampledCtx, info), "not sampled")
+		assert.True(t, p.Enabled(notSampledCtx, warn), "not sampled at floor")
+	})
+
+	t.Run("FilterProcessor", func(t *testing.T) {
+		next := newProcessor("next")
+		p := NewTraceSampledProcessor(log.SeverityUndefined, next)
+		assert.True(t, p.Enabled(sampledCtx, info))
+		assert.False(t, p.Enabled(notSampledCtx, warn))
+
+		next.enabled = false
+		assert.False(t, p.Enabled(sampledCtx, info))
+	})
+
+	t.Run("Logger", func(t *testing.T) {
+		p := NewTraceSampledProcessor(log.SeverityWarn, NewSimpleProcessor(nil))
+		l := NewLoggerProvider(WithProcessor(p)).Logger("test")
+
+		var r log.Record
+		r.SetSeverity(log.SeverityDebug)
+		assert.False(t, l.Enabled(notSampledCtx, r), "not sampled debug enabled")
+		assert.True(t, l.Enabled(sampledCtx, r), "sampled debug disabled")
+	})
+}
+
+func TestTraceSampledProcessorShutdownForceFlush(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceSampledProcessor(log.SeverityWarn, next)
+
+	ctx := context.Background()
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, 1, next.forceFlushCalls, "ForceFlush not forwarded")
+	assert.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 1, next.shutdownCalls, "Shutdown not forwarded")
+}
+
+func TestTraceSampledProcessorNilNext(t *testing.T) {
+	assert.NotPanics(t, func() {
+		p := NewTraceSampledProcessor(log.SeverityWarn, nil)
+		ctx := context.Background()
+		assert.NoError(t, p.OnEmit(ctx, new(Record)))
+		assert.True(t, p.Enabled(ctx, EnabledParameters{Severity: log.SeverityError}))
+		assert.NoError(t, p.ForceFlush(ctx))
+		assert.NoError(t, p.Shutdown(ctx))
+	})
+}
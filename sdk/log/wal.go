@@ -0,0 +1,709 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// dfltWALMaxSize is the default maximum size of the write-ahead log file.
+	dfltWALMaxSize = 64 << 20
+
+	walLogFile    = "wal.log"
+	walOffsetFile = "wal.offset"
+
+	// walHeaderSize is the size of the header of an entry: the length of the
+	// payload and its CRC-32 checksum.
+	walHeaderSize = 8
+)
+
+var (
+	errWALCorrupted = errors.New("persistent queue corrupted")
+	errWALFull      = errors.New("persistent queue full: log records not persisted")
+)
+
+// wal is a write-ahead log persisting the records of a queue.
+//
+// Each record added to the queue is appended to the log file as an entry. The
+// entries are acknowledged, in queue order, once the records are exported or
+// dropped. The offset of the first entry not acknowledged is stored in the
+// offset file. The entries from this offset are replayed by openWAL.
+//
+// The log file is truncated when all of its entries are acknowledged. When it
+// would exceed its maximum size, the acknowledged entries are removed from it
+// (it is compacted). If it is still too large, records are kept in memory
+// only, they are not persisted.
+type wal struct {
+	mu sync.Mutex
+
+	dir     string
+	maxSize int64
+	f       *os.File
+	offsetF *os.File
+
+	// size is the size of the log file.
+	size int64
+	// offset is the offset of the first entry not acknowledged.
+	offset int64
+	// entries are the sizes of the entries not acknowledged, in queue order.
+	// A size of zero is used for a record that was not persisted.
+	entries []int64
+	// groups are the consecutive entries being exported or dropped. They
+	// cover a prefix of entries.
+	groups []*walGroup
+	// full is true if the last record was not persisted because the log
+	// file was full.
+	full bool
+	// closed is true once w is closed. Entries are no longer appended or
+	// acknowledged.
+	closed bool
+
+	buf []byte
+}
+
+// walGroup is a group of consecutive entries acknowledged together.
+type walGroup struct {
+	n    int
+	done bool
+}
+
+// openWAL opens, or creates, the write-ahead log stored in dir. The records
+// of the entries not acknowledged are returned to be replayed.
+//
+// If a corrupted entry is found, the log is truncated before it and an error
+// wrapping errWALCorrupted is returned along with the wal and the records
+// read before it.
+func openWAL(dir string, maxSize int64) (*wal, []Record, error) {
+	if maxSize <= 0 {
+		maxSize = dfltWALMaxSize
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, nil, err
+	}
+	// Remove the temporary files left by an interrupted compaction.
+	tmps, _ := filepath.Glob(filepath.Join(dir, walLogFile+".*"))
+	for _, tmp := range tmps {
+		_ = os.Remove(tmp)
+	}
+	f, err := os.OpenFile(filepath.Join(dir, walLogFile), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+	offsetF, err := os.OpenFile(filepath.Join(dir, walOffsetFile), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, nil, errors.Join(err, f.Close())
+	}
+	w := &wal{dir: dir, maxSize: maxSize, f: f, offsetF: offsetF}
+
+	recs, err := w.replay()
+	if err != nil && !errors.Is(err, errWALCorrupted) {
+		return nil, nil, errors.Join(err, w.close())
+	}
+	return w, recs, err
+}
+
+// replay reads the entries of w not acknowledged.
+func (w *wal) replay() ([]Record, error) {
+	info, err := w.f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	w.size = info.Size()
+
+	var b [8]byte
+	if _, err := w.offsetF.ReadAt(b[:], 0); err == nil {
+		w.offset = int64(binary.LittleEndian.Uint64(b[:]))
+	}
+	if w.offset < 0 || w.offset > w.size {
+		// The offset file is not consistent with the log file. Replay it
+		// all instead of losing records.
+		w.offset = 0
+	}
+
+	var (
+		recs []Record
+		dec  = newWALDecoder()
+		r    = io.NewSectionReader(w.f, w.offset, w.size-w.offset)
+		pos  = w.offset
+		hdr  [walHeaderSize]byte
+		cErr error
+	)
+	for pos < w.size {
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			cErr = fmt.Errorf("%w: truncated entry header at offset %d", errWALCorrupted, pos)
+			break
+		}
+		n := binary.LittleEndian.Uint32(hdr[:4])
+		if int64(n) > w.size-pos-walHeaderSize {
+			cErr = fmt.Errorf("%w: truncated entry at offset %d", errWALCorrupted, pos)
+			break
+		}
+		payload := make([]byte, n)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(hdr[4:]) {
+			cErr = fmt.Errorf("%w: checksum mismatch at offset %d", errWALCorrupted, pos)
+			break
+		}
+		rec, err := dec.decode(payload)
+		if err != nil {
+			cErr = fmt.Errorf("%w: invalid entry at offset %d: %w", errWALCorrupted, pos, err)
+			break
+		}
+		recs = append(recs, rec)
+		size := walHeaderSize + int64(n)
+		w.entries = append(w.entries, size)
+		pos += size
+	}
+
+	if pos < w.size {
+		// Discard the corrupted entries so they are not appended to.
+		if err := w.f.Truncate(pos); err != nil {
+			return nil, errors.Join(cErr, err)
+		}
+		w.size = pos
+	}
+	if len(recs) == 0 {
+		if err := w.reset(); err != nil {
+			return nil, errors.Join(cErr, err)
+		}
+	}
+	return recs, cErr
+}
+
+// append persists r. The entry of r is acknowledged once it is part of a
+// group passed to ack. An error wrapping errWALFull is returned if r is not
+// persisted because the log file is full. It is only returned for the first
+// record not persisted after one that was.
+func (w *wal) append(r *Record) error {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+
+	payload, err := encodeWALRecord(r)
+	if err != nil {
+		w.entries = append(w.entries, 0)
+		return err
+	}
+	size := walHeaderSize + int64(len(payload))
+	if w.size+size > w.maxSize {
+		if err := w.compact(); err != nil {
+			w.entries = append(w.entries, 0)
+			return err
+		}
+	}
+	if w.size+size > w.maxSize {
+		w.entries = append(w.entries, 0)
+		if w.full {
+			return nil
+		}
+		w.full = true
+		return fmt.Errorf("%w: %d byte limit reached", errWALFull, w.maxSize)
+	}
+	w.full = false
+
+	w.buf = binary.LittleEndian.AppendUint32(w.buf[:0], uint32(len(payload)))
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, crc32.ChecksumIEEE(payload))
+	w.buf = append(w.buf, payload...)
+	if _, err := w.f.WriteAt(w.buf, w.size); err != nil {
+		w.entries = append(w.entries, 0)
+		// Do not leave a partial entry behind.
+		return errors.Join(err, w.f.Truncate(w.size))
+	}
+	w.size += size
+	w.entries = append(w.entries, size)
+	return nil
+}
+
+// next returns a group for the next n entries of w. The group needs to be
+// passed to ack once the records of these entries are exported.
+func (w *wal) next(n int) *walGroup {
+	if w == nil || n <= 0 {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	g := &walGroup{n: n}
+	w.groups = append(w.groups, g)
+	return g
+}
+
+// cancel returns g, which needs to be the last group returned by next, to
+// the entries not being exported.
+func (w *wal) cancel(g *walGroup) {
+	if w == nil || g == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if n := len(w.groups); n > 0 && w.groups[n-1] == g {
+		w.groups = w.groups[:n-1]
+	}
+}
+
+// drop acknowledges the next n entries of w. Their records were dropped.
+func (w *wal) drop(n int) {
+	w.ack(w.next(n))
+}
+
+// dropAt acknowledges the entry at index i of the entries not yet passed to
+// next. Its record was dropped from the middle of the queue. The entry is
+// merged into the one before it, so it is acknowledged with it. If the
+// process stops before, the dropped record is replayed.
+func (w *wal) dropAt(i int) {
+	if w == nil {
+		return
+	}
+	if i == 0 {
+		w.drop(1)
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+	for _, g := range w.groups {
+		i += g.n
+	}
+	if i >= len(w.entries) {
+		return
+	}
+	w.entries[i-1] += w.entries[i]
+	w.entries = slices.Delete(w.entries, i, i+1)
+}
+
+// ack acknowledges the entries of g. The offset of w is advanced past all the
+// acknowledged groups preceded only by acknowledged groups.
+func (w *wal) ack(g *walGroup) {
+	if w == nil || g == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	g.done = true
+	if w.closed {
+		return
+	}
+	var advanced bool
+	for len(w.groups) > 0 && w.groups[0].done {
+		n := min(w.groups[0].n, len(w.entries))
+		for _, size := range w.entries[:n] {
+			w.offset += size
+		}
+		w.entries = w.entries[n:]
+		w.groups[0] = nil
+		w.groups = w.groups[1:]
+		advanced = true
+	}
+	if !advanced {
+		return
+	}
+
+	var err error
+	if len(w.entries) == 0 {
+		err = w.reset()
+	} else {
+		err = w.writeOffset()
+	}
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// reset truncates the log file of w. All of its entries need to be
+// acknowledged.
+func (w *wal) reset() error {
+	w.size, w.offset = 0, 0
+	return errors.Join(w.f.Truncate(0), w.writeOffset())
+}
+
+func (w *wal) writeOffset() error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(w.offset))
+	_, err := w.offsetF.WriteAt(b[:], 0)
+	return err
+}
+
+// compact removes the acknowledged entries from the log file of w.
+func (w *wal) compact() error {
+	if w.offset == 0 {
+		return nil
+	}
+	tmp, err := os.CreateTemp(w.dir, walLogFile+".*")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, io.NewSectionReader(w.f, w.offset, w.size-w.offset))
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(w.dir, walLogFile))
+	}
+	if err != nil {
+		return errors.Join(err, tmp.Close(), os.Remove(tmp.Name()))
+	}
+
+	old := w.f
+	w.f = tmp
+	w.size -= w.offset
+	w.offset = 0
+	return errors.Join(old.Close(), w.writeOffset())
+}
+
+// sync commits the files of w to stable storage.
+func (w *wal) sync() error {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	return errors.Join(w.f.Sync(), w.offsetF.Sync())
+}
+
+// close syncs and closes the files of w.
+func (w *wal) close() error {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return errors.Join(
+		w.f.Sync(), w.offsetF.Sync(),
+		w.f.Close(), w.offsetF.Close(),
+	)
+}
+
+// walRecord is the persisted form of a Record.
+type walRecord struct {
+	EventName         string        `json:"en,omitempty"`
+	Timestamp         time.Time     `json:"t,omitempty"`
+	ObservedTimestamp time.Time     `json:"ot,omitempty"`
+	Severity          log.Severity  `json:"sev,omitempty"`
+	SeverityText      string        `json:"st,omitempty"`
+	Body              *walValue     `json:"b,omitempty"`
+	Attributes        []walKeyValue `json:"a,omitempty"`
+	Dropped           int           `json:"d,omitempty"`
+	TraceID           []byte        `json:"tid,omitempty"`
+	SpanID            []byte        `json:"sid,omitempty"`
+	TraceFlags        byte          `json:"tf,omitempty"`
+	Resource          *walResource  `json:"res,omitempty"`
+	Scope             *walScope     `json:"sc,omitempty"`
+}
+
+// walValue is the persisted form of a log.Value. Floats are stored as their
+// IEEE 754 binary representation so NaN and infinities are supported.
+type walValue struct {
+	Kind  log.Kind      `json:"k"`
+	Bool  bool          `json:"bo,omitempty"`
+	Int   int64         `json:"i,omitempty"`
+	Float uint64        `json:"f,omitempty"`
+	Str   string        `json:"s,omitempty"`
+	Bytes []byte        `json:"by,omitempty"`
+	Slice []walValue    `json:"sl,omitempty"`
+	Map   []walKeyValue `json:"m,omitempty"`
+}
+
+type walKeyValue struct {
+	Key   string   `json:"k"`
+	Value walValue `json:"v"`
+}
+
+type walResource struct {
+	SchemaURL  string    `json:"u,omitempty"`
+	Attributes []walAttr `json:"a,omitempty"`
+}
+
+type walScope struct {
+	Name       string    `json:"n,omitempty"`
+	Version    string    `json:"v,omitempty"`
+	SchemaURL  string    `json:"u,omitempty"`
+	Attributes []walAttr `json:"a,omitempty"`
+}
+
+// walAttr is the persisted form of an attribute.KeyValue.
+type walAttr struct {
+	Key    string         `json:"k"`
+	Type   attribute.Type `json:"t"`
+	Bool   bool           `json:"bo,omitempty"`
+	Int    int64          `json:"i,omitempty"`
+	Float  uint64         `json:"f,omitempty"`
+	Str    string         `json:"s,omitempty"`
+	Bools  []bool         `json:"bos,omitempty"`
+	Ints   []int64        `json:"is,omitempty"`
+	Floats []uint64       `json:"fs,omitempty"`
+	Strs   []string       `json:"ss,omitempty"`
+}
+
+func encodeWALRecord(r *Record) ([]byte, error) {
+	rec := walRecord{
+		EventName:         r.eventName,
+		Timestamp:         r.timestamp,
+		ObservedTimestamp: r.observedTimestamp,
+		Severity:          r.severity,
+		SeverityText:      r.severityText,
+		Dropped:           r.dropped,
+		TraceFlags:        byte(r.traceFlags),
+	}
+	if !r.body.Empty() {
+		v := newWALValue(r.body)
+		rec.Body = &v
+	}
+	if n := r.AttributesLen(); n > 0 {
+		rec.Attributes = make([]walKeyValue, 0, n)
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			rec.Attributes = append(rec.Attributes, walKeyValue{Key: kv.Key, Value: newWALValue(kv.Value)})
+			return true
+		})
+	}
+	if r.traceID.IsValid() {
+		rec.TraceID = r.traceID[:]
+	}
+	if r.spanID.IsValid() {
+		rec.SpanID = r.spanID[:]
+	}
+	if r.resource != nil {
+		res := &walResource{SchemaURL: r.resource.SchemaURL()}
+		for _, kv := range r.resource.Attributes() {
+			res.Attributes = append(res.Attributes, newWALAttr(kv))
+		}
+		rec.Resource = res
+	}
+	if r.scope != nil {
+		sc := &walScope{Name: r.scope.Name, Version: r.scope.Version, SchemaURL: r.scope.SchemaURL}
+		for _, kv := range r.scope.Attributes.ToSlice() {
+			sc.Attributes = append(sc.Attributes, newWALAttr(kv))
+		}
+		rec.Scope = sc
+	}
+	return json.Marshal(rec)
+}
+
+func newWALValue(v log.Value) walValue {
+	out := walValue{Kind: v.Kind()}
+	switch v.Kind() {
+	case log.KindBool:
+		out.Bool = v.AsBool()
+	case log.KindInt64:
+		out.Int = v.AsInt64()
+	case log.KindFloat64:
+		out.Float = math.Float64bits(v.AsFloat64())
+	case log.KindString:
+		out.Str = v.AsString()
+	case log.KindBytes:
+		out.Bytes = v.AsBytes()
+	case log.KindSlice:
+		for _, e := range v.AsSlice() {
+			out.Slice = append(out.Slice, newWALValue(e))
+		}
+	case log.KindMap:
+		for _, kv := range v.AsMap() {
+			out.Map = append(out.Map, walKeyValue{Key: kv.Key, Value: newWALValue(kv.Value)})
+		}
+	}
+	return out
+}
+
+func (v walValue) value() log.Value {
+	switch v.Kind {
+	case log.KindBool:
+		return log.BoolValue(v.Bool)
+	case log.KindInt64:
+		return log.Int64Value(v.Int)
+	case log.KindFloat64:
+		return log.Float64Value(math.Float64frombits(v.Float))
+	case log.KindString:
+		return log.StringValue(v.Str)
+	case log.KindBytes:
+		return log.BytesValue(v.Bytes)
+	case log.KindSlice:
+		s := make([]log.Value, len(v.Slice))
+		for i, e := range v.Slice {
+			s[i] = e.value()
+		}
+		return log.SliceValue(s...)
+	case log.KindMap:
+		return log.MapValue(walKeyValues(v.Map)...)
+	}
+	return log.Value{}
+}
+
+func walKeyValues(kvs []walKeyValue) []log.KeyValue {
+	out := make([]log.KeyValue, len(kvs))
+	for i, kv := range kvs {
+		out[i] = log.KeyValue{Key: kv.Key, Value: kv.Value.value()}
+	}
+	return out
+}
+
+func newWALAttr(kv attribute.KeyValue) walAttr {
+	out := walAttr{Key: string(kv.Key), Type: kv.Value.Type()}
+	switch kv.Value.Type() {
+	case attribute.BOOL:
+		out.Bool = kv.Value.AsBool()
+	case attribute.INT64:
+		out.Int = kv.Value.AsInt64()
+	case attribute.FLOAT64:
+		out.Float = math.Float64bits(kv.Value.AsFloat64())
+	case attribute.STRING:
+		out.Str = kv.Value.AsString()
+	case attribute.BOOLSLICE:
+		out.Bools = kv.Value.AsBoolSlice()
+	case attribute.INT64SLICE:
+		out.Ints = kv.Value.AsInt64Slice()
+	case attribute.FLOAT64SLICE:
+		for _, f := range kv.Value.AsFloat64Slice() {
+			out.Floats = append(out.Floats, math.Float64bits(f))
+		}
+	case attribute.STRINGSLICE:
+		out.Strs = kv.Value.AsStringSlice()
+	}
+	return out
+}
+
+func (a walAttr) keyValue() attribute.KeyValue {
+	k := attribute.Key(a.Key)
+	switch a.Type {
+	case attribute.BOOL:
+		return k.Bool(a.Bool)
+	case attribute.INT64:
+		return k.Int64(a.Int)
+	case attribute.FLOAT64:
+		return k.Float64(math.Float64frombits(a.Float))
+	case attribute.STRING:
+		return k.String(a.Str)
+	case attribute.BOOLSLICE:
+		return k.BoolSlice(a.Bools)
+	case attribute.INT64SLICE:
+		return k.Int64Slice(a.Ints)
+	case attribute.FLOAT64SLICE:
+		f := make([]float64, len(a.Floats))
+		for i, b := range a.Floats {
+			f[i] = math.Float64frombits(b)
+		}
+		return k.Float64Slice(f)
+	case attribute.STRINGSLICE:
+		return k.StringSlice(a.Strs)
+	}
+	return attribute.KeyValue{Key: k}
+}
+
+// walDecoder decodes the entries of a wal. The resources and scopes of the
+// decoded records are shared between the records they are equal for.
+type walDecoder struct {
+	resources map[string]*resource.Resource
+	scopes    map[string]*instrumentation.Scope
+}
+
+func newWALDecoder() *walDecoder {
+	return &walDecoder{
+		resources: make(map[string]*resource.Resource),
+		scopes:    make(map[string]*instrumentation.Scope),
+	}
+}
+
+func (d *walDecoder) decode(payload []byte) (Record, error) {
+	var rec walRecord
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return Record{}, err
+	}
+
+	r := Record{
+		eventName:         rec.EventName,
+		timestamp:         rec.Timestamp,
+		observedTimestamp: rec.ObservedTimestamp,
+		severity:          rec.Severity,
+		severityText:      rec.SeverityText,
+		traceFlags:        trace.TraceFlags(rec.TraceFlags),
+		// The persisted record already had the limits applied.
+		attributeValueLengthLimit: -1,
+		attributeCountLimit:       -1,
+		allowDupKeys:              true,
+	}
+	if rec.Body != nil {
+		r.body = rec.Body.value()
+	}
+	r.addAttrs(walKeyValues(rec.Attributes))
+	r.dropped = rec.Dropped
+	copy(r.traceID[:], rec.TraceID)
+	copy(r.spanID[:], rec.SpanID)
+
+	if rec.Resource != nil {
+		key, err := json.Marshal(rec.Resource)
+		if err != nil {
+			return Record{}, err
+		}
+		res, ok := d.resources[string(key)]
+		if !ok {
+			attrs := make([]attribute.KeyValue, len(rec.Resource.Attributes))
+			for i, a := range rec.Resource.Attributes {
+				attrs[i] = a.keyValue()
+			}
+			res = resource.NewWithAttributes(rec.Resource.SchemaURL, attrs...)
+			d.resources[string(key)] = res
+		}
+		r.resource = res
+	}
+	if rec.Scope != nil {
+		key, err := json.Marshal(rec.Scope)
+		if err != nil {
+			return Record{}, err
+		}
+		s, ok := d.scopes[string(key)]
+		if !ok {
+			s = &instrumentation.Scope{
+				Name:      rec.Scope.Name,
+				Version:   rec.Scope.Version,
+				SchemaURL: rec.Scope.SchemaURL,
+			}
+			if len(rec.Scope.Attributes) > 0 {
+				attrs := make([]attribute.KeyValue, len(rec.Scope.Attributes))
+				for i, a := range rec.Scope.Attributes {
+					attrs[i] = a.keyValue()
+				}
+				s.Attributes = attribute.NewSet(attrs...)
+			}
+			d.scopes[string(key)] = s
+		}
+		r.scope = s
+	}
+	return r, nil
+}
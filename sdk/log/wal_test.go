@@ -0,0 +1,381 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newWALTestRecord(body string) Record {
+	r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetBody(log.StringValue(body))
+	return r
+}
+
+func walBodies(recs []Record) []string {
+	out := make([]string, len(recs))
+	for i, r := range recs {
+		out[i] = r.Body().AsString()
+	}
+	return out
+}
+
+func openTestWAL(t *testing.T, dir string, maxSize int64) (*wal, []Record) {
+	t.Helper()
+	w, recs, err := openWAL(dir, maxSize)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.close() })
+	return w, recs
+}
+
+func TestWALRecordRoundTrip(t *testing.T) {
+	res := resource.NewWithAttributes(
+		"https://example.com/schema",
+		attribute.String("service.name", "test"),
+		attribute.Bool("b", true),
+		attribute.Int64("i", 1),
+		attribute.Float64("f", math.Inf(1)),
+		attribute.BoolSlice("bs", []bool{true, false}),
+		attribute.Int64Slice("is", []int64{1, 2}),
+		attribute.Float64Slice("fs", []float64{1.5}),
+		attribute.StringSlice("ss", []string{"a"}),
+	)
+	scope := &instrumentation.Scope{
+		Name:       "scope",
+		Version:    "v1",
+		SchemaURL:  "url",
+		Attributes: attribute.NewSet(attribute.String("sk", "sv")),
+	}
+
+	var r Record
+	r.attributeValueLengthLimit = -1
+	r.attributeCountLimit = -1
+	r.resource = res
+	r.scope = scope
+	r.SetEventName("event")
+	r.SetTimestamp(time.Unix(1, 2).UTC())
+	r.SetObservedTimestamp(time.Unix(3, 4).UTC())
+	r.SetSeverity(log.SeverityWarn)
+	r.SetSeverityText("WARN")
+	r.SetBody(log.MapValue(
+		log.Bytes("bytes", []byte{1, 2}),
+		log.Slice("slice", log.Float64Value(math.NaN()), log.BoolValue(true)),
+		log.Map("map", log.Int64("n", 42)),
+	))
+	attrs := []log.KeyValue{
+		log.String("k1", "v1"),
+		log.Int("k2", 2),
+		log.Float64("k3", 3.5),
+		log.Bool("k4", true),
+		log.Empty("k5"),
+		log.String("k6", "back"),
+	}
+	r.SetAttributes(attrs...)
+	r.dropped = 3
+	r.SetTraceID(trace.TraceID{1})
+	r.SetSpanID(trace.SpanID{2})
+	r.SetTraceFlags(trace.FlagsSampled)
+
+	payload, err := encodeWALRecord(&r)
+	require.NoError(t, err)
+	got, err := newWALDecoder().decode(payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, r.EventName(), got.EventName())
+	assert.Equal(t, r.Timestamp(), got.Timestamp())
+	assert.Equal(t, r.ObservedTimestamp(), got.ObservedTimestamp())
+	assert.Equal(t, r.Severity(), got.Severity())
+	assert.Equal(t, r.SeverityText(), got.SeverityText())
+	assert.Equal(t, r.Body().String(), got.Body().String())
+	assert.True(t, math.IsNaN(got.Body().AsMap()[1].Value.AsSlice()[0].AsFloat64()), "NaN")
+	var gotAttrs []log.KeyValue
+	got.WalkAttributes(func(kv log.KeyValue) bool {
+		gotAttrs = append(gotAttrs, kv)
+		return true
+	})
+	assert.Equal(t, attrs, gotAttrs)
+	assert.Equal(t, 3, got.DroppedAttributes())
+	assert.Equal(t, r.TraceID(), got.TraceID())
+	assert.Equal(t, r.SpanID(), got.SpanID())
+	assert.Equal(t, r.TraceFlags(), got.TraceFlags())
+	gotRes := got.Resource()
+	assert.True(t, res.Equal(&gotRes), "resource")
+	assert.Equal(t, res.SchemaURL(), gotRes.SchemaURL())
+	assert.Equal(t, *scope, got.InstrumentationScope())
+}
+
+func TestWALDecoderSharesResources(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("k", "v"))
+	scope := &instrumentation.Scope{Name: "scope"}
+	r := newWALTestRecord("a")
+	r.resource, r.scope = res, scope
+	payload, err := encodeWALRecord(&r)
+	require.NoError(t, err)
+
+	dec := newWALDecoder()
+	r0, err := dec.decode(payload)
+	require.NoError(t, err)
+	r1, err := dec.decode(payload)
+	require.NoError(t, err)
+	assert.Same(t, r0.resource, r1.resource)
+	assert.Same(t, r0.scope, r1.scope)
+}
+
+func TestWALReplay(t *testing.T) {
+	dir := t.TempDir()
+	w, recs := openTestWAL(t, dir, 0)
+	assert.Empty(t, recs)
+
+	q := newQueue(10)
+	q.wal = w
+	for _, body := range []string{"a", "b", "c", "d"} {
+		q.Enqueue(newWALTestRecord(body))
+	}
+	buf := make([]Record, 2)
+	q.TryDequeue(buf, func(r []Record) bool {
+		w.ack(w.next(len(r)))
+		return true
+	})
+	// Simulate a crash.
+	require.NoError(t, w.close())
+
+	w, recs = openTestWAL(t, dir, 0)
+	assert.Equal(t, []string{"c", "d"}, walBodies(recs))
+
+	q = newQueue(1)
+	q.wal = w
+	assert.Equal(t, 1, q.Replay(recs))
+	_, dropped := q.Stats()
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, []string{"d"}, walBodies(q.Flush()))
+	w.ack(w.next(1))
+	require.NoError(t, w.close())
+
+	info, err := os.Stat(filepath.Join(dir, walLogFile))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size(), "acknowledged log not truncated")
+
+	_, recs = openTestWAL(t, dir, 0)
+	assert.Empty(t, recs)
+}
+
+func TestWALAckOrder(t *testing.T) {
+	dir := t.TempDir()
+	w, _ := openTestWAL(t, dir, 0)
+	for _, body := range []string{"a", "b", "c"} {
+		r := newWALTestRecord(body)
+		require.NoError(t, w.append(&r))
+	}
+	_ = w.next(1)
+	g1 := w.next(1)
+	// Acknowledging out of order must not remove the first entry.
+	w.ack(g1)
+	require.NoError(t, w.close())
+
+	_, recs := openTestWAL(t, dir, 0)
+	assert.Equal(t, []string{"a", "b", "c"}, walBodies(recs))
+}
+
+func TestWALDropAt(t *testing.T) {
+	dir := t.TempDir()
+	w, _ := openTestWAL(t, dir, 0)
+
+	q := newQueue(4)
+	q.wal = w
+	sevs := map[string]log.Severity{
+		"a": log.SeverityError,
+		"b": log.SeverityError,
+		"c": log.SeverityInfo,
+		"d": log.SeverityError,
+		"e": log.SeverityError,
+	}
+	for _, body := range []string{"a", "b", "c", "d"} {
+		r := newWALTestRecord(body)
+		r.SetSeverity(sevs[body])
+		q.Enqueue(r)
+	}
+	buf := make([]Record, 1)
+	q.TryDequeue(buf, func(r []Record) bool {
+		w.ack(w.next(len(r)))
+		return true
+	})
+	e := newWALTestRecord("e")
+	e.SetSeverity(sevs["e"])
+	q.Enqueue(e)
+
+	warn := newWALTestRecord("f")
+	warn.SetSeverity(log.SeverityWarn)
+	_, ok := q.EnqueueEvictBelow(warn, log.SeverityWarn1, nil)
+	require.True(t, ok)
+	assert.Equal(t, []string{"b", "d", "e", "f"}, walBodies(q.Flush()))
+
+	// c is acknowledged with b.
+	w.ack(w.next(1))
+	require.NoError(t, w.close())
+
+	_, recs := openTestWAL(t, dir, 0)
+	assert.Equal(t, []string{"d", "e", "f"}, walBodies(recs))
+}
+
+func TestWALCancel(t *testing.T) {
+	w, _ := openTestWAL(t, t.TempDir(), 0)
+	r := newWALTestRecord("a")
+	require.NoError(t, w.append(&r))
+
+	g := w.next(1)
+	w.cancel(g)
+	assert.Empty(t, w.groups)
+	w.drop(1)
+	assert.Empty(t, w.entries)
+}
+
+func TestWALCorruption(t *testing.T) {
+	dir := t.TempDir()
+	w, _ := openTestWAL(t, dir, 0)
+	var sizes []int64
+	for _, body := range []string{"a", "b", "c"} {
+		r := newWALTestRecord(body)
+		require.NoError(t, w.append(&r))
+		sizes = append(sizes, w.size)
+	}
+	require.NoError(t, w.close())
+
+	path := filepath.Join(dir, walLogFile)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	// Corrupt the payload of the second entry.
+	data[sizes[0]+walHeaderSize] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	w, recs, err := openWAL(dir, 0)
+	assert.ErrorIs(t, err, errWALCorrupted)
+	require.NotNil(t, w)
+	t.Cleanup(func() { _ = w.close() })
+	assert.Equal(t, []string{"a"}, walBodies(recs))
+	assert.Equal(t, sizes[0], w.size, "corrupted entries not discarded")
+}
+
+func TestWALTruncatedEntry(t *testing.T) {
+	dir := t.TempDir()
+	w, _ := openTestWAL(t, dir, 0)
+	r := newWALTestRecord("a")
+	require.NoError(t, w.append(&r))
+	size := w.size
+	r = newWALTestRecord("b")
+	require.NoError(t, w.append(&r))
+	require.NoError(t, w.close())
+
+	// Simulate a crash while the second entry was written.
+	require.NoError(t, os.Truncate(filepath.Join(dir, walLogFile), size+walHeaderSize+1))
+
+	w, recs, err := openWAL(dir, 0)
+	assert.ErrorIs(t, err, errWALCorrupted)
+	require.NotNil(t, w)
+	t.Cleanup(func() { _ = w.close() })
+	assert.Equal(t, []string{"a"}, walBodies(recs))
+	assert.Equal(t, size, w.size)
+}
+
+func TestWALMaxSize(t *testing.T) {
+	dir := t.TempDir()
+	r := newWALTestRecord("a")
+	payload, err := encodeWALRecord(&r)
+	require.NoError(t, err)
+	entrySize := int64(walHeaderSize + len(payload))
+
+	w, _ := openTestWAL(t, dir, 2*entrySize)
+	require.NoError(t, w.append(&r))
+	require.NoError(t, w.append(&r))
+	assert.ErrorIs(t, w.append(&r), errWALFull)
+	assert.NoError(t, w.append(&r), "full error reported twice")
+	assert.Equal(t, 2*entrySize, w.size)
+
+	// Acknowledging the first entry allows the log to be compacted.
+	w.ack(w.next(1))
+	assert.Equal(t, entrySize, w.offset)
+	require.NoError(t, w.append(&r))
+	assert.Equal(t, int64(0), w.offset, "not compacted")
+	assert.Equal(t, 2*entrySize, w.size)
+	require.NoError(t, w.close())
+
+	// The unpersisted records are not replayed.
+	_, recs := openTestWAL(t, dir, 2*entrySize)
+	assert.Len(t, recs, 2)
+}
+
+func TestBatchProcessorPersistentQueue(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	e0 := newTestExporter(nil)
+	t.Cleanup(e0.Stop)
+	b := NewBatchProcessor(
+		e0,
+		WithPersistentQueue(dir, 0),
+		WithExportInterval(time.Hour),
+		WithExportMaxBatchSize(10),
+	)
+	for _, body := range []string{"a", "b", "c"} {
+		r := newWALTestRecord(body)
+		require.NoError(t, b.OnEmit(ctx, &r))
+	}
+	// Simulate a crash: the queued records are not acknowledged.
+	require.NoError(t, b.wal.close())
+	require.NoError(t, b.Shutdown(ctx))
+
+	e1 := newTestExporter(nil)
+	t.Cleanup(e1.Stop)
+	b = NewBatchProcessor(e1, WithPersistentQueue(dir, 0), WithExportInterval(time.Hour))
+	require.NoError(t, b.ForceFlush(ctx))
+	require.NoError(t, b.Shutdown(ctx))
+	got := e1.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, []string{"a", "b", "c"}, walBodies(got[0]))
+
+	e2 := newTestExporter(nil)
+	t.Cleanup(e2.Stop)
+	b = NewBatchProcessor(e2, WithPersistentQueue(dir, 0))
+	require.NoError(t, b.Shutdown(ctx))
+	assert.Equal(t, 0, e2.ExportN(), "acknowledged records replayed")
+}
+
+func TestBatchProcessorPersistentQueueInvalidDir(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	var handled error
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			handled = err
+		}))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	e := newTestExporter(nil)
+	t.Cleanup(e.Stop)
+	b := NewBatchProcessor(e, WithPersistentQueue(file, 0))
+	assert.Error(t, handled)
+	assert.Nil(t, b.wal)
+
+	ctx := context.Background()
+	r := newWALTestRecord("a")
+	require.NoError(t, b.OnEmit(ctx, &r))
+	require.NoError(t, b.Shutdown(ctx))
+	assert.Equal(t, 1, e.ExportN())
+}
@@ -362,6 +362,36 @@ func benchCollectAttrs(setup func(attribute.Set) Reader) func(*testing.B) {
 	}
 }
 
+func BenchmarkCollectSorted(b *testing.B) {
+	ctx := context.Background()
+	run := func(n int, opts ...Option) func(*testing.B) {
+		return func(b *testing.B) {
+			r := NewManualReader()
+			mp := NewMeterProvider(append(opts, WithReader(r))...)
+			ctr, err := mp.Meter("BenchmarkCollectSorted").Int64Counter("int64-counter")
+			assert.NoError(b, err)
+			for i := 0; i < n; i++ {
+				ctr.Add(ctx, 1, metric.WithAttributes(
+					attribute.String("service", "svc"),
+					attribute.Int("id", n-i),
+				))
+			}
+
+			out := new(metricdata.ResourceMetrics)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = r.Collect(ctx, out)
+			}
+		}
+	}
+
+	for _, n := range []int{1, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("DataPoints/%d/Unsorted", n), run(n))
+		b.Run(fmt.Sprintf("DataPoints/%d/Sorted", n), run(n, WithSortedDataPoints()))
+	}
+}
+
 func BenchmarkExemplars(b *testing.B) {
 	sc := trace.NewSpanContext(trace.SpanContextConfig{
 		SpanID:     trace.SpanID{0o1},
@@ -42,6 +42,30 @@ func (c *cache[K, V]) Lookup(key K, f func() V) V {
 	return val
 }
 
+// Load returns the value stored in the cache for key and true, or the zero
+// value and false if no value is stored.
+//
+// Load is safe to call concurrently.
+func (c *cache[K, V]) Load(key K) (V, bool) {
+	c.Lock()
+	defer c.Unlock()
+	v, ok := c.data[key]
+	return v, ok
+}
+
+// Values returns all the values stored in the cache, in no particular order.
+//
+// Values is safe to call concurrently.
+func (c *cache[K, V]) Values() []V {
+	c.Lock()
+	defer c.Unlock()
+	vals := make([]V, 0, len(c.data))
+	for _, v := range c.data {
+		vals = append(vals, v)
+	}
+	return vals
+}
+
 // HasKey returns true if Lookup has previously been called with that key
 //
 // HasKey is safe to call concurrently.
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// CallbackGroup schedules a group of observable callbacks to run at a lower
+// frequency than the collections of the readers. It is intended for
+// callbacks reading slow sources, e.g. cgroup statistics or disk usage.
+//
+// The callbacks wrapped by a CallbackGroup are run by the first collection
+// made after interval has elapsed since they last ran. All the callbacks of a
+// group run in the same collection. The other collections reuse the last
+// values observed by each callback instead of running it.
+//
+// Reused values are reported with the timestamp of the collection reusing
+// them. Do not use a CallbackGroup with callbacks observing values that must
+// not be reported more than once, e.g. with delta temporality.
+type CallbackGroup struct {
+	interval time.Duration
+
+	mu sync.Mutex
+	// epoch is incremented each time the callbacks of the group are due.
+	epoch uint64
+	// next is when the callbacks of the group are due next.
+	next time.Time
+}
+
+// NewCallbackGroup returns a new [CallbackGroup] running its callbacks at
+// most once per interval. If interval is not greater than zero, the callbacks
+// run in every collection.
+func NewCallbackGroup(interval time.Duration) *CallbackGroup {
+	return &CallbackGroup{interval: interval}
+}
+
+// Used for testing.
+var callbackGroupNow = time.Now
+
+// current returns the current epoch of g. A new epoch starts if the
+// callbacks of g are due.
+func (g *CallbackGroup) current() uint64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if now := callbackGroupNow(); !now.Before(g.next) {
+		g.epoch++
+		g.next = now.Add(g.interval)
+	}
+	return g.epoch
+}
+
+// schedule holds the state of a callback of a CallbackGroup.
+type schedule struct {
+	group *CallbackGroup
+
+	mu sync.Mutex
+	// ran is whether the callback ran at least once, and epoch the epoch of
+	// the group when it last ran.
+	ran   bool
+	epoch uint64
+	err   error
+}
+
+// run calls f if the callback is due. The last error returned by f is
+// returned.
+func (s *schedule) run(f func() error) error {
+	epoch := s.group.current()
+	if s.ran && s.epoch == epoch {
+		return s.err
+	}
+	s.ran, s.epoch = true, epoch
+	s.err = f()
+	return s.err
+}
+
+// Callback returns f wrapped to run on the schedule of g. The collections in
+// between reuse the last values observed by f.
+func (g *CallbackGroup) Callback(f metric.Callback) metric.Callback {
+	s := &schedule{group: g}
+	var cache []observation
+	return func(ctx context.Context, o metric.Observer) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		err := s.run(func() error {
+			rec := &recordingObserver{}
+			err := f(ctx, rec)
+			cache = rec.observations
+			return err
+		})
+		for _, obs := range cache {
+			obs.replay(o)
+		}
+		return err
+	}
+}
+
+// Int64Callback returns f wrapped to run on the schedule of g. The
+// collections in between reuse the last values observed by f.
+func (g *CallbackGroup) Int64Callback(f metric.Int64Callback) metric.Int64Callback {
+	s := &schedule{group: g}
+	var cache []int64Observation
+	return func(ctx context.Context, o metric.Int64Observer) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		err := s.run(func() error {
+			rec := &recordingInt64Observer{}
+			err := f(ctx, rec)
+			cache = rec.observations
+			return err
+		})
+		for _, obs := range cache {
+			o.Observe(obs.value, obs.opts...)
+		}
+		return err
+	}
+}
+
+// Float64Callback returns f wrapped to run on the schedule of g. The
+// collections in between reuse the last values observed by f.
+func (g *CallbackGroup) Float64Callback(f metric.Float64Callback) metric.Float64Callback {
+	s := &schedule{group: g}
+	var cache []float64Observation
+	return func(ctx context.Context, o metric.Float64Observer) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		err := s.run(func() error {
+			rec := &recordingFloat64Observer{}
+			err := f(ctx, rec)
+			cache = rec.observations
+			return err
+		})
+		for _, obs := range cache {
+			o.Observe(obs.value, obs.opts...)
+		}
+		return err
+	}
+}
+
+// observation is a value observed by a metric.Callback.
+type observation struct {
+	int64Inst   metric.Int64Observable
+	float64Inst metric.Float64Observable
+	int64Val    int64
+	float64Val  float64
+	opts        []metric.ObserveOption
+}
+
+// replay observes obs with o.
+func (obs observation) replay(o metric.Observer) {
+	if obs.int64Inst != nil {
+		o.ObserveInt64(obs.int64Inst, obs.int64Val, obs.opts...)
+		return
+	}
+	o.ObserveFloat64(obs.float64Inst, obs.float64Val, obs.opts...)
+}
+
+// recordingObserver is a metric.Observer recording the observations made.
+type recordingObserver struct {
+	embedded.Observer
+
+	observations []observation
+}
+
+func (o *recordingObserver) ObserveInt64(inst metric.Int64Observable, v int64, opts ...metric.ObserveOption) {
+	o.observations = append(o.observations, observation{int64Inst: inst, int64Val: v, opts: opts})
+}
+
+func (o *recordingObserver) ObserveFloat64(inst metric.Float64Observable, v float64, opts ...metric.ObserveOption) {
+	o.observations = append(o.observations, observation{float64Inst: inst, float64Val: v, opts: opts})
+}
+
+type int64Observation struct {
+	value int64
+	opts  []metric.ObserveOption
+}
+
+// recordingInt64Observer is a metric.Int64Observer recording the
+// observations made.
+type recordingInt64Observer struct {
+	embedded.Int64Observer
+
+	observations []int64Observation
+}
+
+func (o *recordingInt64Observer) Observe(v int64, opts ...metric.ObserveOption) {
+	o.observations = append(o.observations, int64Observation{value: v, opts: opts})
+}
+
+type float64Observation struct {
+	value float64
+	opts  []metric.ObserveOption
+}
+
+// recordingFloat64Observer is a metric.Float64Observer recording the
+// observations made.
+type recordingFloat64Observer struct {
+	embedded.Float64Observer
+
+	observations []float64Observation
+}
+
+func (o *recordingFloat64Observer) Observe(v float64, opts ...metric.ObserveOption) {
+	o.observations = append(o.observations, float64Observation{value: v, opts: opts})
+}
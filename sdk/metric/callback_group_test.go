@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestCallbackGroup(t *testing.T) {
+	now := time.Unix(0, 0)
+	t.Cleanup(func(orig func() time.Time) func() {
+		callbackGroupNow = func() time.Time { return now }
+		return func() { callbackGroupNow = orig }
+	}(callbackGroupNow))
+
+	r0, r1 := NewManualReader(), NewManualReader()
+	m := NewMeterProvider(WithReader(r0), WithReader(r1)).Meter("test")
+	g := NewCallbackGroup(time.Minute)
+
+	var intN, floatN, multiN int
+	_, err := m.Int64ObservableGauge("int", metric.WithInt64Callback(
+		g.Int64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			intN++
+			o.Observe(int64(intN), metric.WithAttributes(attribute.Int("n", intN)))
+			return nil
+		}),
+	))
+	require.NoError(t, err)
+	_, err = m.Float64ObservableGauge("float", metric.WithFloat64Callback(
+		g.Float64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			floatN++
+			o.Observe(float64(floatN))
+			return nil
+		}),
+	))
+	require.NoError(t, err)
+	multiInt, err := m.Int64ObservableGauge("multi.int")
+	require.NoError(t, err)
+	multiFloat, err := m.Float64ObservableGauge("multi.float")
+	require.NoError(t, err)
+	_, err = m.RegisterCallback(g.Callback(func(_ context.Context, o metric.Observer) error {
+		multiN++
+		o.ObserveInt64(multiInt, int64(multiN))
+		o.ObserveFloat64(multiFloat, float64(multiN))
+		return nil
+	}), multiInt, multiFloat)
+	require.NoError(t, err)
+
+	assertValues := func(r Reader, want int) {
+		t.Helper()
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, r.Collect(context.Background(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 4)
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				require.Len(t, data.DataPoints, 1, m.Name)
+				assert.Equal(t, int64(want), data.DataPoints[0].Value, m.Name)
+			case metricdata.Gauge[float64]:
+				require.Len(t, data.DataPoints, 1, m.Name)
+				assert.Equal(t, float64(want), data.DataPoints[0].Value, m.Name)
+			}
+		}
+	}
+
+	assertValues(r0, 1)
+	// Other readers and collections within the interval reuse the values.
+	assertValues(r1, 1)
+	now = now.Add(time.Second)
+	assertValues(r0, 1)
+	assert.Equal(t, 1, intN)
+	assert.Equal(t, 1, floatN)
+	assert.Equal(t, 1, multiN)
+
+	now = now.Add(time.Minute)
+	assertValues(r1, 2)
+	assertValues(r0, 2)
+	assert.Equal(t, 2, intN)
+	assert.Equal(t, 2, floatN)
+	assert.Equal(t, 2, multiN)
+}
+
+func TestCallbackGroupError(t *testing.T) {
+	reader := NewManualReader()
+	m := NewMeterProvider(WithReader(reader)).Meter("test")
+	g := NewCallbackGroup(time.Hour)
+
+	var n int
+	_, err := m.Int64ObservableCounter("counter", metric.WithInt64Callback(
+		g.Int64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			n++
+			o.Observe(1)
+			return assert.AnError
+		}),
+	))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		var rm metricdata.ResourceMetrics
+		assert.ErrorIs(t, reader.Collect(context.Background(), &rm), assert.AnError)
+		require.Len(t, rm.ScopeMetrics, 1)
+		assert.Len(t, rm.ScopeMetrics[0].Metrics, 1, "observations not reused")
+	}
+	assert.Equal(t, 1, n, "failed callback run again before interval")
+}
+
+func TestCallbackGroupNoInterval(t *testing.T) {
+	reader := NewManualReader()
+	m := NewMeterProvider(WithReader(reader)).Meter("test")
+	g := NewCallbackGroup(0)
+
+	var n int
+	_, err := m.Int64ObservableGauge("gauge", metric.WithInt64Callback(
+		g.Int64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			n++
+			return nil
+		}),
+	))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &rm))
+	}
+	assert.Equal(t, 3, n)
+}
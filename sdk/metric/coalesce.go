@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// WithCollectCoalescing configures a reader to coalesce concurrent
+// collections. A collection started while another one is in progress waits
+// for it and receives a copy of its result instead of running the
+// callbacks and aggregations a second time.
+//
+// If minInterval is greater than zero, the result of a collection is also
+// reused by the collections started within minInterval of its completion.
+// This guards against aggressive scrapes triggering costly collections.
+//
+// The data of a coalesced or reused collection is returned to all of its
+// callers. This option should only be used with readers using cumulative
+// temporality. With delta temporality, the same measurements would be
+// reported more than once.
+//
+// By default, if this option is not used, each collection is made
+// independently.
+func WithCollectCoalescing(minInterval time.Duration) ReaderOption {
+	return coalescingOption{minInterval: max(minInterval, 0)}
+}
+
+type coalescingOption struct {
+	minInterval time.Duration
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o coalescingOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.coalescer = newCoalescer(o.minInterval)
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o coalescingOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.coalescer = newCoalescer(o.minInterval)
+	return c
+}
+
+// coalescer coalesces concurrent collections and caches their result.
+type coalescer struct {
+	minInterval time.Duration
+
+	mu sync.Mutex
+	// inflight is the collection in progress, if any.
+	inflight *collection
+	// last is the last successful collection, if any.
+	last *collection
+}
+
+// collection is the result of a collection shared by coalesced callers.
+type collection struct {
+	done chan struct{}
+	end  time.Time
+	rm   metricdata.ResourceMetrics
+	err  error
+}
+
+func newCoalescer(minInterval time.Duration) *coalescer {
+	return &coalescer{minInterval: minInterval}
+}
+
+// Used for testing.
+var coalescerNow = time.Now
+
+// collect calls f to collect into rm. If c is nil, f is called directly.
+// Otherwise, rm is populated with a copy of the in progress collection, or
+// the last collection if it completed less than c.minInterval ago, if
+// either exists.
+func (c *coalescer) collect(ctx context.Context, rm *metricdata.ResourceMetrics, f func(context.Context, *metricdata.ResourceMetrics) error) error {
+	if c == nil {
+		return f(ctx, rm)
+	}
+
+	c.mu.Lock()
+	if last := c.last; last != nil && coalescerNow().Sub(last.end) < c.minInterval {
+		c.mu.Unlock()
+		copyResourceMetrics(rm, &last.rm)
+		return last.err
+	}
+	if call := c.inflight; call != nil {
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		copyResourceMetrics(rm, &call.rm)
+		return call.err
+	}
+	call := &collection{done: make(chan struct{})}
+	c.inflight = call
+	c.mu.Unlock()
+
+	err := f(ctx, rm)
+
+	// The caller owns rm once this returns. Share a copy.
+	copyResourceMetrics(&call.rm, rm)
+	call.err = err
+	call.end = coalescerNow()
+
+	c.mu.Lock()
+	c.inflight = nil
+	if ctx.Err() == nil {
+		// Do not reuse a collection that was interrupted.
+		c.last = call
+	}
+	c.mu.Unlock()
+	close(call.done)
+
+	return err
+}
+
+// copyResourceMetrics sets dst to a deep copy of src.
+func copyResourceMetrics(dst, src *metricdata.ResourceMetrics) {
+	dst.Resource = src.Resource
+	dst.ScopeMetrics = make([]metricdata.ScopeMetrics, len(src.ScopeMetrics))
+	for i, sm := range src.ScopeMetrics {
+		dst.ScopeMetrics[i].Scope = sm.Scope
+		dst.ScopeMetrics[i].Metrics = make([]metricdata.Metrics, len(sm.Metrics))
+		for j, m := range sm.Metrics {
+			dst.ScopeMetrics[i].Metrics[j] = metricdata.Metrics{
+				Name:        m.Name,
+				Description: m.Description,
+				Unit:        m.Unit,
+				Data:        copyAggregation(m.Data),
+			}
+		}
+	}
+}
+
+// copyAggregation returns a deep copy of agg. The Attributes of data points
+// are immutable and are not copied.
+func copyAggregation(agg metricdata.Aggregation) metricdata.Aggregation {
+	switch a := agg.(type) {
+	case metricdata.Gauge[int64]:
+		return metricdata.Gauge[int64]{DataPoints: copyDataPoints(a.DataPoints)}
+	case metricdata.Gauge[float64]:
+		return metricdata.Gauge[float64]{DataPoints: copyDataPoints(a.DataPoints)}
+	case metricdata.Sum[int64]:
+		a.DataPoints = copyDataPoints(a.DataPoints)
+		return a
+	case metricdata.Sum[float64]:
+		a.DataPoints = copyDataPoints(a.DataPoints)
+		return a
+	case metricdata.Histogram[int64]:
+		a.DataPoints = copyHistogramDataPoints(a.DataPoints)
+		return a
+	case metricdata.Histogram[float64]:
+		a.DataPoints = copyHistogramDataPoints(a.DataPoints)
+		return a
+	case metricdata.ExponentialHistogram[int64]:
+		a.DataPoints = copyExpoHistogramDataPoints(a.DataPoints)
+		return a
+	case metricdata.ExponentialHistogram[float64]:
+		a.DataPoints = copyExpoHistogramDataPoints(a.DataPoints)
+		return a
+	case metricdata.Summary:
+		dPts := slices.Clone(a.DataPoints)
+		for i := range dPts {
+			dPts[i].QuantileValues = slices.Clone(dPts[i].QuantileValues)
+		}
+		a.DataPoints = dPts
+		return a
+	}
+	// Unknown aggregations are shared.
+	return agg
+}
+
+func copyDataPoints[N int64 | float64](dPts []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	out := slices.Clone(dPts)
+	for i := range out {
+		out[i].Exemplars = copyExemplars(out[i].Exemplars)
+	}
+	return out
+}
+
+func copyHistogramDataPoints[N int64 | float64](dPts []metricdata.HistogramDataPoint[N]) []metricdata.HistogramDataPoint[N] {
+	out := slices.Clone(dPts)
+	for i := range out {
+		out[i].Bounds = slices.Clone(out[i].Bounds)
+		out[i].BucketCounts = slices.Clone(out[i].BucketCounts)
+		out[i].Exemplars = copyExemplars(out[i].Exemplars)
+	}
+	return out
+}
+
+func copyExpoHistogramDataPoints[N int64 | float64](dPts []metricdata.ExponentialHistogramDataPoint[N]) []metricdata.ExponentialHistogramDataPoint[N] {
+	out := slices.Clone(dPts)
+	for i := range out {
+		out[i].PositiveBucket.Counts = slices.Clone(out[i].PositiveBucket.Counts)
+		out[i].NegativeBucket.Counts = slices.Clone(out[i].NegativeBucket.Counts)
+		out[i].Exemplars = copyExemplars(out[i].Exemplars)
+	}
+	return out
+}
+
+func copyExemplars[N int64 | float64](exemplars []metricdata.Exemplar[N]) []metricdata.Exemplar[N] {
+	out := slices.Clone(exemplars)
+	for i := range out {
+		out[i].FilteredAttributes = slices.Clone(out[i].FilteredAttributes)
+		out[i].SpanID = slices.Clone(out[i].SpanID)
+		out[i].TraceID = slices.Clone(out[i].TraceID)
+	}
+	return out
+}
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+// newCountingReader returns a ManualReader created with opts and registered
+// with a MeterProvider. The returned counter is incremented by each
+// collection of the reader that runs the callbacks.
+func newCountingReader(t *testing.T, opts ...ManualReaderOption) (*ManualReader, *atomic.Int64) {
+	t.Helper()
+
+	reader := NewManualReader(opts...)
+	mp := NewMeterProvider(WithReader(reader))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	var n atomic.Int64
+	_, err := mp.Meter("test").Int64ObservableCounter(
+		"collections",
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(n.Add(1))
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+	return reader, &n
+}
+
+func TestCollectCoalescingMinInterval(t *testing.T) {
+	orig := coalescerNow
+	t.Cleanup(func() { coalescerNow = orig })
+	now := time.Now()
+	coalescerNow = func() time.Time { return now }
+
+	reader, n := newCountingReader(t, WithCollectCoalescing(time.Minute))
+	ctx := context.Background()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.NoError(t, reader.Collect(ctx, &rm))
+	assert.Equal(t, int64(1), n.Load(), "collection not reused")
+
+	// Modifying the returned data must not affect the cached collection.
+	rm.ScopeMetrics[0].Metrics[0].Name = "modified"
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &got))
+	assert.Equal(t, "collections", got.ScopeMetrics[0].Metrics[0].Name)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, reader.Collect(ctx, &rm))
+	assert.Equal(t, int64(2), n.Load(), "collection reused after minInterval")
+}
+
+func TestCollectCoalescingDisabled(t *testing.T) {
+	reader, n := newCountingReader(t)
+	ctx := context.Background()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.NoError(t, reader.Collect(ctx, &rm))
+	assert.Equal(t, int64(2), n.Load())
+}
+
+func TestCoalescerConcurrent(t *testing.T) {
+	c := newCoalescer(0)
+	ctx := context.Background()
+
+	var calls atomic.Int64
+	started, release := make(chan struct{}), make(chan struct{})
+	f := func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		rm.ScopeMetrics = []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "test"},
+		}}
+		return assert.AnError
+	}
+
+	const callers = 5
+	var wg sync.WaitGroup
+	results := make([]metricdata.ResourceMetrics, callers)
+	errs := make([]error, callers)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		errs[0] = c.collect(ctx, &results[0], f)
+	}()
+	<-started
+	for i := 1; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = c.collect(ctx, &results[i], f)
+		}(i)
+	}
+	// Give the coalesced callers time to wait on the in progress collection.
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load(), "collections not coalesced")
+	for i := range results {
+		assert.ErrorIs(t, errs[i], assert.AnError, "caller %d", i)
+		metricdatatest.AssertEqual(t, results[0], results[i])
+	}
+}
+
+func TestCoalescerCanceledContext(t *testing.T) {
+	c := newCoalescer(time.Hour)
+
+	var calls int
+	f := func(context.Context, *metricdata.ResourceMetrics) error {
+		calls++
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var rm metricdata.ResourceMetrics
+	_ = c.collect(ctx, &rm, f)
+	_ = c.collect(context.Background(), &rm, f)
+	assert.Equal(t, 2, calls, "interrupted collection reused")
+}
+
+func TestCoalescerNil(t *testing.T) {
+	var c *coalescer
+	var called bool
+	err := c.collect(context.Background(), new(metricdata.ResourceMetrics), func(context.Context, *metricdata.ResourceMetrics) error {
+		called = true
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestCopyResourceMetrics(t *testing.T) {
+	alice := attribute.NewSet(attribute.String("user", "alice"))
+	ex := []metricdata.Exemplar[int64]{{
+		FilteredAttributes: []attribute.KeyValue{attribute.Int("a", 1)},
+		Value:              1,
+		SpanID:             []byte{1},
+		TraceID:            []byte{2},
+	}}
+	exF := []metricdata.Exemplar[float64]{{Value: 1}}
+	src := metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "test"},
+			Metrics: []metricdata.Metrics{
+				{Name: "gauge", Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: alice, Value: 1, Exemplars: ex}},
+				}},
+				{Name: "gaugeF", Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{Value: 1, Exemplars: exF}},
+				}},
+				{Name: "sum", Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Value: 2}},
+				}},
+				{Name: "sumF", Data: metricdata.Sum[float64]{
+					Temporality: metricdata.DeltaTemporality,
+					DataPoints:  []metricdata.DataPoint[float64]{{Value: 2}},
+				}},
+				{Name: "hist", Data: metricdata.Histogram[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[int64]{{
+						Count:        1,
+						Bounds:       []float64{1, 2},
+						BucketCounts: []uint64{0, 1, 0},
+						Sum:          2,
+						Exemplars:    ex,
+					}},
+				}},
+				{Name: "histF", Data: metricdata.Histogram[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[float64]{{
+						Count:        1,
+						Bounds:       []float64{1},
+						BucketCounts: []uint64{1, 0},
+						Sum:          0.5,
+					}},
+				}},
+				{Name: "expo", Data: metricdata.ExponentialHistogram[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.ExponentialHistogramDataPoint[int64]{{
+						Count:          2,
+						PositiveBucket: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1}},
+						NegativeBucket: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1}},
+					}},
+				}},
+				{Name: "expoF", Data: metricdata.ExponentialHistogram[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.ExponentialHistogramDataPoint[float64]{{
+						Count:          1,
+						PositiveBucket: metricdata.ExponentialBucket{Counts: []uint64{1}},
+					}},
+				}},
+				{Name: "summary", Data: metricdata.Summary{
+					DataPoints: []metricdata.SummaryDataPoint{{
+						Count:          1,
+						QuantileValues: []metricdata.QuantileValue{{Quantile: 0.5, Value: 1}},
+					}},
+				}},
+			},
+		}},
+	}
+
+	var dst metricdata.ResourceMetrics
+	copyResourceMetrics(&dst, &src)
+	metricdatatest.AssertEqual(t, src, dst)
+
+	// Modifying the copy must not modify the source.
+	m := dst.ScopeMetrics[0].Metrics
+	m[0].Data.(metricdata.Gauge[int64]).DataPoints[0].Exemplars[0].SpanID[0] = 0xff
+	m[4].Data.(metricdata.Histogram[int64]).DataPoints[0].BucketCounts[0] = 10
+	m[6].Data.(metricdata.ExponentialHistogram[int64]).DataPoints[0].PositiveBucket.Counts[0] = 10
+	m[8].Data.(metricdata.Summary).DataPoints[0].QuantileValues[0].Value = 10
+	m[0].Name = "modified"
+
+	s := src.ScopeMetrics[0].Metrics
+	assert.Equal(t, "gauge", s[0].Name)
+	assert.Equal(t, []byte{1}, s[0].Data.(metricdata.Gauge[int64]).DataPoints[0].Exemplars[0].SpanID)
+	assert.Equal(t, []uint64{0, 1, 0}, s[4].Data.(metricdata.Histogram[int64]).DataPoints[0].BucketCounts)
+	assert.Equal(t, []uint64{1}, s[6].Data.(metricdata.ExponentialHistogram[int64]).DataPoints[0].PositiveBucket.Counts)
+	assert.Equal(t, 1.0, s[8].Data.(metricdata.Summary).DataPoints[0].QuantileValues[0].Value)
+}
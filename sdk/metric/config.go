@@ -16,6 +16,11 @@ type config struct {
 	res     *resource.Resource
 	readers []Reader
 	views   []View
+
+	alignedStart bool
+	memoryLimit  int64
+	sanitize     bool
+	sorted       bool
 }
 
 // readerSignals returns a force-flush and shutdown function for a
@@ -129,9 +134,69 @@ func WithReader(r Reader) Option {
 //
 // By default, if this option is not used, the MeterProvider will use the
 // default view.
+//
+// Use [MeterProvider.SetViews] to replace the views of a created
+// MeterProvider.
 func WithView(views ...View) Option {
 	return optionFunc(func(cfg config) config {
 		cfg.views = append(cfg.views, views...)
 		return cfg
 	})
 }
+
+// WithMemoryLimit limits the estimated memory, in bytes, used by the data
+// points aggregated by the MeterProvider. The limit is shared by all the
+// instruments and readers of the MeterProvider.
+//
+// Once the limit is reached, measurements made for new attribute sets are
+// aggregated into a single overflow data point, with the
+// "otel.metric.overflow" attribute, of their metric stream. An error is sent
+// to the OTel error handler the first time the limit is reached. The memory
+// used by delta data points is released when they are collected. This
+// guarantees the SDK memory usage is bounded regardless of the cardinality of
+// the measurement attributes.
+//
+// The memory usage is an estimate based on the size of the measurement
+// attributes and the aggregation of the data points. It does not include the
+// memory used by the instruments and the exemplars.
+//
+// By default, if this option is not used or limit is less than or equal to
+// zero, no limit is imposed.
+func WithMemoryLimit(limit int64) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.memoryLimit = limit
+		return cfg
+	})
+}
+
+// WithAlignedStartTime configures the MeterProvider to report the time it was
+// created as the start time of all cumulative data points it produces.
+//
+// Some backends require all streams produced by a process to share the same
+// start time. By default, if this option is not used, the start time of a
+// cumulative data point is the time the instrument it belongs to was created.
+func WithAlignedStartTime() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.alignedStart = true
+		return cfg
+	})
+}
+
+// WithSortedDataPoints configures the MeterProvider to sort the metric data
+// it produces, so that the same telemetry is always exported in the same
+// order.
+//
+// The scope metrics are sorted by the name, version, and schema URL of their
+// scope, and the data points of each metric are sorted by their attributes.
+// Metrics are produced in the order their instruments were created. This is
+// meant for exporters and tests comparing exported data, sorting adds an
+// overhead to each collection proportional to the number of data points.
+//
+// By default, if this option is not used, the order of scope metrics and data
+// points is undefined.
+func WithSortedDataPoints() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.sorted = true
+		return cfg
+	})
+}
@@ -134,3 +134,19 @@ func TestWithView(t *testing.T) {
 	)})
 	assert.Len(t, c.views, 2)
 }
+
+func TestWithAlignedStartTime(t *testing.T) {
+	c := newConfig(nil)
+	assert.False(t, c.alignedStart, "default")
+
+	c = newConfig([]Option{WithAlignedStartTime()})
+	assert.True(t, c.alignedStart)
+}
+
+func TestWithSortedDataPoints(t *testing.T) {
+	c := newConfig(nil)
+	assert.False(t, c.sorted, "default")
+
+	c = newConfig([]Option{WithSortedDataPoints()})
+	assert.True(t, c.sorted)
+}
@@ -71,7 +71,10 @@ func reservoirFunc(agg Aggregation) func() exemplar.Reservoir {
 
 	switch os.Getenv(filterEnvKey) {
 	case "always_on":
-		return resF()
+		newR := resF()
+		return func() exemplar.Reservoir {
+			return exemplar.Unfiltered(newR())
+		}
 	case "always_off":
 		return exemplar.Drop
 	case "trace_based":
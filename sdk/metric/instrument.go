@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -170,7 +171,8 @@ func (i instID) normalize() instID {
 }
 
 type int64Inst struct {
-	measures []aggregate.Measure[int64]
+	// measures are replaced when the views of the MeterProvider are updated.
+	measures atomic.Pointer[measures[int64]]
 
 	embedded.Int64Counter
 	embedded.Int64UpDownCounter
@@ -193,14 +195,26 @@ func (i *int64Inst) Record(ctx context.Context, val int64, opts ...metric.Record
 	i.aggregate(ctx, val, c.Attributes())
 }
 
+func newInt64Inst(meas []aggregate.Measure[int64]) *int64Inst {
+	i := new(int64Inst)
+	i.setMeasures(meas)
+	return i
+}
+
+func (i *int64Inst) setMeasures(meas []aggregate.Measure[int64]) {
+	m := measures[int64](meas)
+	i.measures.Store(&m)
+}
+
 func (i *int64Inst) aggregate(ctx context.Context, val int64, s attribute.Set) { // nolint:revive  // okay to shadow pkg with method.
-	for _, in := range i.measures {
+	for _, in := range *i.measures.Load() {
 		in(ctx, val, s)
 	}
 }
 
 type float64Inst struct {
-	measures []aggregate.Measure[float64]
+	// measures are replaced when the views of the MeterProvider are updated.
+	measures atomic.Pointer[measures[float64]]
 
 	embedded.Float64Counter
 	embedded.Float64UpDownCounter
@@ -223,8 +237,19 @@ func (i *float64Inst) Record(ctx context.Context, val float64, opts ...metric.Re
 	i.aggregate(ctx, val, c.Attributes())
 }
 
+func newFloat64Inst(meas []aggregate.Measure[float64]) *float64Inst {
+	i := new(float64Inst)
+	i.setMeasures(meas)
+	return i
+}
+
+func (i *float64Inst) setMeasures(meas []aggregate.Measure[float64]) {
+	m := measures[float64](meas)
+	i.measures.Store(&m)
+}
+
 func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Set) {
-	for _, in := range i.measures {
+	for _, in := range *i.measures.Load() {
 		in(ctx, val, s)
 	}
 }
@@ -284,9 +309,13 @@ type observable[N int64 | float64] struct {
 	metric.Observable
 	observablID[N]
 
-	meter           *meter
-	measures        measures[N]
-	dropAggregation bool
+	meter *meter
+	// pipeMeasures are the measures of each pipeline, indexed the same way
+	// as the pipelines of the meter. They are replaced when the views of the
+	// MeterProvider are updated.
+	pipeMeasures atomic.Pointer[[]measures[N]]
+	// dropAggregation is true if a drop aggregation is used by any pipeline.
+	dropAggregation atomic.Bool
 }
 
 func newObservable[N int64 | float64](m *meter, kind InstrumentKind, name, desc, u string) *observable[N] {
@@ -304,11 +333,44 @@ func newObservable[N int64 | float64](m *meter, kind InstrumentKind, name, desc,
 
 // observe records the val for the set of attrs.
 func (o *observable[N]) observe(val N, s attribute.Set) {
-	o.measures.observe(val, s)
+	pm := o.pipeMeasures.Load()
+	if pm == nil {
+		return
+	}
+	for _, m := range *pm {
+		m.observe(val, s)
+	}
+}
+
+// observePipe records the val for the set of attrs in the pipeline with index
+// pipe.
+func (o *observable[N]) observePipe(pipe int, val N, s attribute.Set) {
+	pm := o.pipeMeasures.Load()
+	if pm == nil || pipe >= len(*pm) {
+		return
+	}
+	(*pm)[pipe].observe(val, s)
 }
 
-func (o *observable[N]) appendMeasures(meas []aggregate.Measure[N]) {
-	o.measures = append(o.measures, meas...)
+// setMeasures sets the measures of each pipeline, indexed the same way as the
+// pipelines of the meter.
+func (o *observable[N]) setMeasures(pipeMeasures []measures[N], dropAggregation bool) {
+	o.pipeMeasures.Store(&pipeMeasures)
+	o.dropAggregation.Store(dropAggregation)
+}
+
+// empty returns true if o has no measures in any pipeline.
+func (o *observable[N]) empty() bool {
+	pm := o.pipeMeasures.Load()
+	if pm == nil {
+		return true
+	}
+	for _, m := range *pm {
+		if len(m) > 0 {
+			return false
+		}
+	}
+	return true
 }
 
 type measures[N int64 | float64] []aggregate.Measure[N]
@@ -327,7 +389,7 @@ var errEmptyAgg = errors.New("no aggregators for observable instrument")
 // no-op because it does not have any aggregators. Also, an error is returned
 // if scope defines a Meter other than the one o was created by.
 func (o *observable[N]) registerable(m *meter) error {
-	if len(o.measures) == 0 {
+	if o.empty() {
 		return errEmptyAgg
 	}
 	if m != o.meter {
@@ -36,7 +36,7 @@ func BenchmarkInstrument(b *testing.B) {
 		in, _ = build.Sum(true)
 		meas = append(meas, in)
 
-		inst := int64Inst{measures: meas}
+		inst := newInt64Inst(meas)
 		ctx := context.Background()
 
 		b.ReportAllocs()
@@ -61,7 +61,8 @@ func BenchmarkInstrument(b *testing.B) {
 		in, _ = build.Sum(true)
 		meas = append(meas, in)
 
-		o := observable[int64]{measures: meas}
+		var o observable[int64]
+		o.setMeasures([]measures[int64]{meas}, false)
 
 		b.ReportAllocs()
 		b.ResetTimer()
@@ -48,6 +48,27 @@ type Builder[N int64 | float64] struct {
 	// If AggregationLimit is less than or equal to zero there will not be an
 	// aggregation limit imposed (i.e. unlimited attribute sets).
 	AggregationLimit int
+	// Account is the share of the memory budget used by the aggregate
+	// function. Once the budget is exhausted, measurements for new attributes
+	// are aggregated into the aggregate for the "otel.metric.overflow"
+	// attribute.
+	//
+	// If Account is nil, no memory limit is imposed.
+	Account *Account
+	// StartTime is the start time reported for cumulative aggregations.
+	//
+	// If this is not provided, the time the aggregate function is created is
+	// used.
+	StartTime time.Time
+}
+
+// cumulativeStart returns the start time cumulative aggregate functions
+// report.
+func (b Builder[N]) cumulativeStart() time.Time {
+	if !b.StartTime.IsZero() {
+		return b.StartTime
+	}
+	return now()
 }
 
 func (b Builder[N]) resFunc() func() exemplar.Reservoir {
@@ -80,6 +101,7 @@ func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 	// Delta temporality is the only temporality that makes semantic sense for
 	// a last-value aggregate.
 	lv := newLastValue[N](b.AggregationLimit, b.resFunc())
+	lv.limit.setBudget(b.Account, 0)
 
 	return b.filter(lv.measure), func(dest *metricdata.Aggregation) int {
 		// Ignore if dest is not a metricdata.Gauge. The chance for memory
@@ -96,10 +118,12 @@ func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 // arguments passed to the input are expected to be the precomputed sum values.
 func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregation) {
 	s := newPrecomputedSum[N](monotonic, b.AggregationLimit, b.resFunc())
+	s.limit.setBudget(b.Account, 0)
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(s.measure), s.delta
 	default:
+		s.start = b.cumulativeStart()
 		return b.filter(s.measure), s.cumulative
 	}
 }
@@ -107,10 +131,12 @@ func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregati
 // Sum returns a sum aggregate function input and output.
 func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
 	s := newSum[N](monotonic, b.AggregationLimit, b.resFunc())
+	s.limit.setBudget(b.Account, 0)
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(s.measure), s.delta
 	default:
+		s.start = b.cumulativeStart()
 		return b.filter(s.measure), s.cumulative
 	}
 }
@@ -119,10 +145,13 @@ func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
 // output.
 func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
 	h := newHistogram[N](boundaries, noMinMax, noSum, b.AggregationLimit, b.resFunc())
+	// The bucket counts.
+	h.limit.setBudget(b.Account, 8*int64(len(boundaries)+1))
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(h.measure), h.delta
 	default:
+		h.start = b.cumulativeStart()
 		return b.filter(h.measure), h.cumulative
 	}
 }
@@ -131,10 +160,13 @@ func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSu
 // output.
 func (b Builder[N]) ExponentialBucketHistogram(maxSize, maxScale int32, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
 	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.AggregationLimit, b.resFunc())
+	// The positive and negative bucket counts, at their maximum size.
+	h.limit.setBudget(b.Account, 2*8*int64(maxSize))
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(h.measure), h.delta
 	default:
+		h.start = b.cumulativeStart()
 		return b.filter(h.measure), h.cumulative
 	}
 }
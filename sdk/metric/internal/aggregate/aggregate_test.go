@@ -81,6 +81,16 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 	}
 }
 
+func TestBuilderCumulativeStart(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	assert.Equal(t, staticTime, Builder[int64]{}.cumulativeStart(), "default")
+
+	start := staticTime.Add(-time.Hour)
+	b := Builder[float64]{StartTime: start}
+	assert.Equal(t, start, b.cumulativeStart(), "StartTime")
+}
+
 type arg[N int64 | float64] struct {
 	ctx context.Context
 
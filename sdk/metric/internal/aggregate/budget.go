@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// pointOverhead is the estimated size, in bytes, of an aggregated data
+	// point not including its attributes: the map entry, the aggregate
+	// value, and its exemplar reservoir.
+	pointOverhead = 192
+	// attrOverhead is the estimated size, in bytes, of an attribute not
+	// including its key and value data.
+	attrOverhead = 64
+)
+
+// Budget is a limit of the estimated memory used by the data points of
+// aggregate functions. It is shared by all the aggregate functions of a
+// MeterProvider.
+//
+// Once the limit is reached, the measurements for new attribute sets are
+// aggregated into the overflow data point of the aggregate functions, as if
+// their cardinality limit was reached.
+type Budget struct {
+	limit int64
+	used  atomic.Int64
+	// exceeded is true if a reservation was refused since used was last
+	// below limit. It is used to report the limit being exceeded once.
+	exceeded atomic.Bool
+}
+
+// NewBudget returns a new Budget of limit bytes. If limit is less than or
+// equal to zero, nil is returned: no limit is imposed.
+func NewBudget(limit int64) *Budget {
+	if limit <= 0 {
+		return nil
+	}
+	return &Budget{limit: limit}
+}
+
+// Used returns the estimated memory used, in bytes.
+func (b *Budget) Used() int64 {
+	if b == nil {
+		return 0
+	}
+	return b.used.Load()
+}
+
+// Account returns a new Account of b for an aggregate function. If b is nil,
+// nil is returned.
+func (b *Budget) Account() *Account {
+	if b == nil {
+		return nil
+	}
+	return &Account{budget: b}
+}
+
+func (b *Budget) reserve(n int64) bool {
+	for {
+		used := b.used.Load()
+		if used+n > b.limit {
+			if !b.exceeded.Swap(true) {
+				otel.Handle(fmt.Errorf("metric memory limit of %d bytes exceeded: measurements for new attribute sets are aggregated into the overflow data points", b.limit))
+			}
+			return false
+		}
+		if b.used.CompareAndSwap(used, used+n) {
+			return true
+		}
+	}
+}
+
+func (b *Budget) release(n int64) {
+	if b.used.Add(-n) < b.limit {
+		b.exceeded.Store(false)
+	}
+}
+
+// Account is the share of a Budget used by an aggregate function.
+type Account struct {
+	budget *Budget
+
+	mu       sync.Mutex
+	reserved int64
+	closed   bool
+}
+
+// reserve reserves n bytes of the budget. It returns false if the budget is
+// exhausted or a is closed.
+func (a *Account) reserve(n int64) bool {
+	if a == nil {
+		return true
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed || !a.budget.reserve(n) {
+		return false
+	}
+	a.reserved += n
+	return true
+}
+
+// releaseAll releases all the bytes reserved by a.
+func (a *Account) releaseAll() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.budget.release(a.reserved)
+	a.reserved = 0
+}
+
+// Close releases all the bytes reserved by a. No bytes can be reserved by a
+// once closed. It is called when the aggregate function using a is
+// discarded.
+func (a *Account) Close() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.budget.release(a.reserved)
+	a.reserved = 0
+	a.closed = true
+}
+
+// attrSize returns the estimated size, in bytes, of attrs.
+func attrSize(attrs attribute.Set) int64 {
+	var n int64
+	iter := attrs.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		n += attrOverhead + int64(len(kv.Key))
+		switch kv.Value.Type() {
+		case attribute.STRING:
+			n += int64(len(kv.Value.AsString()))
+		case attribute.STRINGSLICE:
+			for _, s := range kv.Value.AsStringSlice() {
+				n += 16 + int64(len(s))
+			}
+		case attribute.BOOLSLICE:
+			n += int64(len(kv.Value.AsBoolSlice()))
+		case attribute.INT64SLICE:
+			n += 8 * int64(len(kv.Value.AsInt64Slice()))
+		case attribute.FLOAT64SLICE:
+			n += 8 * int64(len(kv.Value.AsFloat64Slice()))
+		}
+	}
+	return n
+}
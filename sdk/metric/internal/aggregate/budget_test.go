@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestNewBudgetNoLimit(t *testing.T) {
+	assert.Nil(t, NewBudget(0))
+	assert.Nil(t, NewBudget(-1))
+
+	var b *Budget
+	assert.Nil(t, b.Account())
+	assert.Zero(t, b.Used())
+}
+
+func TestBudgetAccount(t *testing.T) {
+	var errs []error
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	b := NewBudget(10)
+	a0, a1 := b.Account(), b.Account()
+
+	assert.True(t, a0.reserve(6))
+	assert.True(t, a1.reserve(4))
+	assert.Equal(t, int64(10), b.Used())
+
+	assert.False(t, a0.reserve(1), "budget exceeded")
+	assert.False(t, a1.reserve(1), "budget exceeded")
+	assert.Len(t, errs, 1, "exceeded budget not reported once")
+
+	a0.releaseAll()
+	assert.Equal(t, int64(4), b.Used())
+	assert.True(t, a0.reserve(6))
+	assert.False(t, a0.reserve(1), "budget exceeded")
+	assert.Len(t, errs, 2, "exceeded budget not reported again")
+
+	a1.Close()
+	assert.Equal(t, int64(6), b.Used())
+	assert.False(t, a1.reserve(1), "closed account reserved")
+}
+
+func TestAttrSize(t *testing.T) {
+	assert.Zero(t, attrSize(*attribute.EmptySet()))
+
+	set := attribute.NewSet(
+		attribute.String("s", "abc"),
+		attribute.Int("i", 1),
+		attribute.StringSlice("ss", []string{"a", "bc"}),
+		attribute.Int64Slice("is", []int64{1, 2}),
+	)
+	want := int64(4*attrOverhead) +
+		1 + 3 + // s
+		1 + // i
+		2 + 16 + 1 + 16 + 2 + // ss
+		2 + 2*8 // is
+	assert.Equal(t, want, attrSize(set))
+}
+
+func TestLimiterBudget(t *testing.T) {
+	size := pointOverhead + attrSize(alice)
+	b := NewBudget(size)
+	l := newLimiter[struct{}](0)
+	l.setBudget(b.Account(), 0)
+
+	m := map[attribute.Distinct]struct{}{}
+	assert.Equal(t, alice, l.Attributes(alice, m))
+	m[alice.Equivalent()] = struct{}{}
+	assert.Equal(t, alice, l.Attributes(alice, m), "existing set reserved twice")
+	assert.Equal(t, overflowSet, l.Attributes(bob, m))
+	assert.Equal(t, overflowSet, l.Attributes(overflowSet, m), "overflow not allowed")
+
+	clear(m)
+	l.release()
+	assert.Zero(t, b.Used())
+	assert.Equal(t, bob, l.Attributes(bob, m))
+}
+
+func TestBuilderBudget(t *testing.T) {
+	b := NewBudget(pointOverhead + attrSize(alice))
+	in, out := Builder[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		Account:     b.Account(),
+	}.Sum(true)
+
+	ctx := context.Background()
+	in(ctx, 1, alice)
+	in(ctx, 2, bob)
+	in(ctx, 3, carol)
+
+	var got metricdata.Aggregation = metricdata.Sum[int64]{}
+	assert.Equal(t, 2, out(&got))
+	assert.ElementsMatch(t, []metricdata.DataPoint[int64]{
+		{Attributes: alice, Value: 1},
+		{Attributes: overflowSet, Value: 5},
+	}, zeroTimes(got.(metricdata.Sum[int64]).DataPoints))
+
+	// Delta collection releases the budget.
+	assert.Zero(t, b.Used())
+	in(ctx, 2, bob)
+	assert.Equal(t, 1, out(&got))
+	assert.Equal(t, bob, got.(metricdata.Sum[int64]).DataPoints[0].Attributes)
+}
+
+func zeroTimes[N int64 | float64](dPts []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	for i := range dPts {
+		dPts[i].StartTime, dPts[i].Time = time.Time{}, time.Time{}
+	}
+	return dPts
+}
@@ -40,3 +40,19 @@ func collectExemplars[N int64 | float64](out *[]metricdata.Exemplar[N], f func(*
 		}
 	}
 }
+
+// collectActivity sets out to the activity of the exemplar reservoir r, or to
+// nil if r does not report its activity. The ExemplarActivity out points to is
+// reused.
+func collectActivity(out **metricdata.ExemplarActivity, r exemplar.Reservoir) {
+	ar, ok := r.(exemplar.ActivityReporter)
+	if !ok {
+		*out = nil
+		return
+	}
+	a := ar.Activity()
+	if *out == nil {
+		*out = new(metricdata.ExemplarActivity)
+	}
+	(*out).Offered, (*out).Sampled = a.Offered, a.Sampled
+}
@@ -377,11 +377,13 @@ func (e *expoHistogram[N]) delta(dest *metricdata.Aggregation) int {
 		}
 
 		collectExemplars(&hDPts[i].Exemplars, val.res.Collect)
+		collectActivity(&hDPts[i].ExemplarActivity, val.res)
 
 		i++
 	}
 	// Unused attribute sets do not report.
 	clear(e.values)
+	e.limit.release()
 
 	e.start = t
 	h.DataPoints = hDPts
@@ -430,6 +432,7 @@ func (e *expoHistogram[N]) cumulative(dest *metricdata.Aggregation) int {
 		}
 
 		collectExemplars(&hDPts[i].Exemplars, val.res.Collect)
+		collectActivity(&hDPts[i].ExemplarActivity, val.res)
 
 		i++
 		// TODO (#3006): This will use an unbounded amount of memory if there
@@ -164,11 +164,13 @@ func (s *histogram[N]) delta(dest *metricdata.Aggregation) int {
 		}
 
 		collectExemplars(&hDPts[i].Exemplars, val.res.Collect)
+		collectActivity(&hDPts[i].ExemplarActivity, val.res)
 
 		i++
 	}
 	// Unused attribute sets do not report.
 	clear(s.values)
+	s.limit.release()
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -220,6 +222,7 @@ func (s *histogram[N]) cumulative(dest *metricdata.Aggregation) int {
 		}
 
 		collectExemplars(&hDPts[i].Exemplars, val.res.Collect)
+		collectActivity(&hDPts[i].ExemplarActivity, val.res)
 
 		i++
 		// TODO (#3006): This will use an unbounded amount of memory if there
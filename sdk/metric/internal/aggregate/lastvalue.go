@@ -73,8 +73,10 @@ func (s *lastValue[N]) computeAggregation(dest *[]metricdata.DataPoint[N]) {
 		(*dest)[i].Time = v.timestamp
 		(*dest)[i].Value = v.value
 		collectExemplars(&(*dest)[i].Exemplars, v.res.Collect)
+		collectActivity(&(*dest)[i].ExemplarActivity, v.res)
 		i++
 	}
 	// Do not report stale values.
 	clear(s.values)
+	s.limit.release()
 }
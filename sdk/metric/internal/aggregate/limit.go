@@ -19,6 +19,13 @@ type limiter[V any] struct {
 	// into an "overflow" metric stream. That stream will only contain the
 	// "otel.metric.overflow"=true attribute.
 	aggLimit int
+
+	// account is the memory budget share of the aggregate values. If nil, no
+	// memory limit is imposed.
+	account *Account
+	// pointSize is the estimated size, in bytes, of an aggregate value not
+	// including its attributes.
+	pointSize int64
 }
 
 // newLimiter returns a new Limiter with the provided aggregation limit.
@@ -26,17 +33,41 @@ func newLimiter[V any](aggregation int) limiter[V] {
 	return limiter[V]{aggLimit: aggregation}
 }
 
+// setBudget sets the memory budget share of the aggregate values to a. The
+// estimated size of an aggregate value, not including its attributes, is
+// pointSize bytes.
+func (l *limiter[V]) setBudget(a *Account, pointSize int64) {
+	l.account = a
+	l.pointSize = pointOverhead + pointSize
+}
+
 // Attributes checks if adding a measurement for attrs will exceed the
-// aggregation cardinality limit for the existing measurements. If it will,
-// overflowSet is returned. Otherwise, if it will not exceed the limit, or the
-// limit is not set (limit <= 0), attr is returned.
+// aggregation cardinality limit, or the memory budget, for the existing
+// measurements. If it will, overflowSet is returned. Otherwise, if it will not
+// exceed the limits, or the limits are not set, attr is returned.
 func (l limiter[V]) Attributes(attrs attribute.Set, measurements map[attribute.Distinct]V) attribute.Set {
-	if l.aggLimit > 0 {
-		_, exists := measurements[attrs.Equivalent()]
-		if !exists && len(measurements) >= l.aggLimit-1 {
-			return overflowSet
-		}
+	if l.aggLimit <= 0 && l.account == nil {
+		return attrs
 	}
 
+	if _, exists := measurements[attrs.Equivalent()]; exists {
+		return attrs
+	}
+	if l.aggLimit > 0 && len(measurements) >= l.aggLimit-1 {
+		return overflowSet
+	}
+	if attrs.Equals(&overflowSet) {
+		// The overflow value is not accounted for. There is at most one.
+		return attrs
+	}
+	if !l.account.reserve(l.pointSize + attrSize(attrs)) {
+		return overflowSet
+	}
 	return attrs
 }
+
+// release releases the memory budget reserved for the aggregate values. It
+// needs to be called when the values are cleared.
+func (l limiter[V]) release() {
+	l.account.releaseAll()
+}
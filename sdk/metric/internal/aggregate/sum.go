@@ -95,10 +95,12 @@ func (s *sum[N]) delta(dest *metricdata.Aggregation) int {
 		dPts[i].Time = t
 		dPts[i].Value = val.n
 		collectExemplars(&dPts[i].Exemplars, val.res.Collect)
+		collectActivity(&dPts[i].ExemplarActivity, val.res)
 		i++
 	}
 	// Do not report stale values.
 	clear(s.values)
+	s.limit.release()
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -130,6 +132,7 @@ func (s *sum[N]) cumulative(dest *metricdata.Aggregation) int {
 		dPts[i].Time = t
 		dPts[i].Value = value.n
 		collectExemplars(&dPts[i].Exemplars, value.res.Collect)
+		collectActivity(&dPts[i].ExemplarActivity, value.res)
 		// TODO (#3006): This will use an unbounded amount of memory if there
 		// are unbounded number of attribute sets being aggregated. Attribute
 		// sets that become "stale" need to be forgotten so this will not
@@ -189,12 +192,14 @@ func (s *precomputedSum[N]) delta(dest *metricdata.Aggregation) int {
 		dPts[i].Time = t
 		dPts[i].Value = delta
 		collectExemplars(&dPts[i].Exemplars, value.res.Collect)
+		collectActivity(&dPts[i].ExemplarActivity, value.res)
 
 		newReported[key] = value.n
 		i++
 	}
 	// Unused attribute sets do not report.
 	clear(s.values)
+	s.limit.release()
 	s.reported = newReported
 	// The delta collection cycle resets.
 	s.start = t
@@ -227,11 +232,13 @@ func (s *precomputedSum[N]) cumulative(dest *metricdata.Aggregation) int {
 		dPts[i].Time = t
 		dPts[i].Value = val.n
 		collectExemplars(&dPts[i].Exemplars, val.res.Collect)
+		collectActivity(&dPts[i].ExemplarActivity, val.res)
 
 		i++
 	}
 	// Unused attribute sets do not report.
 	clear(s.values)
+	s.limit.release()
 
 	sData.DataPoints = dPts
 	*dest = sData
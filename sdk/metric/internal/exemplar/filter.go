@@ -11,19 +11,60 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// Activity is the activity of the exemplar reservoir of a timeseries.
+type Activity struct {
+	// Offered is the number of measurements offered to the exemplar filter.
+	Offered uint64
+	// Sampled is the number of offered measurements that passed the exemplar
+	// filter and were offered to the reservoir.
+	Sampled uint64
+}
+
+// ActivityReporter is implemented by the [Reservoir] values reporting their
+// [Activity].
+type ActivityReporter interface {
+	// Activity returns the activity of the Reservoir since it was created.
+	Activity() Activity
+}
+
 // SampledFilter returns a [Reservoir] wrapping r that will only offer measurements
 // to r if the passed context associated with the measurement contains a sampled
 // [go.opentelemetry.io/otel/trace.SpanContext].
+//
+// The returned Reservoir is an [ActivityReporter].
 func SampledFilter(r Reservoir) Reservoir {
-	return filtered{Reservoir: r}
+	return &filtered{Reservoir: r, filter: isSampled}
+}
+
+// Unfiltered returns a [Reservoir] wrapping r that offers all measurements to
+// r.
+//
+// The returned Reservoir is an [ActivityReporter].
+func Unfiltered(r Reservoir) Reservoir {
+	return &filtered{Reservoir: r}
+}
+
+func isSampled(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsSampled()
 }
 
 type filtered struct {
 	Reservoir
+
+	// filter reports if the measurement made with ctx is offered to
+	// Reservoir. All measurements are offered if it is nil.
+	filter   func(ctx context.Context) bool
+	activity Activity
 }
 
-func (f filtered) Offer(ctx context.Context, t time.Time, n Value, a []attribute.KeyValue) {
-	if trace.SpanContextFromContext(ctx).IsSampled() {
+func (f *filtered) Offer(ctx context.Context, t time.Time, n Value, a []attribute.KeyValue) {
+	f.activity.Offered++
+	if f.filter == nil || f.filter(ctx) {
+		f.activity.Sampled++
 		f.Reservoir.Offer(ctx, t, n, a)
 	}
 }
+
+func (f *filtered) Activity() Activity {
+	return f.activity
+}
@@ -32,6 +32,17 @@ func testSampledFiltered[N int64 | float64](t *testing.T) {
 
 	r.Collect(nil)
 	assert.True(t, under.CollectCalled, "underlying Reservoir Collect not called")
+
+	assert.Equal(t, Activity{Offered: 2, Sampled: 1}, r.(ActivityReporter).Activity())
+}
+
+func TestUnfiltered(t *testing.T) {
+	under := &res{}
+	r := Unfiltered(under)
+
+	r.Offer(context.Background(), staticTime, NewValue(int64(0)), nil)
+	assert.True(t, under.OfferCalled, "underlying Reservoir Offer not called")
+	assert.Equal(t, Activity{Offered: 1, Sampled: 1}, r.(ActivityReporter).Activity())
 }
 
 func sample(parent context.Context) context.Context {
@@ -8,9 +8,10 @@
 package x // import "go.opentelemetry.io/otel/sdk/metric/internal/x"
 
 import (
-	"os"
 	"strconv"
 	"strings"
+
+	"go.opentelemetry.io/otel/experimental"
 )
 
 var (
@@ -20,7 +21,7 @@ var (
 	// To enable this feature set the OTEL_GO_X_EXEMPLAR environment variable
 	// to the case-insensitive string value of "true" (i.e. "True" and "TRUE"
 	// will also enable this).
-	Exemplars = newFeature("EXEMPLAR", func(v string) (string, bool) {
+	Exemplars = newFeature("EXEMPLAR", "Record exemplars for metric data-points.", func(v string) (string, bool) {
 		if strings.ToLower(v) == "true" {
 			return v, true
 		}
@@ -35,7 +36,7 @@ var (
 	//
 	// Setting OTEL_GO_X_CARDINALITY_LIMIT to a value less than or equal to 0
 	// will disable the cardinality limits.
-	CardinalityLimit = newFeature("CARDINALITY_LIMIT", func(v string) (int, bool) {
+	CardinalityLimit = newFeature("CARDINALITY_LIMIT", "Limit the cardinality of the recorded metric data-points.", func(v string) (int, bool) {
 		n, err := strconv.Atoi(v)
 		if err != nil {
 			return 0, false
@@ -51,27 +52,38 @@ type Feature[T any] struct {
 	parse func(v string) (T, bool)
 }
 
-func newFeature[T any](suffix string, parse func(string) (T, bool)) Feature[T] {
+// newFeature returns a new Feature registered in the experimental feature
+// registry.
+func newFeature[T any](suffix, description string, parse func(string) (T, bool)) Feature[T] {
 	const envKeyRoot = "OTEL_GO_X_"
-	return Feature[T]{
+	f := Feature[T]{
 		key:   envKeyRoot + suffix,
 		parse: parse,
 	}
+	experimental.Register(f.key, description, func(v string) bool {
+		_, ok := parse(v)
+		return ok
+	})
+	return f
 }
 
 // Key returns the environment variable key that needs to be set to enable the
-// feature.
+// feature. It also identifies the feature in the
+// go.opentelemetry.io/otel/experimental registry.
 func (f Feature[T]) Key() string { return f.key }
 
 // Lookup returns the user configured value for the feature and true if the
 // user has enabled the feature. Otherwise, if the feature is not enabled, a
 // zero-value and false are returned.
+//
+// A value configured with the go.opentelemetry.io/otel/experimental registry
+// takes precedence over the environment variable.
 func (f Feature[T]) Lookup() (v T, ok bool) {
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/62effed618589a0bec416a87e559c0a9d96289bb/specification/configuration/sdk-environment-variables.md#parsing-empty-value
 	//
 	// > The SDK MUST interpret an empty value of an environment variable the
 	// > same way as when the variable is unset.
-	vRaw := os.Getenv(f.key)
+	vRaw := experimental.Value(f.key)
 	if vRaw == "" {
 		return v, ok
 	}
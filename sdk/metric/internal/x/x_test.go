@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/experimental"
 )
 
 func TestExemplars(t *testing.T) {
@@ -68,3 +70,48 @@ func assertDisabled[T any](f Feature[T]) func(*testing.T) {
 		assert.Equal(t, zero, v, "Lookup value")
 	}
 }
+
+func TestExperimentalRegistry(t *testing.T) {
+	const key = "OTEL_GO_X_EXEMPLAR"
+	t.Cleanup(func() { require.NoError(t, experimental.Reset(key)) })
+
+	t.Run("Enable", run(
+		setenv(key, "false"),
+		enable(key, "true"),
+		assertEnabled(Exemplars, "true"),
+	))
+	t.Run("Disable", run(
+		setenv(key, "true"),
+		disable(key),
+		assertDisabled(Exemplars),
+	))
+	t.Run("Reset", run(
+		setenv(key, "true"),
+		disable(key),
+		reset(key),
+		assertEnabled(Exemplars, "true"),
+	))
+	t.Run("InvalidValue", func(t *testing.T) {
+		assert.Error(t, experimental.Enable(key, "false"))
+	})
+	t.Run("Features", func(t *testing.T) {
+		var keys []string
+		for _, f := range experimental.Features() {
+			keys = append(keys, f.Key)
+		}
+		assert.Contains(t, keys, Exemplars.Key())
+		assert.Contains(t, keys, CardinalityLimit.Key())
+	})
+}
+
+func enable(k, v string) func(*testing.T) {
+	return func(t *testing.T) { require.NoError(t, experimental.Enable(k, v)) }
+}
+
+func disable(k string) func(*testing.T) {
+	return func(t *testing.T) { require.NoError(t, experimental.Disable(k)) }
+}
+
+func reset(k string) func(*testing.T) {
+	return func(t *testing.T) { require.NoError(t, experimental.Reset(k)) }
+}
@@ -26,6 +26,9 @@ type ManualReader struct {
 
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+
+	coalescer *coalescer
+	zeroDelta *zeroDeltaFilter
 }
 
 // Compile time check the manualReader implements Reader and is comparable.
@@ -37,6 +40,8 @@ func NewManualReader(opts ...ManualReaderOption) *ManualReader {
 	r := &ManualReader{
 		temporalitySelector: cfg.temporalitySelector,
 		aggregationSelector: cfg.aggregationSelector,
+		coalescer:           cfg.coalescer,
+		zeroDelta:           cfg.zeroDelta,
 	}
 	r.externalProducers.Store(cfg.producers)
 	return r
@@ -109,22 +114,26 @@ func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetr
 		return err
 	}
 
-	err := ph.produce(ctx, rm)
-	if err != nil {
-		return err
-	}
-	var errs []error
-	for _, producer := range mr.externalProducers.Load().([]Producer) {
-		externalMetrics, err := producer.Produce(ctx)
+	return mr.coalescer.collect(ctx, rm, func(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+		err := ph.produce(ctx, rm)
 		if err != nil {
-			errs = append(errs, err)
+			return err
 		}
-		rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
-	}
+		var errs []error
+		for _, producer := range mr.externalProducers.Load().([]Producer) {
+			externalMetrics, err := producer.Produce(ctx)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
+		}
+
+		mr.zeroDelta.filter(rm)
 
-	global.Debug("ManualReader collection", "Data", rm)
+		global.Debug("ManualReader collection", "Data", rm)
 
-	return unifyErrors(errs)
+		return unifyErrors(errs)
+	})
 }
 
 // MarshalLog returns logging data about the ManualReader.
@@ -148,6 +157,8 @@ type manualReaderConfig struct {
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
 	producers           []Producer
+	coalescer           *coalescer
+	zeroDelta           *zeroDeltaFilter
 }
 
 // newManualReaderConfig returns a manualReaderConfig configured with options.
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
@@ -16,10 +18,6 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 )
 
-// ErrInstrumentName indicates the created instrument has an invalid name.
-// Valid names must consist of 255 or fewer characters including alphanumeric, _, ., -, / and start with a letter.
-var ErrInstrumentName = errors.New("invalid instrument name")
-
 // meter handles the creation and coordination of all metric instruments. A
 // meter represents a single instrumentation scope; all metric telemetry
 // produced by an instrumentation scope will use metric instruments from a
@@ -37,9 +35,26 @@ type meter struct {
 
 	int64Resolver   resolver[int64]
 	float64Resolver resolver[float64]
+
+	// reconfig guards the creation of instruments against the update of the
+	// views of the MeterProvider.
+	reconfig *sync.RWMutex
+
+	rebuildsMu sync.Mutex
+	// rebuilds resolve the aggregations of the instruments created by the
+	// meter again, in creation order.
+	rebuilds []func() error
+
+	// sanitize is true if the invalid instrument names and units are replaced
+	// with valid ones instead of resulting in an error.
+	sanitize bool
 }
 
-func newMeter(s instrumentation.Scope, p pipelines) *meter {
+func newMeter(s instrumentation.Scope, p pipelines, reconfig *sync.RWMutex, sanitize bool) *meter {
+	if reconfig == nil {
+		reconfig = new(sync.RWMutex)
+	}
+
 	// viewCache ensures instrument conflicts, including number conflicts, this
 	// meter is asked to create are logged to the user.
 	var viewCache cache[string, instID]
@@ -58,7 +73,42 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 		float64ObservableInsts: &float64ObservableInsts,
 		int64Resolver:          newResolver[int64](p, &viewCache),
 		float64Resolver:        newResolver[float64](p, &viewCache),
+		reconfig:               reconfig,
+		sanitize:               sanitize,
+	}
+}
+
+// addRebuild adds f to the functions called to resolve the aggregations of
+// the instruments of m again when the views are updated.
+func (m *meter) addRebuild(f func() error) {
+	m.rebuildsMu.Lock()
+	defer m.rebuildsMu.Unlock()
+	m.rebuilds = append(m.rebuilds, f)
+}
+
+// rebuild resolves the aggregations of all the instruments created by m again
+// using the current views of its pipelines. Aggregations of unchanged streams
+// are preserved.
+//
+// The caller needs to hold the reconfig lock of m.
+func (m *meter) rebuild() error {
+	var viewCache cache[string, instID]
+	m.int64Resolver = m.int64Resolver.rebuild(&viewCache)
+	m.float64Resolver = m.float64Resolver.rebuild(&viewCache)
+
+	m.rebuildsMu.Lock()
+	rebuilds := slices.Clone(m.rebuilds)
+	m.rebuildsMu.Unlock()
+
+	var errs []error
+	for _, f := range rebuilds {
+		errs = append(errs, f())
 	}
+
+	// Release the replaced aggregations.
+	m.int64Resolver.releasePrev()
+	m.float64Resolver.releasePrev()
+	return errors.Join(errs...)
 }
 
 // Compile-time check meter implements metric.Meter.
@@ -70,13 +120,14 @@ var _ metric.Meter = (*meter)(nil)
 func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
 	cfg := metric.NewInt64CounterConfig(options...)
 	const kind = InstrumentKindCounter
+	name, unit, vErr := m.validate(name, cfg.Unit())
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit)
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, vErr
 }
 
 // Int64UpDownCounter returns a new instrument identified by name and
@@ -85,13 +136,14 @@ func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption)
 func (m *meter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
 	cfg := metric.NewInt64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
+	name, unit, vErr := m.validate(name, cfg.Unit())
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit)
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, vErr
 }
 
 // Int64Histogram returns a new instrument identified by name and configured
@@ -99,18 +151,24 @@ func (m *meter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCou
 // distribution of int64 measurements during a computational operation.
 func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
 	cfg := metric.NewInt64HistogramConfig(options...)
+	name, unit, vErr := m.validate(name, cfg.Unit())
+	if unit != cfg.Unit() {
+		cfg = metric.NewInt64HistogramConfig(append(slices.Clip(options), metric.WithUnit(unit))...)
+	}
 	p := int64InstProvider{m}
 	i, err := p.lookupHistogram(name, cfg)
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, vErr
 }
 
 // int64ObservableInstrument returns a new observable identified by the Instrument.
 // It registers callbacks for each reader's pipeline.
 func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int64Callback) (int64Observable, error) {
+	var vErr error
+	id.Name, id.Unit, vErr = m.validate(id.Name, id.Unit)
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -120,29 +178,52 @@ func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int6
 	if m.int64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
 		warnRepeatedObservableCallbacks(id)
 	}
-	return m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
+	m.reconfig.RLock()
+	defer m.reconfig.RUnlock()
+	inst, err := m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
 		inst := newInt64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
-		for _, insert := range m.int64Resolver.inserters {
-			// Connect the measure functions for instruments in this pipeline with the
-			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
-			if err != nil {
-				return inst, err
-			}
-			// Drop aggregation
-			if len(in) == 0 {
-				inst.dropAggregation = true
-				continue
-			}
-			inst.appendMeasures(in)
-			for _, cback := range callbacks {
-				inst := int64Observer{measures: in}
-				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
-			}
+		resolve := func() error {
+			return m.resolveInt64Observable(id, inst.observable, callbacks)
 		}
-		return inst, validateInstrumentName(id.Name)
+		m.addRebuild(resolve)
+		return inst, resolve()
 	})
+	if err != nil {
+		return inst, err
+	}
+	return inst, vErr
+}
+
+// resolveInt64Observable resolves the aggregations of o in all pipelines and
+// adds callbacks to the pipelines that do not drop o.
+func (m *meter) resolveInt64Observable(id Instrument, o *observable[int64], callbacks []metric.Int64Callback) error {
+	var (
+		err          error
+		drop         bool
+		pipeMeasures = make([]measures[int64], len(m.int64Resolver.inserters))
+	)
+	for i, insert := range m.int64Resolver.inserters {
+		// Connect the measure functions for instruments in this pipeline with the
+		// callbacks for this pipeline.
+		var in []aggregate.Measure[int64]
+		in, err = insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+		if err != nil {
+			break
+		}
+		// Drop aggregation
+		if len(in) == 0 {
+			drop = true
+			continue
+		}
+		pipeMeasures[i] = in
+		for _, cback := range callbacks {
+			inst := int64Observer{observable: o, pipe: i}
+			fn := cback
+			insert.addCallback(m.guardCallback(func(ctx context.Context) error { return fn(ctx, inst) }))
+		}
+	}
+	o.setMeasures(pipeMeasures, drop)
+	return err
 }
 
 // Int64ObservableCounter returns a new instrument identified by name and
@@ -204,13 +285,14 @@ func (m *meter) Int64ObservableGauge(name string, options ...metric.Int64Observa
 func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error) {
 	cfg := metric.NewFloat64CounterConfig(options...)
 	const kind = InstrumentKindCounter
+	name, unit, vErr := m.validate(name, cfg.Unit())
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit)
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, vErr
 }
 
 // Float64UpDownCounter returns a new instrument identified by name and
@@ -219,13 +301,14 @@ func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOpti
 func (m *meter) Float64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
 	cfg := metric.NewFloat64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
+	name, unit, vErr := m.validate(name, cfg.Unit())
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit)
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, vErr
 }
 
 // Float64Histogram returns a new instrument identified by name and configured
@@ -233,18 +316,24 @@ func (m *meter) Float64UpDownCounter(name string, options ...metric.Float64UpDow
 // distribution of float64 measurements during a computational operation.
 func (m *meter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
 	cfg := metric.NewFloat64HistogramConfig(options...)
+	name, unit, vErr := m.validate(name, cfg.Unit())
+	if unit != cfg.Unit() {
+		cfg = metric.NewFloat64HistogramConfig(append(slices.Clip(options), metric.WithUnit(unit))...)
+	}
 	p := float64InstProvider{m}
 	i, err := p.lookupHistogram(name, cfg)
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, vErr
 }
 
 // float64ObservableInstrument returns a new observable identified by the Instrument.
 // It registers callbacks for each reader's pipeline.
 func (m *meter) float64ObservableInstrument(id Instrument, callbacks []metric.Float64Callback) (float64Observable, error) {
+	var vErr error
+	id.Name, id.Unit, vErr = m.validate(id.Name, id.Unit)
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -254,29 +343,52 @@ func (m *meter) float64ObservableInstrument(id Instrument, callbacks []metric.Fl
 	if m.int64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
 		warnRepeatedObservableCallbacks(id)
 	}
-	return m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
+	m.reconfig.RLock()
+	defer m.reconfig.RUnlock()
+	inst, err := m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
 		inst := newFloat64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
-		for _, insert := range m.float64Resolver.inserters {
-			// Connect the measure functions for instruments in this pipeline with the
-			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
-			if err != nil {
-				return inst, err
-			}
-			// Drop aggregation
-			if len(in) == 0 {
-				inst.dropAggregation = true
-				continue
-			}
-			inst.appendMeasures(in)
-			for _, cback := range callbacks {
-				inst := float64Observer{measures: in}
-				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
-			}
+		resolve := func() error {
+			return m.resolveFloat64Observable(id, inst.observable, callbacks)
 		}
-		return inst, validateInstrumentName(id.Name)
+		m.addRebuild(resolve)
+		return inst, resolve()
 	})
+	if err != nil {
+		return inst, err
+	}
+	return inst, vErr
+}
+
+// resolveFloat64Observable resolves the aggregations of o in all pipelines and
+// adds callbacks to the pipelines that do not drop o.
+func (m *meter) resolveFloat64Observable(id Instrument, o *observable[float64], callbacks []metric.Float64Callback) error {
+	var (
+		err          error
+		drop         bool
+		pipeMeasures = make([]measures[float64], len(m.float64Resolver.inserters))
+	)
+	for i, insert := range m.float64Resolver.inserters {
+		// Connect the measure functions for instruments in this pipeline with the
+		// callbacks for this pipeline.
+		var in []aggregate.Measure[float64]
+		in, err = insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+		if err != nil {
+			break
+		}
+		// Drop aggregation
+		if len(in) == 0 {
+			drop = true
+			continue
+		}
+		pipeMeasures[i] = in
+		for _, cback := range callbacks {
+			inst := float64Observer{observable: o, pipe: i}
+			fn := cback
+			insert.addCallback(m.guardCallback(func(ctx context.Context) error { return fn(ctx, inst) }))
+		}
+	}
+	o.setMeasures(pipeMeasures, drop)
+	return err
 }
 
 // Float64ObservableCounter returns a new instrument identified by name and
@@ -332,35 +444,6 @@ func (m *meter) Float64ObservableGauge(name string, options ...metric.Float64Obs
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
 
-func validateInstrumentName(name string) error {
-	if len(name) == 0 {
-		return fmt.Errorf("%w: %s: is empty", ErrInstrumentName, name)
-	}
-	if len(name) > 255 {
-		return fmt.Errorf("%w: %s: longer than 255 characters", ErrInstrumentName, name)
-	}
-	if !isAlpha([]rune(name)[0]) {
-		return fmt.Errorf("%w: %s: must start with a letter", ErrInstrumentName, name)
-	}
-	if len(name) == 1 {
-		return nil
-	}
-	for _, c := range name[1:] {
-		if !isAlphanumeric(c) && c != '_' && c != '.' && c != '-' && c != '/' {
-			return fmt.Errorf("%w: %s: must only contain [A-Za-z0-9_.-/]", ErrInstrumentName, name)
-		}
-	}
-	return nil
-}
-
-func isAlpha(c rune) bool {
-	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
-}
-
-func isAlphanumeric(c rune) bool {
-	return isAlpha(c) || ('0' <= c && c <= '9')
-}
-
 func warnRepeatedObservableCallbacks(id Instrument) {
 	inst := fmt.Sprintf(
 		"Instrument{Name: %q, Description: %q, Kind: %q, Unit: %q}",
@@ -431,10 +514,51 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 	}
 
 	// Some or all instruments were valid.
-	cback := func(ctx context.Context) error { return f(ctx, reg) }
+	cback := m.guardCallback(func(ctx context.Context) error { return f(ctx, reg) })
 	return m.pipes.registerMultiCallback(cback), err
 }
 
+// CallbackError is the error returned when a callback registered with a Meter
+// returns an error or panics during a collection.
+type CallbackError struct {
+	// Scope is the instrumentation scope of the Meter the callback was
+	// registered with.
+	Scope instrumentation.Scope
+	// Err is the error returned by the callback, or an error describing the
+	// recovered panic.
+	Err error
+	// Panic is true if the callback panicked.
+	Panic bool
+}
+
+func (e *CallbackError) Error() string {
+	if e.Panic {
+		return fmt.Sprintf("metric callback panic (scope %q): %v", e.Scope.Name, e.Err)
+	}
+	return fmt.Sprintf("metric callback failed (scope %q): %v", e.Scope.Name, e.Err)
+}
+
+// Unwrap returns the error returned by the callback.
+func (e *CallbackError) Unwrap() error {
+	return e.Err
+}
+
+// guardCallback returns f wrapped so that any error it returns, or panic it
+// raises, is returned as a *CallbackError with the scope of m.
+func (m *meter) guardCallback(f func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = &CallbackError{Scope: m.scope, Err: fmt.Errorf("%v", r), Panic: true}
+			}
+		}()
+		if err := f(ctx); err != nil {
+			return &CallbackError{Scope: m.scope, Err: err}
+		}
+		return nil
+	}
+}
+
 type observer struct {
 	embedded.Observer
 
@@ -487,7 +611,7 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 	}
 
 	if _, registered := r.float64[oImpl.observablID]; !registered {
-		if !oImpl.dropAggregation {
+		if !oImpl.dropAggregation.Load() {
 			global.Error(errUnregObserver, "failed to record",
 				"name", oImpl.name,
 				"description", oImpl.description,
@@ -522,7 +646,7 @@ func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric
 	}
 
 	if _, registered := r.int64[oImpl.observablID]; !registered {
-		if !oImpl.dropAggregation {
+		if !oImpl.dropAggregation.Load() {
 			global.Error(errUnregObserver, "failed to record",
 				"name", oImpl.name,
 				"description", oImpl.description,
@@ -576,6 +700,8 @@ func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramC
 
 // lookup returns the resolved instrumentImpl.
 func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*int64Inst, error) {
+	p.reconfig.RLock()
+	defer p.reconfig.RUnlock()
 	return p.meter.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
@@ -583,12 +709,20 @@ func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*i
 		Kind:        kind,
 	}, func() (*int64Inst, error) {
 		aggs, err := p.aggs(kind, name, desc, u)
-		return &int64Inst{measures: aggs}, err
+		inst := newInt64Inst(aggs)
+		p.addRebuild(func() error {
+			aggs, err := p.aggs(kind, name, desc, u)
+			inst.setMeasures(aggs)
+			return err
+		})
+		return inst, err
 	})
 }
 
 // lookupHistogram returns the resolved instrumentImpl.
 func (p int64InstProvider) lookupHistogram(name string, cfg metric.Int64HistogramConfig) (*int64Inst, error) {
+	p.reconfig.RLock()
+	defer p.reconfig.RUnlock()
 	return p.meter.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
@@ -596,7 +730,13 @@ func (p int64InstProvider) lookupHistogram(name string, cfg metric.Int64Histogra
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
 		aggs, err := p.histogramAggs(name, cfg)
-		return &int64Inst{measures: aggs}, err
+		inst := newInt64Inst(aggs)
+		p.addRebuild(func() error {
+			aggs, err := p.histogramAggs(name, cfg)
+			inst.setMeasures(aggs)
+			return err
+		})
+		return inst, err
 	})
 }
 
@@ -634,6 +774,8 @@ func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64Histog
 
 // lookup returns the resolved instrumentImpl.
 func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*float64Inst, error) {
+	p.reconfig.RLock()
+	defer p.reconfig.RUnlock()
 	return p.meter.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
@@ -641,12 +783,20 @@ func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (
 		Kind:        kind,
 	}, func() (*float64Inst, error) {
 		aggs, err := p.aggs(kind, name, desc, u)
-		return &float64Inst{measures: aggs}, err
+		inst := newFloat64Inst(aggs)
+		p.addRebuild(func() error {
+			aggs, err := p.aggs(kind, name, desc, u)
+			inst.setMeasures(aggs)
+			return err
+		})
+		return inst, err
 	})
 }
 
 // lookupHistogram returns the resolved instrumentImpl.
 func (p float64InstProvider) lookupHistogram(name string, cfg metric.Float64HistogramConfig) (*float64Inst, error) {
+	p.reconfig.RLock()
+	defer p.reconfig.RUnlock()
 	return p.meter.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
@@ -654,26 +804,38 @@ func (p float64InstProvider) lookupHistogram(name string, cfg metric.Float64Hist
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
 		aggs, err := p.histogramAggs(name, cfg)
-		return &float64Inst{measures: aggs}, err
+		inst := newFloat64Inst(aggs)
+		p.addRebuild(func() error {
+			aggs, err := p.histogramAggs(name, cfg)
+			inst.setMeasures(aggs)
+			return err
+		})
+		return inst, err
 	})
 }
 
+// int64Observer observes the measurements of an observable in a single
+// pipeline.
 type int64Observer struct {
 	embedded.Int64Observer
-	measures[int64]
+	observable *observable[int64]
+	pipe       int
 }
 
 func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
 	c := metric.NewObserveConfig(opts)
-	o.observe(val, c.Attributes())
+	o.observable.observePipe(o.pipe, val, c.Attributes())
 }
 
+// float64Observer observes the measurements of an observable in a single
+// pipeline.
 type float64Observer struct {
 	embedded.Float64Observer
-	measures[float64]
+	observable *observable[float64]
+	pipe       int
 }
 
 func (o float64Observer) Observe(val float64, opts ...metric.ObserveOption) {
 	c := metric.NewObserveConfig(opts)
-	o.observe(val, c.Attributes())
+	o.observable.observePipe(o.pipe, val, c.Attributes())
 }
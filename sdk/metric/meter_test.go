@@ -556,7 +556,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Int64UpDownCounter with no validation issues",
@@ -576,7 +576,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Int64Histogram with no validation issues",
@@ -596,7 +596,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Int64Histogram with invalid buckets",
@@ -627,7 +627,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Int64ObservableUpDownCounter with no validation issues",
@@ -647,7 +647,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Int64ObservableGauge with no validation issues",
@@ -667,7 +667,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Float64Counter with no validation issues",
@@ -687,7 +687,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Float64UpDownCounter with no validation issues",
@@ -707,7 +707,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Float64Histogram with no validation issues",
@@ -727,7 +727,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Float64Histogram with invalid buckets",
@@ -758,7 +758,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Float64ObservableUpDownCounter with no validation issues",
@@ -778,7 +778,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 		{
 			name: "Float64ObservableGauge with no validation issues",
@@ -798,7 +798,7 @@ func TestMeterCreatesInstrumentsValidations(t *testing.T) {
 				return err
 			},
 
-			wantErr: fmt.Errorf("%w: _: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "_", Reason: "must start with a letter", Suggestion: "x_"},
 		},
 	}
 
@@ -824,11 +824,11 @@ func TestValidateInstrumentName(t *testing.T) {
 	}{
 		{
 			name:    "",
-			wantErr: fmt.Errorf("%w: : is empty", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "", Reason: "is empty", Position: -1},
 		},
 		{
 			name:    "1",
-			wantErr: fmt.Errorf("%w: 1: must start with a letter", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "1", Reason: "must start with a letter", Suggestion: "x1"},
 		},
 		{
 			name: "a",
@@ -850,11 +850,11 @@ func TestValidateInstrumentName(t *testing.T) {
 		},
 		{
 			name:    "name!",
-			wantErr: fmt.Errorf("%w: name!: must only contain [A-Za-z0-9_.-/]", ErrInstrumentName),
+			wantErr: &InstrumentNameError{Name: "name!", Reason: "must only contain [A-Za-z0-9_.-/]", Position: 4, Suggestion: "name_"},
 		},
 		{
 			name:    longName,
-			wantErr: fmt.Errorf("%w: %s: longer than 255 characters", ErrInstrumentName, longName),
+			wantErr: &InstrumentNameError{Name: longName, Reason: "longer than 255 characters", Position: -1, Suggestion: longName[:255]},
 		},
 	}
 
@@ -978,6 +978,40 @@ func TestCallbackObserverNonRegistered(t *testing.T) {
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
 }
 
+func TestCallbackError(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+
+	m1 := mp.Meter("failing")
+	_, err := m1.Int64ObservableCounter("ctr", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error { return assert.AnError },
+	))
+	require.NoError(t, err)
+
+	m2 := mp.Meter("panicking")
+	gauge, err := m2.Float64ObservableGauge("gauge")
+	require.NoError(t, err)
+	_, err = m2.RegisterCallback(func(context.Context, metric.Observer) error {
+		panic("callback panic")
+	}, gauge)
+	require.NoError(t, err)
+
+	var got metricdata.ResourceMetrics
+	assert.NotPanics(t, func() {
+		err = rdr.Collect(context.Background(), &got)
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, `metric callback failed (scope "failing")`)
+	assert.ErrorContains(t, err, `metric callback panic (scope "panicking"): callback panic`)
+
+	var cbErr *CallbackError
+	require.ErrorAs(t, err, &cbErr)
+	assert.Equal(t, instrumentation.Scope{Name: "failing"}, cbErr.Scope)
+	assert.False(t, cbErr.Panic)
+}
+
 type logSink struct {
 	logr.LogSink
 
@@ -84,6 +84,9 @@ type DataPoint[N int64 | float64] struct {
 
 	// Exemplars is the sampled Exemplars collected during the timeseries.
 	Exemplars []Exemplar[N] `json:",omitempty"`
+	// ExemplarActivity is the activity of the exemplar reservoir of the
+	// timeseries. It is nil if exemplars are not recorded. (optional)
+	ExemplarActivity *ExemplarActivity `json:",omitempty"`
 }
 
 // Histogram represents the histogram of all measurements of values from an instrument.
@@ -125,6 +128,9 @@ type HistogramDataPoint[N int64 | float64] struct {
 
 	// Exemplars is the sampled Exemplars collected during the timeseries.
 	Exemplars []Exemplar[N] `json:",omitempty"`
+	// ExemplarActivity is the activity of the exemplar reservoir of the
+	// timeseries. It is nil if exemplars are not recorded. (optional)
+	ExemplarActivity *ExemplarActivity `json:",omitempty"`
 }
 
 // ExponentialHistogram represents the histogram of all measurements of values from an instrument.
@@ -182,6 +188,9 @@ type ExponentialHistogramDataPoint[N int64 | float64] struct {
 
 	// Exemplars is the sampled Exemplars collected during the timeseries.
 	Exemplars []Exemplar[N] `json:",omitempty"`
+	// ExemplarActivity is the activity of the exemplar reservoir of the
+	// timeseries. It is nil if exemplars are not recorded. (optional)
+	ExemplarActivity *ExemplarActivity `json:",omitempty"`
 }
 
 // ExponentialBucket are a set of bucket counts, encoded in a contiguous array
@@ -225,6 +234,20 @@ func (e Extrema[N]) Value() (v N, defined bool) {
 	return e.value, e.valid
 }
 
+// ExemplarActivity is the activity of the exemplar reservoir of a timeseries.
+//
+// It tells the timeseries without exemplars because none of their
+// measurements were sampled, e.g. because they were not made in a sampled
+// span, apart from the ones without exemplars despite sampled measurements.
+type ExemplarActivity struct {
+	// Offered is the number of measurements offered to the exemplar filter
+	// since the StartTime of the timeseries.
+	Offered uint64
+	// Sampled is the number of offered measurements that passed the exemplar
+	// filter and were offered to the exemplar reservoir.
+	Sampled uint64
+}
+
 // Exemplar is a measurement sampled from a timeseries providing a typical
 // example.
 type Exemplar[N int64 | float64] struct {
@@ -266,6 +266,9 @@ func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config
 		if r != "" {
 			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
 		}
+		if !equalExemplarActivity(a.ExemplarActivity, b.ExemplarActivity) {
+			reasons = append(reasons, notEqualStr("ExemplarActivity", a.ExemplarActivity, b.ExemplarActivity))
+		}
 	}
 	return reasons
 }
@@ -320,6 +323,9 @@ func equalHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPo
 		if r != "" {
 			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
 		}
+		if !equalExemplarActivity(a.ExemplarActivity, b.ExemplarActivity) {
+			reasons = append(reasons, notEqualStr("ExemplarActivity", a.ExemplarActivity, b.ExemplarActivity))
+		}
 	}
 	return reasons
 }
@@ -349,6 +355,15 @@ func equalExponentialHistograms[N int64 | float64](a, b metricdata.ExponentialHi
 }
 
 // equalExponentialHistogramDataPoints returns reasons HistogramDataPoints are not equal.
+// equalExemplarActivity returns true if a and b are both nil or point to
+// equal ExemplarActivity.
+func equalExemplarActivity(a, b *metricdata.ExemplarActivity) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // If they are equal, the returned reasons will be empty.
 func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.ExponentialHistogramDataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
 	if !a.Attributes.Equals(&b.Attributes) {
@@ -408,6 +423,9 @@ func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.Expo
 		if r != "" {
 			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
 		}
+		if !equalExemplarActivity(a.ExemplarActivity, b.ExemplarActivity) {
+			reasons = append(reasons, notEqualStr("ExemplarActivity", a.ExemplarActivity, b.ExemplarActivity))
+		}
 	}
 	return reasons
 }
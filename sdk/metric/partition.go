@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Compile-time check PartitionedExporter implements Exporter.
+var _ Exporter = (*PartitionedExporter)(nil)
+
+// PartitionedExporter is an [Exporter] that partitions the exported data
+// points by the value of a designated attribute. Each partition is exported
+// in a separate export request by its own Exporter.
+//
+// It can be used to isolate the telemetry of multiple tenants at the request
+// level, e.g. exporting the data points of each tenant with an exporter
+// configured with a tenant-specific endpoint and headers.
+type PartitionedExporter struct {
+	key attribute.Key
+
+	// exporters holds the fallback exporter at index 0 followed by the
+	// partition exporters.
+	exporters []Exporter
+	// index maps a partition value to its exporter index.
+	index map[string]int
+}
+
+// NewPartitionedExporter returns a [PartitionedExporter] that exports the
+// data points with the key attribute value found in partitions using the
+// associated Exporter. All other data points are exported using fallback. If
+// fallback is nil, those data points are dropped.
+//
+// The Temporality and Aggregation of the returned exporter are the ones of
+// fallback. If fallback is nil, [DefaultTemporalitySelector] and
+// [DefaultAggregationSelector] are used. The partition exporters are expected
+// to use the same Temporality and Aggregation.
+//
+// Nil exporters in partitions are ignored. An exporter must not be
+// associated with more than one partition value.
+func NewPartitionedExporter(key attribute.Key, fallback Exporter, partitions map[string]Exporter) *PartitionedExporter {
+	if fallback == nil {
+		fallback = discardExporter{}
+	}
+	e := &PartitionedExporter{
+		key:       key,
+		exporters: []Exporter{fallback},
+		index:     make(map[string]int, len(partitions)),
+	}
+	for value, exp := range partitions {
+		if exp == nil {
+			continue
+		}
+		e.index[value] = len(e.exporters)
+		e.exporters = append(e.exporters, exp)
+	}
+	return e
+}
+
+// Temporality returns the Temporality of the fallback exporter.
+func (e *PartitionedExporter) Temporality(k InstrumentKind) metricdata.Temporality {
+	return e.exporters[0].Temporality(k)
+}
+
+// Aggregation returns the Aggregation of the fallback exporter.
+func (e *PartitionedExporter) Aggregation(k InstrumentKind) Aggregation {
+	return e.exporters[0].Aggregation(k)
+}
+
+// Export partitions the data points of rm and exports each partition
+// containing data points with its exporter. The fallback exporter is always
+// called, even if it has no data points to export. The errors returned by
+// the exporters are joined and returned.
+func (e *PartitionedExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	parts := make([]metricdata.ResourceMetrics, len(e.exporters))
+	for i := range parts {
+		parts[i].Resource = rm.Resource
+	}
+
+	metrics := make([][]metricdata.Metrics, len(e.exporters))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for i, data := range e.partition(m.Data) {
+				if data == nil {
+					continue
+				}
+				metrics[i] = append(metrics[i], metricdata.Metrics{
+					Name:        m.Name,
+					Description: m.Description,
+					Unit:        m.Unit,
+					Data:        data,
+				})
+			}
+		}
+		for i, ms := range metrics {
+			if len(ms) == 0 {
+				continue
+			}
+			parts[i].ScopeMetrics = append(parts[i].ScopeMetrics, metricdata.ScopeMetrics{
+				Scope:   sm.Scope,
+				Metrics: ms,
+			})
+			metrics[i] = nil
+		}
+	}
+
+	var err error
+	for i, exp := range e.exporters {
+		if i > 0 && len(parts[i].ScopeMetrics) == 0 {
+			continue
+		}
+		err = errors.Join(err, exp.Export(ctx, &parts[i]))
+	}
+	return err
+}
+
+// exporterIndex returns the exporter index of the data point with attrs.
+func (e *PartitionedExporter) exporterIndex(attrs attribute.Set) int {
+	v, ok := attrs.Value(e.key)
+	if !ok {
+		return 0
+	}
+	return e.index[v.Emit()]
+}
+
+// partition returns the data of each exporter, indexed as the exporters of
+// e. The data is nil for an exporter without data points.
+func (e *PartitionedExporter) partition(data metricdata.Aggregation) []metricdata.Aggregation {
+	switch a := data.(type) {
+	case metricdata.Gauge[int64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.DataPoint[int64]) metricdata.Aggregation {
+			return metricdata.Gauge[int64]{DataPoints: dps}
+		})
+	case metricdata.Gauge[float64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.DataPoint[float64]) metricdata.Aggregation {
+			return metricdata.Gauge[float64]{DataPoints: dps}
+		})
+	case metricdata.Sum[int64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.DataPoint[int64]) metricdata.Aggregation {
+			return metricdata.Sum[int64]{DataPoints: dps, Temporality: a.Temporality, IsMonotonic: a.IsMonotonic}
+		})
+	case metricdata.Sum[float64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.DataPoint[float64]) metricdata.Aggregation {
+			return metricdata.Sum[float64]{DataPoints: dps, Temporality: a.Temporality, IsMonotonic: a.IsMonotonic}
+		})
+	case metricdata.Histogram[int64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.HistogramDataPoint[int64]) metricdata.Aggregation {
+			return metricdata.Histogram[int64]{DataPoints: dps, Temporality: a.Temporality}
+		})
+	case metricdata.Histogram[float64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.HistogramDataPoint[float64]) metricdata.Aggregation {
+			return metricdata.Histogram[float64]{DataPoints: dps, Temporality: a.Temporality}
+		})
+	case metricdata.ExponentialHistogram[int64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.ExponentialHistogramDataPoint[int64]) metricdata.Aggregation {
+			return metricdata.ExponentialHistogram[int64]{DataPoints: dps, Temporality: a.Temporality}
+		})
+	case metricdata.ExponentialHistogram[float64]:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.ExponentialHistogramDataPoint[float64]) metricdata.Aggregation {
+			return metricdata.ExponentialHistogram[float64]{DataPoints: dps, Temporality: a.Temporality}
+		})
+	case metricdata.Summary:
+		return partitionDataPoints(e, a.DataPoints, func(dps []metricdata.SummaryDataPoint) metricdata.Aggregation {
+			return metricdata.Summary{DataPoints: dps}
+		})
+	}
+	// Unknown aggregations cannot be partitioned. Export them as a whole
+	// with the fallback exporter.
+	out := make([]metricdata.Aggregation, len(e.exporters))
+	out[0] = data
+	return out
+}
+
+// dataPoint is a metric data point.
+type dataPoint interface {
+	metricdata.DataPoint[int64] | metricdata.DataPoint[float64] |
+		metricdata.HistogramDataPoint[int64] | metricdata.HistogramDataPoint[float64] |
+		metricdata.ExponentialHistogramDataPoint[int64] | metricdata.ExponentialHistogramDataPoint[float64] |
+		metricdata.SummaryDataPoint
+}
+
+// partitionDataPoints partitions dps by exporter and returns the aggregation
+// built with newAgg for each exporter, indexed as the exporters of e.
+func partitionDataPoints[DP dataPoint](e *PartitionedExporter, dps []DP, newAgg func([]DP) metricdata.Aggregation) []metricdata.Aggregation {
+	parts := make([][]DP, len(e.exporters))
+	for j := range dps {
+		i := e.exporterIndex(dataPointAttributes(&dps[j]))
+		parts[i] = append(parts[i], dps[j])
+	}
+
+	out := make([]metricdata.Aggregation, len(e.exporters))
+	for i, p := range parts {
+		if len(p) > 0 {
+			out[i] = newAgg(p)
+		}
+	}
+	return out
+}
+
+// dataPointAttributes returns the attributes of dp.
+func dataPointAttributes[DP dataPoint](dp *DP) attribute.Set {
+	switch v := any(dp).(type) {
+	case *metricdata.DataPoint[int64]:
+		return v.Attributes
+	case *metricdata.DataPoint[float64]:
+		return v.Attributes
+	case *metricdata.HistogramDataPoint[int64]:
+		return v.Attributes
+	case *metricdata.HistogramDataPoint[float64]:
+		return v.Attributes
+	case *metricdata.ExponentialHistogramDataPoint[int64]:
+		return v.Attributes
+	case *metricdata.ExponentialHistogramDataPoint[float64]:
+		return v.Attributes
+	case *metricdata.SummaryDataPoint:
+		return v.Attributes
+	}
+	return *attribute.EmptySet()
+}
+
+// ForceFlush flushes all held exporters. The errors returned by the exporters
+// are joined and returned.
+func (e *PartitionedExporter) ForceFlush(ctx context.Context) error {
+	var err error
+	for _, exp := range e.exporters {
+		err = errors.Join(err, exp.ForceFlush(ctx))
+	}
+	return err
+}
+
+// Shutdown shuts down all held exporters. The errors returned by the
+// exporters are joined and returned.
+func (e *PartitionedExporter) Shutdown(ctx context.Context) error {
+	var err error
+	for _, exp := range e.exporters {
+		err = errors.Join(err, exp.Shutdown(ctx))
+	}
+	return err
+}
+
+// discardExporter is an Exporter that drops all data. It uses the default
+// Temporality and Aggregation.
+type discardExporter struct{}
+
+func (discardExporter) Temporality(k InstrumentKind) metricdata.Temporality {
+	return DefaultTemporalitySelector(k)
+}
+
+func (discardExporter) Aggregation(k InstrumentKind) Aggregation {
+	return DefaultAggregationSelector(k)
+}
+
+func (discardExporter) Export(context.Context, *metricdata.ResourceMetrics) error { return nil }
+func (discardExporter) ForceFlush(context.Context) error                          { return nil }
+func (discardExporter) Shutdown(context.Context) error                            { return nil }
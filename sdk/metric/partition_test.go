@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// recordingExporter returns an Exporter that stores the exported data in
+// got.
+func recordingExporter(got *[]metricdata.ResourceMetrics) *fnExporter {
+	return &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			*got = append(*got, *rm)
+			return nil
+		},
+	}
+}
+
+func TestPartitionedExporterExport(t *testing.T) {
+	const key = attribute.Key("tenant")
+	var (
+		setA    = attribute.NewSet(key.String("a"), attribute.Int("n", 1))
+		setB    = attribute.NewSet(key.String("b"))
+		setNone = attribute.NewSet(attribute.Int("n", 1))
+		setC    = attribute.NewSet(key.String("c"))
+
+		res    = resource.NewSchemaless(attribute.String("service.name", "test"))
+		scope0 = instrumentation.Scope{Name: "0"}
+		scope1 = instrumentation.Scope{Name: "1"}
+	)
+
+	sum := func(sets ...attribute.Set) metricdata.Metrics {
+		dps := make([]metricdata.DataPoint[int64], len(sets))
+		for i, s := range sets {
+			dps[i] = metricdata.DataPoint[int64]{Attributes: s, Value: int64(i)}
+		}
+		return metricdata.Metrics{
+			Name: "sum",
+			Data: metricdata.Sum[int64]{
+				DataPoints:  dps,
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			},
+		}
+	}
+	hist := func(sets ...attribute.Set) metricdata.Metrics {
+		dps := make([]metricdata.HistogramDataPoint[float64], len(sets))
+		for i, s := range sets {
+			dps[i] = metricdata.HistogramDataPoint[float64]{Attributes: s, Count: uint64(i)}
+		}
+		return metricdata.Metrics{
+			Name: "hist",
+			Data: metricdata.Histogram[float64]{
+				DataPoints:  dps,
+				Temporality: metricdata.DeltaTemporality,
+			},
+		}
+	}
+	// sumAt and histAt return m with only the data points at the idx indexes.
+	sumAt := func(m metricdata.Metrics, idx ...int) metricdata.Metrics {
+		s := m.Data.(metricdata.Sum[int64])
+		dps := make([]metricdata.DataPoint[int64], len(idx))
+		for i, j := range idx {
+			dps[i] = s.DataPoints[j]
+		}
+		s.DataPoints = dps
+		m.Data = s
+		return m
+	}
+	histAt := func(m metricdata.Metrics, idx ...int) metricdata.Metrics {
+		h := m.Data.(metricdata.Histogram[float64])
+		dps := make([]metricdata.HistogramDataPoint[float64], len(idx))
+		for i, j := range idx {
+			dps[i] = h.DataPoints[j]
+		}
+		h.DataPoints = dps
+		m.Data = h
+		return m
+	}
+
+	s0 := sum(setA, setNone, setB, setA)
+	h1 := hist(setB, setC)
+	rm := &metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: scope0, Metrics: []metricdata.Metrics{s0}},
+			{Scope: scope1, Metrics: []metricdata.Metrics{h1}},
+		},
+	}
+
+	var gotA, gotB, gotFallback, gotUnused []metricdata.ResourceMetrics
+	exp := NewPartitionedExporter(key, recordingExporter(&gotFallback), map[string]Exporter{
+		"a":      recordingExporter(&gotA),
+		"b":      recordingExporter(&gotB),
+		"unused": recordingExporter(&gotUnused),
+		"nil":    nil,
+	})
+	require.NoError(t, exp.Export(context.Background(), rm))
+
+	require.Len(t, gotA, 1)
+	metricdatatest.AssertEqual(t, metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: scope0, Metrics: []metricdata.Metrics{sumAt(s0, 0, 3)}},
+		},
+	}, gotA[0])
+
+	require.Len(t, gotB, 1)
+	metricdatatest.AssertEqual(t, metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: scope0, Metrics: []metricdata.Metrics{sumAt(s0, 2)}},
+			{Scope: scope1, Metrics: []metricdata.Metrics{histAt(h1, 0)}},
+		},
+	}, gotB[0])
+
+	require.Len(t, gotFallback, 1)
+	metricdatatest.AssertEqual(t, metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: scope0, Metrics: []metricdata.Metrics{sumAt(s0, 1)}},
+			{Scope: scope1, Metrics: []metricdata.Metrics{histAt(h1, 1)}},
+		},
+	}, gotFallback[0])
+
+	assert.Empty(t, gotUnused, "partition without data exported")
+}
+
+func TestPartitionedExporterExportEmpty(t *testing.T) {
+	var gotA, gotFallback []metricdata.ResourceMetrics
+	exp := NewPartitionedExporter("tenant", recordingExporter(&gotFallback), map[string]Exporter{
+		"a": recordingExporter(&gotA),
+	})
+	require.NoError(t, exp.Export(context.Background(), &metricdata.ResourceMetrics{}))
+
+	assert.Len(t, gotFallback, 1, "fallback not exported")
+	assert.Empty(t, gotA)
+}
+
+func TestPartitionedExporterNilFallback(t *testing.T) {
+	exp := NewPartitionedExporter("tenant", nil, nil)
+
+	assert.Equal(t, DefaultTemporalitySelector(InstrumentKindCounter), exp.Temporality(InstrumentKindCounter))
+	assert.Equal(t, DefaultAggregationSelector(InstrumentKindHistogram), exp.Aggregation(InstrumentKindHistogram))
+
+	ctx := context.Background()
+	assert.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	assert.NoError(t, exp.ForceFlush(ctx))
+	assert.NoError(t, exp.Shutdown(ctx))
+}
+
+func TestPartitionedExporterSelectors(t *testing.T) {
+	fallback := &fnExporter{
+		temporalityFunc: func(InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		},
+		aggregationFunc: func(InstrumentKind) Aggregation { return AggregationDrop{} },
+	}
+	exp := NewPartitionedExporter("tenant", fallback, nil)
+
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(InstrumentKindCounter))
+	assert.Equal(t, AggregationDrop{}, exp.Aggregation(InstrumentKindCounter))
+}
+
+func TestPartitionedExporterErrors(t *testing.T) {
+	var exported, flushed, shutdown int
+	errExp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			exported++
+			return assert.AnError
+		},
+		flushFunc: func(context.Context) error {
+			flushed++
+			return assert.AnError
+		},
+		shutdownFunc: func(context.Context) error {
+			shutdown++
+			return assert.AnError
+		},
+	}
+	exp := NewPartitionedExporter("tenant", &fnExporter{}, map[string]Exporter{"a": errExp})
+
+	ctx := context.Background()
+	rm := &metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Metrics: []metricdata.Metrics{{
+				Name: "gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Attributes: attribute.NewSet(attribute.String("tenant", "a"))},
+					},
+				},
+			}},
+		}},
+	}
+	assert.ErrorIs(t, exp.Export(ctx, rm), assert.AnError)
+	assert.ErrorIs(t, exp.ForceFlush(ctx), assert.AnError)
+	assert.ErrorIs(t, exp.Shutdown(ctx), assert.AnError)
+	assert.Equal(t, 1, exported)
+	assert.Equal(t, 1, flushed)
+	assert.Equal(t, 1, shutdown)
+}
@@ -24,9 +24,12 @@ const (
 
 // periodicReaderConfig contains configuration options for a PeriodicReader.
 type periodicReaderConfig struct {
-	interval  time.Duration
-	timeout   time.Duration
-	producers []Producer
+	interval   time.Duration
+	timeout    time.Duration
+	maxBackoff time.Duration
+	producers  []Producer
+	coalescer  *coalescer
+	zeroDelta  *zeroDeltaFilter
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
@@ -94,6 +97,20 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithFailureBackoff configures a PeriodicReader to back off when collecting
+// and exporting metric data fails. After each consecutive failed attempt, the
+// time until the next attempt is doubled, up to d. The interval configured
+// with WithInterval is restored after the first successful attempt.
+//
+// If this option is not used or d is less than or equal to the interval,
+// attempts are made at the configured interval regardless of failures.
+func WithFailureBackoff(d time.Duration) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.maxBackoff = d
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel any attempts that
@@ -107,12 +124,14 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 	conf := newPeriodicReaderConfig(options)
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &PeriodicReader{
-		interval: conf.interval,
-		timeout:  conf.timeout,
-		exporter: exporter,
-		flushCh:  make(chan chan error),
-		cancel:   cancel,
-		done:     make(chan struct{}),
+		interval:  conf.interval,
+		timeout:   conf.timeout,
+		exporter:  exporter,
+		coalescer: conf.coalescer,
+		zeroDelta: conf.zeroDelta,
+		flushCh:   make(chan chan error),
+		cancel:    cancel,
+		done:      make(chan struct{}),
 		rmPool: sync.Pool{
 			New: func() interface{} {
 				return &metricdata.ResourceMetrics{}
@@ -123,7 +142,7 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 
 	go func() {
 		defer func() { close(r.done) }()
-		r.run(ctx, conf.interval)
+		r.run(ctx, conf.interval, conf.maxBackoff)
 	}()
 
 	return r
@@ -143,6 +162,9 @@ type PeriodicReader struct {
 	exporter Exporter
 	flushCh  chan chan error
 
+	coalescer *coalescer
+	zeroDelta *zeroDeltaFilter
+
 	done         chan struct{}
 	cancel       context.CancelFunc
 	shutdownOnce sync.Once
@@ -158,10 +180,15 @@ var newTicker = time.NewTicker
 
 // run continuously collects and exports metric data at the specified
 // interval. This will run until ctx is canceled or times out.
-func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
+//
+// If maxBackoff is greater than interval, the time between attempts is
+// increased up to maxBackoff while attempts fail.
+func (r *PeriodicReader) run(ctx context.Context, interval, maxBackoff time.Duration) {
 	ticker := newTicker(interval)
 	defer ticker.Stop()
 
+	b := backoff{interval: interval, max: maxBackoff}
+	current := interval
 	for {
 		select {
 		case <-ticker.C:
@@ -169,15 +196,48 @@ func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
 			if err != nil {
 				otel.Handle(err)
 			}
+			if d := b.next(err); d != current {
+				ticker.Reset(d)
+				current = d
+			}
 		case errCh := <-r.flushCh:
-			errCh <- r.collectAndExport(ctx)
-			ticker.Reset(interval)
+			err := r.collectAndExport(ctx)
+			errCh <- err
+			current = b.next(err)
+			ticker.Reset(current)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// backoff computes the time between collect and export attempts of a
+// PeriodicReader based on the number of consecutive failed attempts.
+type backoff struct {
+	interval time.Duration
+	max      time.Duration
+	failures int
+}
+
+// next records the result of an attempt, err, and returns the time to wait
+// before the next attempt.
+func (b *backoff) next(err error) time.Duration {
+	if err == nil || b.max <= b.interval {
+		b.failures = 0
+		return b.interval
+	}
+
+	b.failures++
+	d := b.interval
+	for i := 0; i < b.failures && d < b.max; i++ {
+		d *= 2
+	}
+	if d > b.max {
+		d = b.max
+	}
+	return d
+}
+
 // register registers p as the producer of this reader.
 func (r *PeriodicReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
@@ -247,22 +307,26 @@ func (r *PeriodicReader) collect(ctx context.Context, p interface{}, rm *metricd
 		return err
 	}
 
-	err := ph.produce(ctx, rm)
-	if err != nil {
-		return err
-	}
-	var errs []error
-	for _, producer := range r.externalProducers.Load().([]Producer) {
-		externalMetrics, err := producer.Produce(ctx)
+	return r.coalescer.collect(ctx, rm, func(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+		err := ph.produce(ctx, rm)
 		if err != nil {
-			errs = append(errs, err)
+			return err
 		}
-		rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
-	}
+		var errs []error
+		for _, producer := range r.externalProducers.Load().([]Producer) {
+			externalMetrics, err := producer.Produce(ctx)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
+		}
+
+		r.zeroDelta.filter(rm)
 
-	global.Debug("PeriodicReader collection", "Data", rm)
+		global.Debug("PeriodicReader collection", "Data", rm)
 
-	return unifyErrors(errs)
+		return unifyErrors(errs)
+	})
 }
 
 // export exports metric data m using r's exporter.
@@ -139,6 +139,37 @@ func TestIntervalEnvAndOption(t *testing.T) {
 	assert.Equal(t, want, got, "option should have precedence over env var")
 }
 
+func TestWithFailureBackoff(t *testing.T) {
+	assert.Equal(t, time.Duration(0), newPeriodicReaderConfig(nil).maxBackoff)
+
+	opts := []PeriodicReaderOption{WithFailureBackoff(time.Minute)}
+	assert.Equal(t, time.Minute, newPeriodicReaderConfig(opts).maxBackoff)
+}
+
+func TestBackoffNext(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		b := backoff{interval: time.Second}
+		assert.Equal(t, time.Second, b.next(assert.AnError))
+		assert.Equal(t, time.Second, b.next(assert.AnError))
+	})
+
+	t.Run("MaxLessThanInterval", func(t *testing.T) {
+		b := backoff{interval: time.Second, max: time.Millisecond}
+		assert.Equal(t, time.Second, b.next(assert.AnError))
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		b := backoff{interval: time.Second, max: 5 * time.Second}
+		assert.Equal(t, time.Second, b.next(nil))
+		assert.Equal(t, 2*time.Second, b.next(assert.AnError))
+		assert.Equal(t, 4*time.Second, b.next(assert.AnError))
+		assert.Equal(t, 5*time.Second, b.next(assert.AnError), "max not applied")
+		assert.Equal(t, 5*time.Second, b.next(assert.AnError), "max not applied")
+		assert.Equal(t, time.Second, b.next(nil), "interval not restored")
+		assert.Equal(t, 2*time.Second, b.next(assert.AnError), "failures not reset")
+	})
+}
+
 type fnExporter struct {
 	temporalityFunc TemporalitySelector
 	aggregationFunc AggregationSelector
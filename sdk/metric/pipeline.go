@@ -8,9 +8,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
@@ -36,6 +38,8 @@ type instrumentSync struct {
 	description string
 	unit        string
 	compAgg     aggregate.ComputeAggregation
+	// account is the memory budget share of the aggregate function.
+	account *aggregate.Account
 }
 
 func newPipeline(res *resource.Resource, reader Reader, views []View) *pipeline {
@@ -62,10 +66,67 @@ type pipeline struct {
 	reader Reader
 	views  []View
 
+	// start is the start time of all cumulative streams of the pipeline. If
+	// zero, the creation time of each instrument stream is used.
+	start time.Time
+	// budget is the memory budget shared by the aggregate functions. If nil,
+	// no memory limit is imposed.
+	budget *aggregate.Budget
+	// sorted is true if the produced scope metrics and data points are
+	// sorted.
+	sorted bool
+
 	sync.Mutex
 	aggregations   map[instrumentation.Scope][]instrumentSync
 	callbacks      []func(context.Context) error
 	multiCallbacks list.List
+
+	// staged holds the aggregations and callbacks added while the pipeline
+	// is rebuilt with new views. It is nil if no rebuild is in progress.
+	staged *stagedPipeline
+}
+
+// stagedPipeline is the state of a pipeline being rebuilt with new views.
+type stagedPipeline struct {
+	aggregations map[instrumentation.Scope][]instrumentSync
+	callbacks    []func(context.Context) error
+}
+
+// stage starts the rebuild of p with views. Until commit is called, the
+// aggregations and callbacks added to p are staged and the ones already held
+// continue to be produced.
+func (p *pipeline) stage(views []View) {
+	p.Lock()
+	defer p.Unlock()
+	p.views = views
+	p.staged = &stagedPipeline{}
+}
+
+// commit replaces the aggregations and callbacks of p with the staged ones.
+func (p *pipeline) commit() {
+	p.Lock()
+	defer p.Unlock()
+	if p.staged == nil {
+		return
+	}
+	// Release the memory budget of the discarded aggregate functions.
+	kept := make(map[*aggregate.Account]struct{})
+	for _, syncs := range p.staged.aggregations {
+		for _, s := range syncs {
+			kept[s.account] = struct{}{}
+		}
+	}
+	for _, syncs := range p.aggregations {
+		for _, s := range syncs {
+			if _, ok := kept[s.account]; !ok {
+				s.account.Close()
+			}
+		}
+	}
+
+	p.aggregations = p.staged.aggregations
+	p.callbacks = p.staged.callbacks
+	p.staged = nil
 }
 
 // addSync adds the instrumentSync to pipeline p with scope. This method is not
@@ -74,13 +135,17 @@ type pipeline struct {
 func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 	p.Lock()
 	defer p.Unlock()
-	if p.aggregations == nil {
-		p.aggregations = map[instrumentation.Scope][]instrumentSync{
+	aggs := &p.aggregations
+	if p.staged != nil {
+		aggs = &p.staged.aggregations
+	}
+	if *aggs == nil {
+		*aggs = map[instrumentation.Scope][]instrumentSync{
 			scope: {iSync},
 		}
 		return
 	}
-	p.aggregations[scope] = append(p.aggregations[scope], iSync)
+	(*aggs)[scope] = append((*aggs)[scope], iSync)
 }
 
 type multiCallback func(context.Context) error
@@ -156,6 +221,9 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	}
 
 	rm.ScopeMetrics = rm.ScopeMetrics[:i]
+	if p.sorted {
+		sortResourceMetrics(rm)
+	}
 
 	return errs.errorOrNil()
 }
@@ -177,6 +245,11 @@ type inserter[N int64 | float64] struct {
 	// warning message is logged.
 	views *cache[string, instID]
 
+	// prev holds the aggregate functions of the inserter replaced when the
+	// pipeline was rebuilt. They are reused for unchanged streams. It is nil
+	// if no rebuild is in progress.
+	prev *cache[instID, aggVal[N]]
+
 	pipeline *pipeline
 }
 
@@ -268,6 +341,10 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 func (i *inserter[N]) addCallback(cback func(context.Context) error) {
 	i.pipeline.Lock()
 	defer i.pipeline.Unlock()
+	if i.pipeline.staged != nil {
+		i.pipeline.staged.callbacks = append(i.pipeline.staged.callbacks, cback)
+		return
+	}
 	i.pipeline.callbacks = append(i.pipeline.callbacks, cback)
 }
 
@@ -278,6 +355,12 @@ type aggVal[N int64 | float64] struct {
 	ID      uint64
 	Measure aggregate.Measure[N]
 	Err     error
+
+	// Stream is the stream the aggregate function was created for.
+	Stream Stream
+	// Sync is the output of the aggregate function inserted into the
+	// pipeline.
+	Sync instrumentSync
 }
 
 // readerDefaultAggregation returns the default aggregation for the instrument
@@ -347,9 +430,15 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 	// cache lookup to ensure the correct comparison.
 	normID := id.normalize()
 	cv := i.aggregators.Lookup(normID, func() aggVal[N] {
+		if prev, ok := i.reuse(scope, normID, stream); ok {
+			return prev
+		}
+
 		b := aggregate.Builder[N]{
 			Temporality:   i.pipeline.reader.temporality(kind),
 			ReservoirFunc: reservoirFunc(stream.Aggregation),
+			StartTime:     i.pipeline.start,
+			Account:       i.pipeline.budget.Account(),
 		}
 		b.Filter = stream.AttributeFilter
 		// A value less than or equal to zero will disable the aggregation
@@ -360,25 +449,54 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 
 		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
-			return aggVal[N]{0, nil, err}
+			return aggVal[N]{Err: err}
 		}
 		if in == nil { // Drop aggregator.
-			return aggVal[N]{0, nil, nil}
+			return aggVal[N]{Stream: stream}
 		}
-		i.pipeline.addSync(scope, instrumentSync{
+		iSync := instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
 			// requests of this instrument.
 			name:        stream.Name,
 			description: stream.Description,
 			unit:        stream.Unit,
 			compAgg:     out,
-		})
+			account:     b.Account,
+		}
+		i.pipeline.addSync(scope, iSync)
 		id := atomic.AddUint64(&aggIDCount, 1)
-		return aggVal[N]{id, in, err}
+		return aggVal[N]{ID: id, Measure: in, Stream: stream, Sync: iSync}
 	})
 	return cv.Measure, cv.ID, cv.Err
 }
 
+// reuse returns the aggregate function created for id before the pipeline
+// was rebuilt if it was created for the same stream. Its output is inserted
+// into the pipeline again.
+func (i *inserter[N]) reuse(scope instrumentation.Scope, id instID, stream Stream) (aggVal[N], bool) {
+	if i.prev == nil {
+		return aggVal[N]{}, false
+	}
+	v, ok := i.prev.Load(id)
+	if !ok || v.Err != nil || !sameStream(v.Stream, stream) {
+		return aggVal[N]{}, false
+	}
+	if v.Measure != nil {
+		i.pipeline.addSync(scope, v.Sync)
+	}
+	return v, true
+}
+
+// sameStream returns true if a and b produce the same aggregation. Streams
+// with an attribute filter are never the same as filters cannot be compared.
+func sameStream(a, b Stream) bool {
+	return a.Name == b.Name &&
+		a.Description == b.Description &&
+		a.Unit == b.Unit &&
+		a.AttributeFilter == nil && b.AttributeFilter == nil &&
+		reflect.DeepEqual(a.Aggregation, b.Aggregation)
+}
+
 // logConflict validates if an instrument with the same case-insensitive name
 // as id has already been created. If that instrument conflicts with id, a
 // warning is logged.
@@ -589,6 +707,26 @@ func newResolver[N int64 | float64](p pipelines, vc *cache[string, instID]) reso
 	return resolver[N]{in}
 }
 
+// rebuild returns a resolver for the same pipelines as r with empty caches.
+// The aggregate functions cached by r are reused for unchanged streams until
+// releasePrev is called.
+func (r resolver[N]) rebuild(vc *cache[string, instID]) resolver[N] {
+	in := make([]*inserter[N], len(r.inserters))
+	for i, old := range r.inserters {
+		in[i] = newInserter[N](old.pipeline, vc)
+		in[i].prev = old.aggregators
+	}
+	return resolver[N]{in}
+}
+
+// releasePrev releases the aggregate functions of the resolver r was rebuilt
+// from.
+func (r resolver[N]) releasePrev() {
+	for _, i := range r.inserters {
+		i.prev = nil
+	}
+}
+
 // Aggregators returns the Aggregators that must be updated by the instrument
 // defined by key.
 func (r resolver[N]) Aggregators(id Instrument) ([]aggregate.Measure[N], error) {
@@ -629,19 +767,36 @@ func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) (
 
 type multierror struct {
 	wrapped error
-	errors  []string
+	errors  []error
 }
 
 func (m *multierror) errorOrNil() error {
 	if len(m.errors) == 0 {
 		return nil
 	}
+
+	msgs := make([]string, len(m.errors))
+	for i, err := range m.errors {
+		msgs[i] = err.Error()
+	}
+	msg := strings.Join(msgs, "; ")
 	if m.wrapped == nil {
-		return errors.New(strings.Join(m.errors, "; "))
+		return &joinedError{msg: msg, errs: m.errors}
 	}
-	return fmt.Errorf("%w: %s", m.wrapped, strings.Join(m.errors, "; "))
+	errs := append([]error{m.wrapped}, m.errors...)
+	return &joinedError{msg: m.wrapped.Error() + ": " + msg, errs: errs}
+}
+
+// joinedError is an error with a custom message that wraps multiple errors.
+type joinedError struct {
+	msg  string
+	errs []error
 }
 
+func (e *joinedError) Error() string { return e.msg }
+
+func (e *joinedError) Unwrap() []error { return e.errs }
+
 func (m *multierror) append(err error) {
-	m.errors = append(m.errors, err.Error())
+	m.errors = append(m.errors, err)
 }
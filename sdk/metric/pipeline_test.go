@@ -47,7 +47,7 @@ func TestNewPipeline(t *testing.T) {
 	assert.Equal(t, resource.Empty(), output.Resource)
 	assert.Len(t, output.ScopeMetrics, 0)
 
-	iSync := instrumentSync{"name", "desc", "1", testSumAggregateOutput}
+	iSync := instrumentSync{"name", "desc", "1", testSumAggregateOutput, nil}
 	assert.NotPanics(t, func() {
 		pipe.addSync(instrumentation.Scope{}, iSync)
 	})
@@ -91,7 +91,7 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 		go func(n int) {
 			defer wg.Done()
 			name := fmt.Sprintf("name %d", n)
-			sync := instrumentSync{name, "desc", "1", testSumAggregateOutput}
+			sync := instrumentSync{name, "desc", "1", testSumAggregateOutput, nil}
 			pipe.addSync(instrumentation.Scope{}, sync)
 		}(i)
 
@@ -504,3 +504,57 @@ func TestExemplars(t *testing.T) {
 		check(t, r, 0, 0, 0)
 	})
 }
+
+func TestExemplarActivity(t *testing.T) {
+	collect := func(t *testing.T, r Reader) *metricdata.ExemplarActivity {
+		t.Helper()
+
+		rm := new(metricdata.ResourceMetrics)
+		require.NoError(t, r.Collect(context.Background(), rm))
+		require.Len(t, rm.ScopeMetrics, 1, "ScopeMetrics")
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1, "Metrics")
+		sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		return sum.DataPoints[0].ExemplarActivity
+	}
+
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		SpanID:     trace.SpanID{0o1},
+		TraceID:    trace.TraceID{0o1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	tests := []struct {
+		filter string
+		want   *metricdata.ExemplarActivity
+	}{
+		{"trace_based", &metricdata.ExemplarActivity{Offered: 3, Sampled: 1}},
+		{"always_on", &metricdata.ExemplarActivity{Offered: 3, Sampled: 3}},
+		{"always_off", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			t.Setenv("OTEL_GO_X_EXEMPLAR", "true")
+			t.Setenv("OTEL_METRICS_EXEMPLAR_FILTER", tt.filter)
+
+			r := NewManualReader()
+			c, err := NewMeterProvider(WithReader(r)).Meter("test").Int64Counter("counter")
+			require.NoError(t, err)
+			c.Add(context.Background(), 1)
+			c.Add(context.Background(), 1)
+			c.Add(sampled, 1)
+
+			assert.Equal(t, tt.want, collect(t, r))
+		})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Setenv("OTEL_GO_X_EXEMPLAR", "false")
+
+		r := NewManualReader()
+		c, err := NewMeterProvider(WithReader(r)).Meter("test").Int64Counter("counter")
+		require.NoError(t, err)
+		c.Add(sampled, 1)
+
+		assert.Nil(t, collect(t, r))
+	})
+}
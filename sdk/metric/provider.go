@@ -5,13 +5,18 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 )
 
 // MeterProvider handles the creation and coordination of Meters. All Meters
@@ -24,8 +29,16 @@ type MeterProvider struct {
 	pipes  pipelines
 	meters cache[instrumentation.Scope, *meter]
 
+	// reconfig guards the update of the views against the creation of
+	// instruments.
+	reconfig sync.RWMutex
+
 	forceFlush, shutdown func(context.Context) error
 	stopped              atomic.Bool
+
+	// sanitize is true if the invalid instrument names and units are replaced
+	// with valid ones instead of resulting in an error.
+	sanitize bool
 }
 
 // Compile-time check MeterProvider implements metric.MeterProvider.
@@ -41,10 +54,29 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 	conf := newConfig(options)
 	flush, sdown := conf.readerSignals()
 
+	pipes := newPipelines(conf.res, conf.readers, conf.views)
+	if conf.alignedStart {
+		start := time.Now()
+		for _, p := range pipes {
+			p.start = start
+		}
+	}
+	if conf.sorted {
+		for _, p := range pipes {
+			p.sorted = true
+		}
+	}
+	if budget := aggregate.NewBudget(conf.memoryLimit); budget != nil {
+		for _, p := range pipes {
+			p.budget = budget
+		}
+	}
+
 	mp := &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views),
+		pipes:      pipes,
 		forceFlush: flush,
 		shutdown:   sdown,
+		sanitize:   conf.sanitize,
 	}
 	// Log after creation so all readers show correctly they are registered.
 	global.Info("MeterProvider created",
@@ -88,10 +120,54 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 	)
 
 	return mp.meters.Lookup(s, func() *meter {
-		return newMeter(s, mp.pipes)
+		return newMeter(s, mp.pipes, &mp.reconfig, mp.sanitize)
 	})
 }
 
+// SetViews replaces the views of the MeterProvider with views, without
+// restarting it. If no views are provided, the default view is used.
+//
+// The aggregations of all the instruments already created are resolved again
+// using views. The state of the aggregations of streams not changed by the
+// update, i.e. with the same name, description, unit, and aggregation, and no
+// attribute filter, is preserved. Changed streams start with a new state and
+// the removed ones are no longer produced. The replacement is atomic:
+// collections return the streams of either the previous views or views.
+//
+// Instruments with a drop aggregation when a callback is registered with
+// [metric.Meter.RegisterCallback] are not observed by that callback if they
+// are no longer dropped after the update. Register the callback again to
+// observe them.
+//
+// Errors creating the aggregations of the instruments are joined and
+// returned. Calling SetViews after Shutdown has no effect.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) SetViews(views ...View) error {
+	if mp.stopped.Load() {
+		return nil
+	}
+
+	// Block the creation of instruments during the update.
+	mp.reconfig.Lock()
+	defer mp.reconfig.Unlock()
+
+	views = slices.Clone(views)
+	for _, p := range mp.pipes {
+		p.stage(views)
+	}
+	var errs []error
+	for _, m := range mp.meters.Values() {
+		errs = append(errs, m.rebuild())
+	}
+	for _, p := range mp.pipes {
+		p.commit()
+	}
+
+	global.Info("MeterProvider views updated", "Views", len(views))
+	return errors.Join(errs...)
+}
+
 // ForceFlush flushes all pending telemetry.
 //
 // This method honors the deadline or cancellation of ctx. An appropriate
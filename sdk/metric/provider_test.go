@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/funcr"
 	"github.com/go-logr/logr/testr"
@@ -15,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -172,3 +175,293 @@ func TestMeterProviderMixingOnRegisterErrors(t *testing.T) {
 		"Metrics produced for instrument collected by different MeterProvider",
 	)
 }
+
+func TestMeterProviderAlignedStartTime(t *testing.T) {
+	collectStart := func(t *testing.T, opts ...Option) []time.Time {
+		t.Helper()
+
+		rdr := NewManualReader()
+		mp := NewMeterProvider(append(opts, WithReader(rdr))...)
+		m := mp.Meter("TestMeterProviderAlignedStartTime")
+
+		ctr0, err := m.Int64Counter("counter.0")
+		require.NoError(t, err)
+		// Ensure the instruments are created at distinct times.
+		time.Sleep(time.Millisecond)
+		ctr1, err := m.Int64Counter("counter.1")
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		ctr0.Add(ctx, 1)
+		ctr1.Add(ctx, 1)
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, rdr.Collect(ctx, &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		var start []time.Time
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			require.Len(t, sum.DataPoints, 1)
+			start = append(start, sum.DataPoints[0].StartTime)
+		}
+		require.Len(t, start, 2)
+		return start
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		start := collectStart(t)
+		assert.NotEqual(t, start[0], start[1])
+	})
+
+	t.Run("Aligned", func(t *testing.T) {
+		start := collectStart(t, WithAlignedStartTime())
+		assert.Equal(t, start[0], start[1])
+	})
+}
+
+func TestMeterProviderSortedDataPoints(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithSortedDataPoints())
+
+	ctx := context.Background()
+	for _, name := range []string{"c", "a", "b"} {
+		ctr, err := mp.Meter(name).Int64Counter("counter")
+		require.NoError(t, err)
+		for i := 9; i >= 0; i-- {
+			ctr.Add(ctx, 1, api.WithAttributes(attribute.Int("i", i)))
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 3)
+	for i, name := range []string{"a", "b", "c"} {
+		sm := rm.ScopeMetrics[i]
+		assert.Equal(t, name, sm.Scope.Name)
+		require.Len(t, sm.Metrics, 1)
+		sum, ok := sm.Metrics[0].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, sum.DataPoints, 10)
+		for j, dp := range sum.DataPoints {
+			assert.Equal(t, attribute.NewSet(attribute.Int("i", j)), dp.Attributes)
+		}
+	}
+}
+
+func TestMeterProviderMemoryLimit(t *testing.T) {
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(error) {}))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	const limit = 4 << 10
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithMemoryLimit(limit))
+	budget := mp.pipes[0].budget
+	require.NotNil(t, budget)
+
+	ctr, err := mp.Meter("TestMeterProviderMemoryLimit").Int64Counter("counter")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	const n = 1000
+	for i := 0; i < n; i++ {
+		ctr.Add(ctx, 1, api.WithAttributes(attribute.Int("i", i)))
+	}
+	assert.LessOrEqual(t, budget.Used(), int64(limit))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	assert.Less(t, len(sum.DataPoints), n, "memory limit not applied")
+
+	var total int64
+	var overflow bool
+	for _, dp := range sum.DataPoints {
+		total += dp.Value
+		if v, ok := dp.Attributes.Value("otel.metric.overflow"); ok && v.AsBool() {
+			overflow = true
+		}
+	}
+	assert.True(t, overflow, "no overflow data point")
+	assert.Equal(t, int64(n), total, "measurements lost")
+
+	// Discarded aggregations release their memory.
+	require.NoError(t, mp.SetViews(NewView(Instrument{Name: "counter"}, Stream{Name: "renamed"})))
+	assert.Zero(t, budget.Used())
+}
+
+func TestMeterProviderSetViews(t *testing.T) {
+	ctx := context.Background()
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	m := mp.Meter("TestMeterProviderSetViews")
+
+	kept, err := m.Int64Counter("kept")
+	require.NoError(t, err)
+	renamed, err := m.Int64Counter("renamed")
+	require.NoError(t, err)
+	hist, err := m.Float64Histogram("hist")
+	require.NoError(t, err)
+	_, err = m.Int64ObservableGauge("gauge", api.WithInt64Callback(
+		func(_ context.Context, o api.Int64Observer) error {
+			o.Observe(7)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	collect := func(t *testing.T) map[string]metricdata.Aggregation {
+		t.Helper()
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, rdr.Collect(ctx, &rm))
+		got := make(map[string]metricdata.Aggregation)
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				got[m.Name] = m.Data
+			}
+		}
+		return got
+	}
+	sumOf := func(t *testing.T, data metricdata.Aggregation) int64 {
+		t.Helper()
+		sum, ok := data.(metricdata.Sum[int64])
+		require.True(t, ok, "not a sum")
+		require.Len(t, sum.DataPoints, 1)
+		return sum.DataPoints[0].Value
+	}
+
+	kept.Add(ctx, 1)
+	renamed.Add(ctx, 1)
+	hist.Record(ctx, 1)
+	got := collect(t)
+	require.Len(t, got, 4)
+	assert.Equal(t, int64(1), sumOf(t, got["kept"]))
+
+	bounds := []float64{0, 10}
+	require.NoError(t, mp.SetViews(
+		NewView(Instrument{Name: "renamed"}, Stream{Name: "new"}),
+		NewView(Instrument{Name: "hist"}, Stream{
+			Aggregation: AggregationExplicitBucketHistogram{Boundaries: bounds},
+		}),
+	))
+
+	kept.Add(ctx, 1)
+	renamed.Add(ctx, 1)
+	hist.Record(ctx, 1)
+	got = collect(t)
+	require.Len(t, got, 4)
+	assert.Equal(t, int64(2), sumOf(t, got["kept"]), "unchanged stream state not preserved")
+	assert.NotContains(t, got, "renamed")
+	assert.Equal(t, int64(1), sumOf(t, got["new"]), "changed stream state preserved")
+	h, ok := got["hist"].(metricdata.Histogram[float64])
+	require.True(t, ok, "not a histogram")
+	require.Len(t, h.DataPoints, 1)
+	assert.Equal(t, bounds, h.DataPoints[0].Bounds)
+	assert.Equal(t, uint64(1), h.DataPoints[0].Count, "changed stream state preserved")
+	g, ok := got["gauge"].(metricdata.Gauge[int64])
+	require.True(t, ok, "not a gauge")
+	require.Len(t, g.DataPoints, 1)
+	assert.Equal(t, int64(7), g.DataPoints[0].Value)
+
+	// Drop everything.
+	require.NoError(t, mp.SetViews(NewView(
+		Instrument{Name: "*"},
+		Stream{Aggregation: AggregationDrop{}},
+	)))
+	kept.Add(ctx, 1)
+	assert.Empty(t, collect(t))
+
+	// Restore the default view.
+	require.NoError(t, mp.SetViews())
+	kept.Add(ctx, 1)
+	got = collect(t)
+	assert.Equal(t, int64(1), sumOf(t, got["kept"]))
+	assert.Contains(t, got, "gauge", "observable callback not restored")
+
+	// Instruments created after the update use the new views.
+	require.NoError(t, mp.SetViews(NewView(
+		Instrument{Name: "late"},
+		Stream{Name: "late.renamed"},
+	)))
+	late, err := m.Int64Counter("late")
+	require.NoError(t, err)
+	late.Add(ctx, 1)
+	assert.Contains(t, collect(t), "late.renamed")
+
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.NoError(t, mp.SetViews(), "SetViews after Shutdown")
+}
+
+func TestMeterProviderSetViewsError(t *testing.T) {
+	mp := NewMeterProvider(WithReader(NewManualReader()))
+	_, err := mp.Meter("TestMeterProviderSetViewsError").Int64Counter("counter")
+	require.NoError(t, err)
+
+	err = mp.SetViews(NewView(
+		Instrument{Name: "counter"},
+		Stream{Aggregation: AggregationLastValue{}},
+	))
+	assert.ErrorIs(t, err, errIncompatibleAggregation)
+}
+
+func TestMeterProviderSetViewsConcurrentSafe(t *testing.T) {
+	ctx := context.Background()
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	m := mp.Meter("TestMeterProviderSetViewsConcurrentSafe")
+	ctr, err := m.Int64Counter("counter")
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				ctr.Add(ctx, 1)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		var rm metricdata.ResourceMetrics
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				_ = rdr.Collect(ctx, &rm)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+				_, _ = m.Int64Counter(fmt.Sprintf("counter.%d", i%10))
+			}
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("renamed.%d", i)
+		assert.NoError(t, mp.SetViews(NewView(
+			Instrument{Name: "counter"},
+			Stream{Name: name},
+		)))
+	}
+	close(done)
+	wg.Wait()
+}
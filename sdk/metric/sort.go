@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"cmp"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// sortResourceMetrics sorts the scope metrics of rm by scope and the data
+// points of each of their metrics by attributes.
+//
+// The metrics of a scope are not reordered, they are already produced in the
+// order their instruments were created.
+func sortResourceMetrics(rm *metricdata.ResourceMetrics) {
+	slices.SortFunc(rm.ScopeMetrics, func(a, b metricdata.ScopeMetrics) int {
+		return compareScopes(a.Scope, b.Scope)
+	})
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sortDataPoints(m.Data)
+		}
+	}
+}
+
+// sortDataPoints sorts the data points of agg by attributes.
+func sortDataPoints(agg metricdata.Aggregation) {
+	switch a := agg.(type) {
+	case metricdata.Gauge[int64]:
+		sortByAttrs(a.DataPoints, dpAttrs[int64])
+	case metricdata.Gauge[float64]:
+		sortByAttrs(a.DataPoints, dpAttrs[float64])
+	case metricdata.Sum[int64]:
+		sortByAttrs(a.DataPoints, dpAttrs[int64])
+	case metricdata.Sum[float64]:
+		sortByAttrs(a.DataPoints, dpAttrs[float64])
+	case metricdata.Histogram[int64]:
+		sortByAttrs(a.DataPoints, hDPAttrs[int64])
+	case metricdata.Histogram[float64]:
+		sortByAttrs(a.DataPoints, hDPAttrs[float64])
+	case metricdata.ExponentialHistogram[int64]:
+		sortByAttrs(a.DataPoints, eHDPAttrs[int64])
+	case metricdata.ExponentialHistogram[float64]:
+		sortByAttrs(a.DataPoints, eHDPAttrs[float64])
+	case metricdata.Summary:
+		sortByAttrs(a.DataPoints, func(dp metricdata.SummaryDataPoint) *attribute.Set {
+			return &dp.Attributes
+		})
+	}
+}
+
+func dpAttrs[N int64 | float64](dp metricdata.DataPoint[N]) *attribute.Set {
+	return &dp.Attributes
+}
+
+func hDPAttrs[N int64 | float64](dp metricdata.HistogramDataPoint[N]) *attribute.Set {
+	return &dp.Attributes
+}
+
+func eHDPAttrs[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N]) *attribute.Set {
+	return &dp.Attributes
+}
+
+// keyed is a data point and its attributes.
+type keyed[DP any] struct {
+	attrs []attribute.KeyValue
+	dp    DP
+}
+
+// sortByAttrs sorts dPts by the attributes returned by attrs.
+//
+// The attributes of each data point are extracted once up front, comparing
+// attribute sets directly would allocate on every comparison.
+func sortByAttrs[DP any](dPts []DP, attrs func(DP) *attribute.Set) {
+	if len(dPts) < 2 {
+		return
+	}
+	k := make([]keyed[DP], len(dPts))
+	for i, dp := range dPts {
+		k[i] = keyed[DP]{attrs: attrs(dp).ToSlice(), dp: dp}
+	}
+	slices.SortFunc(k, func(a, b keyed[DP]) int {
+		return compareAttrs(a.attrs, b.attrs)
+	})
+	for i := range k {
+		dPts[i] = k[i].dp
+	}
+}
+
+// compareScopes compares a and b by name, version, and schema URL.
+func compareScopes(a, b instrumentation.Scope) int {
+	if c := cmp.Compare(a.Name, b.Name); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Version, b.Version); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.SchemaURL, b.SchemaURL)
+}
+
+// compareAttrs compares the sorted attributes a and b pairwise, by key and
+// then by value. Attributes that are a prefix of the others are ordered first.
+func compareAttrs(a, b []attribute.KeyValue) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if c := cmp.Compare(a[i].Key, b[i].Key); c != 0 {
+			return c
+		}
+		if c := compareValues(a[i].Value, b[i].Value); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// compareValues compares a and b by type and then by value. Slice values are
+// compared by their emitted string representation.
+func compareValues(a, b attribute.Value) int {
+	if c := cmp.Compare(a.Type(), b.Type()); c != 0 {
+		return c
+	}
+	switch a.Type() {
+	case attribute.BOOL:
+		switch av, bv := a.AsBool(), b.AsBool(); {
+		case av == bv:
+			return 0
+		case bv:
+			return -1
+		default:
+			return 1
+		}
+	case attribute.INT64:
+		return cmp.Compare(a.AsInt64(), b.AsInt64())
+	case attribute.FLOAT64:
+		return cmp.Compare(a.AsFloat64(), b.AsFloat64())
+	case attribute.STRING:
+		return cmp.Compare(a.AsString(), b.AsString())
+	default:
+		return cmp.Compare(a.Emit(), b.Emit())
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestCompareAttrs(t *testing.T) {
+	// Sets in ascending order.
+	sets := []attribute.Set{
+		*attribute.EmptySet(),
+		attribute.NewSet(attribute.Bool("a", false)),
+		attribute.NewSet(attribute.Bool("a", true)),
+		attribute.NewSet(attribute.Int("a", 2)),
+		attribute.NewSet(attribute.Int("a", 10)),
+		attribute.NewSet(attribute.Int("a", 10), attribute.String("b", "a")),
+		attribute.NewSet(attribute.Int("a", 10), attribute.String("c", "a")),
+		attribute.NewSet(attribute.Float64("a", 0.5)),
+		attribute.NewSet(attribute.String("a", "a")),
+		attribute.NewSet(attribute.String("a", "b")),
+		attribute.NewSet(attribute.StringSlice("a", []string{"a"})),
+		attribute.NewSet(attribute.StringSlice("a", []string{"b"})),
+		attribute.NewSet(attribute.String("b", "a")),
+	}
+	for i := range sets {
+		for j := range sets {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			assert.Equalf(t, want, compareAttrs(sets[i].ToSlice(), sets[j].ToSlice()), "%s <=> %s", sets[i].Encoded(attribute.DefaultEncoder()), sets[j].Encoded(attribute.DefaultEncoder()))
+		}
+	}
+}
+
+func TestSortResourceMetrics(t *testing.T) {
+	a := attribute.NewSet(attribute.String("k", "a"))
+	b := attribute.NewSet(attribute.String("k", "b"))
+
+	rm := metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: instrumentation.Scope{Name: "b"}},
+			{
+				Scope: instrumentation.Scope{Name: "a", Version: "v2"},
+				Metrics: []metricdata.Metrics{
+					{Name: "sum", Data: metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{{Attributes: b}, {Attributes: a}}}},
+					{Name: "gauge", Data: metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{{Attributes: b}, {Attributes: a}}}},
+					{Name: "histogram", Data: metricdata.Histogram[int64]{DataPoints: []metricdata.HistogramDataPoint[int64]{{Attributes: b}, {Attributes: a}}}},
+					{Name: "exponential", Data: metricdata.ExponentialHistogram[float64]{DataPoints: []metricdata.ExponentialHistogramDataPoint[float64]{{Attributes: b}, {Attributes: a}}}},
+					{Name: "summary", Data: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{{Attributes: b}, {Attributes: a}}}},
+				},
+			},
+			{Scope: instrumentation.Scope{Name: "a", Version: "v1"}},
+		},
+	}
+	sortResourceMetrics(&rm)
+
+	want := metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: instrumentation.Scope{Name: "a", Version: "v1"}},
+			{
+				Scope: instrumentation.Scope{Name: "a", Version: "v2"},
+				Metrics: []metricdata.Metrics{
+					{Name: "sum", Data: metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{{Attributes: a}, {Attributes: b}}}},
+					{Name: "gauge", Data: metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{{Attributes: a}, {Attributes: b}}}},
+					{Name: "histogram", Data: metricdata.Histogram[int64]{DataPoints: []metricdata.HistogramDataPoint[int64]{{Attributes: a}, {Attributes: b}}}},
+					{Name: "exponential", Data: metricdata.ExponentialHistogram[float64]{DataPoints: []metricdata.ExponentialHistogramDataPoint[float64]{{Attributes: a}, {Attributes: b}}}},
+					{Name: "summary", Data: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{{Attributes: a}, {Attributes: b}}}},
+				},
+			},
+			{Scope: instrumentation.Scope{Name: "b"}},
+		},
+	}
+	assert.Equal(t, want, rm)
+}
@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// ErrInstrumentName indicates the created instrument has an invalid name.
+// Valid names must consist of 255 or fewer characters including alphanumeric, _, ., -, / and start with a letter.
+//
+// The errors returned for invalid names are [*InstrumentNameError] values
+// wrapping ErrInstrumentName.
+var ErrInstrumentName = errors.New("invalid instrument name")
+
+// ErrInstrumentUnit indicates the created instrument has an invalid unit.
+// Valid units must consist of 63 or fewer ASCII characters.
+//
+// The errors returned for invalid units are [*InstrumentUnitError] values
+// wrapping ErrInstrumentUnit.
+var ErrInstrumentUnit = errors.New("invalid instrument unit")
+
+const (
+	maxInstrumentNameLen = 255
+	maxInstrumentUnitLen = 63
+)
+
+// InstrumentNameError is the error returned when an instrument is created
+// with an invalid name. It wraps [ErrInstrumentName].
+type InstrumentNameError struct {
+	// Name is the invalid name.
+	Name string
+	// Reason describes why Name is invalid.
+	Reason string
+	// Position is the position, in runes, of the first invalid character of
+	// Name. It is -1 if Name is not invalid because of a character, e.g. if
+	// it is empty or too long.
+	Position int
+	// Suggestion is a valid name derived from Name: the invalid characters
+	// are replaced with _, a name not starting with a letter is prefixed with
+	// x, and a name too long is truncated. It is empty if no name can be
+	// derived, i.e. if Name is empty.
+	Suggestion string
+}
+
+func (e *InstrumentNameError) Error() string {
+	return validationMessage(ErrInstrumentName, e.Name, e.Reason, e.Position, e.Suggestion)
+}
+
+// Unwrap returns [ErrInstrumentName].
+func (e *InstrumentNameError) Unwrap() error { return ErrInstrumentName }
+
+// InstrumentUnitError is the error returned when an instrument is created
+// with an invalid unit. It wraps [ErrInstrumentUnit].
+type InstrumentUnitError struct {
+	// Unit is the invalid unit.
+	Unit string
+	// Reason describes why Unit is invalid.
+	Reason string
+	// Position is the position, in runes, of the first invalid character of
+	// Unit. It is -1 if Unit is not invalid because of a character, i.e. if
+	// it is too long.
+	Position int
+	// Suggestion is a valid unit derived from Unit: the non-ASCII characters
+	// are replaced with _ and a unit too long is truncated.
+	Suggestion string
+}
+
+func (e *InstrumentUnitError) Error() string {
+	return validationMessage(ErrInstrumentUnit, e.Unit, e.Reason, e.Position, e.Suggestion)
+}
+
+// Unwrap returns [ErrInstrumentUnit].
+func (e *InstrumentUnitError) Unwrap() error { return ErrInstrumentUnit }
+
+func validationMessage(err error, value, reason string, pos int, suggestion string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s: %s", err, value, reason)
+	if pos >= 0 {
+		fmt.Fprintf(&b, " (position %d)", pos)
+	}
+	if suggestion != "" {
+		fmt.Fprintf(&b, ", suggestion: %s", suggestion)
+	}
+	return b.String()
+}
+
+// WithInstrumentNameSanitization configures the MeterProvider to create the
+// instruments with an invalid name or unit using the suggested valid name or
+// unit of the [InstrumentNameError] or [InstrumentUnitError] instead of
+// returning the error. A warning is logged for each sanitized name or unit.
+// The empty names, for which no name can be suggested, still result in an
+// error.
+//
+// This is useful when the instrument names are generated, e.g. from the names
+// of the metrics of another system.
+//
+// By default, if this option is not used, the instruments are created with
+// the invalid name or unit and the error is returned.
+func WithInstrumentNameSanitization() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.sanitize = true
+		return cfg
+	})
+}
+
+// validate returns the name and unit an instrument is created with, and the
+// validation error to return along with the instrument.
+func (m *meter) validate(name, unit string) (string, string, error) {
+	nameErr := validateInstrumentName(name)
+	unitErr := validateInstrumentUnit(unit)
+	if m.sanitize {
+		if e, ok := nameErr.(*InstrumentNameError); ok && e.Suggestion != "" {
+			global.Warn("Invalid instrument name sanitized.", "name", name, "sanitized", e.Suggestion, "reason", e.Reason)
+			name, nameErr = e.Suggestion, nil
+		}
+		if e, ok := unitErr.(*InstrumentUnitError); ok {
+			global.Warn("Invalid instrument unit sanitized.", "unit", unit, "sanitized", e.Suggestion, "reason", e.Reason)
+			unit, unitErr = e.Suggestion, nil
+		}
+	}
+
+	switch {
+	case nameErr == nil:
+		return name, unit, unitErr
+	case unitErr == nil:
+		return name, unit, nameErr
+	}
+	return name, unit, errors.Join(nameErr, unitErr)
+}
+
+func validateInstrumentName(name string) error {
+	if len(name) == 0 {
+		return &InstrumentNameError{Name: name, Reason: "is empty", Position: -1}
+	}
+	if len(name) > maxInstrumentNameLen {
+		return &InstrumentNameError{
+			Name:       name,
+			Reason:     "longer than 255 characters",
+			Position:   -1,
+			Suggestion: sanitizeInstrumentName(name),
+		}
+	}
+	if first, _ := utf8.DecodeRuneInString(name); !isAlpha(first) {
+		return &InstrumentNameError{
+			Name:       name,
+			Reason:     "must start with a letter",
+			Position:   0,
+			Suggestion: sanitizeInstrumentName(name),
+		}
+	}
+	pos := 0
+	for _, c := range name {
+		if !isNameChar(c) {
+			return &InstrumentNameError{
+				Name:       name,
+				Reason:     "must only contain [A-Za-z0-9_.-/]",
+				Position:   pos,
+				Suggestion: sanitizeInstrumentName(name),
+			}
+		}
+		pos++
+	}
+	return nil
+}
+
+// sanitizeInstrumentName returns the valid name derived from name, or an
+// empty string if name is empty.
+func sanitizeInstrumentName(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(len(name) + 1)
+	if first, _ := utf8.DecodeRuneInString(name); !isAlpha(first) {
+		b.WriteByte('x')
+	}
+	for _, c := range name {
+		if isNameChar(c) {
+			b.WriteRune(c)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	s := b.String()
+	if len(s) > maxInstrumentNameLen {
+		// Only ASCII characters are left, the name is truncated on a
+		// character boundary.
+		s = s[:maxInstrumentNameLen]
+	}
+	return s
+}
+
+func validateInstrumentUnit(unit string) error {
+	pos := 0
+	for _, c := range unit {
+		if c >= utf8.RuneSelf {
+			return &InstrumentUnitError{
+				Unit:       unit,
+				Reason:     "must only contain ASCII characters",
+				Position:   pos,
+				Suggestion: sanitizeInstrumentUnit(unit),
+			}
+		}
+		pos++
+	}
+	if len(unit) > maxInstrumentUnitLen {
+		return &InstrumentUnitError{
+			Unit:       unit,
+			Reason:     "longer than 63 characters",
+			Position:   -1,
+			Suggestion: sanitizeInstrumentUnit(unit),
+		}
+	}
+	return nil
+}
+
+// sanitizeInstrumentUnit returns the valid unit derived from unit.
+func sanitizeInstrumentUnit(unit string) string {
+	var b strings.Builder
+	b.Grow(len(unit))
+	for _, c := range unit {
+		if c < utf8.RuneSelf {
+			b.WriteRune(c)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	s := b.String()
+	if len(s) > maxInstrumentUnitLen {
+		s = s[:maxInstrumentUnitLen]
+	}
+	return s
+}
+
+func isNameChar(c rune) bool {
+	return isAlphanumeric(c) || c == '_' || c == '.' || c == '-' || c == '/'
+}
+
+func isAlpha(c rune) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isAlphanumeric(c rune) bool {
+	return isAlpha(c) || ('0' <= c && c <= '9')
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestInstrumentNameError(t *testing.T) {
+	err := validateInstrumentName("héllo!")
+	var nameErr *InstrumentNameError
+	require.ErrorAs(t, err, &nameErr)
+	assert.ErrorIs(t, err, ErrInstrumentName)
+	assert.Equal(t, 1, nameErr.Position, "position in runes")
+	assert.Equal(t, "h_llo_", nameErr.Suggestion)
+	assert.EqualError(t, err, "invalid instrument name: héllo!: must only contain [A-Za-z0-9_.-/] (position 1), suggestion: h_llo_")
+
+	assert.EqualError(t, validateInstrumentName(""), "invalid instrument name: : is empty")
+}
+
+func TestSanitizeInstrumentName(t *testing.T) {
+	for name, want := range map[string]string{
+		"":                       "",
+		"valid":                  "valid",
+		"1st":                    "x1st",
+		"_a":                     "x_a",
+		"a b":                    "a_b",
+		"é":                      "x_",
+		strings.Repeat("a", 300): strings.Repeat("a", 255),
+	} {
+		got := sanitizeInstrumentName(name)
+		assert.Equal(t, want, got, "name %q", name)
+		if got != "" {
+			assert.NoError(t, validateInstrumentName(got), "name %q", name)
+		}
+	}
+}
+
+func TestValidateInstrumentUnit(t *testing.T) {
+	assert.NoError(t, validateInstrumentUnit(""))
+	assert.NoError(t, validateInstrumentUnit("By/s"))
+
+	err := validateInstrumentUnit("°C")
+	assert.ErrorIs(t, err, ErrInstrumentUnit)
+	assert.Equal(t, &InstrumentUnitError{
+		Unit:       "°C",
+		Reason:     "must only contain ASCII characters",
+		Position:   0,
+		Suggestion: "_C",
+	}, err)
+
+	long := strings.Repeat("u", 64)
+	assert.Equal(t, &InstrumentUnitError{
+		Unit:       long,
+		Reason:     "longer than 63 characters",
+		Position:   -1,
+		Suggestion: long[:63],
+	}, validateInstrumentUnit(long))
+}
+
+func TestMeterValidationErrors(t *testing.T) {
+	m := NewMeterProvider().Meter("test")
+
+	_, err := m.Int64Counter("1", metric.WithUnit("°C"))
+	assert.ErrorIs(t, err, ErrInstrumentName)
+	assert.ErrorIs(t, err, ErrInstrumentUnit)
+
+	_, err = m.Float64Histogram("hist", metric.WithUnit("°C"))
+	var unitErr *InstrumentUnitError
+	assert.ErrorAs(t, err, &unitErr)
+	assert.False(t, errors.Is(err, ErrInstrumentName))
+
+	_, err = m.Int64ObservableGauge("gauge!")
+	assert.ErrorIs(t, err, ErrInstrumentName)
+}
+
+func TestWithInstrumentNameSanitization(t *testing.T) {
+	rdr := NewManualReader()
+	m := NewMeterProvider(WithReader(rdr), WithInstrumentNameSanitization()).Meter("test")
+
+	ctx := context.Background()
+	counter, err := m.Int64Counter("1 counter", metric.WithUnit("°C"))
+	require.NoError(t, err)
+	counter.Add(ctx, 1)
+
+	hist, err := m.Float64Histogram("hist!", metric.WithUnit("°C"))
+	require.NoError(t, err)
+	hist.Record(ctx, 1)
+
+	_, err = m.Float64ObservableGauge("gauge!", metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+		o.Observe(1)
+		return nil
+	}))
+	require.NoError(t, err)
+
+	_, err = m.Int64Counter("")
+	assert.ErrorIs(t, err, ErrInstrumentName, "empty names cannot be sanitized")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	var got []string
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		got = append(got, m.Name+" "+m.Unit)
+	}
+	assert.ElementsMatch(t, []string{"x1_counter _C", "hist_ _C", "gauge_ "}, got)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"go.opentelemetry.io/otel/internal/global"
 )
@@ -14,6 +15,7 @@ import (
 var (
 	errMultiInst = errors.New("name replacement for multiple instruments")
 	errEmptyView = errors.New("no criteria provided for view")
+	errViewDesc  = errors.New("invalid view description: must be valid UTF-8")
 
 	emptyView = func(Instrument) (Stream, bool) { return Stream{}, false }
 )
@@ -42,6 +44,13 @@ type View func(Instrument) (Stream, bool)
 // AttributeFilter are set. All non-zero-value fields of mask are used instead
 // of the default. If you need to zero out an Stream field returned from a
 // View, create a View directly.
+//
+// The Name and Unit of mask are validated as the ones of the instruments, see
+// [ErrInstrumentName] and [ErrInstrumentUnit], and its Description needs to
+// be valid UTF-8. An invalid field of mask is not used, the error is logged
+// and the field of the Instrument is used instead. This allows overriding the
+// metadata of the instruments of third-party instrumentation, e.g. fixing an
+// inconsistent unit, without producing invalid streams.
 func NewView(criteria Instrument, mask Stream) View {
 	if criteria.empty() {
 		global.Error(
@@ -93,6 +102,33 @@ func NewView(criteria Instrument, mask Stream) View {
 		}
 	}
 
+	if mask.Name != "" {
+		if err := validateInstrumentName(mask.Name); err != nil {
+			global.Error(
+				err, "not using name with view",
+				"criteria", criteria,
+				"mask", mask,
+			)
+			mask.Name = ""
+		}
+	}
+	if err := validateInstrumentUnit(mask.Unit); err != nil {
+		global.Error(
+			err, "not using unit with view",
+			"criteria", criteria,
+			"mask", mask,
+		)
+		mask.Unit = ""
+	}
+	if !utf8.ValidString(mask.Description) {
+		global.Error(
+			errViewDesc, "not using description with view",
+			"criteria", criteria,
+			"mask", mask,
+		)
+		mask.Description = ""
+	}
+
 	return func(i Instrument) (Stream, bool) {
 		if matchFunc(i) {
 			return Stream{
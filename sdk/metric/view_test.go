@@ -331,6 +331,7 @@ func TestNewViewMatch(t *testing.T) {
 
 func TestNewViewReplace(t *testing.T) {
 	alt := "alternative value"
+	altName := "alternative.name"
 	tests := []struct {
 		name string
 		mask Stream
@@ -348,10 +349,10 @@ func TestNewViewReplace(t *testing.T) {
 		},
 		{
 			name: "Name",
-			mask: Stream{Name: alt},
+			mask: Stream{Name: altName},
 			want: func(i Instrument) Stream {
 				return Stream{
-					Name:        alt,
+					Name:        altName,
 					Description: i.Description,
 					Unit:        i.Unit,
 				}
@@ -394,14 +395,14 @@ func TestNewViewReplace(t *testing.T) {
 		{
 			name: "Complete",
 			mask: Stream{
-				Name:        alt,
+				Name:        altName,
 				Description: alt,
 				Unit:        "1",
 				Aggregation: AggregationLastValue{},
 			},
 			want: func(i Instrument) Stream {
 				return Stream{
-					Name:        alt,
+					Name:        altName,
 					Description: alt,
 					Unit:        "1",
 					Aggregation: AggregationLastValue{},
@@ -457,6 +458,53 @@ func TestNewViewAggregationErrorLogged(t *testing.T) {
 	assert.Equal(t, 1, l.ErrorN())
 }
 
+func TestNewViewInvalidMaskErrorLogged(t *testing.T) {
+	tests := []struct {
+		name string
+		mask Stream
+		want error
+	}{
+		{
+			name: "Name",
+			mask: Stream{Name: "1nvalid name"},
+			want: ErrInstrumentName,
+		},
+		{
+			name: "Unit",
+			mask: Stream{Unit: "°C"},
+			want: ErrInstrumentUnit,
+		},
+		{
+			name: "Description",
+			mask: Stream{Description: "invalid \xff"},
+			want: errViewDesc,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			otel.SetLogger(funcr.New(func(_, args string) {
+				got = append(got, args)
+			}, funcr.Options{Verbosity: 6}))
+
+			mask := test.mask
+			mask.Aggregation = AggregationLastValue{}
+			stream, match := NewView(completeIP, mask)(completeIP)
+			require.True(t, match, "view did not match exact criteria")
+			assert.Equal(t, Stream{
+				Name:        completeIP.Name,
+				Description: completeIP.Description,
+				Unit:        completeIP.Unit,
+				Aggregation: AggregationLastValue{},
+			}, stream, "invalid field used")
+
+			require.Len(t, got, 1)
+			assert.Contains(t, got[0], test.want.Error())
+		})
+	}
+}
+
 func TestNewViewEmptyViewErrorLogged(t *testing.T) {
 	var got string
 	otel.SetLogger(funcr.New(func(_, args string) {
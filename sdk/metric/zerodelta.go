@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// WithZeroDeltaFiltering configures a reader to drop the delta temporality
+// data points that report no change: the sums with a zero value and the
+// histograms with a zero count. This reduces the size of the exports of
+// instruments whose measurements cancel out, e.g. an UpDownCounter, or that
+// record zero values.
+//
+// The first data point of a stream, identified by its instrumentation scope,
+// instrument name, and attributes, is always kept so that the backends learn
+// of the stream. A stream that is not part of a collection is considered
+// stale and is forgotten: its next data point is also considered the first
+// one and kept.
+//
+// The metrics left without data points, and the scopes left without metrics,
+// are dropped. Cumulative temporality data points and gauges are not
+// filtered.
+//
+// By default, if this option is not used, all the data points are kept.
+func WithZeroDeltaFiltering() ReaderOption {
+	return zeroDeltaOption{}
+}
+
+type zeroDeltaOption struct{}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (zeroDeltaOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.zeroDelta = newZeroDeltaFilter()
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (zeroDeltaOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.zeroDelta = newZeroDeltaFilter()
+	return c
+}
+
+// streamID identifies a stream of data points.
+type streamID struct {
+	scope instrumentation.Scope
+	name  string
+	attrs attribute.Distinct
+}
+
+// zeroDeltaFilter drops the delta data points reporting no change of the
+// streams it has already seen.
+type zeroDeltaFilter struct {
+	mu sync.Mutex
+	// seen are the streams of the last collection.
+	seen map[streamID]struct{}
+}
+
+func newZeroDeltaFilter() *zeroDeltaFilter {
+	return &zeroDeltaFilter{seen: make(map[streamID]struct{})}
+}
+
+// filter drops the delta data points reporting no change of the streams
+// already seen from rm. If f is nil, rm is left unchanged.
+func (f *zeroDeltaFilter) filter(rm *metricdata.ResourceMetrics) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	seen := make(map[streamID]struct{}, len(f.seen))
+	rm.ScopeMetrics = compact(rm.ScopeMetrics, func(sm *metricdata.ScopeMetrics) bool {
+		sm.Metrics = compact(sm.Metrics, func(m *metricdata.Metrics) bool {
+			id := streamID{scope: sm.Scope, name: m.Name}
+			keep := func(attrs attribute.Set, zero bool) bool {
+				id.attrs = attrs.Equivalent()
+				_, ok := f.seen[id]
+				seen[id] = struct{}{}
+				return !zero || !ok
+			}
+			return filterZeroDelta(&m.Data, keep)
+		})
+		return len(sm.Metrics) > 0
+	})
+	f.seen = seen
+}
+
+// filterZeroDelta drops the data points of a delta temporality agg for which
+// keep returns false. The attributes of each data point, and if it reports no
+// change, are passed to keep. It returns if agg still has data points. The
+// data points of other aggregations are all kept.
+func filterZeroDelta(agg *metricdata.Aggregation, keep func(attribute.Set, bool) bool) bool {
+	switch a := (*agg).(type) {
+	case metricdata.Sum[int64]:
+		if a.Temporality == metricdata.DeltaTemporality {
+			a.DataPoints = compact(a.DataPoints, func(dp *metricdata.DataPoint[int64]) bool {
+				return keep(dp.Attributes, dp.Value == 0)
+			})
+			*agg = a
+		}
+		return len(a.DataPoints) > 0
+	case metricdata.Sum[float64]:
+		if a.Temporality == metricdata.DeltaTemporality {
+			a.DataPoints = compact(a.DataPoints, func(dp *metricdata.DataPoint[float64]) bool {
+				return keep(dp.Attributes, dp.Value == 0)
+			})
+			*agg = a
+		}
+		return len(a.DataPoints) > 0
+	case metricdata.Histogram[int64]:
+		if a.Temporality == metricdata.DeltaTemporality {
+			a.DataPoints = compact(a.DataPoints, func(dp *metricdata.HistogramDataPoint[int64]) bool {
+				return keep(dp.Attributes, dp.Count == 0)
+			})
+			*agg = a
+		}
+		return len(a.DataPoints) > 0
+	case metricdata.Histogram[float64]:
+		if a.Temporality == metricdata.DeltaTemporality {
+			a.DataPoints = compact(a.DataPoints, func(dp *metricdata.HistogramDataPoint[float64]) bool {
+				return keep(dp.Attributes, dp.Count == 0)
+			})
+			*agg = a
+		}
+		return len(a.DataPoints) > 0
+	case metricdata.ExponentialHistogram[int64]:
+		if a.Temporality == metricdata.DeltaTemporality {
+			a.DataPoints = compact(a.DataPoints, func(dp *metricdata.ExponentialHistogramDataPoint[int64]) bool {
+				return keep(dp.Attributes, dp.Count == 0)
+			})
+			*agg = a
+		}
+		return len(a.DataPoints) > 0
+	case metricdata.ExponentialHistogram[float64]:
+		if a.Temporality == metricdata.DeltaTemporality {
+			a.DataPoints = compact(a.DataPoints, func(dp *metricdata.ExponentialHistogramDataPoint[float64]) bool {
+				return keep(dp.Attributes, dp.Count == 0)
+			})
+			*agg = a
+		}
+		return len(a.DataPoints) > 0
+	}
+	return true
+}
+
+// compact moves the elements of s for which keep returns true to the front of
+// s, in order, and returns them. The other elements are swapped to the back of
+// s instead of being overwritten so that no element is duplicated: the
+// backing array is reused by the next collection.
+func compact[T any](s []T, keep func(*T) bool) []T {
+	n := 0
+	for i := range s {
+		if keep(&s[i]) {
+			s[n], s[i] = s[i], s[n]
+			n++
+		}
+	}
+	return s[:n]
+}
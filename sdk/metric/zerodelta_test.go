@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func deltaSelector(InstrumentKind) metricdata.Temporality {
+	return metricdata.DeltaTemporality
+}
+
+// streams returns the "<metric>:<attribute value>" of the data points of rm.
+func streams(rm metricdata.ResourceMetrics) []string {
+	var out []string
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			add := func(attrs attribute.Set) {
+				v, _ := attrs.Value("k")
+				out = append(out, m.Name+":"+v.AsString())
+			}
+			switch a := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range a.DataPoints {
+					add(dp.Attributes)
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range a.DataPoints {
+					add(dp.Attributes)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func TestWithZeroDeltaFiltering(t *testing.T) {
+	reader := NewManualReader(
+		WithTemporalitySelector(deltaSelector),
+		WithZeroDeltaFiltering(),
+	)
+	mp := NewMeterProvider(WithReader(reader))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	meter := mp.Meter("test")
+	udc, err := meter.Int64UpDownCounter("udc")
+	require.NoError(t, err)
+	counter, err := meter.Int64Counter("counter")
+	require.NoError(t, err)
+	hist, err := meter.Float64Histogram("hist")
+	require.NoError(t, err)
+
+	a := metric.WithAttributes(attribute.String("k", "a"))
+	b := metric.WithAttributes(attribute.String("k", "b"))
+	ctx := context.Background()
+	var rm metricdata.ResourceMetrics
+	collect := func() []string {
+		t.Helper()
+		require.NoError(t, reader.Collect(ctx, &rm))
+		return streams(rm)
+	}
+
+	udc.Add(ctx, 1, a)
+	udc.Add(ctx, -1, a)
+	counter.Add(ctx, 0, a)
+	hist.Record(ctx, 1, a)
+	assert.Equal(t, []string{"udc:a", "counter:a", "hist:a"}, collect(), "first observations")
+
+	udc.Add(ctx, 1, a)
+	udc.Add(ctx, -1, a)
+	counter.Add(ctx, 0, a)
+	counter.Add(ctx, 0, b)
+	hist.Record(ctx, 1, a)
+	assert.Equal(t, []string{"counter:b", "hist:a"}, collect(), "unchanged streams")
+
+	counter.Add(ctx, 2, a)
+	assert.Equal(t, []string{"counter:a"}, collect(), "changed stream")
+
+	// The udc:a stream was not part of the last collection. It is stale.
+	udc.Add(ctx, 0, a)
+	counter.Add(ctx, 0, a)
+	assert.Equal(t, []string{"udc:a"}, collect(), "stale stream")
+}
+
+func TestWithZeroDeltaFilteringPeriodicReader(t *testing.T) {
+	cfg := newPeriodicReaderConfig([]PeriodicReaderOption{WithZeroDeltaFiltering()})
+	assert.NotNil(t, cfg.zeroDelta)
+}
+
+func TestZeroDeltaFilterOtherAggregations(t *testing.T) {
+	zero := attribute.NewSet(attribute.String("k", "zero"))
+	cumulative := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.DataPoint[int64]{{Attributes: zero}},
+	}
+	gauge := metricdata.Gauge[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{{Attributes: zero}},
+	}
+	rm := metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+		Metrics: []metricdata.Metrics{
+			{Name: "cumulative", Data: cumulative},
+			{Name: "gauge", Data: gauge},
+		},
+	}}}
+
+	f := newZeroDeltaFilter()
+	f.filter(&rm)
+	f.filter(&rm)
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, []metricdata.Metrics{
+		{Name: "cumulative", Data: cumulative},
+		{Name: "gauge", Data: gauge},
+	}, rm.ScopeMetrics[0].Metrics)
+}
+
+func TestZeroDeltaFilterNil(t *testing.T) {
+	rm := metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{}}}
+	var f *zeroDeltaFilter
+	f.filter(&rm)
+	assert.Len(t, rm.ScopeMetrics, 1)
+}
+
+func TestCompact(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	got := compact(s, func(v *int) bool { return *v%2 == 1 })
+	assert.Equal(t, []int{1, 3, 5}, got)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, s, "elements duplicated")
+}
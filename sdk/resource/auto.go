@@ -46,7 +46,7 @@ type Detector interface {
 // error will wrap that detector's error.
 func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
 	r := new(Resource)
-	return r, detect(ctx, r, detectors)
+	return r, detect(ctx, r, detectors, nil)
 }
 
 // detect runs all detectors using ctx and merges the result into res. This
@@ -55,7 +55,7 @@ func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
 // If the detectors or merging resources produces any errors (i.e.
 // [ErrPartialResource] [ErrSchemaURLConflict]), a single error wrapping all of
 // these errors will be returned. Otherwise, nil is returned.
-func detect(ctx context.Context, res *Resource, detectors []Detector) error {
+func detect(ctx context.Context, res *Resource, detectors []Detector, resolve SchemaURLResolver) error {
 	var (
 		r    *Resource
 		errs detectErrs
@@ -73,7 +73,7 @@ func detect(ctx context.Context, res *Resource, detectors []Detector) error {
 				continue
 			}
 		}
-		r, err = Merge(res, r)
+		r, err = MergeWithResolver(res, r, resolve)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -15,6 +15,9 @@ type config struct {
 	detectors []Detector
 	// SchemaURL to associate with the Resource.
 	schemaURL string
+	// schemaURLResolver resolves conflicting schema URLs of detected
+	// Resources.
+	schemaURLResolver SchemaURLResolver
 }
 
 // Option is the interface that applies a configuration option.
@@ -82,6 +85,26 @@ func (o schemaURLOption) apply(cfg config) config {
 	return cfg
 }
 
+// WithSchemaURLResolver sets the [SchemaURLResolver] used to resolve the
+// different, non-empty, schema URLs of the Resources detected for the
+// configured Resource (see [MergeWithResolver]).
+//
+// By default, if this option is not passed, a schema URL conflict results in
+// an error containing [ErrSchemaURLConflict] and a Resource with an empty
+// schema URL.
+func WithSchemaURLResolver(resolve SchemaURLResolver) Option {
+	return schemaURLResolverOption{resolve: resolve}
+}
+
+type schemaURLResolverOption struct {
+	resolve SchemaURLResolver
+}
+
+func (o schemaURLResolverOption) apply(cfg config) config {
+	cfg.schemaURLResolver = o.resolve
+	return cfg
+}
+
 // WithOS adds all the OS attributes to the configured Resource.
 // See individual WithOS* functions to configure specific attributes.
 func WithOS() Option {
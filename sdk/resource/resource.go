@@ -51,7 +51,7 @@ func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	}
 
 	r := &Resource{schemaURL: cfg.schemaURL}
-	return r, detect(ctx, r, cfg.detectors)
+	return r, detect(ctx, r, cfg.detectors, cfg.schemaURLResolver)
 }
 
 // NewWithAttributes creates a resource from attrs and associates the resource with a
@@ -169,8 +169,21 @@ func (r *Resource) Equal(eq *Resource) bool {
 //     returned Resource. It is up to the caller to determine if this returned
 //     Resource should be used or not.
 //
+// Use [MergeWithResolver] to resolve conflicting schema URLs instead.
+//
 // [OpenTelemetry specification rules]: https://github.com/open-telemetry/opentelemetry-specification/blob/v1.20.0/specification/resource/sdk.md#merge
 func Merge(a, b *Resource) (*Resource, error) {
+	return MergeWithResolver(a, b, nil)
+}
+
+// MergeWithResolver creates a new [Resource] by merging a and b the same way
+// as [Merge], except that different, non-empty, schema URLs of a and b are
+// resolved with resolve. This allows merging Resources created with
+// different versions of the semantic conventions (e.g. by detectors from
+// different modules) without a merge error.
+//
+// If resolve is nil, conflicting schema URLs are handled like [Merge] does.
+func MergeWithResolver(a, b *Resource, resolve SchemaURLResolver) (*Resource, error) {
 	if a == nil && b == nil {
 		return Empty(), nil
 	}
@@ -196,6 +209,12 @@ func Merge(a, b *Resource) (*Resource, error) {
 		return NewWithAttributes(a.schemaURL, combine...), nil
 	case a.schemaURL == b.schemaURL:
 		return NewWithAttributes(a.schemaURL, combine...), nil
+	case resolve != nil:
+		schemaURL, err := resolve(a.schemaURL, b.schemaURL)
+		if err != nil {
+			return NewSchemaless(combine...), err
+		}
+		return NewWithAttributes(schemaURL, combine...), nil
 	}
 	// Return the merged resource with an appropriate error. It is up to
 	// the user to decide if the returned resource can be used or not.
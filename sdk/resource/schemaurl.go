@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SchemaURLResolver resolves the schema URL of the Resource merged from a
+// Resource with the schema URL a and a Resource with the schema URL b. It is
+// only called when a and b are different and non-empty.
+//
+// If an error is returned, the merged Resource has no schema URL and the
+// error is returned by the merge.
+type SchemaURLResolver func(a, b string) (string, error)
+
+// Compile-time check the resolvers provided implement SchemaURLResolver.
+var (
+	_ SchemaURLResolver = PreferLatestSchemaURL
+	_ SchemaURLResolver = PreferTargetSchemaURL
+)
+
+// PreferLatestSchemaURL is a [SchemaURLResolver] that resolves to the schema
+// URL with the latest version. Schema URLs are expected to end with their
+// version, e.g. https://opentelemetry.io/schemas/1.24.0.
+//
+// An error containing [ErrSchemaURLConflict] is returned if a and b are not
+// from the same schema family, i.e. they differ by more than their version,
+// or if the version of either cannot be parsed.
+func PreferLatestSchemaURL(a, b string) (string, error) {
+	aPrefix, aVer, aOK := splitSchemaURL(a)
+	bPrefix, bVer, bOK := splitSchemaURL(b)
+	if !aOK || !bOK || aPrefix != bPrefix {
+		return "", fmt.Errorf("%w: %s and %s", ErrSchemaURLConflict, a, b)
+	}
+	if compareVersions(aVer, bVer) > 0 {
+		return a, nil
+	}
+	return b, nil
+}
+
+// PreferTargetSchemaURL is a [SchemaURLResolver] that resolves to the schema
+// URL b of the Resource merged into the other one. This matches how the
+// attributes of that Resource take precedence in a merge.
+func PreferTargetSchemaURL(_, b string) (string, error) {
+	return b, nil
+}
+
+// splitSchemaURL splits u into the prefix and version of the schema. If the
+// version cannot be parsed, false is returned.
+func splitSchemaURL(u string) (prefix string, version []int, ok bool) {
+	i := strings.LastIndexByte(u, '/')
+	if i < 0 {
+		return "", nil, false
+	}
+	prefix = u[:i]
+	for _, s := range strings.Split(u[i+1:], ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return "", nil, false
+		}
+		version = append(version, n)
+	}
+	return prefix, version, true
+}
+
+// compareVersions returns -1, 0, or 1 if a is respectively lower, equal, or
+// greater than b. Missing trailing components are considered zero.
+func compareVersions(a, b []int) int {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
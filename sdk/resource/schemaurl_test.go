@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	schema120 = "https://opentelemetry.io/schemas/1.20.0"
+	schema124 = "https://opentelemetry.io/schemas/1.24.0"
+)
+
+func TestPreferLatestSchemaURL(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantErr bool
+	}{
+		{name: "BLatest", a: schema120, b: schema124, want: schema124},
+		{name: "ALatest", a: schema124, b: schema120, want: schema124},
+		{name: "Numeric", a: "https://example.com/1.9", b: "https://example.com/1.10", want: "https://example.com/1.10"},
+		{name: "MissingComponent", a: "https://example.com/1.1", b: "https://example.com/1.1.0", want: "https://example.com/1.1.0"},
+		{name: "DifferentFamilies", a: schema120, b: "https://example.com/schemas/1.24.0", wantErr: true},
+		{name: "InvalidVersion", a: schema120, b: "https://opentelemetry.io/schemas/latest", wantErr: true},
+		{name: "NoPath", a: "1.0.0", b: "2.0.0", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resource.PreferLatestSchemaURL(tc.a, tc.b)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPreferTargetSchemaURL(t *testing.T) {
+	got, err := resource.PreferTargetSchemaURL(schema124, schema120)
+	require.NoError(t, err)
+	assert.Equal(t, schema120, got)
+}
+
+func TestMergeWithResolver(t *testing.T) {
+	a := resource.NewWithAttributes(schema120, kv11)
+	b := resource.NewWithAttributes(schema124, kv12, kv21)
+
+	res, err := resource.MergeWithResolver(a, b, resource.PreferLatestSchemaURL)
+	require.NoError(t, err)
+	assert.Equal(t, schema124, res.SchemaURL())
+	assert.Equal(t, []attribute.KeyValue{kv12, kv21}, res.Attributes())
+
+	errResolver := errors.New("resolver error")
+	res, err = resource.MergeWithResolver(a, b, func(string, string) (string, error) {
+		return "", errResolver
+	})
+	assert.ErrorIs(t, err, errResolver)
+	assert.Equal(t, "", res.SchemaURL())
+	assert.Equal(t, []attribute.KeyValue{kv12, kv21}, res.Attributes())
+
+	_, err = resource.MergeWithResolver(a, b, nil)
+	assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+
+	var called bool
+	res, err = resource.MergeWithResolver(a, resource.NewSchemaless(kv21), func(string, string) (string, error) {
+		called = true
+		return "", nil
+	})
+	require.NoError(t, err)
+	assert.False(t, called, "resolver called without conflict")
+	assert.Equal(t, schema120, res.SchemaURL())
+}
+
+func TestNewWithSchemaURLResolver(t *testing.T) {
+	ctx := context.Background()
+	opts := []resource.Option{
+		resource.WithDetectors(
+			resource.StringDetector(schema124, "k1", func() (string, error) { return "v1", nil }),
+			resource.StringDetector(schema120, "k2", func() (string, error) { return "v2", nil }),
+		),
+	}
+
+	_, err := resource.New(ctx, opts...)
+	assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+
+	opts = append(opts, resource.WithSchemaURLResolver(resource.PreferLatestSchemaURL))
+	res, err := resource.New(ctx, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, schema124, res.SchemaURL())
+	assert.Equal(t, 2, res.Len())
+}
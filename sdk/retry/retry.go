@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retry provides request retry functionality that can perform
+// configurable exponential backoff for transient errors and honor any
+// explicit throttle responses received.
+//
+// It is intended to be used by exporters to retry the export of telemetry.
+package retry // import "go.opentelemetry.io/otel/sdk/retry"
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const (
+	// DefaultMultiplier is the default factor by which the backoff interval
+	// is multiplied after each retry.
+	DefaultMultiplier = 1.5
+	// DefaultRandomizationFactor is the default jitter applied to the
+	// backoff interval.
+	DefaultRandomizationFactor = 0.5
+)
+
+// DefaultConfig are the recommended defaults to use.
+var DefaultConfig = Config{
+	Enabled:         true,
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     30 * time.Second,
+	MaxElapsedTime:  time.Minute,
+}
+
+// Config defines configuration for retrying requests in case of failure
+// using an exponential backoff.
+type Config struct {
+	// Enabled indicates whether to retry requests in case of failure.
+	Enabled bool
+	// InitialInterval the time to wait after the first failure before
+	// retrying.
+	InitialInterval time.Duration
+	// MaxInterval is the upper bound on backoff interval. Once this value is
+	// reached the delay between consecutive retries will always be
+	// `MaxInterval`.
+	MaxInterval time.Duration
+	// MaxElapsedTime is the maximum amount of time (including retries) spent
+	// trying to send a request. Once this value is reached, the request is
+	// abandoned. If zero, there is no limit.
+	MaxElapsedTime time.Duration
+	// Multiplier is the factor by which the backoff interval is multiplied
+	// after each retry. If zero, DefaultMultiplier is used.
+	Multiplier float64
+	// RandomizationFactor is the jitter applied to the backoff interval. The
+	// wait time is randomly selected in the range
+	// [interval*(1-RandomizationFactor), interval*(1+RandomizationFactor)].
+	// If zero, DefaultRandomizationFactor is used. If negative, no jitter is
+	// applied.
+	RandomizationFactor float64
+}
+
+// RequestFunc wraps a request with retry logic.
+type RequestFunc func(context.Context, func(context.Context) error) error
+
+// EvaluateFunc returns if an error is retry-able and if an explicit throttle
+// duration should be honored that was included in the error.
+//
+// The function must return true if the error argument is retry-able,
+// otherwise it must return false for the first return parameter.
+//
+// The function must return a non-zero time.Duration if the error contains
+// explicit throttle duration that should be honored, otherwise it must return
+// a zero valued time.Duration.
+type EvaluateFunc func(error) (bool, time.Duration)
+
+// RequestFunc returns a RequestFunc using the evaluate function to determine
+// if requests can be retried and based on the exponential backoff
+// configuration of c.
+func (c Config) RequestFunc(evaluate EvaluateFunc) RequestFunc {
+	if !c.Enabled {
+		return func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}
+	}
+
+	return func(ctx context.Context, fn func(context.Context) error) error {
+		b := c.newBackoff()
+		for {
+			err := fn(ctx)
+			if err == nil {
+				return nil
+			}
+
+			retryable, throttle := evaluate(err)
+			if !retryable {
+				return err
+			}
+
+			bOff, ok := b.next()
+			if !ok {
+				return fmt.Errorf("max retry time elapsed: %w", err)
+			}
+
+			// Wait for the greater of the backoff or throttle delay.
+			var delay time.Duration
+			if bOff > throttle {
+				delay = bOff
+			} else {
+				elapsed := b.elapsed()
+				if c.MaxElapsedTime != 0 && elapsed+throttle > c.MaxElapsedTime {
+					return fmt.Errorf("max retry time would elapse: %w", err)
+				}
+				delay = throttle
+			}
+
+			if ctxErr := waitFunc(ctx, delay); ctxErr != nil {
+				return fmt.Errorf("%w: %w", ctxErr, err)
+			}
+		}
+	}
+}
+
+// Do calls fn, retrying it as configured by c while evaluate reports its
+// returned error is retry-able. It is a shorthand for
+// c.RequestFunc(evaluate)(ctx, fn).
+func (c Config) Do(ctx context.Context, evaluate EvaluateFunc, fn func(context.Context) error) error {
+	return c.RequestFunc(evaluate)(ctx, fn)
+}
+
+// backoff computes exponential backoff intervals.
+type backoff struct {
+	current  time.Duration
+	max      time.Duration
+	maxTotal time.Duration
+	mult     float64
+	factor   float64
+	start    time.Time
+}
+
+func (c Config) newBackoff() *backoff {
+	b := &backoff{
+		current:  c.InitialInterval,
+		max:      c.MaxInterval,
+		maxTotal: c.MaxElapsedTime,
+		mult:     c.Multiplier,
+		factor:   c.RandomizationFactor,
+		start:    now(),
+	}
+	if b.mult == 0 {
+		b.mult = DefaultMultiplier
+	}
+	if b.factor == 0 {
+		b.factor = DefaultRandomizationFactor
+	} else if b.factor < 0 {
+		b.factor = 0
+	}
+	return b
+}
+
+// elapsed returns the time elapsed since b was created.
+func (b *backoff) elapsed() time.Duration {
+	return now().Sub(b.start)
+}
+
+// next returns the next backoff interval and true, or false if the maximum
+// elapsed time would be exceeded.
+func (b *backoff) next() (time.Duration, bool) {
+	elapsed := b.elapsed()
+	next := jitter(b.current, b.factor)
+
+	// Increment the current interval, guarding against overflow.
+	if b.max > 0 && float64(b.current) >= float64(b.max)/b.mult {
+		b.current = b.max
+	} else {
+		b.current = time.Duration(float64(b.current) * b.mult)
+	}
+
+	if b.maxTotal != 0 && elapsed+next > b.maxTotal {
+		return 0, false
+	}
+	return next, true
+}
+
+// Allow override for testing.
+var randFloat64 = rand.Float64
+
+// jitter returns a random duration in the range
+// [interval*(1-factor), interval*(1+factor)].
+func jitter(interval time.Duration, factor float64) time.Duration {
+	if factor == 0 {
+		return interval
+	}
+	r := randFloat64()
+	delta := factor * float64(interval)
+	minInterval := float64(interval) - delta
+	maxInterval := float64(interval) + delta
+	return time.Duration(minInterval + r*(maxInterval-minInterval+1))
+}
+
+// Allow override for testing.
+var (
+	now      = time.Now
+	waitFunc = wait
+)
+
+// wait takes the caller's context, and the amount of time to wait.  It will
+// return nil if the timer fires before or at the same time as the context's
+// deadline.  This indicates that the call can be retried.
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Handle the case where the timer and context deadline end
+		// simultaneously by prioritizing the timer expiration nil value
+		// response.
+		select {
+		case <-timer.C:
+		default:
+			return ctx.Err()
+		}
+	case <-timer.C:
+	}
+
+	return nil
+}
@@ -1,6 +1,3 @@
-// Code created by gotmpl. DO NOT MODIFY.
-// source: internal/shared/otlp/retry/retry_test.go.tmpl
-
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
@@ -14,7 +11,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -128,7 +124,7 @@ func TestBackoffRetry(t *testing.T) {
 	origWait := waitFunc
 	var done bool
 	waitFunc = func(_ context.Context, d time.Duration) error {
-		delta := math.Ceil(float64(delay) * backoff.DefaultRandomizationFactor)
+		delta := math.Ceil(float64(delay) * DefaultRandomizationFactor)
 		assert.InDelta(t, delay, d, delta, "retry not backoffed")
 		// Try twice to ensure call is attempted again after delay.
 		if done {
@@ -248,3 +244,64 @@ func TestRetryConcurrentSafe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestBackoffIntervals(t *testing.T) {
+	orig := randFloat64
+	t.Cleanup(func() { randFloat64 = orig })
+
+	c := Config{
+		Enabled:             true,
+		InitialInterval:     time.Second,
+		MaxInterval:         5 * time.Second,
+		Multiplier:          2,
+		RandomizationFactor: -1, // No jitter.
+	}
+
+	var got []time.Duration
+	origWait := waitFunc
+	t.Cleanup(func() { waitFunc = origWait })
+	waitFunc = func(_ context.Context, d time.Duration) error {
+		got = append(got, d)
+		if len(got) == 5 {
+			return assert.AnError
+		}
+		return nil
+	}
+
+	ev := func(error) (bool, time.Duration) { return true, 0 }
+	err := c.Do(context.Background(), ev, func(context.Context) error {
+		return errors.New("retry")
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	}, got)
+}
+
+func TestJitter(t *testing.T) {
+	orig := randFloat64
+	t.Cleanup(func() { randFloat64 = orig })
+
+	const interval = 10 * time.Second
+	randFloat64 = func() float64 { return 0 }
+	assert.Equal(t, 5*time.Second, jitter(interval, 0.5), "lower bound")
+
+	randFloat64 = func() float64 { return 1 }
+	assert.InDelta(t, 15*time.Second, jitter(interval, 0.5), 1, "upper bound")
+
+	assert.Equal(t, interval, jitter(interval, 0), "no jitter")
+}
+
+func TestNewBackoffDefaults(t *testing.T) {
+	b := Config{}.newBackoff()
+	assert.Equal(t, DefaultMultiplier, b.mult)
+	assert.Equal(t, DefaultRandomizationFactor, b.factor)
+
+	b = Config{Multiplier: 3, RandomizationFactor: -1}.newBackoff()
+	assert.Equal(t, 3.0, b.mult)
+	assert.Equal(t, 0.0, b.factor)
+}
@@ -5,12 +5,14 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/internal/env"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -55,6 +57,14 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// MeterProvider is used to record metrics about the health of the
+	// BatchSpanProcessor: the size and capacity of its queue, and the number
+	// of spans dropped and failed to be exported. The metrics of each
+	// BatchSpanProcessor are distinguished by the otel.component.name
+	// attribute.
+	// The default value of MeterProvider is nil, no metrics are recorded.
+	MeterProvider metric.MeterProvider
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
@@ -65,6 +75,7 @@ type batchSpanProcessor struct {
 
 	queue   chan ReadOnlySpan
 	dropped uint32
+	metrics *bspMetrics
 
 	batch      []ReadOnlySpan
 	batchMutex sync.Mutex
@@ -80,6 +91,13 @@ var _ SpanProcessor = (*batchSpanProcessor)(nil)
 // NewBatchSpanProcessor creates a new SpanProcessor that will send completed
 // span batches to the exporter with the supplied options.
 //
+// Each BatchSpanProcessor has its own queue and exports from its own
+// goroutine. A slow or failing exporter only fills the queue of its
+// BatchSpanProcessor, the spans that do not fit in it are dropped. It does not
+// affect the other span processors of a TracerProvider, unless WithBlocking is
+// used. An exporter panicking is reported to the global error handler as a
+// failed export.
+//
 // If the exporter is nil, the span processor will perform no action.
 func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorOption) SpanProcessor {
 	maxQueueSize := env.BatchSpanProcessorMaxQueueSize(DefaultMaxQueueSize)
@@ -111,6 +129,12 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 		stopCh: make(chan struct{}),
 	}
 
+	var err error
+	bsp.metrics, err = newBSPMetrics(o.MeterProvider, bsp)
+	if err != nil {
+		otel.Handle(err)
+	}
+
 	bsp.stopWait.Add(1)
 	go func() {
 		defer bsp.stopWait.Done()
@@ -153,6 +177,9 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 					otel.Handle(err)
 				}
 			}
+			if err := bsp.metrics.unregister(); err != nil {
+				otel.Handle(err)
+			}
 			close(wait)
 		}()
 		// Wait until the wait group is done or the context is cancelled
@@ -253,12 +280,25 @@ func WithExportTimeout(timeout time.Duration) BatchSpanProcessorOption {
 // WithBlocking returns a BatchSpanProcessorOption that configures a
 // BatchSpanProcessor to wait for enqueue operations to succeed instead of
 // dropping data when the queue is full.
+//
+// Ending a span waits for the queue to have room. A slow exporter therefore
+// delays the callers ending spans and the other span processors of the
+// TracerProvider.
 func WithBlocking() BatchSpanProcessorOption {
 	return func(o *BatchSpanProcessorOptions) {
 		o.BlockOnQueueFull = true
 	}
 }
 
+// WithMeterProvider returns a BatchSpanProcessorOption that configures the
+// MeterProvider used to record metrics about the health of a
+// BatchSpanProcessor.
+func WithMeterProvider(mp metric.MeterProvider) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MeterProvider = mp
+	}
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
@@ -274,7 +314,10 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 
 	if l := len(bsp.batch); l > 0 {
 		global.Debug("exporting spans", "count", len(bsp.batch), "total_dropped", atomic.LoadUint32(&bsp.dropped))
-		err := bsp.e.ExportSpans(ctx, bsp.batch)
+		err := exportSpans(ctx, bsp.e, bsp.batch)
+		if err != nil {
+			bsp.metrics.recordExportFailed(ctx, l)
+		}
 
 		// A new batch is always created after exporting, even if the batch failed to be exported.
 		//
@@ -289,6 +332,17 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	return nil
 }
 
+// exportSpans exports spans with e. A panic of e is returned as an error so
+// it does not affect the other span processors.
+func exportSpans(ctx context.Context, e SpanExporter, spans []ReadOnlySpan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("span exporter panicked: %v", r)
+		}
+	}()
+	return e.ExportSpans(ctx, spans)
+}
+
 // processQueue removes spans from the `queue` channel until processor
 // is shut down. It calls the exporter in batches of up to MaxExportBatchSize
 // waiting up to BatchTimeout to form a batch.
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// bspID is the ID of the last BatchSpanProcessor created with metrics. It is
+// used to distinguish the metrics of the BatchSpanProcessors of a process.
+var bspID atomic.Int64
+
+// bspMetrics records the metrics about the health of a BatchSpanProcessor.
+type bspMetrics struct {
+	attrs        metric.MeasurementOption
+	exportFailed metric.Int64Counter
+
+	reg metric.Registration
+}
+
+// newBSPMetrics returns the bspMetrics of bsp created with a Meter from mp.
+// If mp is nil, nil is returned and no metrics are recorded.
+func newBSPMetrics(mp metric.MeterProvider, bsp *batchSpanProcessor) (*bspMetrics, error) {
+	if mp == nil {
+		return nil, nil
+	}
+
+	meter := mp.Meter("go.opentelemetry.io/otel/sdk/trace")
+
+	m := &bspMetrics{
+		attrs: metric.WithAttributeSet(attribute.NewSet(
+			attribute.String("otel.component.type", "batching_span_processor"),
+			attribute.String(
+				"otel.component.name",
+				fmt.Sprintf("batching_span_processor/%d", bspID.Add(1)-1),
+			),
+		)),
+	}
+
+	var err, e error
+	m.exportFailed, e = meter.Int64Counter(
+		"otel.sdk.trace.batch.export.failed",
+		metric.WithDescription("The number of spans that failed to be exported."),
+		metric.WithUnit("{span}"),
+	)
+	err = errors.Join(err, e)
+
+	qSize, e := meter.Int64ObservableGauge(
+		"otel.sdk.trace.batch.queue.size",
+		metric.WithDescription("The number of spans in the queue."),
+		metric.WithUnit("{span}"),
+	)
+	err = errors.Join(err, e)
+	qCap, e := meter.Int64ObservableGauge(
+		"otel.sdk.trace.batch.queue.capacity",
+		metric.WithDescription("The maximum number of spans the queue can hold."),
+		metric.WithUnit("{span}"),
+	)
+	err = errors.Join(err, e)
+	dropped, e := meter.Int64ObservableCounter(
+		"otel.sdk.trace.batch.dropped",
+		metric.WithDescription("The number of spans dropped because the queue was full."),
+		metric.WithUnit("{span}"),
+	)
+	err = errors.Join(err, e)
+
+	m.reg, e = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(qSize, int64(len(bsp.queue)), m.attrs)
+		o.ObserveInt64(qCap, int64(cap(bsp.queue)), m.attrs)
+		o.ObserveInt64(dropped, int64(atomic.LoadUint32(&bsp.dropped)), m.attrs)
+		return nil
+	}, qSize, qCap, dropped)
+	err = errors.Join(err, e)
+
+	return m, err
+}
+
+// recordExportFailed records that n spans failed to be exported.
+func (m *bspMetrics) recordExportFailed(ctx context.Context, n int) {
+	if m == nil {
+		return
+	}
+	m.exportFailed.Add(ctx, int64(n), m.attrs)
+}
+
+// unregister stops observing the queue of the BatchSpanProcessor.
+func (m *bspMetrics) unregister() error {
+	if m == nil || m.reg == nil {
+		return nil
+	}
+	return m.reg.Unregister()
+}
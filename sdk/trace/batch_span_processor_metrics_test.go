@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// testMeterProvider records the measurements of the instruments created by
+// its Meters. The sdk/metric module cannot be used by this module.
+type testMeterProvider struct {
+	embedded.MeterProvider
+
+	mu        sync.Mutex
+	callbacks []metric.Callback
+	// values are the measurements by instrument name and otel.component.name.
+	values map[string]map[string]int64
+}
+
+func (p *testMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return &testMeter{provider: p}
+}
+
+func (p *testMeterProvider) collect() map[string]map[string]int64 {
+	p.mu.Lock()
+	callbacks := p.callbacks
+	p.mu.Unlock()
+
+	obs := &testObserver{provider: p}
+	for _, cb := range callbacks {
+		_ = cb(context.Background(), obs)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.values
+}
+
+func (p *testMeterProvider) set(name string, v int64, add bool, set attribute.Set) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.values == nil {
+		p.values = make(map[string]map[string]int64)
+	}
+	if p.values[name] == nil {
+		p.values[name] = make(map[string]int64)
+	}
+	component, _ := set.Value("otel.component.name")
+	if add {
+		p.values[name][component.AsString()] += v
+	} else {
+		p.values[name][component.AsString()] = v
+	}
+}
+
+type testMeter struct {
+	noop.Meter
+
+	provider *testMeterProvider
+}
+
+func (m *testMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return &testCounter{name: name, provider: m.provider}, nil
+}
+
+func (m *testMeter) Int64ObservableGauge(name string, _ ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	return &testGauge{testObservable: testObservable{name: name}}, nil
+}
+
+func (m *testMeter) Int64ObservableCounter(name string, _ ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	return &testObsCounter{testObservable: testObservable{name: name}}, nil
+}
+
+func (m *testMeter) RegisterCallback(f metric.Callback, _ ...metric.Observable) (metric.Registration, error) {
+	m.provider.mu.Lock()
+	defer m.provider.mu.Unlock()
+	m.provider.callbacks = append(m.provider.callbacks, f)
+	return noop.Registration{}, nil
+}
+
+type testCounter struct {
+	noop.Int64Counter
+
+	name     string
+	provider *testMeterProvider
+}
+
+func (c *testCounter) Add(_ context.Context, v int64, opts ...metric.AddOption) {
+	cfg := metric.NewAddConfig(opts)
+	c.provider.set(c.name, v, true, cfg.Attributes())
+}
+
+type testObservable struct{ name string }
+
+func (o testObservable) instName() string { return o.name }
+
+type testGauge struct {
+	testObservable
+	noop.Int64ObservableGauge
+}
+
+type testObsCounter struct {
+	testObservable
+	noop.Int64ObservableCounter
+}
+
+type testObserver struct {
+	noop.Observer
+
+	provider *testMeterProvider
+}
+
+func (o *testObserver) ObserveInt64(obsrv metric.Int64Observable, v int64, opts ...metric.ObserveOption) {
+	cfg := metric.NewObserveConfig(opts)
+	o.provider.set(obsrv.(interface{ instName() string }).instName(), v, false, cfg.Attributes())
+}
+
+type panicExporter struct{}
+
+func (panicExporter) ExportSpans(context.Context, []ReadOnlySpan) error { panic("export") }
+func (panicExporter) Shutdown(context.Context) error                    { return nil }
+
+// componentName returns the otel.component.name of the last
+// BatchSpanProcessor created with metrics.
+func componentName() string {
+	return fmt.Sprintf("batching_span_processor/%d", bspID.Load()-1)
+}
+
+func TestBatchSpanProcessorMetricsQueue(t *testing.T) {
+	bsp := &batchSpanProcessor{queue: make(chan ReadOnlySpan, 2), dropped: 3}
+	bsp.queue <- nil
+
+	mp := new(testMeterProvider)
+	m, err := newBSPMetrics(mp, bsp)
+	require.NoError(t, err)
+	name := componentName()
+
+	got := mp.collect()
+	assert.Equal(t, map[string]int64{name: 1}, got["otel.sdk.trace.batch.queue.size"])
+	assert.Equal(t, map[string]int64{name: 2}, got["otel.sdk.trace.batch.queue.capacity"])
+	assert.Equal(t, map[string]int64{name: 3}, got["otel.sdk.trace.batch.dropped"])
+	assert.NoError(t, m.unregister())
+}
+
+func TestBatchSpanProcessorMetricsDisabled(t *testing.T) {
+	m, err := newBSPMetrics(nil, &batchSpanProcessor{})
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+
+	// Methods of a nil bspMetrics must not panic.
+	m.recordExportFailed(context.Background(), 1)
+	assert.NoError(t, m.unregister())
+}
+
+func TestBatchSpanProcessorExporterPanicIsolated(t *testing.T) {
+	mp := new(testMeterProvider)
+	bsp := NewBatchSpanProcessor(panicExporter{}, WithMeterProvider(mp))
+	name := componentName()
+	other := NewTestExporter()
+	tp := NewTracerProvider(WithSpanProcessor(bsp), WithSyncer(other))
+
+	ctx := context.Background()
+	_, span := tp.Tracer("test").Start(ctx, "span")
+	span.End()
+
+	err := tp.ForceFlush(ctx)
+	assert.ErrorContains(t, err, "span exporter panicked: export")
+	assert.Equal(t, 1, other.Len(), "other span processor affected")
+	assert.Equal(t, map[string]int64{name: 1}, mp.collect()["otel.sdk.trace.batch.export.failed"])
+
+	require.NoError(t, tp.Shutdown(ctx))
+}
@@ -288,6 +288,67 @@ func BenchmarkSpanWithEvents_WithTimestamp(b *testing.B) {
 	})
 }
 
+func BenchmarkSpanAddEvent(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attribute.String("key1", "value1"),
+		attribute.Int("key2", 2),
+	}
+	ts := time.Unix(0, 0)
+
+	traceBenchmark(b, "Benchmark AddEvent", func(b *testing.B, t trace.Tracer) {
+		_, span := t.Start(context.Background(), "/foo")
+		defer span.End()
+
+		b.Run("Options", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				span.AddEvent("event", trace.WithAttributes(attrs...), trace.WithTimestamp(ts))
+			}
+		})
+		b.Run("Event", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				trace.AddEvent(span, trace.Event{Name: "event", Attributes: attrs, Timestamp: ts})
+			}
+		})
+	})
+}
+
+func BenchmarkSpanEventsLinks(b *testing.B) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: [16]byte{0x01},
+		SpanID:  [8]byte{0x01},
+	})
+	t := tracer(b, "Benchmark Events and Links", sdktrace.AlwaysSample())
+	newSpan := func() sdktrace.ReadOnlySpan {
+		_, span := t.Start(context.Background(), "/foo")
+		for i := 0; i < 8; i++ {
+			span.AddEvent("event")
+			span.AddLink(trace.Link{SpanContext: sc})
+		}
+		return span.(sdktrace.ReadOnlySpan)
+	}
+
+	recording := newSpan()
+	ended := newSpan()
+	ended.(trace.Span).End()
+
+	b.Run("Recording", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = recording.Events()
+			_ = recording.Links()
+		}
+	})
+	b.Run("Ended", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ended.Events()
+			_ = ended.Links()
+		}
+	})
+}
+
 func BenchmarkTraceID_DotString(b *testing.B) {
 	t, _ := trace.TraceIDFromHex("0000000000000001000000000000002a")
 	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: t})
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by the ExportSpans method of a
+// [CircuitBreakerExporter] when the spans are not exported because the
+// exporter it wraps is failing.
+var ErrCircuitOpen = errors.New("exporter circuit breaker is open")
+
+// Compile-time check CircuitBreakerExporter implements SpanExporter.
+var _ SpanExporter = (*CircuitBreakerExporter)(nil)
+
+// circuitState is the state of a CircuitBreakerExporter.
+type circuitState int
+
+const (
+	// circuitClosed is the state in which exports are passed to the exporter.
+	circuitClosed circuitState = iota
+	// circuitOpen is the state in which exports are rejected.
+	circuitOpen
+	// circuitHalfOpen is the state in which a single probe export is passed
+	// to the exporter to check if it recovered.
+	circuitHalfOpen
+)
+
+// CircuitBreakerExporter is a [SpanExporter] decorator that stops calling a
+// failing exporter for some time. A dead collector then does not cause every
+// export to wait for the full export timeout.
+//
+// The circuit opens after a number of consecutive failed exports. While it
+// is open, exports are rejected with [ErrCircuitOpen] without calling the
+// wrapped exporter. Once the cool-down has elapsed, the circuit is half-open:
+// the next export is passed to the wrapped exporter as a probe while the
+// concurrent exports are rejected. The circuit closes if the probe succeeds,
+// and opens again for another cool-down if it fails.
+//
+// The spans of rejected exports are not exported. Wrap the
+// CircuitBreakerExporter with a fallback, e.g. the SpanFallbackExporter of
+// go.opentelemetry.io/otel/sdk/log emitting them as log records, to keep a
+// minimal visibility while the circuit is open.
+type CircuitBreakerExporter struct {
+	exporter  SpanExporter
+	threshold int
+	coolDown  time.Duration
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// NewCircuitBreakerExporter returns a [CircuitBreakerExporter] wrapping
+// exporter. The circuit opens after threshold consecutive failed exports and
+// stays open for coolDown before a probe export is attempted.
+//
+// If threshold is less than one, one is used. If coolDown is negative, zero
+// is used: a probe is attempted by the next export after the circuit opens.
+func NewCircuitBreakerExporter(exporter SpanExporter, threshold int, coolDown time.Duration) *CircuitBreakerExporter {
+	if exporter == nil {
+		// Do not panic on nil exporter.
+		exporter = noopSpanExporter{}
+	}
+	return &CircuitBreakerExporter{
+		exporter:  exporter,
+		threshold: max(threshold, 1),
+		coolDown:  max(coolDown, 0),
+		now:       time.Now,
+	}
+}
+
+// ExportSpans exports spans with the wrapped exporter if the circuit is
+// closed, or if the export is a probe of the half-open circuit. Otherwise,
+// [ErrCircuitOpen] is returned.
+func (e *CircuitBreakerExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if !e.allow() {
+		return ErrCircuitOpen
+	}
+	err := e.exporter.ExportSpans(ctx, spans)
+	e.done(err)
+	return err
+}
+
+// allow returns if an export is allowed. If the cool-down of an open circuit
+// has elapsed, the circuit becomes half-open and the export is allowed as a
+// probe.
+func (e *CircuitBreakerExporter) allow() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch e.state {
+	case circuitOpen:
+		if e.now().Sub(e.openedAt) < e.coolDown {
+			return false
+		}
+		e.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// A probe is in progress.
+		return false
+	}
+	return true
+}
+
+// done updates the state of the circuit with the result of an export.
+func (e *CircuitBreakerExporter) done(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err == nil {
+		e.state, e.failures = circuitClosed, 0
+		return
+	}
+
+	e.failures++
+	if e.state == circuitHalfOpen || e.failures >= e.threshold {
+		e.state, e.openedAt = circuitOpen, e.now()
+	}
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *CircuitBreakerExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// noopSpanExporter is a SpanExporter that does nothing.
+type noopSpanExporter struct{}
+
+func (noopSpanExporter) ExportSpans(context.Context, []ReadOnlySpan) error { return nil }
+func (noopSpanExporter) Shutdown(context.Context) error                    { return nil }
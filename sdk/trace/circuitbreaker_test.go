@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// circuitExporter is a SpanExporter counting its calls. Its exports return
+// err and, if trigger is not nil, block until trigger receives.
+type circuitExporter struct {
+	err     error
+	trigger chan struct{}
+
+	exportN   atomic.Int32
+	shutdownN atomic.Int32
+}
+
+func (e *circuitExporter) ExportSpans(context.Context, []ReadOnlySpan) error {
+	e.exportN.Add(1)
+	if e.trigger != nil {
+		<-e.trigger
+	}
+	return e.err
+}
+
+func (e *circuitExporter) Shutdown(context.Context) error {
+	e.shutdownN.Add(1)
+	return nil
+}
+
+func TestCircuitBreakerExporter(t *testing.T) {
+	ctx := context.Background()
+	spans := make([]ReadOnlySpan, 1)
+
+	exp := &circuitExporter{err: assert.AnError}
+
+	now := time.Unix(0, 0)
+	e := NewCircuitBreakerExporter(exp, 2, time.Minute)
+	e.now = func() time.Time { return now }
+
+	// Closed: failures below the threshold are passed through.
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), assert.AnError)
+	assert.Equal(t, int32(1), exp.exportN.Load())
+
+	// A success resets the consecutive failures.
+	exp.err = nil
+	assert.NoError(t, e.ExportSpans(ctx, spans))
+	exp.err = assert.AnError
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), assert.AnError)
+	assert.Equal(t, int32(3), exp.exportN.Load())
+
+	// Open after threshold consecutive failures.
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), assert.AnError)
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), ErrCircuitOpen)
+	now = now.Add(time.Minute - 1)
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), ErrCircuitOpen)
+	assert.Equal(t, int32(4), exp.exportN.Load(), "exporter called while open")
+
+	// Half-open: a failed probe opens the circuit for another cool-down.
+	now = now.Add(1)
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), assert.AnError)
+	assert.Equal(t, int32(5), exp.exportN.Load(), "probe not exported")
+	assert.ErrorIs(t, e.ExportSpans(ctx, spans), ErrCircuitOpen)
+	assert.Equal(t, int32(5), exp.exportN.Load(), "exporter called while open")
+
+	// Half-open: a successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	exp.err = nil
+	assert.NoError(t, e.ExportSpans(ctx, spans))
+	assert.NoError(t, e.ExportSpans(ctx, spans))
+	assert.Equal(t, int32(7), exp.exportN.Load())
+}
+
+func TestCircuitBreakerExporterSingleProbe(t *testing.T) {
+	ctx := context.Background()
+
+	exp := &circuitExporter{err: assert.AnError}
+	e := NewCircuitBreakerExporter(exp, 1, 0)
+	assert.ErrorIs(t, e.ExportSpans(ctx, nil), assert.AnError)
+
+	// Block the probe.
+	exp.trigger = make(chan struct{})
+	t.Cleanup(func() { close(exp.trigger) })
+	errCh := make(chan error, 1)
+	go func() { errCh <- e.ExportSpans(ctx, nil) }()
+	assert.Eventually(t, func() bool { return exp.exportN.Load() == 2 }, time.Second, time.Millisecond)
+
+	assert.ErrorIs(t, e.ExportSpans(ctx, nil), ErrCircuitOpen, "concurrent export during probe")
+	exp.trigger <- struct{}{}
+	assert.ErrorIs(t, <-errCh, assert.AnError)
+}
+
+func TestCircuitBreakerExporterShutdown(t *testing.T) {
+	exp := &circuitExporter{}
+	e := NewCircuitBreakerExporter(exp, 1, time.Minute)
+	assert.NoError(t, e.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), exp.shutdownN.Load())
+}
+
+func TestCircuitBreakerExporterNil(t *testing.T) {
+	e := NewCircuitBreakerExporter(nil, 0, -1)
+	assert.NotPanics(t, func() {
+		_ = e.ExportSpans(context.Background(), nil)
+		_ = e.Shutdown(context.Background())
+	})
+}
@@ -4,6 +4,8 @@
 package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -24,3 +26,68 @@ type Event struct {
 	// Time at which this event was recorded.
 	Time time.Time
 }
+
+// EventOrdering is the policy a span applies to the timestamps of its events
+// when it ends.
+//
+// The timestamps of the events can be out of order when they are passed with
+// the trace.WithTimestamp option, which confuses some backends.
+type EventOrdering int
+
+const (
+	// EventOrderingPreserve keeps the events in the order they were added,
+	// with their timestamps.
+	EventOrderingPreserve EventOrdering = iota
+	// EventOrderingSort sorts the events by timestamp. The events with the
+	// same timestamp keep the order they were added in.
+	EventOrderingSort
+	// EventOrderingClamp keeps the events in the order they were added and
+	// raises the timestamp of each event earlier than the event preceding it
+	// to the timestamp of that event.
+	EventOrderingClamp
+)
+
+// String returns the name of o.
+func (o EventOrdering) String() string {
+	switch o {
+	case EventOrderingPreserve:
+		return "preserve"
+	case EventOrderingSort:
+		return "sort"
+	case EventOrderingClamp:
+		return "clamp"
+	}
+	return fmt.Sprintf("EventOrdering(%d)", int(o))
+}
+
+// orderEvents applies o to events, in place. It returns true if events were
+// out of order and were changed.
+func orderEvents(events []Event, o EventOrdering) bool {
+	if o == EventOrderingPreserve || len(events) < 2 {
+		return false
+	}
+	if slices.IsSortedFunc(events, compareEventTime) {
+		return false
+	}
+
+	switch o {
+	case EventOrderingSort:
+		slices.SortStableFunc(events, compareEventTime)
+	case EventOrderingClamp:
+		latest := events[0].Time
+		for i := 1; i < len(events); i++ {
+			if events[i].Time.Before(latest) {
+				events[i].Time = latest
+			} else {
+				latest = events[i].Time
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+func compareEventTime(a, b Event) int {
+	return a.Time.Compare(b.Time)
+}
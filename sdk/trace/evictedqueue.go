@@ -3,31 +3,55 @@
 
 package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
+import "slices"
+
 // evictedQueue is a FIFO queue with a configurable capacity.
-type evictedQueue struct {
-	queue        []interface{}
+type evictedQueue[T any] struct {
+	queue        []T
 	capacity     int
 	droppedCount int
 }
 
-func newEvictedQueue(capacity int) evictedQueue {
+func newEvictedQueue[T any](capacity int) evictedQueue[T] {
 	// Do not pre-allocate queue, do this lazily.
-	return evictedQueue{capacity: capacity}
+	return evictedQueue[T]{capacity: capacity}
 }
 
 // add adds value to the evictedQueue eq. If eq is at capacity, the oldest
-// queued value will be discarded and the drop count incremented.
-func (eq *evictedQueue) add(value interface{}) {
+// queued value will be discarded and the drop count incremented. The
+// discarded value and true are returned if a queued value was discarded.
+func (eq *evictedQueue[T]) add(value T) (evicted T, ok bool) {
 	if eq.capacity == 0 {
 		eq.droppedCount++
-		return
+		return evicted, false
 	}
 
 	if eq.capacity > 0 && len(eq.queue) == eq.capacity {
-		// Drop first-in while avoiding allocating more capacity to eq.queue.
-		copy(eq.queue[:eq.capacity-1], eq.queue[1:])
-		eq.queue = eq.queue[:eq.capacity-1]
-		eq.droppedCount++
+		evicted, ok = eq.evict()
 	}
 	eq.queue = append(eq.queue, value)
+	return evicted, ok
+}
+
+// evict discards the oldest queued value of eq and increments the drop
+// count. The discarded value and true are returned, or the zero value and
+// false if eq is empty.
+func (eq *evictedQueue[T]) evict() (evicted T, ok bool) {
+	if len(eq.queue) == 0 {
+		return evicted, false
+	}
+	evicted = eq.queue[0]
+	// Drop first-in while avoiding allocating more capacity to eq.queue.
+	n := len(eq.queue)
+	copy(eq.queue[:n-1], eq.queue[1:])
+	var zero T
+	eq.queue[n-1] = zero
+	eq.queue = eq.queue[:n-1]
+	eq.droppedCount++
+	return evicted, true
+}
+
+// copy returns a copy of the evictedQueue.
+func (eq *evictedQueue[T]) copy() []T {
+	return slices.Clone(eq.queue)
 }
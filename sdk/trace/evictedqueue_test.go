@@ -12,7 +12,7 @@ func init() {
 }
 
 func TestAdd(t *testing.T) {
-	q := newEvictedQueue(3)
+	q := newEvictedQueue[string](3)
 	q.add("value1")
 	q.add("value2")
 	if wantLen, gotLen := 2, len(q.queue); wantLen != gotLen {
@@ -20,16 +20,8 @@ func TestAdd(t *testing.T) {
 	}
 }
 
-func (eq *evictedQueue) queueToArray() []string {
-	arr := make([]string, 0)
-	for _, value := range eq.queue {
-		arr = append(arr, value.(string))
-	}
-	return arr
-}
-
 func TestDropCount(t *testing.T) {
-	q := newEvictedQueue(3)
+	q := newEvictedQueue[string](3)
 	q.add("value1")
 	q.add("value2")
 	q.add("value3")
@@ -42,7 +34,7 @@ func TestDropCount(t *testing.T) {
 		t.Errorf("got drop count %d want %d", gotDropCount, wantDropCount)
 	}
 	wantArr := []string{"value3", "value1", "value4"}
-	gotArr := q.queueToArray()
+	gotArr := q.copy()
 
 	if wantLen, gotLen := len(wantArr), len(gotArr); gotLen != wantLen {
 		t.Errorf("got array len %d want %d", gotLen, wantLen)
@@ -52,3 +44,39 @@ func TestDropCount(t *testing.T) {
 		t.Errorf("got array = %#v; want %#v", gotArr, wantArr)
 	}
 }
+
+func TestCopy(t *testing.T) {
+	q := newEvictedQueue[string](3)
+	q.add("value1")
+	cp := q.copy()
+
+	q.add("value2")
+	if wantArr := []string{"value1"}; !reflect.DeepEqual(cp, wantArr) {
+		t.Errorf("got array = %#v; want %#v", cp, wantArr)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	q := newEvictedQueue[string](3)
+	if _, ok := q.evict(); ok {
+		t.Error("evicted from empty queue")
+	}
+
+	q.add("value1")
+	q.add("value2")
+	q.add("value3")
+	if evicted, ok := q.add("value4"); !ok || evicted != "value1" {
+		t.Errorf("add evicted %q, %t; want %q, true", evicted, ok, "value1")
+	}
+	if evicted, ok := q.evict(); !ok || evicted != "value2" {
+		t.Errorf("evict returned %q, %t; want %q, true", evicted, ok, "value2")
+	}
+
+	wantArr := []string{"value3", "value4"}
+	if gotArr := q.copy(); !reflect.DeepEqual(gotArr, wantArr) {
+		t.Errorf("got array = %#v; want %#v", gotArr, wantArr)
+	}
+	if wantDropCount, gotDropCount := 2, q.droppedCount; wantDropCount != gotDropCount {
+		t.Errorf("got drop count %d want %d", gotDropCount, wantDropCount)
+	}
+}
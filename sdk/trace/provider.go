@@ -6,10 +6,12 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -40,6 +42,22 @@ type tracerProviderConfig struct {
 	// spanLimits defines the attribute, event, and link limits for spans.
 	spanLimits SpanLimits
 
+	// spanSizeLimit is the maximum size, in bytes, of the attributes, events,
+	// and links of a span. A non-positive value means no limit is applied.
+	spanSizeLimit int
+
+	// inheritedKeys are the keys of the attributes a span inherits from its
+	// parent span.
+	inheritedKeys []attribute.Key
+
+	// eventOrdering is the policy applied to the timestamps of the events of
+	// a span when it ends.
+	eventOrdering EventOrdering
+
+	// startOptions return the default options of the spans started, in
+	// order.
+	startOptions []SpanStartOptionsFunc
+
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
 }
@@ -51,12 +69,18 @@ func (cfg tracerProviderConfig) MarshalLog() interface{} {
 		SamplerType     string
 		IDGeneratorType string
 		SpanLimits      SpanLimits
+		SpanSizeLimit   int
+		InheritedKeys   []attribute.Key
+		EventOrdering   string
 		Resource        *resource.Resource
 	}{
 		SpanProcessors:  cfg.processors,
 		SamplerType:     fmt.Sprintf("%T", cfg.sampler),
 		IDGeneratorType: fmt.Sprintf("%T", cfg.idGenerator),
 		SpanLimits:      cfg.spanLimits,
+		SpanSizeLimit:   cfg.spanSizeLimit,
+		InheritedKeys:   cfg.inheritedKeys,
+		EventOrdering:   cfg.eventOrdering.String(),
 		Resource:        cfg.resource,
 	}
 }
@@ -72,12 +96,19 @@ type TracerProvider struct {
 
 	isShutdown atomic.Bool
 
+	// sampler is the sampler used when creating new spans. It can be
+	// replaced with SetSampler.
+	sampler atomic.Pointer[Sampler]
+
 	// These fields are not protected by the lock mu. They are assumed to be
 	// immutable after creation of the TracerProvider.
-	sampler     Sampler
-	idGenerator IDGenerator
-	spanLimits  SpanLimits
-	resource    *resource.Resource
+	idGenerator   IDGenerator
+	spanLimits    SpanLimits
+	spanSizeLimit int
+	inheritedKeys []attribute.Key
+	eventOrdering EventOrdering
+	startOptions  []SpanStartOptionsFunc
+	resource      *resource.Resource
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -105,12 +136,16 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 	o = ensureValidTracerProviderConfig(o)
 
 	tp := &TracerProvider{
-		namedTracer: make(map[instrumentation.Scope]*tracer),
-		sampler:     o.sampler,
-		idGenerator: o.idGenerator,
-		spanLimits:  o.spanLimits,
-		resource:    o.resource,
-	}
+		namedTracer:   make(map[instrumentation.Scope]*tracer),
+		idGenerator:   o.idGenerator,
+		spanLimits:    o.spanLimits,
+		spanSizeLimit: o.spanSizeLimit,
+		inheritedKeys: o.inheritedKeys,
+		eventOrdering: o.eventOrdering,
+		startOptions:  o.startOptions,
+		resource:      o.resource,
+	}
+	tp.sampler.Store(&o.sampler)
 	global.Info("TracerProvider created", "config", o)
 
 	spss := make(spanProcessorStates, 0, len(o.processors))
@@ -173,6 +208,27 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 	return t
 }
 
+// SetSampler replaces the Sampler used by all the Tracers of the
+// TracerProvider when creating new spans. Spans already started are not
+// affected. It can be used to raise the sampling of a live service, e.g.
+// during an incident, without recreating the TracerProvider.
+//
+// If sampler is nil, the default ParentBased(AlwaysSample) Sampler is used.
+//
+// This method is safe to be called concurrently.
+func (p *TracerProvider) SetSampler(sampler Sampler) {
+	if sampler == nil {
+		sampler = ParentBased(AlwaysSample())
+	}
+	p.sampler.Store(&sampler)
+	global.Info("TracerProvider sampler updated", "sampler", sampler.Description())
+}
+
+// getSampler returns the Sampler currently used by p.
+func (p *TracerProvider) getSampler() Sampler {
+	return *p.sampler.Load()
+}
+
 // RegisterSpanProcessor adds the given SpanProcessor to the list of SpanProcessors.
 func (p *TracerProvider) RegisterSpanProcessor(sp SpanProcessor) {
 	// This check prevents calls during a shutdown.
@@ -455,6 +511,118 @@ func WithRawSpanLimits(limits SpanLimits) TracerProviderOption {
 	})
 }
 
+// WithSpanSizeLimit returns a TracerProviderOption that configures a
+// TracerProvider to bound the memory held by each Span created by a Tracer
+// from the TracerProvider. The limit is the maximum size, in bytes, of the
+// attributes, events, and links of a span. It is applied in addition to the
+// count limits configured with WithRawSpanLimits.
+//
+// The size is an approximation. It accounts for the length of the attribute
+// keys, the length of string values, 8 bytes per other value, the length of
+// event names, and 24 bytes per event timestamp and link span context.
+//
+// When adding an attribute, event, or link would exceed the limit, room is
+// made for it by evicting, in order:
+//
+//  1. the oldest events of the span,
+//  2. the oldest links of the span.
+//
+// Attributes are never evicted. If the addition does not fit even after all
+// events and links are evicted, it is dropped instead and nothing is
+// evicted. Evicted and dropped items are reported in the dropped counts of
+// the span.
+//
+// Setting this to zero or a negative value means no limit is applied. By
+// default, if this option is not used, no limit is applied.
+func WithSpanSizeLimit(limit int) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.spanSizeLimit = limit
+		return cfg
+	})
+}
+
+// WithInheritedAttributes returns a TracerProviderOption that configures the
+// attributes a span inherits from its parent span. When a span is started,
+// the attributes of its parent span with one of keys are copied to it, e.g.
+// so that an enduser.id or tenant attribute set on a root span is carried by
+// all its descendants.
+//
+// Only the attributes of parent spans created by the TracerProvider, and
+// recording, are inherited. The attributes are copied when the span is
+// started: the attributes set on the parent span afterwards are not
+// inherited. The attributes passed to the Tracer Start method, or returned by
+// the Sampler, with the same keys take precedence over the inherited ones.
+// The spans started with the trace.WithNewRoot option inherit no attribute.
+//
+// Passing this option multiple times appends keys. By default, if this option
+// is not used, no attribute is inherited.
+func WithInheritedAttributes(keys ...attribute.Key) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.inheritedKeys = append(cfg.inheritedKeys, keys...)
+		return cfg
+	})
+}
+
+// WithEventOrdering returns a TracerProviderOption that configures the policy
+// a span applies to the timestamps of its events when it ends. The spans with
+// events out of order that are changed are reported to the global
+// ErrorHandler.
+//
+// By default, if this option is not used, EventOrderingPreserve is used: the
+// events are kept in the order they were added, with their timestamps.
+func WithEventOrdering(o EventOrdering) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.eventOrdering = o
+		return cfg
+	})
+}
+
+// SpanStartOptionsFunc returns the default options of a span started with ctx
+// by a Tracer of the instrumentation scope.
+type SpanStartOptionsFunc func(ctx context.Context, scope instrumentation.Scope) []trace.SpanStartOption
+
+// WithDefaultSpanStartOptions returns a TracerProviderOption that configures
+// the options applied to all the spans started by the Tracers of the
+// TracerProvider, e.g. common attributes.
+//
+// The default options are applied before the ones passed to the Tracer Start
+// method, so the options passed to Start take precedence: a span kind or a
+// start time passed to Start overrides the default one, and the attributes
+// passed to Start with the same keys as default ones replace them. Links are
+// appended.
+//
+// Passing this option, or WithSpanStartOptionsFunc, multiple times appends
+// options. By default, if this option is not used, no default option is
+// applied.
+func WithDefaultSpanStartOptions(opts ...trace.SpanStartOption) TracerProviderOption {
+	opts = slices.Clone(opts)
+	return WithSpanStartOptionsFunc(func(context.Context, instrumentation.Scope) []trace.SpanStartOption {
+		return opts
+	})
+}
+
+// WithSpanStartOptionsFunc returns a TracerProviderOption that configures f
+// to return the default options of each span started by the Tracers of the
+// TracerProvider. It is called with the context passed to the Tracer Start
+// method and the instrumentation scope of the Tracer, so it can e.g. return
+// links to spans held by the context or a default span kind for a scope.
+//
+// The returned options are applied before the ones passed to Start, as
+// described in WithDefaultSpanStartOptions. The function f is called for each
+// span started, it needs to be fast and safe to be called concurrently.
+//
+// Passing this option, or WithDefaultSpanStartOptions, multiple times appends
+// functions. The functions are called in order. By default, if this option is
+// not used, no default option is applied.
+func WithSpanStartOptionsFunc(f SpanStartOptionsFunc) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if f != nil {
+			cfg.startOptions = append(cfg.startOptions, f)
+		}
+		return cfg
+	})
+}
+
 func applyTracerProviderEnvConfigs(cfg tracerProviderConfig) tracerProviderConfig {
 	for _, opt := range tracerProviderOptionsFromEnv() {
 		cfg = opt.apply(cfg)
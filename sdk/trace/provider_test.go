@@ -220,6 +220,52 @@ func TestRegisterAfterShutdownWithProcessors(t *testing.T) {
 	assert.Empty(t, stp.getSpanProcessors())
 }
 
+func TestTracerProviderSetSampler(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSampler(NeverSample()), WithSyncer(te))
+	tr := tp.Tracer("TestTracerProviderSetSampler")
+	ctx := context.Background()
+
+	_, span := tr.Start(ctx, "not sampled")
+	assert.False(t, span.SpanContext().IsSampled())
+	span.End()
+
+	tp.SetSampler(AlwaysSample())
+	_, span = tr.Start(ctx, "sampled")
+	assert.True(t, span.SpanContext().IsSampled())
+	span.End()
+
+	// Tracers created before and after the change share the sampler.
+	_, span = tp.Tracer("other").Start(ctx, "other")
+	assert.True(t, span.SpanContext().IsSampled())
+	span.End()
+
+	// The processors registered are kept.
+	require.Len(t, te.Spans(), 2)
+	assert.Equal(t, "sampled", te.Spans()[0].Name())
+
+	tp.SetSampler(nil)
+	assert.Equal(t, ParentBased(AlwaysSample()).Description(), tp.getSampler().Description())
+}
+
+func TestTracerProviderSetSamplerConcurrentSafe(t *testing.T) {
+	tp := NewTracerProvider()
+	tr := tp.Tracer("TestTracerProviderSetSamplerConcurrentSafe")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_, span := tr.Start(context.Background(), "span")
+			span.End()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		tp.SetSampler(TraceIDRatioBased(float64(i) / 100))
+	}
+	<-done
+}
+
 func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 	type testCase struct {
 		sampler             string
@@ -327,7 +373,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 			})
 
 			stp := NewTracerProvider(WithSyncer(NewTestExporter()))
-			assert.Equal(t, test.description, stp.sampler.Description())
+			assert.Equal(t, test.description, stp.getSampler().Description())
 			if test.errorType != nil {
 				testStoredError(t, test.errorType)
 			} else {
@@ -350,7 +396,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 					t.Cleanup(func() {
 						require.NoError(t, stp.Shutdown(context.Background()))
 					})
-					assert.Equal(t, test.description, stp.sampler.Description())
+					assert.Equal(t, test.description, stp.getSampler().Description())
 
 					if test.invalidArgErrorType != nil {
 						testStoredError(t, test.invalidArgErrorType)
@@ -5,9 +5,12 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -63,23 +66,42 @@ type SamplingResult struct {
 	Tracestate trace.TraceState
 }
 
+const (
+	// randomnessBits is the number of bits of the trace ID used as the
+	// randomness value of a trace.
+	randomnessBits = 56
+	// maxThreshold is the rejection threshold of a sampler not sampling any
+	// trace.
+	maxThreshold = 1 << randomnessBits
+
+	// otTraceStateKey is the key of the OpenTelemetry entry of the
+	// tracestate.
+	otTraceStateKey = "ot"
+	// thresholdSubKey is the sub-key of the rejection threshold in the
+	// OpenTelemetry entry of the tracestate.
+	thresholdSubKey = "th"
+)
+
 type traceIDRatioSampler struct {
-	traceIDUpperBound uint64
-	description       string
+	// threshold is the rejection threshold: traces with a randomness value
+	// less than threshold are dropped.
+	threshold uint64
+	// th is the encoding of threshold in the tracestate.
+	th          string
+	description string
 }
 
 func (ts traceIDRatioSampler) ShouldSample(p SamplingParameters) SamplingResult {
 	psc := trace.SpanContextFromContext(p.ParentContext)
-	x := binary.BigEndian.Uint64(p.TraceID[8:16]) >> 1
-	if x < ts.traceIDUpperBound {
+	if randomness(p.TraceID) < ts.threshold {
 		return SamplingResult{
-			Decision:   RecordAndSample,
+			Decision:   Drop,
 			Tracestate: psc.TraceState(),
 		}
 	}
 	return SamplingResult{
-		Decision:   Drop,
-		Tracestate: psc.TraceState(),
+		Decision:   RecordAndSample,
+		Tracestate: withThreshold(psc.TraceState(), ts.th),
 	}
 }
 
@@ -88,24 +110,92 @@ func (ts traceIDRatioSampler) Description() string {
 }
 
 // TraceIDRatioBased samples a given fraction of traces. Fractions >= 1 will
-// always sample. Fractions < 0 are treated as zero. To respect the
-// parent trace's `SampledFlag`, the `TraceIDRatioBased` sampler should be used
-// as a delegate of a `Parent` sampler.
+// always sample. To respect the parent trace's `SampledFlag`, the
+// `TraceIDRatioBased` sampler should be used as a delegate of a `Parent`
+// sampler.
+//
+// The decision uses the 56-bit rejection threshold technique of the
+// OpenTelemetry specification: a trace is sampled if the randomness value
+// held by the 56 least significant bits of its trace ID is greater than or
+// equal to the threshold (1 - fraction) * 2^56. The threshold is recorded as
+// the "th" sub-key of the "ot" entry of the tracestate of the sampled spans so
+// it can be propagated.
+//
+// Fractions less than 0, greater than 1, or NaN are invalid. An error is sent
+// to the OTel error handler, and fractions less than 0 or NaN are treated as
+// zero.
 //
 //nolint:revive // revive complains about stutter of `trace.TraceIDRatioBased`
 func TraceIDRatioBased(fraction float64) Sampler {
+	if fraction < 0 || fraction > 1 || math.IsNaN(fraction) {
+		otel.Handle(fmt.Errorf("invalid trace ID ratio: %g: must be in [0.0, 1.0]", fraction))
+	}
+
 	if fraction >= 1 {
 		return AlwaysSample()
 	}
 
-	if fraction <= 0 {
+	if !(fraction > 0) {
 		fraction = 0
 	}
 
+	// Scale the fraction instead of 1 - fraction to keep the precision of
+	// small fractions.
+	threshold := maxThreshold - uint64(math.Round(fraction*maxThreshold))
 	return &traceIDRatioSampler{
-		traceIDUpperBound: uint64(fraction * (1 << 63)),
-		description:       fmt.Sprintf("TraceIDRatioBased{%g}", fraction),
+		threshold:   threshold,
+		th:          encodeThreshold(threshold),
+		description: fmt.Sprintf("TraceIDRatioBased{%g}", fraction),
+	}
+}
+
+// randomness returns the randomness value of the trace with traceID: its 56
+// least significant bits.
+func randomness(traceID trace.TraceID) uint64 {
+	var r uint64
+	for _, b := range traceID[16-randomnessBits/8:] {
+		r = r<<8 | uint64(b)
+	}
+	return r
+}
+
+// encodeThreshold returns the tracestate encoding of threshold: 14
+// hexadecimal digits with the trailing zeros removed. A zero threshold is
+// encoded as "0". The maximum threshold, sampling no trace, has no encoding.
+func encodeThreshold(threshold uint64) string {
+	switch {
+	case threshold >= maxThreshold:
+		return ""
+	case threshold == 0:
+		return "0"
+	}
+	s := strconv.FormatUint(threshold, 16)
+	s = strings.Repeat("0", randomnessBits/4-len(s)) + s
+	return strings.TrimRight(s, "0")
+}
+
+// withThreshold returns ts with the threshold sub-key of its OpenTelemetry
+// entry set to th. If the entry cannot be updated, ts is returned unchanged.
+func withThreshold(ts trace.TraceState, th string) trace.TraceState {
+	if th == "" {
+		return ts
+	}
+	value := thresholdSubKey + ":" + th
+	if ot := ts.Get(otTraceStateKey); ot != "" {
+		subs := strings.Split(ot, ";")
+		for i, sub := range subs {
+			if strings.HasPrefix(sub, thresholdSubKey+":") {
+				subs = append(subs[:i], subs[i+1:]...)
+				break
+			}
+		}
+		value = strings.Join(append([]string{value}, subs...), ";")
+	}
+	updated, err := ts.Insert(otTraceStateKey, value)
+	if err != nil {
+		return ts
 	}
+	return updated
 }
 
 type alwaysOnSampler struct{}
@@ -6,6 +6,7 @@ package trace
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 
@@ -246,3 +247,79 @@ func TestTracestateIsPassed(t *testing.T) {
 		})
 	}
 }
+
+func TestTraceIDRatioBasedThreshold(t *testing.T) {
+	tests := []struct {
+		fraction  float64
+		threshold uint64
+		th        string
+	}{
+		{fraction: 0, threshold: maxThreshold, th: ""},
+		{fraction: 0x1p-56, threshold: maxThreshold - 1, th: "ffffffffffffff"},
+		{fraction: 0x1p-40, threshold: maxThreshold - 1<<16, th: "ffffffffff"},
+		{fraction: 0.25, threshold: 0xc0000000000000, th: "c"},
+		{fraction: 0.5, threshold: 0x80000000000000, th: "8"},
+		{fraction: 0x1p-1 + 0x1p-52, threshold: 0x7ffffffffffff0, th: "7ffffffffffff"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.fraction), func(t *testing.T) {
+			s, ok := TraceIDRatioBased(test.fraction).(*traceIDRatioSampler)
+			require.True(t, ok)
+			assert.Equal(t, test.threshold, s.threshold)
+			assert.Equal(t, test.th, s.th)
+		})
+	}
+}
+
+func TestTraceIDRatioBasedDecision(t *testing.T) {
+	sampler := TraceIDRatioBased(0x1p-56)
+	// Only the 56 least significant bits are used as randomness.
+	lo, _ := trace.TraceIDFromHex("ffffffffffffffff00fffffffffffffe")
+	hi, _ := trace.TraceIDFromHex("0000000000000000ffffffffffffffff")
+
+	assert.Equal(t, Drop, sampler.ShouldSample(SamplingParameters{TraceID: lo}).Decision)
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(SamplingParameters{TraceID: hi}).Decision)
+}
+
+func TestTraceIDRatioBasedTraceState(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("0000000000000000ffffffffffffffff")
+	sampler := TraceIDRatioBased(0.5)
+
+	tests := []struct {
+		parent string
+		want   string
+	}{
+		{parent: "", want: "ot=th:8"},
+		{parent: "k=v", want: "ot=th:8,k=v"},
+		{parent: "ot=rv:abcdef;th:c,k=v", want: "ot=th:8;rv:abcdef,k=v"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.parent, func(t *testing.T) {
+			ts, err := trace.ParseTraceState(test.parent)
+			require.NoError(t, err)
+			ctx := trace.ContextWithSpanContext(
+				context.Background(),
+				trace.NewSpanContext(trace.SpanContextConfig{TraceState: ts}),
+			)
+			res := sampler.ShouldSample(SamplingParameters{ParentContext: ctx, TraceID: traceID})
+			require.Equal(t, RecordAndSample, res.Decision)
+			assert.Equal(t, test.want, res.Tracestate.String())
+		})
+	}
+}
+
+func TestTraceIDRatioBasedInvalid(t *testing.T) {
+	for _, fraction := range []float64{-0.1, 1.1, math.NaN()} {
+		handler.Reset()
+		s := TraceIDRatioBased(fraction)
+		assert.Len(t, handler.errs, 1, "fraction %g not reported", fraction)
+		if fraction > 1 {
+			assert.Equal(t, AlwaysSample(), s)
+		} else {
+			assert.Equal(t, uint64(maxThreshold), s.(*traceIDRatioSampler).threshold)
+		}
+	}
+	handler.Reset()
+}
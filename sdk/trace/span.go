@@ -15,6 +15,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
@@ -50,9 +51,15 @@ type ReadOnlySpan interface {
 	// The order of the returned attributes is not guaranteed to be stable across invocations.
 	Attributes() []attribute.KeyValue
 	// Links returns all the links the span has to other spans.
+	//
+	// The returned slice may be shared with the span and other callers. It
+	// must not be modified.
 	Links() []Link
 	// Events returns all the events that occurred within in the spans
 	// lifetime.
+	//
+	// The returned slice may be shared with the span and other callers. It
+	// must not be modified.
 	Events() []Event
 	// Status returns the spans status.
 	Status() Status
@@ -139,10 +146,17 @@ type recordingSpan struct {
 	droppedAttributes int
 
 	// events are stored in FIFO queue capped by configured limit.
-	events evictedQueue
+	events evictedQueue[Event]
 
 	// links are stored in FIFO queue capped by configured limit.
-	links evictedQueue
+	links evictedQueue[Link]
+
+	// attrsSize, eventsSize, and linksSize are the approximate sizes, in
+	// bytes, of the attributes, events, and links of the span. They are only
+	// tracked if the TracerProvider is configured with a span size limit.
+	attrsSize  int
+	eventsSize int
+	linksSize  int
 
 	// executionTracerTaskEnd ends the execution tracer span.
 	executionTracerTaskEnd func()
@@ -152,8 +166,9 @@ type recordingSpan struct {
 }
 
 var (
-	_ ReadWriteSpan = (*recordingSpan)(nil)
-	_ runtimeTracer = (*recordingSpan)(nil)
+	_ ReadWriteSpan    = (*recordingSpan)(nil)
+	_ trace.EventAdder = (*recordingSpan)(nil)
+	_ runtimeTracer    = (*recordingSpan)(nil)
 )
 
 // SpanContext returns the SpanContext of this span.
@@ -225,7 +240,11 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 
 	// If adding these attributes could exceed the capacity of s perform a
 	// de-duplication and truncation while adding to avoid over allocation.
-	if limit > 0 && len(s.attributes)+len(attributes) > limit {
+	//
+	// The size of the attributes can only be tracked accurately if they are
+	// de-duplicated. Therefore, they are always de-duplicated if a size limit
+	// is applied.
+	if (limit > 0 && len(s.attributes)+len(attributes) > limit) || s.tracer.provider.spanSizeLimit > 0 {
 		s.addOverCapAttrs(limit, attributes)
 		return
 	}
@@ -246,17 +265,17 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 
 // addOverCapAttrs adds the attributes attrs to the span s while
 // de-duplicating the attributes of s and attrs and dropping attributes that
-// exceed the limit.
+// exceed the limit or the span size limit.
 //
 // This method assumes s.mu.Lock is held by the caller.
 //
 // This method should only be called when there is a possibility that adding
-// attrs to s will exceed the limit. Otherwise, attrs should be added to s
-// without checking for duplicates and all retrieval methods of the attributes
-// for s will de-duplicate as needed.
+// attrs to s will exceed the limit or when a span size limit is applied.
+// Otherwise, attrs should be added to s without checking for duplicates and
+// all retrieval methods of the attributes for s will de-duplicate as needed.
 //
-// This method assumes limit is a value > 0. The argument should be validated
-// by the caller.
+// This method assumes limit is a non-zero value. A negative limit means no
+// count limit is applied. The argument should be validated by the caller.
 func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 	// In order to not allocate more capacity to s.attributes than needed,
 	// prune and truncate this addition of attributes while adding.
@@ -269,7 +288,11 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 	// Now that s.attributes is deduplicated, adding unique attributes up to
 	// the capacity of s will not over allocate s.attributes.
 	sum := len(attrs) + len(s.attributes)
-	s.attributes = slices.Grow(s.attributes, min(sum, limit))
+	if limit > 0 {
+		sum = min(sum, limit)
+	}
+	s.attributes = slices.Grow(s.attributes, sum)
+	sized := s.tracer.provider.spanSizeLimit > 0
 	for _, a := range attrs {
 		if !a.Valid() {
 			// Drop all invalid attributes.
@@ -279,22 +302,116 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 
 		if idx, ok := exists[a.Key]; ok {
 			// Perform all updates before dropping, even when at capacity.
+			if sized {
+				a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+				delta := attrSize(a) - attrSize(s.attributes[idx])
+				if !s.makeRoom(delta) {
+					s.droppedAttributes++
+					continue
+				}
+				s.attrsSize += delta
+			}
 			s.attributes[idx] = a
 			continue
 		}
 
-		if len(s.attributes) >= limit {
+		if limit > 0 && len(s.attributes) >= limit {
 			// Do not just drop all of the remaining attributes, make sure
 			// updates are checked and performed.
 			s.droppedAttributes++
-		} else {
-			a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
-			s.attributes = append(s.attributes, a)
-			exists[a.Key] = len(s.attributes) - 1
+			continue
 		}
+
+		a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+		if sized {
+			n := attrSize(a)
+			if !s.makeRoom(n) {
+				s.droppedAttributes++
+				continue
+			}
+			s.attrsSize += n
+		}
+		s.attributes = append(s.attributes, a)
+		exists[a.Key] = len(s.attributes) - 1
 	}
 }
 
+// makeRoom evicts the oldest events, and then the oldest links, of s until n
+// more bytes fit in the span size limit. It returns false, without evicting
+// anything, if n bytes do not fit even after all events and links are
+// evicted.
+//
+// This method assumes s.mu.Lock is held by the caller and that a span size
+// limit is applied.
+func (s *recordingSpan) makeRoom(n int) bool {
+	limit := s.tracer.provider.spanSizeLimit
+	size := func() int { return s.attrsSize + s.eventsSize + s.linksSize + n }
+	if size() <= limit {
+		return true
+	}
+	if s.attrsSize+n > limit {
+		return false
+	}
+	for size() > limit {
+		e, ok := s.events.evict()
+		if !ok {
+			break
+		}
+		s.eventsSize -= eventSize(e)
+	}
+	for size() > limit {
+		l, ok := s.links.evict()
+		if !ok {
+			break
+		}
+		s.linksSize -= linkSize(l)
+	}
+	return true
+}
+
+// attrSize returns the approximate size, in bytes, of kv.
+func attrSize(kv attribute.KeyValue) int {
+	n := len(kv.Key)
+	switch kv.Value.Type() {
+	case attribute.STRING:
+		n += len(kv.Value.AsString())
+	case attribute.STRINGSLICE:
+		for _, v := range kv.Value.AsStringSlice() {
+			n += len(v)
+		}
+	case attribute.BOOLSLICE:
+		n += 8 * len(kv.Value.AsBoolSlice())
+	case attribute.INT64SLICE:
+		n += 8 * len(kv.Value.AsInt64Slice())
+	case attribute.FLOAT64SLICE:
+		n += 8 * len(kv.Value.AsFloat64Slice())
+	default:
+		n += 8
+	}
+	return n
+}
+
+// attrsSize returns the approximate size, in bytes, of attrs.
+func attrsSize(attrs []attribute.KeyValue) int {
+	var n int
+	for _, a := range attrs {
+		n += attrSize(a)
+	}
+	return n
+}
+
+// eventSize returns the approximate size, in bytes, of e.
+func eventSize(e Event) int {
+	const timeSize = 24
+	return len(e.Name) + timeSize + attrsSize(e.Attributes)
+}
+
+// linkSize returns the approximate size, in bytes, of l.
+func linkSize(l Link) int {
+	const spanContextSize = 24 // Trace ID and span ID.
+	return spanContextSize + attrsSize(l.Attributes)
+}
+
 // truncateAttr returns a truncated version of attr. Only string and string
 // slice attribute values are truncated. String values are truncated to at
 // most a length of limit. Each string slice value is truncated in this fashion
@@ -406,15 +523,22 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 	} else {
 		s.endTime = config.Timestamp()
 	}
+	ordering := s.tracer.provider.eventOrdering
+	reordered := orderEvents(s.events.queue, ordering)
+	name := s.name
 	s.mu.Unlock()
 
+	if reordered {
+		otel.Handle(fmt.Errorf("span %q: events out of order, %s event ordering applied", name, ordering))
+	}
+
 	sps := s.tracer.provider.getSpanProcessors()
 	if len(sps) == 0 {
 		return
 	}
 	snap := s.snapshot()
 	for _, sp := range sps {
-		sp.sp.OnEnd(snap)
+		sp.onEnd(snap)
 	}
 }
 
@@ -467,10 +591,27 @@ func (s *recordingSpan) AddEvent(name string, o ...trace.EventOption) {
 	s.addEvent(name, o...)
 }
 
+// AddEventValue adds e to the span. It is equivalent to AddEvent without
+// the allocation of the options.
+func (s *recordingSpan) AddEventValue(e trace.Event) {
+	if !s.IsRecording() {
+		return
+	}
+	t := e.Timestamp
+	if t.IsZero() {
+		t = time.Now()
+	}
+	s.recordEvent(Event{Name: e.Name, Attributes: e.Attributes, Time: t})
+}
+
 func (s *recordingSpan) addEvent(name string, o ...trace.EventOption) {
 	c := trace.NewEventConfig(o...)
-	e := Event{Name: name, Attributes: c.Attributes(), Time: c.Timestamp()}
+	s.recordEvent(Event{Name: name, Attributes: c.Attributes(), Time: c.Timestamp()})
+}
 
+// recordEvent records e, applying the span limits, if the span has not
+// ended.
+func (s *recordingSpan) recordEvent(e Event) {
 	// Discard attributes over limit.
 	limit := s.tracer.provider.spanLimits.AttributePerEventCountLimit
 	if limit == 0 {
@@ -484,8 +625,26 @@ func (s *recordingSpan) addEvent(name string, o ...trace.EventOption) {
 	}
 
 	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The span may have ended since it was checked to be recording. The
+	// events of an ended span are shared with its snapshot and must not be
+	// modified.
+	if !s.endTime.IsZero() {
+		return
+	}
+	if s.tracer.provider.spanSizeLimit > 0 && s.events.capacity != 0 {
+		n := eventSize(e)
+		if !s.makeRoom(n) {
+			s.events.droppedCount++
+			return
+		}
+		s.eventsSize += n
+		if evicted, ok := s.events.add(e); ok {
+			s.eventsSize -= eventSize(evicted)
+		}
+		return
+	}
 	s.events.add(e)
-	s.mu.Unlock()
 }
 
 // SetName sets the name of this span. If this span is not being recorded than
@@ -579,23 +738,35 @@ func (s *recordingSpan) dedupeAttrsFromRecord(record *map[attribute.Key]int) {
 }
 
 // Links returns the links of this span.
+//
+// The links of an ended span are no longer modified and are returned without
+// being copied. The returned slice is shared and must not be modified.
 func (s *recordingSpan) Links() []Link {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if len(s.links.queue) == 0 {
 		return []Link{}
 	}
-	return s.interfaceArrayToLinksArray()
+	if !s.endTime.IsZero() {
+		return s.links.queue
+	}
+	return s.links.copy()
 }
 
 // Events returns the events of this span.
+//
+// The events of an ended span are no longer modified and are returned without
+// being copied. The returned slice is shared and must not be modified.
 func (s *recordingSpan) Events() []Event {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if len(s.events.queue) == 0 {
 		return []Event{}
 	}
-	return s.interfaceArrayToEventArray()
+	if !s.endTime.IsZero() {
+		return s.events.queue
+	}
+	return s.events.copy()
 }
 
 // Status returns the status of this span.
@@ -648,8 +819,25 @@ func (s *recordingSpan) AddLink(link trace.Link) {
 	}
 
 	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The links of an ended span are shared with its snapshot and must not be
+	// modified.
+	if !s.endTime.IsZero() {
+		return
+	}
+	if s.tracer.provider.spanSizeLimit > 0 && s.links.capacity != 0 {
+		n := linkSize(l)
+		if !s.makeRoom(n) {
+			s.links.droppedCount++
+			return
+		}
+		s.linksSize += n
+		if evicted, ok := s.links.add(l); ok {
+			s.linksSize -= linkSize(evicted)
+		}
+		return
+	}
 	s.links.add(l)
-	s.mu.Unlock()
 }
 
 // DroppedAttributes returns the number of attributes dropped by the span
@@ -712,31 +900,45 @@ func (s *recordingSpan) snapshot() ReadOnlySpan {
 		sd.attributes = s.attributes
 	}
 	sd.droppedAttributeCount = s.droppedAttributes
+	// The events and links of an ended span are no longer modified. Share
+	// them with the snapshot instead of copying them.
+	ended := !s.endTime.IsZero()
 	if len(s.events.queue) > 0 {
-		sd.events = s.interfaceArrayToEventArray()
-		sd.droppedEventCount = s.events.droppedCount
+		if ended {
+			sd.events = s.events.queue
+		} else {
+			sd.events = s.events.copy()
+		}
 	}
+	sd.droppedEventCount = s.events.droppedCount
 	if len(s.links.queue) > 0 {
-		sd.links = s.interfaceArrayToLinksArray()
-		sd.droppedLinkCount = s.links.droppedCount
+		if ended {
+			sd.links = s.links.queue
+		} else {
+			sd.links = s.links.copy()
+		}
 	}
+	sd.droppedLinkCount = s.links.droppedCount
 	return &sd
 }
 
-func (s *recordingSpan) interfaceArrayToLinksArray() []Link {
-	linkArr := make([]Link, 0)
-	for _, value := range s.links.queue {
-		linkArr = append(linkArr, value.(Link))
-	}
-	return linkArr
-}
+// inheritedAttributes returns the attributes of s with one of keys, in the
+// order of keys.
+func (s *recordingSpan) inheritedAttributes(keys []attribute.Key) []attribute.KeyValue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-func (s *recordingSpan) interfaceArrayToEventArray() []Event {
-	eventArr := make([]Event, 0)
-	for _, value := range s.events.queue {
-		eventArr = append(eventArr, value.(Event))
+	var out []attribute.KeyValue
+	for _, k := range keys {
+		// The last attribute set with a key holds its value.
+		for i := len(s.attributes) - 1; i >= 0; i-- {
+			if s.attributes[i].Key == k {
+				out = append(out, s.attributes[i])
+				break
+			}
+		}
 	}
-	return eventArr
+	return out
 }
 
 func (s *recordingSpan) addChild() {
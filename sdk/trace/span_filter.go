@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanFilter selects the spans a SpanProcessor is called with. It is
+// evaluated by the TracerProvider before calling the SpanProcessor.
+//
+// Each field that is set needs to match a span for it to be selected. The
+// zero value selects all spans.
+type SpanFilter struct {
+	// SpanKinds are the kinds of spans to select. If empty, spans of any kind
+	// are selected.
+	SpanKinds []trace.SpanKind
+	// StatusCodes are the status codes of spans to select. If empty, spans
+	// with any status code are selected.
+	//
+	// The status of a span is only known when it ends. This is only evaluated
+	// for the call to OnEnd, OnStart is called regardless of it.
+	StatusCodes []codes.Code
+	// Name selects spans based on their name. If nil, spans with any name
+	// are selected.
+	//
+	// The name of a span can change while it is recording. The name is
+	// evaluated each time the SpanProcessor is about to be called.
+	Name func(name string) bool
+}
+
+// matchStart returns if s matches f when it is started.
+func (f *SpanFilter) matchStart(s ReadOnlySpan) bool {
+	if len(f.SpanKinds) > 0 && !slices.Contains(f.SpanKinds, s.SpanKind()) {
+		return false
+	}
+	return f.Name == nil || f.Name(s.Name())
+}
+
+// matchEnd returns if s matches f when it is ended.
+func (f *SpanFilter) matchEnd(s ReadOnlySpan) bool {
+	if len(f.StatusCodes) > 0 && !slices.Contains(f.StatusCodes, s.Status().Code) {
+		return false
+	}
+	return f.matchStart(s)
+}
+
+// filteredSpanProcessor is a SpanProcessor registered with a SpanFilter.
+type filteredSpanProcessor struct {
+	SpanProcessor
+
+	filter SpanFilter
+}
+
+// WithFilteredSpanProcessor registers the SpanProcessor with a
+// TracerProvider. The SpanProcessor is only called with the spans selected by
+// filter.
+func WithFilteredSpanProcessor(sp SpanProcessor, filter SpanFilter) TracerProviderOption {
+	return WithSpanProcessor(filteredSpanProcessor{SpanProcessor: sp, filter: filter})
+}
+
+// RegisterFilteredSpanProcessor adds the given SpanProcessor to the list of
+// SpanProcessors. The SpanProcessor is only called with the spans selected
+// by filter.
+//
+// Use UnregisterSpanProcessor with sp to remove it.
+func (p *TracerProvider) RegisterFilteredSpanProcessor(sp SpanProcessor, filter SpanFilter) {
+	p.RegisterSpanProcessor(filteredSpanProcessor{SpanProcessor: sp, filter: filter})
+}
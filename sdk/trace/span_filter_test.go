@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanNames[T interface{ Name() string }](spans []T) []string {
+	var names []string
+	for _, s := range spans {
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+func TestWithFilteredSpanProcessor(t *testing.T) {
+	kindSP := &testSpanProcessor{name: "kind"}
+	statusSP := &testSpanProcessor{name: "status"}
+	nameSP := &testSpanProcessor{name: "name"}
+	allSP := &testSpanProcessor{name: "all"}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithFilteredSpanProcessor(kindSP, sdktrace.SpanFilter{
+			SpanKinds: []trace.SpanKind{trace.SpanKindServer, trace.SpanKindConsumer},
+		}),
+		sdktrace.WithFilteredSpanProcessor(statusSP, sdktrace.SpanFilter{
+			StatusCodes: []codes.Code{codes.Error},
+		}),
+		sdktrace.WithFilteredSpanProcessor(nameSP, sdktrace.SpanFilter{
+			Name: func(name string) bool { return strings.HasPrefix(name, "db.") },
+		}),
+		sdktrace.WithFilteredSpanProcessor(allSP, sdktrace.SpanFilter{}),
+	)
+	tr := tp.Tracer("TestWithFilteredSpanProcessor")
+	ctx := context.Background()
+
+	_, s := tr.Start(ctx, "server", trace.WithSpanKind(trace.SpanKindServer))
+	s.End()
+	_, s = tr.Start(ctx, "failed")
+	s.SetStatus(codes.Error, "failure")
+	s.End()
+	_, s = tr.Start(ctx, "db.query", trace.WithSpanKind(trace.SpanKindClient))
+	s.End()
+
+	assert.Equal(t, []string{"server"}, spanNames(kindSP.spansStarted))
+	assert.Equal(t, []string{"server"}, spanNames(kindSP.spansEnded))
+
+	// The status is only known at the end of a span.
+	assert.Equal(t, []string{"server", "failed", "db.query"}, spanNames(statusSP.spansStarted))
+	assert.Equal(t, []string{"failed"}, spanNames(statusSP.spansEnded))
+
+	assert.Equal(t, []string{"db.query"}, spanNames(nameSP.spansStarted))
+	assert.Equal(t, []string{"db.query"}, spanNames(nameSP.spansEnded))
+
+	assert.Len(t, allSP.spansStarted, 3)
+	assert.Len(t, allSP.spansEnded, 3)
+
+	assert.NoError(t, tp.Shutdown(ctx))
+	for _, sp := range []*testSpanProcessor{kindSP, statusSP, nameSP, allSP} {
+		assert.Equalf(t, 1, sp.shutdownCount, "%s not shut down", sp.name)
+	}
+}
+
+func TestRegisterFilteredSpanProcessor(t *testing.T) {
+	sp := &testSpanProcessor{name: "sp"}
+	tp := sdktrace.NewTracerProvider()
+	tp.RegisterFilteredSpanProcessor(sp, sdktrace.SpanFilter{
+		SpanKinds: []trace.SpanKind{trace.SpanKindProducer},
+	})
+	tr := tp.Tracer("TestRegisterFilteredSpanProcessor")
+	ctx := context.Background()
+
+	_, s := tr.Start(ctx, "internal")
+	s.End()
+	_, s = tr.Start(ctx, "producer", trace.WithSpanKind(trace.SpanKindProducer))
+	s.End()
+	assert.Equal(t, []string{"producer"}, spanNames(sp.spansEnded))
+
+	tp.UnregisterSpanProcessor(sp)
+	assert.Equal(t, 1, sp.shutdownCount, "filtered processor not unregistered")
+
+	_, s = tr.Start(ctx, "producer", trace.WithSpanKind(trace.SpanKindProducer))
+	s.End()
+	assert.Len(t, sp.spansEnded, 1)
+}
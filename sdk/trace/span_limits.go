@@ -89,19 +89,21 @@ type SpanLimits struct {
 // NewSpanLimits returns a SpanLimits with all limits set to the value their
 // corresponding environment variable holds, or the default if unset.
 //
-// • AttributeValueLengthLimit: OTEL_SPAN_ATTRIBUTE_VALUE_LENGTH_LIMIT
-// (default: unlimited)
+// • AttributeValueLengthLimit: OTEL_SPAN_ATTRIBUTE_VALUE_LENGTH_LIMIT, or
+// OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT if unset (default: unlimited)
 //
-// • AttributeCountLimit: OTEL_SPAN_ATTRIBUTE_COUNT_LIMIT (default: 128)
+// • AttributeCountLimit: OTEL_SPAN_ATTRIBUTE_COUNT_LIMIT, or
+// OTEL_ATTRIBUTE_COUNT_LIMIT if unset (default: 128)
 //
 // • EventCountLimit: OTEL_SPAN_EVENT_COUNT_LIMIT (default: 128)
 //
-// • AttributePerEventCountLimit: OTEL_EVENT_ATTRIBUTE_COUNT_LIMIT (default:
-// 128)
+// • AttributePerEventCountLimit: OTEL_EVENT_ATTRIBUTE_COUNT_LIMIT, or
+// OTEL_ATTRIBUTE_COUNT_LIMIT if unset (default: 128)
 //
 // • LinkCountLimit: OTEL_SPAN_LINK_COUNT_LIMIT (default: 128)
 //
-// • AttributePerLinkCountLimit: OTEL_LINK_ATTRIBUTE_COUNT_LIMIT (default: 128)
+// • AttributePerLinkCountLimit: OTEL_LINK_ATTRIBUTE_COUNT_LIMIT, or
+// OTEL_ATTRIBUTE_COUNT_LIMIT if unset (default: 128)
 func NewSpanLimits() SpanLimits {
 	return SpanLimits{
 		AttributeValueLengthLimit:   env.SpanAttributeValueLength(DefaultAttributeValueLengthLimit),
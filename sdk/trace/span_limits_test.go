@@ -5,7 +5,9 @@ package trace
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -274,3 +276,67 @@ func TestSpanLimits(t *testing.T) {
 		}
 	})
 }
+
+func TestSpanSizeLimit(t *testing.T) {
+	// Sizes: attribute.Int("a", 1) is 9 bytes, an event named "eN" without
+	// attributes is 26 bytes, a link without attributes is 24 bytes.
+	const limit = 110
+	sc := func(id byte) trace.SpanContext {
+		return trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: [16]byte{id},
+			SpanID:  [8]byte{id},
+		})
+	}
+	str := func(n int) string { return strings.Repeat("x", n) }
+
+	for _, cntLim := range []int{DefaultAttributeCountLimit, -1} {
+		t.Run(fmt.Sprintf("AttributeCountLimit=%d", cntLim), func(t *testing.T) {
+			limits := NewSpanLimits()
+			limits.AttributeCountLimit = cntLim
+			rec := new(recorder)
+			tp := NewTracerProvider(
+				WithRawSpanLimits(limits),
+				WithSpanSizeLimit(limit),
+				WithSpanProcessor(rec),
+			)
+
+			_, span := tp.Tracer("TestSpanSizeLimit").Start(
+				context.Background(),
+				"span",
+				trace.WithLinks(trace.Link{SpanContext: sc(1)}), // 24
+			)
+			span.SetAttributes(attribute.Int("a", 1)) // 33
+			span.AddEvent("e1")                       // 59
+			span.AddEvent("e2")                       // 85
+			span.AddEvent("e3")                       // 111: evicts e1, 85
+
+			// 41 bytes: evicts e2, 100.
+			span.SetAttributes(attribute.String("s", str(40)))
+			// Larger than the limit by itself: dropped, nothing evicted.
+			span.SetAttributes(attribute.String("big", str(limit)))
+			// 126: evicts e3, 100.
+			span.AddEvent("e4")
+			// Update of s by 10 bytes: evicts e4, 84.
+			span.SetAttributes(attribute.String("s", str(50)))
+			// 108.
+			span.AddLink(trace.Link{SpanContext: sc(2)})
+			// 132: no more events, evicts the link of sc(1), 108.
+			span.AddLink(trace.Link{SpanContext: sc(3)})
+			span.End()
+
+			require.Len(t, *rec, 1)
+			got := (*rec)[0]
+			assert.ElementsMatch(t, []attribute.KeyValue{
+				attribute.Int("a", 1),
+				attribute.String("s", str(50)),
+			}, got.Attributes())
+			assert.Equal(t, 1, got.DroppedAttributes(), "dropped attributes")
+			assert.Empty(t, got.Events())
+			assert.Equal(t, 4, got.DroppedEvents(), "dropped events")
+			require.Len(t, got.Links(), 2)
+			assert.Equal(t, sc(2), got.Links()[0].SpanContext)
+			assert.Equal(t, sc(3), got.Links()[1].SpanContext)
+			assert.Equal(t, 1, got.DroppedLinks(), "dropped links")
+		})
+	}
+}
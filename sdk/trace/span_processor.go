@@ -52,10 +52,33 @@ type SpanProcessor interface {
 type spanProcessorState struct {
 	sp    SpanProcessor
 	state sync.Once
+
+	// filter selects the spans sp is called with. If nil, sp is called with
+	// all spans.
+	filter *SpanFilter
 }
 
 func newSpanProcessorState(sp SpanProcessor) *spanProcessorState {
+	if f, ok := sp.(filteredSpanProcessor); ok {
+		return &spanProcessorState{sp: f.SpanProcessor, filter: &f.filter}
+	}
 	return &spanProcessorState{sp: sp}
 }
 
+// onStart calls OnStart of the SpanProcessor if s is selected by its filter.
+func (sps *spanProcessorState) onStart(parent context.Context, s ReadWriteSpan) {
+	if sps.filter != nil && !sps.filter.matchStart(s) {
+		return
+	}
+	sps.sp.OnStart(parent, s)
+}
+
+// onEnd calls OnEnd of the SpanProcessor if s is selected by its filter.
+func (sps *spanProcessorState) onEnd(s ReadOnlySpan) {
+	if sps.filter != nil && !sps.filter.matchEnd(s) {
+		return
+	}
+	sps.sp.OnEnd(s)
+}
+
 type spanProcessorStates []*spanProcessorState
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// SpanSubscription receives the spans ended by a TracerProvider on a bounded
+// channel. It allows in-process consumers (e.g. anomaly detectors or
+// debugging tools) to observe ended spans without implementing a
+// SpanProcessor.
+//
+// Use [TracerProvider.SubscribeEndedSpans] to create a SpanSubscription.
+type SpanSubscription struct {
+	provider *TracerProvider
+	proc     *subscriptionProcessor
+}
+
+// SubscribeEndedSpans returns a SpanSubscription that receives the spans
+// ended by p after this call. The channel of the subscription buffers up to
+// size spans. If size is less than one, a size of one is used.
+//
+// Spans are sent without blocking. Spans ended while the channel is full are
+// dropped and counted by the Dropped method of the returned
+// SpanSubscription. The received spans must not be modified.
+//
+// The channel is closed when the subscription is closed or p is shut down.
+// If p is already shut down, the returned SpanSubscription is closed.
+func (p *TracerProvider) SubscribeEndedSpans(size int) *SpanSubscription {
+	if size < 1 {
+		size = 1
+	}
+	s := &SpanSubscription{
+		provider: p,
+		proc:     &subscriptionProcessor{c: make(chan ReadOnlySpan, size)},
+	}
+	p.RegisterSpanProcessor(s.proc)
+	if p.isShutdown.Load() {
+		// The span processor was not registered.
+		s.proc.close()
+	}
+	return s
+}
+
+// Spans returns the channel the ended spans are sent to.
+func (s *SpanSubscription) Spans() <-chan ReadOnlySpan {
+	return s.proc.c
+}
+
+// Dropped returns the number of ended spans that were dropped because the
+// channel was full.
+func (s *SpanSubscription) Dropped() uint64 {
+	return s.proc.dropped.Load()
+}
+
+// Close stops sending ended spans to s and closes its channel. Spans already
+// buffered in the channel can still be received.
+func (s *SpanSubscription) Close() {
+	s.provider.UnregisterSpanProcessor(s.proc)
+	// Close even if the provider is shut down and did not unregister.
+	s.proc.close()
+}
+
+// subscriptionProcessor is the SpanProcessor sending the ended spans of a
+// SpanSubscription to its channel.
+type subscriptionProcessor struct {
+	// mu guards closed and closing c. It ensures no span is sent to c after
+	// it is closed.
+	mu     sync.RWMutex
+	closed bool
+	c      chan ReadOnlySpan
+
+	dropped atomic.Uint64
+}
+
+var _ SpanProcessor = (*subscriptionProcessor)(nil)
+
+func (*subscriptionProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd sends s to the channel without blocking. It is dropped if the channel
+// is full.
+func (p *subscriptionProcessor) OnEnd(s ReadOnlySpan) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return
+	}
+	select {
+	case p.c <- s:
+	default:
+		p.dropped.Add(1)
+	}
+}
+
+// Shutdown closes the channel.
+func (p *subscriptionProcessor) Shutdown(context.Context) error {
+	p.close()
+	return nil
+}
+
+func (*subscriptionProcessor) ForceFlush(context.Context) error { return nil }
+
+func (p *subscriptionProcessor) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.c)
+	}
+}
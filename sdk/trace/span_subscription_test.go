@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func receiveAll(c <-chan sdktrace.ReadOnlySpan) []sdktrace.ReadOnlySpan {
+	var spans []sdktrace.ReadOnlySpan
+	for s := range c {
+		spans = append(spans, s)
+	}
+	return spans
+}
+
+func TestSubscribeEndedSpans(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	sub := tp.SubscribeEndedSpans(2)
+	tr := tp.Tracer("TestSubscribeEndedSpans")
+	ctx := context.Background()
+
+	_, s := tr.Start(ctx, "started before subscription closed")
+	for _, name := range []string{"a", "b", "c"} {
+		_, span := tr.Start(ctx, name)
+		span.End()
+	}
+	sub.Close()
+	s.End()
+
+	assert.Equal(t, []string{"a", "b"}, spanNames(receiveAll(sub.Spans())))
+	assert.Equal(t, uint64(1), sub.Dropped(), "dropped")
+
+	assert.NotPanics(t, sub.Close, "second close")
+	assert.NoError(t, tp.Shutdown(ctx))
+}
+
+func TestSubscribeEndedSpansProviderShutdown(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	sub := tp.SubscribeEndedSpans(1)
+
+	ctx := context.Background()
+	_, s := tp.Tracer("TestSubscribeEndedSpansProviderShutdown").Start(ctx, "span")
+	s.End()
+
+	assert.NoError(t, tp.Shutdown(ctx))
+	assert.Equal(t, []string{"span"}, spanNames(receiveAll(sub.Spans())))
+	assert.NotPanics(t, sub.Close, "close after shutdown")
+
+	sub = tp.SubscribeEndedSpans(1)
+	_, ok := <-sub.Spans()
+	assert.False(t, ok, "subscription after shutdown not closed")
+}
+
+func TestSubscribeEndedSpansInvalidSize(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	sub := tp.SubscribeEndedSpans(0)
+	assert.Equal(t, 1, cap(sub.Spans()))
+	sub.Close()
+}
+
+func TestSubscribeEndedSpansConcurrentSafe(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	tr := tp.Tracer("TestSubscribeEndedSpansConcurrentSafe")
+	sub := tp.SubscribeEndedSpans(10)
+
+	const goroutines = 10
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, s := tr.Start(ctx, "span")
+				s.End()
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sub.Close()
+	}()
+
+	done := make(chan int)
+	go func() { done <- len(receiveAll(sub.Spans())) }()
+
+	wg.Wait()
+	received := <-done
+	assert.LessOrEqual(t, uint64(received)+sub.Dropped(), uint64(goroutines*10))
+	assert.NoError(t, tp.Shutdown(ctx))
+}
@@ -673,6 +673,31 @@ func TestEvents(t *testing.T) {
 	}
 }
 
+func TestAddEventValue(t *testing.T) {
+	te := NewTestExporter()
+	sl := NewSpanLimits()
+	sl.AttributePerEventCountLimit = 1
+	tp := NewTracerProvider(WithSpanLimits(sl), WithSyncer(te), WithResource(resource.Empty()))
+
+	span := startSpan(tp, "AddEventValue")
+	k1v1 := attribute.String("key1", "value1")
+	k2v2 := attribute.Bool("key2", true)
+	ts := time.Unix(1, 0)
+
+	trace.AddEvent(span, trace.Event{Name: "foo", Attributes: []attribute.KeyValue{k1v1}, Timestamp: ts})
+	trace.AddEvent(span, trace.Event{Name: "bar", Attributes: []attribute.KeyValue{k1v1, k2v2}})
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+
+	events := got.Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, Event{Name: "foo", Attributes: []attribute.KeyValue{k1v1}, Time: ts}, events[0])
+	assert.Equal(t, "bar", events[1].Name)
+	assert.Equal(t, []attribute.KeyValue{k1v1}, events[1].Attributes)
+	assert.Equal(t, 1, events[1].DroppedAttributeCount)
+	assert.False(t, events[1].Time.IsZero(), "default timestamp")
+}
+
 func TestEventsOverLimit(t *testing.T) {
 	te := NewTestExporter()
 	sl := NewSpanLimits()
@@ -901,6 +926,7 @@ func cmpDiff(x, y interface{}) string {
 	return cmp.Diff(x, y,
 		cmp.AllowUnexported(snapshot{}),
 		cmp.AllowUnexported(attribute.Value{}),
+		cmp.Comparer(func(a, b attribute.Set) bool { return a.Equals(&b) }),
 		cmp.AllowUnexported(Event{}),
 		cmp.AllowUnexported(trace.TraceState{}))
 }
@@ -1616,6 +1642,20 @@ func TestReadOnlySpan(t *testing.T) {
 	et := st.Add(time.Millisecond)
 	s.End(trace.WithTimestamp(et))
 	assert.Equal(t, et, ro.EndTime())
+
+	// Verify events and links shared by a snapshot of an ended span are not
+	// modified.
+	d3 := s.(*recordingSpan).snapshot()
+	wantEvents, wantLinks := d3.Events(), d3.Links()
+	gotEvents, gotLinks := ro.Events(), ro.Links()
+	s.AddEvent("qux")
+	s.AddLink(trace.Link{SpanContext: parent})
+	assert.Equal(t, wantEvents, d3.Events())
+	assert.Equal(t, wantLinks, d3.Links())
+	assert.Equal(t, wantEvents, gotEvents)
+	assert.Equal(t, wantLinks, gotLinks)
+	assert.Equal(t, wantEvents, ro.Events())
+	assert.Equal(t, wantLinks, ro.Links())
 }
 
 func TestReadWriteSpan(t *testing.T) {
@@ -2054,3 +2094,185 @@ func TestAddLink(t *testing.T) {
 		t.Errorf("AddLink: -got +want %s", diff)
 	}
 }
+
+func TestWithInheritedAttributes(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithInheritedAttributes("enduser.id"),
+		WithInheritedAttributes("tenant"),
+	)
+	tr := tp.Tracer(t.Name())
+
+	ctx, root := tr.Start(context.Background(), "root", trace.WithAttributes(
+		attribute.String("tenant", "a"),
+		attribute.String("other", "v"),
+	))
+	root.SetAttributes(
+		attribute.String("tenant", "b"),
+		attribute.String("enduser.id", "alice"),
+	)
+
+	ctx, child := tr.Start(ctx, "child", trace.WithAttributes(attribute.String("enduser.id", "bob")))
+	_, grandchild := tr.Start(ctx, "grandchild")
+	grandchild.End()
+	child.End()
+
+	root.SetAttributes(attribute.String("tenant", "late"))
+	_, late := tr.Start(trace.ContextWithSpan(context.Background(), root), "late")
+	late.End()
+
+	_, newRoot := tr.Start(trace.ContextWithSpan(context.Background(), root), "new root", trace.WithNewRoot())
+	newRoot.End()
+	root.End()
+
+	get := func(name string) []attribute.KeyValue {
+		t.Helper()
+		s, ok := te.GetSpan(name)
+		require.True(t, ok, "span %q not exported", name)
+		return s.Attributes()
+	}
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("enduser.id", "bob"),
+		attribute.String("tenant", "b"),
+	}, get("child"))
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("enduser.id", "bob"),
+		attribute.String("tenant", "b"),
+	}, get("grandchild"))
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("enduser.id", "alice"),
+		attribute.String("tenant", "late"),
+	}, get("late"))
+	assert.Empty(t, get("new root"))
+}
+
+func TestWithInheritedAttributesRemoteParent(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithInheritedAttributes("tenant"))
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+	_, s := tp.Tracer(t.Name()).Start(ctx, "span")
+	s.End()
+
+	got, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Empty(t, got.Attributes())
+}
+
+func TestWithEventOrdering(t *testing.T) {
+	t0 := time.Unix(100, 0)
+	t1 := t0.Add(time.Second)
+	t2 := t0.Add(2 * time.Second)
+
+	tests := []struct {
+		ordering      EventOrdering
+		want          []time.Time
+		wantNames     []string
+		wantReordered bool
+	}{
+		{EventOrderingPreserve, []time.Time{t1, t0, t2, t1}, []string{"a", "b", "c", "d"}, false},
+		{EventOrderingSort, []time.Time{t0, t1, t1, t2}, []string{"b", "a", "d", "c"}, true},
+		{EventOrderingClamp, []time.Time{t1, t1, t2, t2}, []string{"a", "b", "c", "d"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ordering.String(), func(t *testing.T) {
+			handler.Reset()
+			te := NewTestExporter()
+			tp := NewTracerProvider(WithSyncer(te), WithEventOrdering(tt.ordering))
+			_, span := tp.Tracer(t.Name()).Start(context.Background(), "span")
+			span.AddEvent("a", trace.WithTimestamp(t1))
+			span.AddEvent("b", trace.WithTimestamp(t0))
+			span.AddEvent("c", trace.WithTimestamp(t2))
+			span.AddEvent("d", trace.WithTimestamp(t1))
+			span.End()
+
+			got, ok := te.GetSpan("span")
+			require.True(t, ok)
+			var times []time.Time
+			var names []string
+			for _, e := range got.Events() {
+				times = append(times, e.Time)
+				names = append(names, e.Name)
+			}
+			assert.Equal(t, tt.want, times)
+			assert.Equal(t, tt.wantNames, names)
+			if tt.wantReordered {
+				require.Len(t, handler.errs, 1, "reordering not reported")
+				assert.ErrorContains(t, handler.errs[0], tt.ordering.String())
+			} else {
+				assert.Empty(t, handler.errs, "reordering reported")
+			}
+		})
+	}
+}
+
+func TestOrderEventsInOrder(t *testing.T) {
+	t0 := time.Unix(100, 0)
+	events := []Event{{Name: "a", Time: t0}, {Name: "b", Time: t0}, {Name: "c", Time: t0.Add(time.Second)}}
+	for _, o := range []EventOrdering{EventOrderingSort, EventOrderingClamp, EventOrdering(-1)} {
+		assert.False(t, orderEvents(events, o), o.String())
+	}
+	assert.False(t, orderEvents(nil, EventOrderingSort))
+	assert.Equal(t, "EventOrdering(-1)", EventOrdering(-1).String())
+}
+
+func TestWithDefaultSpanStartOptions(t *testing.T) {
+	type linksKey struct{}
+	link := trace.Link{SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})}
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithDefaultSpanStartOptions(
+			trace.WithAttributes(attribute.String("team", "a"), attribute.String("tier", "1")),
+		),
+		WithSpanStartOptionsFunc(func(ctx context.Context, scope instrumentation.Scope) []trace.SpanStartOption {
+			var opts []trace.SpanStartOption
+			if links, ok := ctx.Value(linksKey{}).([]trace.Link); ok {
+				opts = append(opts, trace.WithLinks(links...))
+			}
+			if scope.Name == "server" {
+				opts = append(opts, trace.WithSpanKind(trace.SpanKindServer))
+			}
+			return opts
+		}),
+		WithSpanStartOptionsFunc(nil),
+	)
+
+	ctx := context.WithValue(context.Background(), linksKey{}, []trace.Link{link})
+	_, s := tp.Tracer("server").Start(ctx, "default")
+	s.End()
+	_, s = tp.Tracer("server").Start(context.Background(), "override",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attribute.String("tier", "2")),
+	)
+	s.End()
+	_, s = tp.Tracer("client").Start(context.Background(), "other scope")
+	s.End()
+
+	got, ok := te.GetSpan("default")
+	require.True(t, ok)
+	assert.Equal(t, trace.SpanKindServer, got.SpanKind())
+	assert.ElementsMatch(t, []attribute.KeyValue{attribute.String("team", "a"), attribute.String("tier", "1")}, got.Attributes())
+	require.Len(t, got.Links(), 1)
+	assert.Equal(t, link.SpanContext, got.Links()[0].SpanContext)
+
+	got, ok = te.GetSpan("override")
+	require.True(t, ok)
+	assert.Equal(t, trace.SpanKindConsumer, got.SpanKind())
+	assert.ElementsMatch(t, []attribute.KeyValue{attribute.String("team", "a"), attribute.String("tier", "2")}, got.Attributes())
+	assert.Empty(t, got.Links())
+
+	got, ok = te.GetSpan("other scope")
+	require.True(t, ok)
+	assert.Equal(t, trace.SpanKindInternal, got.SpanKind())
+}
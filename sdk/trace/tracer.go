@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
@@ -27,13 +28,13 @@ var _ trace.Tracer = &tracer{}
 // span context found in the passed context. The created Span will be
 // configured appropriately by any SpanOption passed.
 func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
-	config := trace.NewSpanStartConfig(options...)
-
 	if ctx == nil {
 		// Prevent trace.ContextWithSpan from panicking.
 		ctx = context.Background()
 	}
 
+	config := trace.NewSpanStartConfig(tr.startOptions(ctx, options)...)
+
 	// For local spans created by this SDK, track child span count.
 	if p := trace.SpanFromContext(ctx); p != nil {
 		if sdkSpan, ok := p.(*recordingSpan); ok {
@@ -45,7 +46,7 @@ func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanS
 	if rw, ok := s.(ReadWriteSpan); ok && s.IsRecording() {
 		sps := tr.provider.getSpanProcessors()
 		for _, sp := range sps {
-			sp.sp.OnStart(ctx, rw)
+			sp.onStart(ctx, rw)
 		}
 	}
 	if rtt, ok := s.(runtimeTracer); ok {
@@ -55,6 +56,19 @@ func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanS
 	return trace.ContextWithSpan(ctx, s), s
 }
 
+// startOptions returns the default options of the TracerProvider for a span
+// started with ctx, followed by options.
+func (tr *tracer) startOptions(ctx context.Context, options []trace.SpanStartOption) []trace.SpanStartOption {
+	if len(tr.provider.startOptions) == 0 {
+		return options
+	}
+	var opts []trace.SpanStartOption
+	for _, f := range tr.provider.startOptions {
+		opts = append(opts, f(ctx, tr.instrumentationScope)...)
+	}
+	return append(opts, options...)
+}
+
 type runtimeTracer interface {
 	// runtimeTrace starts a "runtime/trace".Task for the span and
 	// returns a context containing the task.
@@ -84,7 +98,7 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		sid = tr.provider.idGenerator.NewSpanID(ctx, tid)
 	}
 
-	samplingResult := tr.provider.sampler.ShouldSample(SamplingParameters{
+	samplingResult := tr.provider.getSampler().ShouldSample(SamplingParameters{
 		ParentContext: ctx,
 		TraceID:       tid,
 		Name:          name,
@@ -108,11 +122,18 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 	if !isRecording(samplingResult) {
 		return tr.newNonRecordingSpan(sc)
 	}
-	return tr.newRecordingSpan(psc, sc, name, samplingResult, config)
+	var inherited []attribute.KeyValue
+	if keys := tr.provider.inheritedKeys; len(keys) > 0 && !config.NewRoot() {
+		if p, ok := trace.SpanFromContext(ctx).(*recordingSpan); ok {
+			inherited = p.inheritedAttributes(keys)
+		}
+	}
+	return tr.newRecordingSpan(psc, sc, name, samplingResult, config, inherited)
 }
 
-// newRecordingSpan returns a new configured recordingSpan.
-func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr SamplingResult, config *trace.SpanConfig) *recordingSpan {
+// newRecordingSpan returns a new configured recordingSpan holding the
+// inherited attributes.
+func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr SamplingResult, config *trace.SpanConfig, inherited []attribute.KeyValue) *recordingSpan {
 	startTime := config.Timestamp()
 	if startTime.IsZero() {
 		startTime = time.Now()
@@ -132,8 +153,8 @@ func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr Sa
 		spanKind:    trace.ValidateSpanKind(config.SpanKind()),
 		name:        name,
 		startTime:   startTime,
-		events:      newEvictedQueue(tr.provider.spanLimits.EventCountLimit),
-		links:       newEvictedQueue(tr.provider.spanLimits.LinkCountLimit),
+		events:      newEvictedQueue[Event](tr.provider.spanLimits.EventCountLimit),
+		links:       newEvictedQueue[Link](tr.provider.spanLimits.LinkCountLimit),
 		tracer:      tr,
 	}
 
@@ -141,6 +162,7 @@ func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr Sa
 		s.AddLink(l)
 	}
 
+	s.SetAttributes(inherited...)
 	s.SetAttributes(sr.Attributes...)
 	s.SetAttributes(config.Attributes()...)
 
@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"encoding/binary"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultTreeStartTime is the start time of the first span built by a
+// TreeBuilder, unless configured otherwise.
+var defaultTreeStartTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// defaultTreeTimeStep is the time between consecutive timestamps of the spans
+// built by a TreeBuilder, unless configured otherwise.
+const defaultTreeTimeStep = time.Millisecond
+
+// TreeBuilder builds consistent trees of spans for testing exporters and
+// processors.
+//
+// The spans are added with [TreeBuilder.Root] and [SpanNode.Child], and
+// configured with the methods of [SpanNode]. [TreeBuilder.Build] then returns
+// them as [SpanStubs] with:
+//
+//   - Deterministic IDs. Unless set with [SpanNode.SetTraceID] or
+//     [SpanNode.SetSpanID], the trace and span IDs are sequence numbers
+//     starting at 1, assigned in the order the roots and spans were added.
+//   - Deterministic times. Unless set with [SpanNode.SetStartTime] or
+//     [SpanNode.SetEndTime], the timestamps follow a clock starting at the
+//     configured start time and advancing by the configured time step after
+//     each timestamp. For every tree, in the order the spans were added, the
+//     clock gives the start time of a span, then the time of each of its
+//     events, then the timestamps of its children, and finally its end time,
+//     so that children are within the time range of their parent.
+//   - Consistent relationships. The parents, links, and child span counts
+//     are those of the tree.
+//
+// A TreeBuilder is not safe for concurrent use.
+type TreeBuilder struct {
+	cfg   treeConfig
+	roots []*SpanNode
+	spans []*SpanNode
+}
+
+// NewTreeBuilder returns a new [TreeBuilder] configured with options.
+func NewTreeBuilder(options ...TreeOption) *TreeBuilder {
+	cfg := treeConfig{
+		startTime: defaultTreeStartTime,
+		timeStep:  defaultTreeTimeStep,
+	}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return &TreeBuilder{cfg: cfg}
+}
+
+type treeConfig struct {
+	startTime time.Time
+	timeStep  time.Duration
+	resource  *resource.Resource
+	scope     instrumentation.Scope
+}
+
+// TreeOption configures a [TreeBuilder].
+type TreeOption interface {
+	apply(treeConfig) treeConfig
+}
+
+type treeOptionFunc func(treeConfig) treeConfig
+
+func (fn treeOptionFunc) apply(cfg treeConfig) treeConfig {
+	return fn(cfg)
+}
+
+// WithTreeStartTime returns a [TreeOption] that sets the time of the first
+// timestamp of the spans built. The default is 2000-01-01 00:00:00 UTC.
+func WithTreeStartTime(t time.Time) TreeOption {
+	return treeOptionFunc(func(cfg treeConfig) treeConfig {
+		cfg.startTime = t
+		return cfg
+	})
+}
+
+// WithTreeTimeStep returns a [TreeOption] that sets the time between
+// consecutive timestamps of the spans built. The default is one millisecond.
+func WithTreeTimeStep(d time.Duration) TreeOption {
+	return treeOptionFunc(func(cfg treeConfig) treeConfig {
+		cfg.timeStep = d
+		return cfg
+	})
+}
+
+// WithTreeResource returns a [TreeOption] that sets the resource of the spans
+// built. By default, the resource is nil.
+func WithTreeResource(res *resource.Resource) TreeOption {
+	return treeOptionFunc(func(cfg treeConfig) treeConfig {
+		cfg.resource = res
+		return cfg
+	})
+}
+
+// WithTreeInstrumentationScope returns a [TreeOption] that sets the
+// instrumentation scope of the spans built.
+func WithTreeInstrumentationScope(scope instrumentation.Scope) TreeOption {
+	return treeOptionFunc(func(cfg treeConfig) treeConfig {
+		cfg.scope = scope
+		return cfg
+	})
+}
+
+// SpanNode is a span of a tree built by a [TreeBuilder].
+//
+// Its methods return the SpanNode so that calls can be chained.
+type SpanNode struct {
+	builder  *TreeBuilder
+	parent   *SpanNode
+	children []*SpanNode
+
+	name       string
+	kind       trace.SpanKind
+	attributes []attribute.KeyValue
+	events     []tracesdk.Event
+	links      []treeLink
+	status     tracesdk.Status
+
+	traceID          trace.TraceID
+	spanID           trace.SpanID
+	startTime        time.Time
+	endTime          time.Time
+	startSet, endSet bool
+}
+
+// treeLink is a link to a span of a tree that is not built yet.
+type treeLink struct {
+	to         *SpanNode
+	attributes []attribute.KeyValue
+}
+
+// Root adds a span named name as the root of a new trace.
+func (b *TreeBuilder) Root(name string) *SpanNode {
+	n := b.newNode(name, nil)
+	b.roots = append(b.roots, n)
+	return n
+}
+
+// Child adds a span named name as a child of n.
+func (n *SpanNode) Child(name string) *SpanNode {
+	c := n.builder.newNode(name, n)
+	n.children = append(n.children, c)
+	return c
+}
+
+func (b *TreeBuilder) newNode(name string, parent *SpanNode) *SpanNode {
+	n := &SpanNode{builder: b, parent: parent, name: name, kind: trace.SpanKindInternal}
+	b.spans = append(b.spans, n)
+	return n
+}
+
+// SetKind sets the kind of n. The default is [trace.SpanKindInternal].
+func (n *SpanNode) SetKind(kind trace.SpanKind) *SpanNode {
+	n.kind = kind
+	return n
+}
+
+// SetAttributes adds attrs to the attributes of n.
+func (n *SpanNode) SetAttributes(attrs ...attribute.KeyValue) *SpanNode {
+	n.attributes = append(n.attributes, attrs...)
+	return n
+}
+
+// AddEvent adds an event named name with attrs to n. Its time is set when the
+// tree is built.
+func (n *SpanNode) AddEvent(name string, attrs ...attribute.KeyValue) *SpanNode {
+	n.events = append(n.events, tracesdk.Event{Name: name, Attributes: attrs})
+	return n
+}
+
+// AddLink adds a link from n to the span to, with attrs. The span to can be
+// part of any tree of the same [TreeBuilder].
+func (n *SpanNode) AddLink(to *SpanNode, attrs ...attribute.KeyValue) *SpanNode {
+	n.links = append(n.links, treeLink{to: to, attributes: attrs})
+	return n
+}
+
+// SetStatus sets the status of n.
+func (n *SpanNode) SetStatus(code codes.Code, description string) *SpanNode {
+	n.status = tracesdk.Status{Code: code, Description: description}
+	return n
+}
+
+// SetTraceID sets the trace ID of the tree of n. It needs to be called on the
+// root of the tree.
+func (n *SpanNode) SetTraceID(id trace.TraceID) *SpanNode {
+	n.traceID = id
+	return n
+}
+
+// SetSpanID sets the span ID of n.
+func (n *SpanNode) SetSpanID(id trace.SpanID) *SpanNode {
+	n.spanID = id
+	return n
+}
+
+// SetStartTime sets the start time of n. The clock of the [TreeBuilder] is
+// not affected.
+func (n *SpanNode) SetStartTime(t time.Time) *SpanNode {
+	n.startTime, n.startSet = t, true
+	return n
+}
+
+// SetEndTime sets the end time of n. The clock of the [TreeBuilder] is not
+// affected.
+func (n *SpanNode) SetEndTime(t time.Time) *SpanNode {
+	n.endTime, n.endSet = t, true
+	return n
+}
+
+// Build returns the spans of b in the order they were added.
+//
+// Build can be called multiple times. The returned spans are the same for
+// the same tree.
+func (b *TreeBuilder) Build() SpanStubs {
+	if len(b.spans) == 0 {
+		return nil
+	}
+
+	// Assign the IDs first so the links can refer to any span.
+	var traceSeq, spanSeq uint64
+	traceIDs := make(map[*SpanNode]trace.TraceID, len(b.roots))
+	for _, r := range b.roots {
+		id := r.traceID
+		if !id.IsValid() {
+			traceSeq++
+			binary.BigEndian.PutUint64(id[8:], traceSeq)
+		}
+		traceIDs[r] = id
+	}
+	spanCtx := make(map[*SpanNode]trace.SpanContext, len(b.spans))
+	for _, n := range b.spans {
+		id := n.spanID
+		if !id.IsValid() {
+			spanSeq++
+			binary.BigEndian.PutUint64(id[:], spanSeq)
+		}
+		spanCtx[n] = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceIDs[n.root()],
+			SpanID:     id,
+			TraceFlags: trace.FlagsSampled,
+		})
+	}
+
+	stubs := make(map[*SpanNode]*SpanStub, len(b.spans))
+	clock := b.cfg.startTime
+	tick := func() time.Time {
+		t := clock
+		clock = clock.Add(b.cfg.timeStep)
+		return t
+	}
+	var build func(n *SpanNode)
+	build = func(n *SpanNode) {
+		s := &SpanStub{
+			Name:                   n.name,
+			SpanContext:            spanCtx[n],
+			SpanKind:               n.kind,
+			Attributes:             append([]attribute.KeyValue(nil), n.attributes...),
+			Status:                 n.status,
+			ChildSpanCount:         len(n.children),
+			Resource:               b.cfg.resource,
+			InstrumentationLibrary: b.cfg.scope,
+		}
+		if n.parent != nil {
+			s.Parent = spanCtx[n.parent]
+		}
+		s.StartTime = tick()
+		if len(n.events) > 0 {
+			s.Events = make([]tracesdk.Event, len(n.events))
+			for i, e := range n.events {
+				e.Time = tick()
+				s.Events[i] = e
+			}
+		}
+		for _, l := range n.links {
+			s.Links = append(s.Links, tracesdk.Link{
+				SpanContext: spanCtx[l.to],
+				Attributes:  l.attributes,
+			})
+		}
+		for _, c := range n.children {
+			build(c)
+		}
+		s.EndTime = tick()
+		if n.startSet {
+			s.StartTime = n.startTime
+		}
+		if n.endSet {
+			s.EndTime = n.endTime
+		}
+		stubs[n] = s
+	}
+	for _, r := range b.roots {
+		build(r)
+	}
+
+	out := make(SpanStubs, len(b.spans))
+	for i, n := range b.spans {
+		out[i] = *stubs[n]
+	}
+	return out
+}
+
+// root returns the root of the tree of n.
+func (n *SpanNode) root() *SpanNode {
+	for n.parent != nil {
+		n = n.parent
+	}
+	return n
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTreeBuilderEmpty(t *testing.T) {
+	assert.Nil(t, NewTreeBuilder().Build())
+}
+
+func TestTreeBuilder(t *testing.T) {
+	start := time.Unix(100, 0)
+	res := resource.NewSchemaless(attribute.String("service.name", "test"))
+	scope := instrumentation.Scope{Name: "scope"}
+	b := NewTreeBuilder(
+		WithTreeStartTime(start),
+		WithTreeTimeStep(time.Second),
+		WithTreeResource(res),
+		WithTreeInstrumentationScope(scope),
+	)
+
+	root := b.Root("root").SetKind(trace.SpanKindServer)
+	child := root.Child("child").
+		SetAttributes(attribute.Int("i", 1)).
+		AddEvent("event", attribute.Bool("b", true)).
+		SetStatus(codes.Error, "failed")
+	grandchild := child.Child("grandchild")
+	b.Root("other").AddLink(grandchild, attribute.String("k", "v"))
+
+	spans := b.Build()
+	require.Len(t, spans, 4)
+	r, c, g, o := spans[0], spans[1], spans[2], spans[3]
+
+	traceID := func(n uint64) trace.TraceID {
+		return trace.TraceID{15: byte(n)}
+	}
+	spanID := func(n uint64) trace.SpanID {
+		return trace.SpanID{7: byte(n)}
+	}
+	at := func(n int) time.Time {
+		return start.Add(time.Duration(n) * time.Second)
+	}
+
+	assert.Equal(t, "root", r.Name)
+	assert.Equal(t, trace.SpanKindServer, r.SpanKind)
+	assert.Equal(t, traceID(1), r.SpanContext.TraceID())
+	assert.Equal(t, spanID(1), r.SpanContext.SpanID())
+	assert.True(t, r.SpanContext.IsSampled(), "root not sampled")
+	assert.False(t, r.Parent.IsValid(), "root has parent")
+	assert.Equal(t, 1, r.ChildSpanCount)
+	assert.Equal(t, at(0), r.StartTime)
+	assert.Equal(t, at(6), r.EndTime)
+	assert.Same(t, res, r.Resource)
+	assert.Equal(t, scope, r.InstrumentationLibrary)
+
+	assert.Equal(t, trace.SpanKindInternal, c.SpanKind)
+	assert.Equal(t, traceID(1), c.SpanContext.TraceID())
+	assert.Equal(t, spanID(2), c.SpanContext.SpanID())
+	assert.Equal(t, r.SpanContext, c.Parent)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("i", 1)}, c.Attributes)
+	assert.Equal(t, []tracesdk.Event{{
+		Name:       "event",
+		Attributes: []attribute.KeyValue{attribute.Bool("b", true)},
+		Time:       at(2),
+	}}, c.Events)
+	assert.Equal(t, tracesdk.Status{Code: codes.Error, Description: "failed"}, c.Status)
+	assert.Equal(t, at(1), c.StartTime)
+	assert.Equal(t, at(5), c.EndTime)
+
+	assert.Equal(t, c.SpanContext, g.Parent)
+	assert.Equal(t, 0, g.ChildSpanCount)
+	assert.Equal(t, at(3), g.StartTime)
+	assert.Equal(t, at(4), g.EndTime)
+
+	assert.Equal(t, traceID(2), o.SpanContext.TraceID())
+	assert.Equal(t, spanID(4), o.SpanContext.SpanID())
+	assert.Equal(t, []tracesdk.Link{{
+		SpanContext: g.SpanContext,
+		Attributes:  []attribute.KeyValue{attribute.String("k", "v")},
+	}}, o.Links)
+	assert.Equal(t, at(7), o.StartTime)
+	assert.Equal(t, at(8), o.EndTime)
+
+	assert.Equal(t, spans, b.Build(), "Build not deterministic")
+}
+
+func TestTreeBuilderOverrides(t *testing.T) {
+	tid := trace.TraceID{1, 2, 3}
+	sid := trace.SpanID{4, 5, 6}
+	start := time.Unix(10, 0)
+	end := time.Unix(20, 0)
+
+	b := NewTreeBuilder()
+	root := b.Root("root").SetTraceID(tid).SetStartTime(start).SetEndTime(end)
+	root.Child("child").SetSpanID(sid)
+
+	spans := b.Build()
+	require.Len(t, spans, 2)
+	assert.Equal(t, tid, spans[0].SpanContext.TraceID())
+	assert.Equal(t, trace.SpanID{7: 1}, spans[0].SpanContext.SpanID())
+	assert.Equal(t, start, spans[0].StartTime)
+	assert.Equal(t, end, spans[0].EndTime)
+
+	assert.Equal(t, tid, spans[1].SpanContext.TraceID())
+	assert.Equal(t, sid, spans[1].SpanContext.SpanID())
+	// The clock is not affected by the overridden times of the root.
+	assert.Equal(t, defaultTreeStartTime.Add(defaultTreeTimeStep), spans[1].StartTime)
+}
+
+func TestTreeBuilderSnapshots(t *testing.T) {
+	b := NewTreeBuilder()
+	b.Root("root").Child("child")
+
+	ro := b.Build().Snapshots()
+	require.Len(t, ro, 2)
+	assert.Equal(t, ro[0].SpanContext(), ro[1].Parent())
+	assert.Equal(t, 1, ro[0].ChildSpanCount())
+}
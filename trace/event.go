@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/trace"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Event is an event added to a Span with [AddEvent]. It is the struct
+// equivalent of the name and EventOption values passed to Span.AddEvent.
+// Unlike these options, it can be built without any allocation and reused
+// across calls (e.g. when declared as a package variable).
+type Event struct {
+	// Name is the name of the event.
+	Name string
+	// Attributes describe the event. They may be retained by the Span and
+	// must not be modified after the Event is added.
+	Attributes []attribute.KeyValue
+	// Timestamp is the time the event occurred. If it is zero, the time the
+	// event is added is used.
+	Timestamp time.Time
+}
+
+// EventAdder is implemented by a Span able to add an Event directly,
+// without building the EventOption values it is equivalent to.
+//
+// This interface is implemented by the spans of the OpenTelemetry SDK.
+// Implementations of Span may implement it to support the efficient addition
+// of events with [AddEvent].
+type EventAdder interface {
+	// AddEventValue adds e to the Span. It is equivalent to calling AddEvent
+	// with the name, attributes, and timestamp of e.
+	AddEventValue(e Event)
+}
+
+// AddEvent adds e to span. It is equivalent to calling span.AddEvent with
+// the name of e and the WithAttributes and WithTimestamp options of its
+// fields. It avoids allocating these options in hot paths.
+//
+// If span is not recording, nothing is done. If span does not implement
+// EventAdder, e is added by calling span.AddEvent.
+func AddEvent(span Span, e Event) {
+	if !span.IsRecording() {
+		return
+	}
+	if a, ok := span.(EventAdder); ok {
+		a.AddEventValue(e)
+		return
+	}
+
+	var opts []EventOption
+	if len(e.Attributes) > 0 {
+		opts = append(opts, WithAttributes(e.Attributes...))
+	}
+	if !e.Timestamp.IsZero() {
+		opts = append(opts, WithTimestamp(e.Timestamp))
+	}
+	span.AddEvent(e.Name, opts...)
+}
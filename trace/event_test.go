@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// eventSpan is a recording Span that does not implement EventAdder.
+type eventSpan struct {
+	noopSpan
+
+	name string
+	cfg  EventConfig
+}
+
+func (*eventSpan) IsRecording() bool { return true }
+
+func (s *eventSpan) AddEvent(name string, opts ...EventOption) {
+	s.name = name
+	s.cfg = NewEventConfig(opts...)
+}
+
+// eventAdderSpan is a recording Span implementing EventAdder.
+type eventAdderSpan struct {
+	eventSpan
+
+	event Event
+}
+
+func (s *eventAdderSpan) AddEventValue(e Event) { s.event = e }
+
+func TestAddEvent(t *testing.T) {
+	e := Event{
+		Name:       "event",
+		Attributes: []attribute.KeyValue{attribute.String("key", "value")},
+		Timestamp:  time.Unix(1, 0),
+	}
+
+	t.Run("Fallback", func(t *testing.T) {
+		s := new(eventSpan)
+		AddEvent(s, e)
+		assert.Equal(t, e.Name, s.name)
+		assert.Equal(t, e.Attributes, s.cfg.Attributes())
+		assert.Equal(t, e.Timestamp, s.cfg.Timestamp())
+	})
+
+	t.Run("FallbackDefaultTimestamp", func(t *testing.T) {
+		s := new(eventSpan)
+		before := time.Now()
+		AddEvent(s, Event{Name: "event"})
+		assert.Empty(t, s.cfg.Attributes())
+		assert.False(t, s.cfg.Timestamp().Before(before))
+	})
+
+	t.Run("EventAdder", func(t *testing.T) {
+		s := new(eventAdderSpan)
+		AddEvent(s, e)
+		assert.Equal(t, e, s.event)
+		assert.Empty(t, s.name, "AddEvent called")
+	})
+
+	t.Run("NotRecording", func(t *testing.T) {
+		assert.NotPanics(t, func() { AddEvent(noopSpan{}, e) })
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/trace"
+
+import "context"
+
+// StartInternalSpan starts a span with tracer that has the SpanKindInternal
+// kind. The returned context and Span are the same as the ones returned by
+// tracer.Start.
+//
+// The kind of the span cannot be changed by opts. Any WithSpanKind option in
+// opts is overridden.
+func StartInternalSpan(ctx context.Context, tracer Tracer, spanName string, opts ...SpanStartOption) (context.Context, Span) {
+	return startSpanWithKind(ctx, tracer, spanName, SpanKindInternal, opts)
+}
+
+// StartServerSpan starts a span with tracer that has the SpanKindServer kind.
+// It is used to instrument the handling of a synchronous request from a
+// remote client. The returned context and Span are the same as the ones
+// returned by tracer.Start.
+//
+// The kind of the span cannot be changed by opts. Any WithSpanKind option in
+// opts is overridden.
+func StartServerSpan(ctx context.Context, tracer Tracer, spanName string, opts ...SpanStartOption) (context.Context, Span) {
+	return startSpanWithKind(ctx, tracer, spanName, SpanKindServer, opts)
+}
+
+// StartClientSpan starts a span with tracer that has the SpanKindClient kind.
+// It is used to instrument a synchronous request made to a remote service.
+// The returned context and Span are the same as the ones returned by
+// tracer.Start.
+//
+// The kind of the span cannot be changed by opts. Any WithSpanKind option in
+// opts is overridden.
+func StartClientSpan(ctx context.Context, tracer Tracer, spanName string, opts ...SpanStartOption) (context.Context, Span) {
+	return startSpanWithKind(ctx, tracer, spanName, SpanKindClient, opts)
+}
+
+// StartProducerSpan starts a span with tracer that has the SpanKindProducer
+// kind. It is used to instrument the creation of a message that is processed
+// asynchronously by a consumer. The returned context and Span are the same as
+// the ones returned by tracer.Start.
+//
+// The kind of the span cannot be changed by opts. Any WithSpanKind option in
+// opts is overridden.
+func StartProducerSpan(ctx context.Context, tracer Tracer, spanName string, opts ...SpanStartOption) (context.Context, Span) {
+	return startSpanWithKind(ctx, tracer, spanName, SpanKindProducer, opts)
+}
+
+// StartConsumerSpan starts a span with tracer that has the SpanKindConsumer
+// kind. It is used to instrument the processing of a message created by a
+// producer. The returned context and Span are the same as the ones returned
+// by tracer.Start.
+//
+// The kind of the span cannot be changed by opts. Any WithSpanKind option in
+// opts is overridden.
+func StartConsumerSpan(ctx context.Context, tracer Tracer, spanName string, opts ...SpanStartOption) (context.Context, Span) {
+	return startSpanWithKind(ctx, tracer, spanName, SpanKindConsumer, opts)
+}
+
+func startSpanWithKind(ctx context.Context, tracer Tracer, spanName string, kind SpanKind, opts []SpanStartOption) (context.Context, Span) {
+	// Options are applied in order, the last WithSpanKind wins. Do not modify
+	// the underlying array of the passed opts.
+	opts = append(opts[:len(opts):len(opts)], WithSpanKind(kind))
+	return tracer.Start(ctx, spanName, opts...)
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+type configTracer struct {
+	embedded.Tracer
+
+	name   string
+	config SpanConfig
+}
+
+func (t *configTracer) Start(ctx context.Context, name string, opts ...SpanStartOption) (context.Context, Span) {
+	t.name = name
+	t.config = NewSpanStartConfig(opts...)
+	return ctx, noopSpanInstance
+}
+
+func TestStartSpanWithKind(t *testing.T) {
+	type startFunc func(context.Context, Tracer, string, ...SpanStartOption) (context.Context, Span)
+
+	testCases := []struct {
+		name  string
+		start startFunc
+		want  SpanKind
+	}{
+		{"Internal", StartInternalSpan, SpanKindInternal},
+		{"Server", StartServerSpan, SpanKindServer},
+		{"Client", StartClientSpan, SpanKindClient},
+		{"Producer", StartProducerSpan, SpanKindProducer},
+		{"Consumer", StartConsumerSpan, SpanKindConsumer},
+	}
+
+	attr := attribute.String("key", "value")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracer := &configTracer{}
+			opts := make([]SpanStartOption, 2, 3)
+			opts[0] = WithAttributes(attr)
+			opts[1] = WithSpanKind(SpanKindUnspecified)
+
+			tc.start(context.Background(), tracer, "span", opts...)
+
+			assert.Equal(t, "span", tracer.name)
+			assert.Equal(t, tc.want, tracer.config.SpanKind(), "kind not enforced")
+			assert.Equal(t, []attribute.KeyValue{attr}, tracer.config.Attributes(), "options not passed")
+			assert.Nil(t, opts[:3][2], "passed options modified")
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracetest is a testing helper package for the users of the
+// OpenTelemetry trace API.
+//
+// It provides a [Span] recording the calls made to it, e.g. to SetStatus and
+// RecordError, and a [Recorder], a TracerProvider starting such spans. It
+// only depends on the trace API, it lets the instrumentation libraries assert
+// their spans without adding the OpenTelemetry SDK to their dependencies. Use
+// the go.opentelemetry.io/otel/sdk/trace/tracetest package to test the SDK
+// processing of the spans instead.
+package tracetest // import "go.opentelemetry.io/otel/trace/tracetest"
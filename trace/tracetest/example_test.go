@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/tracetest"
+)
+
+// fetch is the instrumented function of a library.
+func fetch(ctx context.Context, tracer trace.Tracer) error {
+	_, span := tracer.Start(ctx, "fetch")
+	defer span.End()
+
+	err := errors.New("connection refused")
+	span.RecordError(err)
+	span.SetStatus(codes.Error, "fetch failed")
+	return err
+}
+
+func Example() {
+	// Use the Recorder as the TracerProvider of the tested library.
+	rec := tracetest.NewRecorder()
+	_ = fetch(context.Background(), rec.Tracer("example"))
+
+	span := rec.Ended()[0]
+	fmt.Println(span.Name())
+	fmt.Println(span.Status().Code, span.Status().Description)
+	fmt.Println(span.Errors())
+	// Output:
+	// fetch
+	// Error fetch failed
+	// [connection refused]
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/trace/tracetest"
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+// Compile-time check Recorder implements trace.TracerProvider.
+var _ trace.TracerProvider = (*Recorder)(nil)
+
+// Recorder is a [trace.TracerProvider] starting recording [Span] values and
+// storing them in-memory.
+//
+// The spans started by a Recorder have a valid span context. Their trace ID
+// is the one of their parent, or a new one for a root span. The IDs are
+// generated sequentially, they are deterministic but not random.
+//
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	embedded.TracerProvider
+
+	mu    sync.Mutex
+	spans []*Span
+	ids   uint64
+}
+
+// NewRecorder returns a new [Recorder].
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Tracer returns a [trace.Tracer] starting the spans recorded by r. The
+// instrumentation scope is not recorded.
+func (r *Recorder) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return tracer{recorder: r}
+}
+
+// Started returns the spans started by r, in the order they were started.
+func (r *Recorder) Started() []*Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Span(nil), r.spans...)
+}
+
+// Ended returns the spans started by r that are ended, in the order they
+// were started.
+func (r *Recorder) Ended() []*Span {
+	var out []*Span
+	for _, s := range r.Started() {
+		if s.Ended() {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Reset clears the spans stored by r.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = nil
+}
+
+// start starts and stores a new Span named name, child of the span in ctx.
+func (r *Recorder) start(ctx context.Context, name string, options []trace.SpanStartOption) *Span {
+	cfg := trace.NewSpanStartConfig(options...)
+	var parent trace.SpanContext
+	if !cfg.NewRoot() {
+		parent = trace.SpanContextFromContext(ctx)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ids++
+	scc := trace.SpanContextConfig{TraceFlags: trace.FlagsSampled}
+	binary.BigEndian.PutUint64(scc.SpanID[:], r.ids)
+	if parent.IsValid() {
+		scc.TraceID = parent.TraceID()
+		scc.TraceState = parent.TraceState()
+	} else {
+		binary.BigEndian.PutUint64(scc.TraceID[8:], r.ids)
+	}
+
+	s := newSpan(name, trace.NewSpanContext(scc), parent, r, options)
+	r.spans = append(r.spans, s)
+	return s
+}
+
+// tracer is the trace.Tracer of a Recorder.
+type tracer struct {
+	embedded.Tracer
+
+	recorder *Recorder
+}
+
+// Start starts a new Span, child of the span in ctx, and returns it with a
+// child context of ctx holding it.
+func (t tracer) Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	s := t.recorder.start(ctx, name, options)
+	return trace.ContextWithSpan(ctx, s), s
+}
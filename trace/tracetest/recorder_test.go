@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder()
+	tracer := r.Tracer("name")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	_, root := tracer.Start(ctx, "root", trace.WithNewRoot())
+	child.End()
+
+	assert.Equal(t, parent, trace.SpanFromContext(ctx))
+	assert.Equal(t, r, parent.TracerProvider())
+
+	started := r.Started()
+	require.Len(t, started, 3)
+	assert.Equal(t, []*Span{started[1]}, r.Ended())
+
+	psc := parent.SpanContext()
+	assert.True(t, psc.IsValid())
+	assert.True(t, psc.IsSampled())
+	assert.False(t, started[0].Parent().IsValid())
+
+	csc := child.SpanContext()
+	assert.Equal(t, psc.TraceID(), csc.TraceID())
+	assert.NotEqual(t, psc.SpanID(), csc.SpanID())
+	assert.Equal(t, psc, started[1].Parent())
+
+	rsc := root.SpanContext()
+	assert.NotEqual(t, psc.TraceID(), rsc.TraceID())
+	assert.False(t, started[2].Parent().IsValid())
+
+	r.Reset()
+	assert.Empty(t, r.Started())
+}
+
+func TestRecorderConcurrentSafe(t *testing.T) {
+	r := NewRecorder()
+	tracer := r.Tracer("name")
+
+	const goroutines = 5
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, s := tracer.Start(context.Background(), "span")
+			s.SetAttributes()
+			s.End()
+			_ = r.Ended()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, r.Started(), goroutines)
+}
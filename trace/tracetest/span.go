@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest // import "go.opentelemetry.io/otel/trace/tracetest"
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Compile-time check Span implements trace.Span.
+var _ trace.Span = (*Span)(nil)
+
+// Status is the status of a [Span].
+type Status struct {
+	// Code is the status code.
+	Code codes.Code
+	// Description is the description of an [codes.Error] status.
+	Description string
+}
+
+// Span is a [trace.Span] recording the calls made to it in-memory.
+//
+// A Span is recording until it is ended, the calls made after End are
+// ignored. Its status, events, and attributes are recorded as the
+// OpenTelemetry SDK does, so they can be asserted the same way: a status set
+// with [codes.Unset] or after a [codes.Ok] status is ignored, the description
+// of a status is only kept for [codes.Error], and an error passed to
+// RecordError is recorded as an exception event.
+//
+// Use [NewSpan] or a [Recorder] to create a Span.
+//
+// A Span is safe for concurrent use.
+type Span struct {
+	embedded.Span
+
+	sc       trace.SpanContext
+	parent   trace.SpanContext
+	kind     trace.SpanKind
+	start    time.Time
+	provider trace.TracerProvider
+
+	mu     sync.Mutex
+	name   string
+	attrs  []attribute.KeyValue
+	events []trace.Event
+	links  []trace.Link
+	status Status
+	errs   []error
+	end    time.Time
+	ended  bool
+}
+
+// NewSpan returns a new recording [Span] named name, configured with
+// options. The span context of the returned Span is invalid, use a
+// [Recorder] to start a Span with a valid one.
+func NewSpan(name string, options ...trace.SpanStartOption) *Span {
+	return newSpan(name, trace.SpanContext{}, trace.SpanContext{}, noop.NewTracerProvider(), options)
+}
+
+// newSpan returns a new recording Span.
+func newSpan(name string, sc, parent trace.SpanContext, tp trace.TracerProvider, options []trace.SpanStartOption) *Span {
+	cfg := trace.NewSpanStartConfig(options...)
+	start := cfg.Timestamp()
+	if start.IsZero() {
+		start = time.Now()
+	}
+	s := &Span{
+		sc:       sc,
+		parent:   parent,
+		kind:     cfg.SpanKind(),
+		start:    start,
+		provider: tp,
+		name:     name,
+		links:    slices.Clone(cfg.Links()),
+	}
+	s.setAttributes(cfg.Attributes())
+	return s
+}
+
+// SpanContext returns the span context of the span.
+func (s *Span) SpanContext() trace.SpanContext { return s.sc }
+
+// IsRecording returns true until the span is ended.
+func (s *Span) IsRecording() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.ended
+}
+
+// SetStatus records the status of the span. The status is not changed if
+// code is [codes.Unset] or the span has a [codes.Ok] status. The description
+// is only recorded for the [codes.Error] code.
+func (s *Span) SetStatus(code codes.Code, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended || s.status.Code > code {
+		return
+	}
+	status := Status{Code: code}
+	if code == codes.Error {
+		status.Description = description
+	}
+	s.status = status
+}
+
+// SetAttributes records the attributes of the span. The value of an
+// attribute whose key is already recorded is overwritten.
+func (s *Span) SetAttributes(kv ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.setAttributes(kv)
+}
+
+// setAttributes records kv. The lock of s needs to be held.
+func (s *Span) setAttributes(kv []attribute.KeyValue) {
+	for _, a := range kv {
+		if !a.Valid() {
+			continue
+		}
+		i := slices.IndexFunc(s.attrs, func(b attribute.KeyValue) bool { return a.Key == b.Key })
+		if i < 0 {
+			s.attrs = append(s.attrs, a)
+		} else {
+			s.attrs[i] = a
+		}
+	}
+}
+
+// End ends the span. The end time is the one configured with options, or the
+// time of the call.
+func (s *Span) End(options ...trace.SpanEndOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	cfg := trace.NewSpanEndConfig(options...)
+	s.end = cfg.Timestamp()
+	if s.end.IsZero() {
+		s.end = time.Now()
+	}
+	s.ended = true
+}
+
+// RecordError records err, and adds an exception event holding the type and
+// the message of err to the span. Nothing is recorded if err is nil.
+func (s *Span) RecordError(err error, options ...trace.EventOption) {
+	if err == nil {
+		return
+	}
+	options = append(options, trace.WithAttributes(
+		semconv.ExceptionType(typeStr(err)),
+		semconv.ExceptionMessage(err.Error()),
+	))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.errs = append(s.errs, err)
+	s.addEvent(semconv.ExceptionEventName, options)
+}
+
+// typeStr returns the type name of i, including its package path.
+func typeStr(i any) string {
+	t := reflect.TypeOf(i)
+	if t.PkgPath() == "" && t.Name() == "" {
+		// Likely a builtin type.
+		return t.String()
+	}
+	return fmt.Sprintf("%s.%s", t.PkgPath(), t.Name())
+}
+
+// AddEvent adds an event named name to the span. The event timestamp is the
+// one configured with options, or the time of the call.
+func (s *Span) AddEvent(name string, options ...trace.EventOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.addEvent(name, options)
+}
+
+// addEvent adds an event. The lock of s needs to be held.
+func (s *Span) addEvent(name string, options []trace.EventOption) {
+	cfg := trace.NewEventConfig(options...)
+	ts := cfg.Timestamp()
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	s.events = append(s.events, trace.Event{
+		Name:       name,
+		Attributes: cfg.Attributes(),
+		Timestamp:  ts,
+	})
+}
+
+// AddLink adds link to the span. A link with an invalid span context and no
+// attributes is ignored.
+func (s *Span) AddLink(link trace.Link) {
+	if !link.SpanContext.IsValid() && len(link.Attributes) == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.links = append(s.links, link)
+}
+
+// SetName sets the name of the span.
+func (s *Span) SetName(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.name = name
+}
+
+// TracerProvider returns the TracerProvider that started the span, a
+// [Recorder], or a no-op TracerProvider if the span was created with
+// [NewSpan].
+func (s *Span) TracerProvider() trace.TracerProvider { return s.provider }
+
+// Parent returns the span context of the parent of the span. It is invalid
+// if the span is a root span.
+func (s *Span) Parent() trace.SpanContext { return s.parent }
+
+// SpanKind returns the kind of the span.
+func (s *Span) SpanKind() trace.SpanKind { return s.kind }
+
+// StartTime returns the start time of the span.
+func (s *Span) StartTime() time.Time { return s.start }
+
+// Name returns the name of the span.
+func (s *Span) Name() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.name
+}
+
+// Attributes returns the attributes of the span, in the order their key was
+// first set.
+func (s *Span) Attributes() []attribute.KeyValue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.attrs)
+}
+
+// Events returns the events added to the span, including the exception
+// events of the recorded errors.
+func (s *Span) Events() []trace.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.events)
+}
+
+// Links returns the links of the span.
+func (s *Span) Links() []trace.Link {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.links)
+}
+
+// Status returns the status of the span.
+func (s *Span) Status() Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+// Errors returns the errors passed to RecordError.
+func (s *Span) Errors() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.errs)
+}
+
+// Ended returns if the span is ended.
+func (s *Span) Ended() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ended
+}
+
+// EndTime returns the end time of the span. It is zero if the span is not
+// ended.
+func (s *Span) EndTime() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.end
+}
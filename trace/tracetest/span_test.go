@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewSpan(t *testing.T) {
+	start := time.Unix(1, 0)
+	link := trace.Link{Attributes: []attribute.KeyValue{attribute.Int("l", 1)}}
+	s := NewSpan(
+		"name",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(attribute.String("a", "b")),
+		trace.WithLinks(link),
+	)
+
+	assert.True(t, s.IsRecording())
+	assert.False(t, s.SpanContext().IsValid())
+	assert.False(t, s.Parent().IsValid())
+	assert.Equal(t, "name", s.Name())
+	assert.Equal(t, trace.SpanKindClient, s.SpanKind())
+	assert.Equal(t, start, s.StartTime())
+	assert.Equal(t, []attribute.KeyValue{attribute.String("a", "b")}, s.Attributes())
+	assert.Equal(t, []trace.Link{link}, s.Links())
+	assert.NotNil(t, s.TracerProvider())
+}
+
+func TestSpanSetStatus(t *testing.T) {
+	s := NewSpan("name")
+	assert.Equal(t, Status{}, s.Status())
+
+	s.SetStatus(codes.Error, "failed")
+	assert.Equal(t, Status{Code: codes.Error, Description: "failed"}, s.Status())
+
+	s.SetStatus(codes.Unset, "")
+	assert.Equal(t, Status{Code: codes.Error, Description: "failed"}, s.Status(), "unset ignored")
+
+	s.SetStatus(codes.Ok, "ignored description")
+	assert.Equal(t, Status{Code: codes.Ok}, s.Status())
+
+	s.SetStatus(codes.Error, "failed")
+	assert.Equal(t, Status{Code: codes.Ok}, s.Status(), "ok is final")
+}
+
+func TestSpanRecordError(t *testing.T) {
+	s := NewSpan("name")
+	err := errors.New("boom")
+	now := time.Unix(2, 0)
+	s.RecordError(nil)
+	s.RecordError(err, trace.WithTimestamp(now), trace.WithAttributes(attribute.Bool("retry", true)))
+
+	assert.Equal(t, []error{err}, s.Errors())
+	assert.Equal(t, []trace.Event{{
+		Name: semconv.ExceptionEventName,
+		Attributes: []attribute.KeyValue{
+			attribute.Bool("retry", true),
+			semconv.ExceptionType("*errors.errorString"),
+			semconv.ExceptionMessage("boom"),
+		},
+		Timestamp: now,
+	}}, s.Events())
+	assert.Equal(t, Status{}, s.Status(), "status not set")
+}
+
+func TestSpanRecording(t *testing.T) {
+	s := NewSpan("name")
+	s.SetName("renamed")
+	s.SetAttributes(attribute.Int("a", 1), attribute.Int("b", 2), attribute.Int("a", 3), attribute.KeyValue{})
+	s.AddEvent("event")
+	s.AddLink(trace.Link{})
+	link := trace.Link{Attributes: []attribute.KeyValue{attribute.Int("l", 1)}}
+	s.AddLink(link)
+
+	assert.Equal(t, "renamed", s.Name())
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("a", 3), attribute.Int("b", 2)}, s.Attributes())
+	events := s.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, "event", events[0].Name)
+	assert.False(t, events[0].Timestamp.IsZero())
+	assert.Equal(t, []trace.Link{link}, s.Links())
+}
+
+func TestSpanEnd(t *testing.T) {
+	s := NewSpan("name")
+	assert.False(t, s.Ended())
+	assert.True(t, s.EndTime().IsZero())
+
+	end := time.Unix(3, 0)
+	s.End(trace.WithTimestamp(end))
+	assert.True(t, s.Ended())
+	assert.False(t, s.IsRecording())
+	assert.Equal(t, end, s.EndTime())
+
+	// Calls after End are ignored.
+	s.End()
+	s.SetName("ignored")
+	s.SetStatus(codes.Error, "ignored")
+	s.SetAttributes(attribute.Int("ignored", 1))
+	s.RecordError(errors.New("ignored"))
+	s.AddEvent("ignored")
+	s.AddLink(trace.Link{Attributes: []attribute.KeyValue{attribute.Int("ignored", 1)}})
+
+	assert.Equal(t, end, s.EndTime())
+	assert.Equal(t, "name", s.Name())
+	assert.Equal(t, Status{}, s.Status())
+	assert.Empty(t, s.Attributes())
+	assert.Empty(t, s.Errors())
+	assert.Empty(t, s.Events())
+	assert.Empty(t, s.Links())
+}